@@ -0,0 +1,487 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/muka/go-bluetooth/logger"
+)
+
+// AdapterEventType describe a change of the available adapters
+type AdapterEventType string
+
+const (
+	// AdapterAdded an adapter is available, eg. a dongle has been plugged
+	AdapterAdded AdapterEventType = "added"
+	// AdapterRemoved an adapter is gone, eg. a dongle has been unplugged or
+	// bluetoothd stopped
+	AdapterRemoved AdapterEventType = "removed"
+	// AdapterPowered the Powered property of an adapter changed
+	AdapterPowered AdapterEventType = "powered"
+)
+
+// ErrAdapterNotFound is returned when no available adapter matches
+var ErrAdapterNotFound = errors.New("Adapter not found")
+
+// AdapterEvent is emitted when an adapter is added, removed or powered on or off
+type AdapterEvent struct {
+	Type AdapterEventType
+	Path dbus.ObjectPath
+	// ID of the adapter, eg. hci0. It may change when a dongle is plugged again.
+	ID string
+	// Address of the adapter
+	Address string
+	Powered bool
+}
+
+// managedAdapter is an available adapter
+type managedAdapter struct {
+	adapter *adapter.Adapter1
+	id      string
+	address string
+	powered bool
+}
+
+// AdapterManager track the adapters as they are added and removed, eg.
+// USB dongles unplugged and enumerated again with another hciN. Adapters can
+// be selected by address, which does not change.
+type AdapterManager struct {
+	conn *bluez.Conn
+
+	lock     sync.Mutex
+	adapters map[dbus.ObjectPath]*managedAdapter
+	// changed is closed and replaced on each change of adapters
+	changed chan struct{}
+	events  chan *AdapterEvent
+	closed  bool
+
+	sub           *bluez.Subscription
+	serviceCancel func()
+	done          chan struct{}
+	exited        chan struct{}
+}
+
+// NewAdapterManager create an AdapterManager on the shared system bus connection
+func NewAdapterManager() (*AdapterManager, error) {
+	return NewAdapterManagerWithConn(nil)
+}
+
+// NewAdapterManagerWithConn create an AdapterManager on a connection, nil for
+// the shared system bus connection
+func NewAdapterManagerWithConn(conn *bluez.Conn) (*AdapterManager, error) {
+
+	dbusConn, err := conn.DBusConn()
+	if err != nil {
+		return nil, err
+	}
+
+	// subscribe before loading the adapters to not miss any change
+	sub, err := bluez.GetDispatcher(dbusConn).Subscribe(
+		bluez.SignalMatch{
+			Path:      "/",
+			Interface: "org.freedesktop.DBus.ObjectManager",
+		},
+		bluez.SignalMatch{
+			PathNamespace: bluez.OrgBluezPath,
+			Interface:     bluez.PropertiesInterface,
+			Member:        "PropertiesChanged",
+			Arg0:          adapter.Adapter1Interface,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	service, serviceCancel, err := bluez.WatchServiceWithConn(conn)
+	if err != nil {
+		sub.Unsubscribe()
+		return nil, err
+	}
+
+	m := &AdapterManager{
+		conn:          conn,
+		adapters:      make(map[dbus.ObjectPath]*managedAdapter),
+		changed:       make(chan struct{}),
+		sub:           sub,
+		serviceCancel: serviceCancel,
+		done:          make(chan struct{}),
+		exited:        make(chan struct{}),
+	}
+
+	err = m.load()
+	if err != nil {
+		serviceCancel()
+		sub.Unsubscribe()
+		return nil, err
+	}
+
+	go m.run(service)
+
+	return m, nil
+}
+
+// Events return the changes of the adapters. Once called, the events must be
+// consumed as the manager waits for them to be received. The channel is
+// closed by Close.
+func (m *AdapterManager) Events() <-chan *AdapterEvent {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.events == nil {
+		m.events = make(chan *AdapterEvent)
+		if m.closed {
+			close(m.events)
+		}
+	}
+	return m.events
+}
+
+// Adapters return the available adapters, ordered by path
+func (m *AdapterManager) Adapters() []*adapter.Adapter1 {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	paths := []string{}
+	for path := range m.adapters {
+		paths = append(paths, string(path))
+	}
+	sort.Strings(paths)
+
+	list := []*adapter.Adapter1{}
+	for _, path := range paths {
+		list = append(list, m.adapters[dbus.ObjectPath(path)].adapter)
+	}
+	return list
+}
+
+// GetAdapter return the available adapter with adapterID, eg. hci0
+func (m *AdapterManager) GetAdapter(adapterID string) (*adapter.Adapter1, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, a := range m.adapters {
+		if a.id == adapterID {
+			return a.adapter, nil
+		}
+	}
+	return nil, ErrAdapterNotFound
+}
+
+// GetAdapterByAddress return the available adapter with address
+func (m *AdapterManager) GetAdapterByAddress(address string) (*adapter.Adapter1, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.getAdapterByAddress(address)
+}
+
+func (m *AdapterManager) getAdapterByAddress(address string) (*adapter.Adapter1, error) {
+	for _, a := range m.adapters {
+		if strings.EqualFold(a.address, address) {
+			return a.adapter, nil
+		}
+	}
+	return nil, ErrAdapterNotFound
+}
+
+// WaitAdapter return the adapter with address, waiting for it to be added if
+// it is not available yet
+func (m *AdapterManager) WaitAdapter(ctx context.Context, address string) (*adapter.Adapter1, error) {
+	for {
+		m.lock.Lock()
+		a, err := m.getAdapterByAddress(address)
+		changed := m.changed
+		m.lock.Unlock()
+
+		if err == nil {
+			return a, nil
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-m.done:
+			return nil, ErrAdapterNotFound
+		}
+	}
+}
+
+// Close stop tracking the adapters and close the events channel
+func (m *AdapterManager) Close() {
+
+	m.lock.Lock()
+	if m.closed {
+		m.lock.Unlock()
+		return
+	}
+	m.closed = true
+	close(m.done)
+	m.lock.Unlock()
+
+	m.serviceCancel()
+	m.sub.Unsubscribe()
+	<-m.exited
+
+	m.lock.Lock()
+	if m.events != nil {
+		close(m.events)
+	}
+	m.lock.Unlock()
+}
+
+// load the adapters currently available
+func (m *AdapterManager) load() error {
+
+	om, err := bluez.GetObjectManagerWithConn(m.conn)
+	if err != nil {
+		return err
+	}
+	objects, err := om.GetManagedObjects()
+	if err != nil {
+		return err
+	}
+
+	for path, ifaces := range objects {
+		if _, ok := ifaces[adapter.Adapter1Interface]; ok {
+			m.add(path)
+		}
+	}
+	return nil
+}
+
+// run handle the signals until Close is called
+func (m *AdapterManager) run(service <-chan *bluez.ServiceEvent) {
+	defer close(m.exited)
+
+	signals := m.sub.Signals()
+	for {
+		select {
+		case sig, ok := <-signals:
+			if !ok {
+				return
+			}
+			if !m.handleSignal(sig) {
+				return
+			}
+		case ev, ok := <-service:
+			if !ok {
+				service = nil
+				continue
+			}
+			if !m.handleService(ev) {
+				return
+			}
+		case <-m.done:
+			return
+		}
+	}
+}
+
+// handleSignal process an object manager or properties signal, it return
+// false once the manager is closed
+func (m *AdapterManager) handleSignal(sig *dbus.Signal) bool {
+
+	if len(sig.Body) < 2 {
+		return true
+	}
+
+	switch sig.Name {
+	case bluez.InterfacesAdded:
+		path, ok := sig.Body[0].(dbus.ObjectPath)
+		if !ok {
+			return true
+		}
+		ifaces, _ := sig.Body[1].(map[string]map[string]dbus.Variant)
+		if _, ok := ifaces[adapter.Adapter1Interface]; ok {
+			return m.send(m.add(path))
+		}
+	case bluez.InterfacesRemoved:
+		path, ok := sig.Body[0].(dbus.ObjectPath)
+		if !ok {
+			return true
+		}
+		ifaces, _ := sig.Body[1].([]string)
+		for _, iface := range ifaces {
+			if iface == adapter.Adapter1Interface {
+				return m.send(m.remove(path))
+			}
+		}
+	case bluez.PropertiesChanged:
+		changed, _ := sig.Body[1].(map[string]dbus.Variant)
+		variant, ok := changed["Powered"]
+		if !ok {
+			return true
+		}
+		powered, ok := variant.Value().(bool)
+		if !ok {
+			return true
+		}
+		return m.send(m.setPowered(sig.Path, powered))
+	}
+
+	return true
+}
+
+// handleService drop the adapters when bluetoothd stops and load them again
+// when it starts
+func (m *AdapterManager) handleService(ev *bluez.ServiceEvent) bool {
+
+	if ev.Type == bluez.ServiceStarted {
+		var objects map[dbus.ObjectPath]map[string]map[string]dbus.Variant
+		om, err := bluez.GetObjectManagerWithConn(m.conn)
+		if err == nil {
+			objects, err = om.GetManagedObjects()
+		}
+		if err != nil {
+			logger.New(m.conn.Logger()).WithMethod("GetManagedObjects").Warnf("AdapterManager: %s", err)
+			return true
+		}
+		for path, ifaces := range objects {
+			if _, ok := ifaces[adapter.Adapter1Interface]; ok {
+				if !m.send(m.add(path)) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	m.lock.Lock()
+	paths := []dbus.ObjectPath{}
+	for path := range m.adapters {
+		paths = append(paths, path)
+	}
+	m.lock.Unlock()
+
+	for _, path := range paths {
+		if !m.send(m.remove(path)) {
+			return false
+		}
+	}
+	return true
+}
+
+// add an adapter, it return nil if it is already known
+func (m *AdapterManager) add(path dbus.ObjectPath) *AdapterEvent {
+
+	m.lock.Lock()
+	_, exists := m.adapters[path]
+	m.lock.Unlock()
+	if exists {
+		return nil
+	}
+
+	a, err := adapter.NewAdapter1WithConn(m.conn, path)
+	if err != nil {
+		// removed meanwhile
+		logger.New(m.conn.Logger()).Debugf("AdapterManager: %s: %s", path, err)
+		return nil
+	}
+
+	id, err := adapter.ParseAdapterID(path)
+	if err != nil {
+		id = ""
+	}
+
+	ma := &managedAdapter{
+		adapter: a,
+		id:      id,
+		address: a.Properties.Address,
+		powered: a.Properties.Powered,
+	}
+
+	m.lock.Lock()
+	m.adapters[path] = ma
+	m.notify()
+	m.lock.Unlock()
+
+	return &AdapterEvent{
+		Type:    AdapterAdded,
+		Path:    path,
+		ID:      ma.id,
+		Address: ma.address,
+		Powered: ma.powered,
+	}
+}
+
+// remove an adapter and invalidate the clients cached for it, it return nil
+// if it is not known
+func (m *AdapterManager) remove(path dbus.ObjectPath) *AdapterEvent {
+
+	m.lock.Lock()
+	ma, ok := m.adapters[path]
+	delete(m.adapters, path)
+	if ok {
+		m.notify()
+	}
+	m.lock.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	ma.adapter.Close()
+	forgetAdapter(m.conn, path)
+
+	return &AdapterEvent{
+		Type:    AdapterRemoved,
+		Path:    path,
+		ID:      ma.id,
+		Address: ma.address,
+	}
+}
+
+// setPowered record a change of Powered, it return nil if the adapter is not known
+func (m *AdapterManager) setPowered(path dbus.ObjectPath, powered bool) *AdapterEvent {
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	ma, ok := m.adapters[path]
+	if !ok || ma.powered == powered {
+		return nil
+	}
+	ma.powered = powered
+
+	return &AdapterEvent{
+		Type:    AdapterPowered,
+		Path:    path,
+		ID:      ma.id,
+		Address: ma.address,
+		Powered: powered,
+	}
+}
+
+// notify wake up the callers of WaitAdapter, the lock must be held
+func (m *AdapterManager) notify() {
+	close(m.changed)
+	m.changed = make(chan struct{})
+}
+
+// send emit ev if not nil and Events has been called, it return false once
+// the manager is closed
+func (m *AdapterManager) send(ev *AdapterEvent) bool {
+
+	if ev == nil {
+		return true
+	}
+
+	m.lock.Lock()
+	events := m.events
+	m.lock.Unlock()
+
+	if events == nil {
+		return true
+	}
+
+	select {
+	case events <- ev:
+		return true
+	case <-m.done:
+		return false
+	}
+}
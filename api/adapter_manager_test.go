@@ -0,0 +1,137 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/muka/go-bluetooth/api"
+	"github.com/muka/go-bluetooth/bluez/mock"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDongleAddress = "11:22:33:44:55:66"
+
+func nextAdapterEvent(t *testing.T, events <-chan *api.AdapterEvent) *api.AdapterEvent {
+	select {
+	case ev := <-events:
+		return ev
+	case <-time.After(mock.WaitFor):
+		t.Fatal("Timeout waiting for adapter event")
+	}
+	return nil
+}
+
+func TestAdapterManager(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	m, err := api.NewAdapterManager()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+	events := m.Events()
+
+	assert.Len(t, m.Adapters(), 1)
+	a, err := m.GetAdapter(mock.TestAdapterID)
+	assert.NoError(t, err)
+	if assert.NotNil(t, a) {
+		assert.Equal(t, mock.AdapterPath(mock.TestAdapterID), a.Path())
+	}
+
+	// wait for a dongle not yet plugged
+	waited := make(chan *adapter.Adapter1, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), mock.WaitFor)
+		defer cancel()
+		a, err := m.WaitAdapter(ctx, testDongleAddress)
+		assert.NoError(t, err)
+		waited <- a
+	}()
+
+	_, err = b.AddAdapter("hci1", map[string]interface{}{
+		"Address": testDongleAddress,
+		"Powered": false,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ev := nextAdapterEvent(t, events)
+	assert.Equal(t, api.AdapterAdded, ev.Type)
+	assert.Equal(t, "hci1", ev.ID)
+	assert.Equal(t, testDongleAddress, ev.Address)
+	assert.False(t, ev.Powered)
+
+	select {
+	case a := <-waited:
+		if assert.NotNil(t, a) {
+			assert.Equal(t, mock.AdapterPath("hci1"), a.Path())
+		}
+	case <-time.After(mock.WaitFor):
+		t.Fatal("Timeout waiting for adapter")
+	}
+
+	err = b.SetProperty(mock.AdapterPath("hci1"), adapter.Adapter1Interface, "Powered", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev = nextAdapterEvent(t, events)
+	assert.Equal(t, api.AdapterPowered, ev.Type)
+	assert.True(t, ev.Powered)
+
+	// cached instances are dropped when the dongle is unplugged
+	cached, err := api.GetAdapter("hci1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = b.RemoveObject(mock.AdapterPath("hci1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev = nextAdapterEvent(t, events)
+	assert.Equal(t, api.AdapterRemoved, ev.Type)
+	assert.Equal(t, testDongleAddress, ev.Address)
+
+	_, err = m.GetAdapterByAddress(testDongleAddress)
+	assert.Equal(t, api.ErrAdapterNotFound, err)
+	_, err = api.GetAdapter("hci1")
+	assert.Error(t, err)
+
+	// plugged again with another id
+	_, err = b.AddAdapter("hci2", map[string]interface{}{
+		"Address": testDongleAddress,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev = nextAdapterEvent(t, events)
+	assert.Equal(t, api.AdapterAdded, ev.Type)
+	assert.Equal(t, "hci2", ev.ID)
+
+	a, err = m.GetAdapterByAddress(testDongleAddress)
+	assert.NoError(t, err)
+	if assert.NotNil(t, a) {
+		assert.Equal(t, mock.AdapterPath("hci2"), a.Path())
+		assert.NotEqual(t, cached, a)
+	}
+
+	// the adapters are gone while bluetoothd is stopped
+	err = b.Restart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := map[api.AdapterEventType]int{}
+	for i := 0; i < 4; i++ {
+		types[nextAdapterEvent(t, events).Type]++
+	}
+	assert.Equal(t, 2, types[api.AdapterRemoved])
+	assert.Equal(t, 2, types[api.AdapterAdded])
+	assert.Len(t, m.Adapters(), 2)
+
+	m.Close()
+	_, ok := <-events
+	assert.False(t, ok)
+}
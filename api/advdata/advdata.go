@@ -0,0 +1,138 @@
+// Package advdata decodes and encodes BLE advertising data (AD) structures,
+// as found in the Device1 AdvertisingData, ManufacturerData and ServiceData
+// properties, and produces the LEAdvertisement1 Data property.
+package advdata
+
+import (
+	"github.com/godbus/dbus/v5"
+)
+
+// AD types, see Bluetooth Assigned Numbers "Common Data Types"
+const (
+	TypeFlags                   byte = 0x01
+	TypeIncompleteUUID16        byte = 0x02
+	TypeCompleteUUID16          byte = 0x03
+	TypeIncompleteUUID32        byte = 0x04
+	TypeCompleteUUID32          byte = 0x05
+	TypeIncompleteUUID128       byte = 0x06
+	TypeCompleteUUID128         byte = 0x07
+	TypeShortLocalName          byte = 0x08
+	TypeCompleteLocalName       byte = 0x09
+	TypeTxPower                 byte = 0x0A
+	TypeServiceData16           byte = 0x16
+	TypeAppearance              byte = 0x19
+	TypeServiceData32           byte = 0x20
+	TypeServiceData128          byte = 0x21
+	TypeURI                     byte = 0x24
+	TypeLESupportedFeatures     byte = 0x27
+	TypeMeshMessage             byte = 0x2A
+	TypeMeshBeacon              byte = 0x2B
+	TypeManufacturerSpecific    byte = 0xFF
+	uuidBase                         = "-0000-1000-8000-00805f9b34fb"
+	meshBeaconUnprovisionedSize      = 19
+	meshBeaconSecureNetworkSize      = 22
+)
+
+// Flags is the content of the Flags AD type
+type Flags byte
+
+// Flags bits
+const (
+	FlagLELimitedDiscoverable Flags = 0x01
+	FlagLEGeneralDiscoverable Flags = 0x02
+	FlagBREDRNotSupported     Flags = 0x04
+	FlagLEBREDRController     Flags = 0x08
+	FlagLEBREDRHost           Flags = 0x10
+)
+
+// Has return true if all the bits of flag are set
+func (f Flags) Has(flag Flags) bool {
+	return f&flag == flag
+}
+
+// UUIDList is a list of service UUIDs of the same size
+type UUIDList struct {
+	// Size is the size of the UUIDs in bits: 16, 32 or 128
+	Size int
+	// Complete is true if the list contains all the services
+	Complete bool
+	// UUIDs in their 128 bit lowercase form
+	UUIDs []string
+}
+
+// ManufacturerData is a manufacturer specific data block
+type ManufacturerData struct {
+	CompanyID uint16
+	Data      []byte
+}
+
+// ServiceData is the data associated with a service UUID
+type ServiceData struct {
+	// Size is the size of the UUID in bits: 16, 32 or 128
+	Size int
+	// UUID in its 128 bit lowercase form
+	UUID string
+	Data []byte
+}
+
+// Mesh beacon types
+const (
+	MeshBeaconUnprovisioned byte = 0x00
+	MeshBeaconSecureNetwork byte = 0x01
+)
+
+// MeshBeacon is a Mesh Beacon AD structure. The fields are set according
+// to the beacon Type, Data holds the undecoded payload after the type.
+type MeshBeacon struct {
+	Type byte
+
+	// Unprovisioned Device beacon
+	DeviceUUID string
+	OOBInfo    uint16
+	URIHash    []byte
+
+	// Secure Network beacon
+	KeyRefresh bool
+	IVUpdate   bool
+	NetworkID  []byte
+	IVIndex    uint32
+	AuthValue  []byte
+
+	Data []byte
+}
+
+// Data contains the decoded advertising data. Optional values are nil when
+// not advertised.
+type Data struct {
+	Flags      *Flags
+	UUIDs      []UUIDList
+	LocalName  string
+	ShortName  bool
+	TxPower    *int8
+	Appearance *uint16
+	URI        string
+	// LESupportedFeatures is the features bit mask, in little endian order
+	LESupportedFeatures []byte
+	MeshBeacons         []MeshBeacon
+	MeshMessages        [][]byte
+	Manufacturer        []ManufacturerData
+	ServiceData         []ServiceData
+	// Other contains the AD types not decoded
+	Other map[byte][]byte
+}
+
+// Bytes return the byte array of a property value, unwrapping variants
+func Bytes(v interface{}) ([]byte, bool) {
+	switch val := v.(type) {
+	case []byte:
+		return val, true
+	case dbus.Variant:
+		return Bytes(val.Value())
+	case *dbus.Variant:
+		if val == nil {
+			return nil, false
+		}
+		return Bytes(val.Value())
+	}
+	return nil, false
+}
@@ -0,0 +1,194 @@
+package advdata
+
+import (
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+
+	payload := []byte{
+		0x02, 0x01, 0x06, // flags
+		0x05, 0x03, 0x0f, 0x18, 0x0a, 0x18, // complete 16 bit UUIDs
+		0x05, 0x04, 0x78, 0x56, 0x34, 0x12, // incomplete 32 bit UUIDs
+		0x11, 0x07, // complete 128 bit UUIDs
+		0xf0, 0xde, 0xbc, 0x9a, 0x78, 0x56, 0x34, 0x12, 0x78, 0x56, 0x34, 0x12, 0x78, 0x56, 0x34, 0x12,
+		0x05, 0x09, 't', 'e', 's', 't', // complete local name
+		0x02, 0x0a, 0xf4, // tx power -12
+		0x05, 0x16, 0xaa, 0xfe, 0x10, 0x20, // service data
+		0x03, 0x19, 0xc1, 0x03, // appearance 0x03c1
+		0x0a, 0x24, 0x17, '/', '/', 'a', '.', 'c', 'o', 'm', '/', // https URI
+		0x03, 0x27, 0x01, 0x02, // LE supported features
+		0x02, 0x30, 0x99, // unknown type
+		0x05, 0xff, 0x4c, 0x00, 0x02, 0x15, // manufacturer data
+		0x00, 0x00, // padding
+	}
+
+	d, err := Decode(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if assert.NotNil(t, d.Flags) {
+		assert.True(t, d.Flags.Has(FlagLEGeneralDiscoverable|FlagBREDRNotSupported))
+		assert.False(t, d.Flags.Has(FlagLELimitedDiscoverable))
+	}
+
+	assert.Equal(t, []UUIDList{
+		{Size: 16, Complete: true, UUIDs: []string{"0000180f-0000-1000-8000-00805f9b34fb", "0000180a-0000-1000-8000-00805f9b34fb"}},
+		{Size: 32, Complete: false, UUIDs: []string{"12345678-0000-1000-8000-00805f9b34fb"}},
+		{Size: 128, Complete: true, UUIDs: []string{"12345678-1234-5678-1234-56789abcdef0"}},
+	}, d.UUIDs)
+
+	assert.Equal(t, "test", d.LocalName)
+	assert.False(t, d.ShortName)
+	assert.Equal(t, int8(-12), *d.TxPower)
+	assert.Equal(t, uint16(0x03c1), *d.Appearance)
+	assert.Equal(t, "https://a.com/", d.URI)
+	assert.Equal(t, []byte{0x01, 0x02}, d.LESupportedFeatures)
+	assert.Equal(t, []ServiceData{{Size: 16, UUID: "0000feaa-0000-1000-8000-00805f9b34fb", Data: []byte{0x10, 0x20}}}, d.ServiceData)
+	assert.Equal(t, []ManufacturerData{{CompanyID: 0x004c, Data: []byte{0x02, 0x15}}}, d.Manufacturer)
+	assert.Equal(t, map[byte][]byte{0x30: {0x99}}, d.Other)
+
+	// encoding restores the payload without padding
+	encoded, err := d.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, payload[:len(payload)-2], encoded)
+}
+
+func TestDecodeInvalid(t *testing.T) {
+
+	payloads := [][]byte{
+		{0x05, 0x01, 0x06},
+		{0x04, 0x03, 0x0f, 0x18, 0x0a},
+		{0x03, 0x0a, 0x01, 0x02},
+		{0x02, 0x19, 0x01},
+		{0x02, 0xff, 0x4c},
+		{0x02, 0x2b, 0x00},
+	}
+
+	for _, payload := range payloads {
+		_, err := Decode(payload)
+		assert.Error(t, err, "payload %x", payload)
+	}
+}
+
+func TestMeshBeacon(t *testing.T) {
+
+	unprovisioned := []byte{
+		0x00,
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10,
+		0x40, 0x20,
+		0xde, 0xad, 0xbe, 0xef,
+	}
+	secure := []byte{
+		0x01, 0x03,
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x00, 0x00, 0x01, 0x02,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+	}
+
+	payload := append([]byte{byte(len(unprovisioned) + 1), TypeMeshBeacon}, unprovisioned...)
+	payload = append(payload, byte(len(secure)+1), TypeMeshBeacon)
+	payload = append(payload, secure...)
+
+	d, err := Decode(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !assert.Len(t, d.MeshBeacons, 2) {
+		return
+	}
+
+	beacon := d.MeshBeacons[0]
+	assert.Equal(t, MeshBeaconUnprovisioned, beacon.Type)
+	assert.Equal(t, "01020304-0506-0708-090a-0b0c0d0e0f10", beacon.DeviceUUID)
+	assert.Equal(t, uint16(0x4020), beacon.OOBInfo)
+	assert.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, beacon.URIHash)
+
+	beacon = d.MeshBeacons[1]
+	assert.Equal(t, MeshBeaconSecureNetwork, beacon.Type)
+	assert.True(t, beacon.KeyRefresh)
+	assert.True(t, beacon.IVUpdate)
+	assert.Equal(t, []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}, beacon.NetworkID)
+	assert.Equal(t, uint32(0x0102), beacon.IVIndex)
+	assert.Equal(t, []byte{0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18}, beacon.AuthValue)
+
+	encoded, err := d.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, payload, encoded)
+
+	// the same AD type cannot be set twice in LEAdvertisement1 Data
+	_, err = d.AdvertisementData()
+	assert.Error(t, err)
+}
+
+func TestFromDevice(t *testing.T) {
+
+	props := &device.Device1Properties{
+		AdvertisingFlags: []byte{0x06},
+		AdvertisingData: map[byte]interface{}{
+			TypeAppearance: dbus.MakeVariant([]byte{0x40, 0x00}),
+			TypeURI:        []byte{0x16, '/', '/', 'x'},
+		},
+		ManufacturerData: map[uint16]interface{}{
+			0x0059: dbus.MakeVariant([]byte{0x01}),
+			0x004c: dbus.MakeVariant([]byte{0x02, 0x15}),
+		},
+		ServiceData: map[string]interface{}{
+			"0000FEAA-0000-1000-8000-00805F9B34FB": dbus.MakeVariant([]byte{0x10}),
+		},
+	}
+
+	d, err := FromDevice(props)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, Flags(0x06), *d.Flags)
+	assert.Equal(t, uint16(0x0040), *d.Appearance)
+	assert.Equal(t, "http://x", d.URI)
+	assert.Equal(t, []ManufacturerData{
+		{CompanyID: 0x004c, Data: []byte{0x02, 0x15}},
+		{CompanyID: 0x0059, Data: []byte{0x01}},
+	}, d.Manufacturer)
+	assert.Equal(t, []ServiceData{{Size: 16, UUID: "0000feaa-0000-1000-8000-00805f9b34fb", Data: []byte{0x10}}}, d.ServiceData)
+
+	props.AdvertisingData[TypeTxPower] = "invalid"
+	_, err = FromDevice(props)
+	assert.Error(t, err)
+}
+
+func TestAdvertisementData(t *testing.T) {
+
+	txPower := int8(4)
+	d := &Data{
+		UUIDs:     []UUIDList{{Size: 16, Complete: true, UUIDs: []string{"180f", "0000180A-0000-1000-8000-00805F9B34FB"}}},
+		LocalName: "dev",
+		ShortName: true,
+		TxPower:   &txPower,
+		URI:       "https://example.com",
+	}
+
+	data, err := d.AdvertisementData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[byte]interface{}{
+		TypeCompleteUUID16: []byte{0x0f, 0x18, 0x0a, 0x18},
+		TypeShortLocalName: []byte("dev"),
+		TypeTxPower:        []byte{0x04},
+		TypeURI:            append([]byte{0x17}, "//example.com"...),
+	}, data)
+
+	d.UUIDs[0].UUIDs = append(d.UUIDs[0].UUIDs, "12345678-1234-5678-1234-56789abcdef0")
+	_, err = d.AdvertisementData()
+	assert.Error(t, err)
+}
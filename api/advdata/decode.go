@@ -0,0 +1,320 @@
+package advdata
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+)
+
+// uriSchemes map the URI scheme codes to their prefix, 0x01 means the
+// scheme is part of the URI
+var uriSchemes = map[byte]string{
+	0x01: "",
+	0x16: "http:",
+	0x17: "https:",
+}
+
+// Decode parse a raw advertising payload, a sequence of length, type and
+// data AD structures
+func Decode(payload []byte) (*Data, error) {
+	d := new(Data)
+	for i := 0; i < len(payload); {
+		size := int(payload[i])
+		// zero length marks the end of significant data
+		if size == 0 {
+			break
+		}
+		if i+1+size > len(payload) {
+			return nil, fmt.Errorf("AD structure at %d: length %d exceeds payload", i, size)
+		}
+		err := d.decodeField(payload[i+1], payload[i+2:i+1+size])
+		if err != nil {
+			return nil, err
+		}
+		i += 1 + size
+	}
+	return d, nil
+}
+
+// FromDevice decode the advertising properties of a device: AdvertisingFlags,
+// AdvertisingData, ManufacturerData and ServiceData
+func FromDevice(props *device.Device1Properties) (*Data, error) {
+
+	d := new(Data)
+
+	if len(props.AdvertisingFlags) > 0 {
+		err := d.decodeField(TypeFlags, props.AdvertisingFlags)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	types := []int{}
+	for adType := range props.AdvertisingData {
+		types = append(types, int(adType))
+	}
+	sort.Ints(types)
+	for _, adType := range types {
+		value, ok := Bytes(props.AdvertisingData[byte(adType)])
+		if !ok {
+			return nil, fmt.Errorf("AdvertisingData 0x%02x: value is not a byte array", adType)
+		}
+		err := d.decodeField(byte(adType), value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	manufacturer, err := DecodeManufacturerData(props.ManufacturerData)
+	if err != nil {
+		return nil, err
+	}
+	d.Manufacturer = append(d.Manufacturer, manufacturer...)
+
+	serviceData, err := DecodeServiceData(props.ServiceData)
+	if err != nil {
+		return nil, err
+	}
+	d.ServiceData = append(d.ServiceData, serviceData...)
+
+	return d, nil
+}
+
+// DecodeManufacturerData convert a ManufacturerData property, sorted by company ID
+func DecodeManufacturerData(data map[uint16]interface{}) ([]ManufacturerData, error) {
+	list := []ManufacturerData{}
+	for id, value := range data {
+		b, ok := Bytes(value)
+		if !ok {
+			return nil, fmt.Errorf("ManufacturerData 0x%04x: value is not a byte array", id)
+		}
+		list = append(list, ManufacturerData{CompanyID: id, Data: b})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].CompanyID < list[j].CompanyID
+	})
+	return list, nil
+}
+
+// DecodeServiceData convert a ServiceData property, sorted by UUID
+func DecodeServiceData(data map[string]interface{}) ([]ServiceData, error) {
+	list := []ServiceData{}
+	for uuid, value := range data {
+		b, ok := Bytes(value)
+		if !ok {
+			return nil, fmt.Errorf("ServiceData %s: value is not a byte array", uuid)
+		}
+		uuid = strings.ToLower(uuid)
+		list = append(list, ServiceData{Size: uuidSize(uuid), UUID: uuid, Data: b})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].UUID < list[j].UUID
+	})
+	return list, nil
+}
+
+// decodeField decode a single AD structure
+func (d *Data) decodeField(adType byte, value []byte) error {
+
+	invalid := func() error {
+		return fmt.Errorf("AD type 0x%02x: invalid length %d", adType, len(value))
+	}
+
+	switch adType {
+	case TypeFlags:
+		if len(value) < 1 {
+			return invalid()
+		}
+		flags := Flags(value[0])
+		d.Flags = &flags
+
+	case TypeIncompleteUUID16, TypeCompleteUUID16,
+		TypeIncompleteUUID32, TypeCompleteUUID32,
+		TypeIncompleteUUID128, TypeCompleteUUID128:
+		size := uuidListSize(adType)
+		if len(value)%(size/8) != 0 {
+			return invalid()
+		}
+		list := UUIDList{
+			Size:     size,
+			Complete: adType%2 == 1,
+			UUIDs:    []string{},
+		}
+		for i := 0; i < len(value); i += size / 8 {
+			list.UUIDs = append(list.UUIDs, uuidFromBytes(value[i:i+size/8]))
+		}
+		d.UUIDs = append(d.UUIDs, list)
+
+	case TypeShortLocalName, TypeCompleteLocalName:
+		d.LocalName = string(value)
+		d.ShortName = adType == TypeShortLocalName
+
+	case TypeTxPower:
+		if len(value) != 1 {
+			return invalid()
+		}
+		txPower := int8(value[0])
+		d.TxPower = &txPower
+
+	case TypeAppearance:
+		if len(value) != 2 {
+			return invalid()
+		}
+		appearance := binary.LittleEndian.Uint16(value)
+		d.Appearance = &appearance
+
+	case TypeURI:
+		if len(value) < 1 {
+			return invalid()
+		}
+		scheme, ok := uriSchemes[value[0]]
+		if !ok {
+			d.setOther(adType, value)
+			return nil
+		}
+		d.URI = scheme + string(value[1:])
+
+	case TypeLESupportedFeatures:
+		d.LESupportedFeatures = append([]byte{}, value...)
+
+	case TypeMeshBeacon:
+		beacon, err := decodeMeshBeacon(value)
+		if err != nil {
+			return err
+		}
+		d.MeshBeacons = append(d.MeshBeacons, beacon)
+
+	case TypeMeshMessage:
+		d.MeshMessages = append(d.MeshMessages, append([]byte{}, value...))
+
+	case TypeManufacturerSpecific:
+		if len(value) < 2 {
+			return invalid()
+		}
+		d.Manufacturer = append(d.Manufacturer, ManufacturerData{
+			CompanyID: binary.LittleEndian.Uint16(value),
+			Data:      append([]byte{}, value[2:]...),
+		})
+
+	case TypeServiceData16, TypeServiceData32, TypeServiceData128:
+		size := serviceDataSize(adType)
+		if len(value) < size/8 {
+			return invalid()
+		}
+		d.ServiceData = append(d.ServiceData, ServiceData{
+			Size: size,
+			UUID: uuidFromBytes(value[:size/8]),
+			Data: append([]byte{}, value[size/8:]...),
+		})
+
+	default:
+		d.setOther(adType, value)
+	}
+
+	return nil
+}
+
+func (d *Data) setOther(adType byte, value []byte) {
+	if d.Other == nil {
+		d.Other = make(map[byte][]byte)
+	}
+	d.Other[adType] = append([]byte{}, value...)
+}
+
+// decodeMeshBeacon decode a Mesh Beacon, see Mesh Profile 3.9
+func decodeMeshBeacon(value []byte) (MeshBeacon, error) {
+
+	if len(value) < 1 {
+		return MeshBeacon{}, fmt.Errorf("Mesh beacon: empty")
+	}
+
+	beacon := MeshBeacon{
+		Type: value[0],
+		Data: append([]byte{}, value[1:]...),
+	}
+
+	switch beacon.Type {
+	case MeshBeaconUnprovisioned:
+		if len(value) != meshBeaconUnprovisionedSize && len(value) != meshBeaconUnprovisionedSize+4 {
+			return beacon, fmt.Errorf("Unprovisioned Device beacon: invalid length %d", len(value))
+		}
+		beacon.DeviceUUID = formatUUID(value[1:17])
+		beacon.OOBInfo = binary.BigEndian.Uint16(value[17:19])
+		if len(value) > meshBeaconUnprovisionedSize {
+			beacon.URIHash = append([]byte{}, value[19:23]...)
+		}
+
+	case MeshBeaconSecureNetwork:
+		if len(value) != meshBeaconSecureNetworkSize {
+			return beacon, fmt.Errorf("Secure Network beacon: invalid length %d", len(value))
+		}
+		beacon.KeyRefresh = value[1]&0x01 != 0
+		beacon.IVUpdate = value[1]&0x02 != 0
+		beacon.NetworkID = append([]byte{}, value[2:10]...)
+		beacon.IVIndex = binary.BigEndian.Uint32(value[10:14])
+		beacon.AuthValue = append([]byte{}, value[14:22]...)
+	}
+
+	return beacon, nil
+}
+
+// uuidFromBytes convert a little endian UUID to its 128 bit form
+func uuidFromBytes(b []byte) string {
+	switch len(b) {
+	case 2:
+		return fmt.Sprintf("0000%04x%s", binary.LittleEndian.Uint16(b), uuidBase)
+	case 4:
+		return fmt.Sprintf("%08x%s", binary.LittleEndian.Uint32(b), uuidBase)
+	}
+	reversed := make([]byte, len(b))
+	for i := range b {
+		reversed[len(b)-1-i] = b[i]
+	}
+	return formatUUID(reversed)
+}
+
+// formatUUID format 16 big endian bytes as a UUID string
+func formatUUID(b []byte) string {
+	s := hex.EncodeToString(b)
+	return s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:32]
+}
+
+func uuidListSize(adType byte) int {
+	switch adType {
+	case TypeIncompleteUUID16, TypeCompleteUUID16:
+		return 16
+	case TypeIncompleteUUID32, TypeCompleteUUID32:
+		return 32
+	}
+	return 128
+}
+
+func serviceDataSize(adType byte) int {
+	switch adType {
+	case TypeServiceData16:
+		return 16
+	case TypeServiceData32:
+		return 32
+	}
+	return 128
+}
+
+// uuidSize return the smallest size a UUID can be encoded with
+func uuidSize(uuid string) int {
+	switch {
+	case len(uuid) == 4:
+		return 16
+	case len(uuid) == 8:
+		return 32
+	case len(uuid) == 36 && strings.HasSuffix(uuid, uuidBase):
+		if strings.HasPrefix(uuid, "0000") {
+			return 16
+		}
+		return 32
+	}
+	return 128
+}
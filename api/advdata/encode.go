@@ -0,0 +1,269 @@
+package advdata
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxFieldSize is the maximum size of the data of an AD structure
+const maxFieldSize = 254
+
+type field struct {
+	adType byte
+	value  []byte
+}
+
+// Encode return the raw advertising payload. The payload length is not
+// checked against the 31 bytes limit of legacy advertising.
+func (d *Data) Encode() ([]byte, error) {
+	fields, err := d.fields()
+	if err != nil {
+		return nil, err
+	}
+	payload := []byte{}
+	for _, f := range fields {
+		payload = append(payload, byte(len(f.value)+1), f.adType)
+		payload = append(payload, f.value...)
+	}
+	return payload, nil
+}
+
+// AdvertisementData return the AD structures as LEAdvertisement1 Data
+// property, keyed by AD type. Each type can appear once only.
+func (d *Data) AdvertisementData() (map[byte]interface{}, error) {
+	fields, err := d.fields()
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[byte]interface{})
+	for _, f := range fields {
+		if _, ok := data[f.adType]; ok {
+			return nil, fmt.Errorf("AD type 0x%02x: duplicated type", f.adType)
+		}
+		data[f.adType] = f.value
+	}
+	return data, nil
+}
+
+// fields return the AD structures, manufacturer data last as most devices do
+func (d *Data) fields() ([]field, error) {
+
+	fields := []field{}
+	add := func(adType byte, value []byte) error {
+		if len(value) > maxFieldSize {
+			return fmt.Errorf("AD type 0x%02x: data too long (%d bytes)", adType, len(value))
+		}
+		fields = append(fields, field{adType, value})
+		return nil
+	}
+
+	if d.Flags != nil {
+		add(TypeFlags, []byte{byte(*d.Flags)})
+	}
+
+	for _, list := range d.UUIDs {
+		adType, err := uuidListType(list)
+		if err != nil {
+			return nil, err
+		}
+		value := []byte{}
+		for _, uuid := range list.UUIDs {
+			b, err := uuidToBytes(uuid, list.Size)
+			if err != nil {
+				return nil, err
+			}
+			value = append(value, b...)
+		}
+		if err := add(adType, value); err != nil {
+			return nil, err
+		}
+	}
+
+	if d.LocalName != "" {
+		adType := TypeCompleteLocalName
+		if d.ShortName {
+			adType = TypeShortLocalName
+		}
+		if err := add(adType, []byte(d.LocalName)); err != nil {
+			return nil, err
+		}
+	}
+
+	if d.TxPower != nil {
+		add(TypeTxPower, []byte{byte(*d.TxPower)})
+	}
+
+	for _, serviceData := range d.ServiceData {
+		adType, err := serviceDataType(serviceData.Size)
+		if err != nil {
+			return nil, err
+		}
+		value, err := uuidToBytes(serviceData.UUID, serviceData.Size)
+		if err != nil {
+			return nil, err
+		}
+		if err := add(adType, append(value, serviceData.Data...)); err != nil {
+			return nil, err
+		}
+	}
+
+	if d.Appearance != nil {
+		value := make([]byte, 2)
+		binary.LittleEndian.PutUint16(value, *d.Appearance)
+		add(TypeAppearance, value)
+	}
+
+	if d.URI != "" {
+		if err := add(TypeURI, encodeURI(d.URI)); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(d.LESupportedFeatures) > 0 {
+		if err := add(TypeLESupportedFeatures, d.LESupportedFeatures); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, msg := range d.MeshMessages {
+		if err := add(TypeMeshMessage, msg); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, beacon := range d.MeshBeacons {
+		value, err := encodeMeshBeacon(beacon)
+		if err != nil {
+			return nil, err
+		}
+		if err := add(TypeMeshBeacon, value); err != nil {
+			return nil, err
+		}
+	}
+
+	types := []int{}
+	for adType := range d.Other {
+		types = append(types, int(adType))
+	}
+	sort.Ints(types)
+	for _, adType := range types {
+		if err := add(byte(adType), d.Other[byte(adType)]); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, manufacturer := range d.Manufacturer {
+		value := make([]byte, 2)
+		binary.LittleEndian.PutUint16(value, manufacturer.CompanyID)
+		if err := add(TypeManufacturerSpecific, append(value, manufacturer.Data...)); err != nil {
+			return nil, err
+		}
+	}
+
+	return fields, nil
+}
+
+func uuidListType(list UUIDList) (byte, error) {
+	var adType byte
+	switch list.Size {
+	case 16:
+		adType = TypeIncompleteUUID16
+	case 32:
+		adType = TypeIncompleteUUID32
+	case 128:
+		adType = TypeIncompleteUUID128
+	default:
+		return 0, fmt.Errorf("UUID list: invalid size %d", list.Size)
+	}
+	if list.Complete {
+		adType++
+	}
+	return adType, nil
+}
+
+func serviceDataType(size int) (byte, error) {
+	switch size {
+	case 16:
+		return TypeServiceData16, nil
+	case 32:
+		return TypeServiceData32, nil
+	case 128:
+		return TypeServiceData128, nil
+	}
+	return 0, fmt.Errorf("ServiceData: invalid UUID size %d", size)
+}
+
+// uuidToBytes encode a UUID in little endian order with the given size in
+// bits. 16 and 32 bit UUIDs can be passed in short or 128 bit form.
+func uuidToBytes(uuid string, size int) ([]byte, error) {
+
+	short := strings.ToLower(uuid)
+
+	if len(short) == 36 && size != 128 {
+		if !strings.HasSuffix(short, uuidBase) || (size == 16 && short[:4] != "0000") {
+			return nil, fmt.Errorf("UUID %s: cannot be shortened to %d bits", uuid, size)
+		}
+		short = short[8-size/4 : 8]
+	}
+
+	b, err := hex.DecodeString(strings.Replace(short, "-", "", -1))
+	if err != nil || len(b) != size/8 {
+		return nil, fmt.Errorf("UUID %s: invalid %d bit UUID", uuid, size)
+	}
+
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return b, nil
+}
+
+// encodeURI replace the URI scheme with its code
+func encodeURI(uri string) []byte {
+	for code, scheme := range uriSchemes {
+		if scheme != "" && strings.HasPrefix(uri, scheme) {
+			return append([]byte{code}, uri[len(scheme):]...)
+		}
+	}
+	return append([]byte{0x01}, uri...)
+}
+
+// encodeMeshBeacon encode a Mesh Beacon from its fields, other beacon types
+// are encoded from Data
+func encodeMeshBeacon(beacon MeshBeacon) ([]byte, error) {
+
+	switch beacon.Type {
+	case MeshBeaconUnprovisioned:
+		deviceUUID, err := hex.DecodeString(strings.Replace(beacon.DeviceUUID, "-", "", -1))
+		if err != nil || len(deviceUUID) != 16 {
+			return nil, fmt.Errorf("Unprovisioned Device beacon: invalid device UUID %s", beacon.DeviceUUID)
+		}
+		if len(beacon.URIHash) != 0 && len(beacon.URIHash) != 4 {
+			return nil, fmt.Errorf("Unprovisioned Device beacon: invalid URI hash length %d", len(beacon.URIHash))
+		}
+		value := append([]byte{beacon.Type}, deviceUUID...)
+		value = append(value, byte(beacon.OOBInfo>>8), byte(beacon.OOBInfo))
+		return append(value, beacon.URIHash...), nil
+
+	case MeshBeaconSecureNetwork:
+		if len(beacon.NetworkID) != 8 || len(beacon.AuthValue) != 8 {
+			return nil, fmt.Errorf("Secure Network beacon: network ID and authentication value must be 8 bytes")
+		}
+		var flags byte
+		if beacon.KeyRefresh {
+			flags |= 0x01
+		}
+		if beacon.IVUpdate {
+			flags |= 0x02
+		}
+		value := append([]byte{beacon.Type, flags}, beacon.NetworkID...)
+		ivIndex := make([]byte, 4)
+		binary.BigEndian.PutUint32(ivIndex, beacon.IVIndex)
+		value = append(value, ivIndex...)
+		return append(value, beacon.AuthValue...), nil
+	}
+
+	return append([]byte{beacon.Type}, beacon.Data...), nil
+}
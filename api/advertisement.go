@@ -5,8 +5,9 @@ import (
 
 	"github.com/godbus/dbus/v5"
 	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
 	"github.com/muka/go-bluetooth/bluez/profile/advertising"
-	log "github.com/sirupsen/logrus"
+	"github.com/muka/go-bluetooth/logger"
 )
 
 // const baseAdvertismentPath = "/org/bluez/%s/apps/advertisement%d"
@@ -59,13 +60,19 @@ func (a *Advertisement) Interface() string {
 }
 
 func NewAdvertisement(adapterID string, props *advertising.LEAdvertisement1Properties) (*Advertisement, error) {
+	return NewAdvertisementWithConn(nil, adapterID, props)
+}
+
+// NewAdvertisementWithConn create an advertisement exposed on a connection,
+// nil for the shared system bus connection
+func NewAdvertisementWithConn(c *bluez.Conn, adapterID string, props *advertising.LEAdvertisement1Properties) (*Advertisement, error) {
 
 	adv := new(Advertisement)
 
 	adv.props = props
 	adv.path = nextAdvertismentPath(adapterID)
 
-	conn, err := dbus.SystemBus()
+	conn, err := c.DBusConn()
 	if err != nil {
 		return nil, err
 	}
@@ -75,12 +82,14 @@ func NewAdvertisement(adapterID string, props *advertising.LEAdvertisement1Prope
 	if err != nil {
 		return nil, err
 	}
+	om.SetLogger(c.Logger())
 	adv.objectManager = om
 
 	iprops, err := NewDBusProperties(conn)
 	if err != nil {
 		return nil, err
 	}
+	iprops.SetLogger(c.Logger())
 	adv.iprops = iprops
 
 	return adv, nil
@@ -88,14 +97,29 @@ func NewAdvertisement(adapterID string, props *advertising.LEAdvertisement1Prope
 
 // Expose to bluez an advertisment instance via the adapter advertisement manager
 func ExposeAdvertisement(adapterID string, props *advertising.LEAdvertisement1Properties, discoverableTimeout uint32) (func(), error) {
+	return ExposeAdvertisementWithConn(nil, adapterID, props, discoverableTimeout)
+}
 
+// ExposeAdvertisementWithConn is ExposeAdvertisement on a connection, nil for
+// the shared system bus connection
+func ExposeAdvertisementWithConn(conn *bluez.Conn, adapterID string, props *advertising.LEAdvertisement1Properties, discoverableTimeout uint32) (func(), error) {
+
+	log := logger.New(conn.Logger()).WithField("adapter", adapterID)
 	log.Tracef("Retrieving adapter instance %s", adapterID)
-	a, err := GetAdapter(adapterID)
+	var (
+		a   *adapter.Adapter1
+		err error
+	)
+	if conn == nil {
+		a, err = GetAdapter(adapterID)
+	} else {
+		a, err = adapter.NewAdapter1FromAdapterIDWithConn(conn, adapterID)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	adv, err := NewAdvertisement(adapterID, props)
+	adv, err := NewAdvertisementWithConn(conn, adapterID, props)
 	if err != nil {
 		return nil, err
 	}
@@ -105,7 +129,7 @@ func ExposeAdvertisement(adapterID string, props *advertising.LEAdvertisement1Pr
 		return nil, err
 	}
 
-	log.Debug("Setup adapter")
+	log.Debugf("Setup adapter")
 	err = a.SetDiscoverable(true)
 	if err != nil {
 		return nil, err
@@ -120,8 +144,8 @@ func ExposeAdvertisement(adapterID string, props *advertising.LEAdvertisement1Pr
 		return nil, err
 	}
 
-	log.Trace("Registering LEAdvertisement1 instance")
-	advManager, err := advertising.NewLEAdvertisingManager1FromAdapterID(adapterID)
+	log.Tracef("Registering LEAdvertisement1 instance")
+	advManager, err := advertising.NewLEAdvertisingManager1FromAdapterIDWithConn(conn, adapterID)
 	if err != nil {
 		return nil, err
 	}
@@ -131,15 +155,25 @@ func ExposeAdvertisement(adapterID string, props *advertising.LEAdvertisement1Pr
 		return nil, err
 	}
 
+	// register again when bluetoothd restarts
+	removeRestore, err := bluez.OnServiceRestartWithConn(conn, func() error {
+		return advManager.RegisterAdvertisement(adv.Path(), map[string]interface{}{})
+	})
+	if err != nil {
+		advManager.UnregisterAdvertisement(adv.Path())
+		return nil, err
+	}
+
 	cancel := func() {
+		removeRestore()
 		decreaseAdvertismentCounter()
 		err := advManager.UnregisterAdvertisement(adv.Path())
 		if err != nil {
-			log.Warn(err)
+			log.WithObject(adv.Path(), advertising.LEAdvertisement1Interface).Warnf("UnregisterAdvertisement: %s", err)
 		}
 		err = a.SetProperty("Discoverable", false)
 		if err != nil {
-			log.Warn(err)
+			a.Client().Logger().Warnf("Set Discoverable: %s", err)
 		}
 	}
 
@@ -2,26 +2,38 @@
 package api
 
 import (
+	"sync"
+
+	"github.com/godbus/dbus/v5"
 	"github.com/muka/go-bluetooth/bluez"
 	"github.com/muka/go-bluetooth/bluez/profile/adapter"
 )
 
-var adapters = map[string]*adapter.Adapter1{}
+var (
+	adaptersLock sync.Mutex
+	adapters     = map[string]*adapter.Adapter1{}
+)
 
 //Exit performs a clean exit
 func Exit() error {
 
+	adaptersLock.Lock()
 	for _, a := range adapters {
 		a.Close()
 	}
-
 	adapters = map[string]*adapter.Adapter1{}
+	adaptersLock.Unlock()
 
 	return bluez.CloseConnections()
 }
 
+// GetAdapter return the adapter with adapterID, eg. hci0. Instances are
+// cached until an AdapterManager sees the adapter disappear.
 func GetAdapter(adapterID string) (*adapter.Adapter1, error) {
 
+	adaptersLock.Lock()
+	defer adaptersLock.Unlock()
+
 	if _, ok := adapters[adapterID]; ok {
 		return adapters[adapterID], nil
 	}
@@ -43,3 +55,16 @@ func GetDefaultAdapter() (*adapter.Adapter1, error) {
 func GetDefaultAdapterID() string {
 	return adapter.GetDefaultAdapterID()
 }
+
+// forgetAdapter drop the instances cached for an adapter that disappeared
+func forgetAdapter(conn *bluez.Conn, path dbus.ObjectPath) {
+
+	adaptersLock.Lock()
+	for id, a := range adapters {
+		if a.Path() == path && a.Client().Conn() == conn {
+			a.Close()
+			delete(adapters, id)
+		}
+	}
+	adaptersLock.Unlock()
+}
@@ -2,29 +2,38 @@ package beacon
 
 import (
 	"context"
+	"errors"
+	"sort"
 	"strings"
 
-	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/api/advdata"
 	"github.com/muka/go-bluetooth/bluez/profile/advertising"
 	"github.com/muka/go-bluetooth/bluez/profile/device"
 )
 
 const appleBit = 0x004C
 
+// ErrInvalidFrame is returned when the beacon frames are malformed
+var ErrInvalidFrame = errors.New("invalid beacon frame")
+
 type BeaconType string
 
 const (
 	BeaconTypeEddystone = "eddystone"
 	BeaconTypeIBeacon   = "ibeacon"
+	BeaconTypeAltBeacon = "altbeacon"
 )
 
 type Beacon struct {
 	Name      string
 	iBeacon   BeaconIBeacon
 	eddystone BeaconEddystone
+	altBeacon BeaconAltBeacon
 	props     *advertising.LEAdvertisement1Properties
-	Type      BeaconType
-	Device    *device.Device1
+	// devProps are the device properties parsed instead of Device.Properties
+	devProps *device.Device1Properties
+	Type     BeaconType
+	Device   *device.Device1
 }
 
 func NewBeacon(dev *device.Device1) (Beacon, error) {
@@ -45,6 +54,11 @@ func (b *Beacon) IsIBeacon() bool {
 	return b.Type == BeaconTypeIBeacon
 }
 
+// IsAltBeacon return if the type of beacon is altbeacon
+func (b *Beacon) IsAltBeacon() bool {
+	return b.Type == BeaconTypeAltBeacon
+}
+
 // WatchDeviceChanges watch for properties changes
 func (b *Beacon) WatchDeviceChanges(ctx context.Context) (chan bool, error) {
 
@@ -91,13 +105,36 @@ func (b *Beacon) GetIBeacon() BeaconIBeacon {
 	return b.iBeacon
 }
 
-// GetFrames return the bytes content
+// GetAltBeacon return altbeacon information
+func (b *Beacon) GetAltBeacon() BeaconAltBeacon {
+	return b.altBeacon
+}
+
+// GetFrames return the bytes content, nil if the beacon type is unknown
 func (b *Beacon) GetFrames() []byte {
+
+	var manufacturerData map[uint16]interface{}
+	var serviceData map[string]interface{}
+	if b.props != nil {
+		manufacturerData = b.props.ManufacturerData
+		serviceData = b.props.ServiceData
+	} else if devProps := b.deviceProperties(); devProps != nil {
+		manufacturerData = devProps.ManufacturerData
+		serviceData = devProps.ServiceData
+	}
+
 	var data interface{}
-	if b.IsIBeacon() {
-		data = b.props.ManufacturerData[appleBit].([]byte)
-	} else {
-		data = b.props.ServiceData[eddystoneSrvcUid].([]byte)
+	switch b.Type {
+	case BeaconTypeIBeacon:
+		data = manufacturerData[appleBit]
+	case BeaconTypeAltBeacon:
+		data = manufacturerData[b.altBeacon.ManufacturerID]
+	case BeaconTypeEddystone:
+		for uuid, frames := range serviceData {
+			if isEddystoneUUID(uuid) {
+				data = frames
+			}
+		}
 	}
 	if dataBytes, ok := b.getBytesFromData(data); ok {
 		return dataBytes
@@ -105,18 +142,21 @@ func (b *Beacon) GetFrames() []byte {
 	return nil
 }
 
-// Load beacon information if available
+// Load beacon information if available. Malformed frames are not
+// reported as beacons.
 func (b *Beacon) Parse() bool {
 
-	if b.Device != nil {
+	if props := b.deviceProperties(); props != nil {
 
-		props := b.Device.Properties
 		if b.parserEddystone(props.UUIDs, props.ServiceData) {
 			return true
 		}
 		if b.parserIBeacon(props.ManufacturerData) {
 			return true
 		}
+		if b.parserAltBeacon(props.ManufacturerData) {
+			return true
+		}
 
 	}
 
@@ -128,58 +168,99 @@ func (b *Beacon) Parse() bool {
 		if b.parserIBeacon(props.ManufacturerData) {
 			return true
 		}
+		if b.parserAltBeacon(props.ManufacturerData) {
+			return true
+		}
 	}
 
 	return false
 }
 
+// deviceProperties return the properties of the beacon device, nil if none
+func (b *Beacon) deviceProperties() *device.Device1Properties {
+	if b.devProps != nil {
+		return b.devProps
+	}
+	if b.Device != nil {
+		return b.Device.Properties
+	}
+	return nil
+}
+
 func (b *Beacon) parserIBeacon(manufacturerData map[uint16]interface{}) bool {
 	if len(manufacturerData) == 0 {
 		return false
 	}
 	if frames, ok := manufacturerData[appleBit]; ok {
 		if frameBytes, ok := b.getBytesFromData(frames); ok {
-			if len(frameBytes) < 22 {
+			info, err := b.ParseIBeacon(frameBytes)
+			if err != nil {
 				return false
 			}
-
 			b.Type = BeaconTypeIBeacon
-			b.iBeacon = b.ParseIBeacon(frameBytes)
+			b.iBeacon = info
 			return true
 		}
 	}
 	return false
 }
 
+func (b *Beacon) parserAltBeacon(manufacturerData map[uint16]interface{}) bool {
+
+	ids := []int{}
+	for id := range manufacturerData {
+		ids = append(ids, int(id))
+	}
+	sort.Ints(ids)
+
+	for _, id := range ids {
+		frameBytes, ok := b.getBytesFromData(manufacturerData[uint16(id)])
+		if !ok {
+			continue
+		}
+		info, err := b.ParseAltBeacon(uint16(id), frameBytes)
+		if err != nil {
+			continue
+		}
+		b.Type = BeaconTypeAltBeacon
+		b.altBeacon = info
+		return true
+	}
+	return false
+}
+
 func (b *Beacon) parserEddystone(UUIDs []string, serviceData map[string]interface{}) bool {
 	for _, uuid := range UUIDs {
-		// 0000feaa-
-		srcUUID := uuid
-		if len(uuid) > 8 {
-			uuid = uuid[4:8]
+		if !isEddystoneUUID(uuid) {
+			continue
 		}
-
-		if strings.ToUpper(uuid) == eddystoneSrvcUid {
-			if data, ok := serviceData[srcUUID]; ok {
-				// log.Debug("Found Eddystone")
-				b.Type = BeaconTypeEddystone
-				// log.Debugf("Eddystone data: %d", data)
-				b.eddystone = b.ParseEddystone(data.([]byte))
-				return true
-			}
+		data, ok := serviceData[uuid]
+		if !ok {
+			continue
+		}
+		frames, ok := b.getBytesFromData(data)
+		if !ok {
+			continue
 		}
+		info, err := b.ParseEddystone(frames)
+		if err != nil {
+			return false
+		}
+		b.Type = BeaconTypeEddystone
+		b.eddystone = info
+		return true
 	}
 	return false
 }
 
-func (b *Beacon) getBytesFromData(data interface{}) ([]byte, bool) {
-	if variant, ok := data.(dbus.Variant); ok {
-		if variantBytes, ok := variant.Value().([]byte); ok {
-			return variantBytes, true
-		}
-	} else if dataBytes, ok := data.([]byte); ok {
-		return dataBytes, true
+// isEddystoneUUID match FEAA in its short or 128 bit form (0000feaa-...)
+func isEddystoneUUID(uuid string) bool {
+	if len(uuid) > 8 {
+		uuid = uuid[4:8]
 	}
+	return strings.ToUpper(uuid) == eddystoneSrvcUid
+}
 
-	return nil, false
+func (b *Beacon) getBytesFromData(data interface{}) ([]byte, bool) {
+	return advdata.Bytes(data)
 }
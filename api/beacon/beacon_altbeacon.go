@@ -0,0 +1,51 @@
+package beacon
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// RadiusNetworksID is the company ID of Radius Networks, the AltBeacon
+// authors. Any manufacturer ID can be used to advertise an AltBeacon.
+const RadiusNetworksID = 0x0118
+
+const (
+	altBeaconLength   = 24
+	altBeaconIDLength = 20
+)
+
+type BeaconAltBeacon struct {
+	ManufacturerID uint16
+	BeaconID       string
+	ReferenceRSSI  int
+	Reserved       byte
+}
+
+// From AltBeacon specifications
+// https://github.com/AltBeacon/spec
+// Byte(s) 	Name 						Value 		Notes
+// 0-1 			MFG ID 					0xnnnn 		Company ID, little endian
+// ---- Bluez data starts here ----
+// 2-3 			Beacon Code 		0xBEAC 		AltBeacon advertisement code
+// 4-23 		Beacon ID 			0xnn..nn 	20 bytes, the first 16 are usually an organizational unit
+// 24 			Reference RSSI 	0xnn 			Signed average RSSI at 1m
+// 25 			MFG Reserved 		0xnn 			Reserved for use by the manufacturer
+func (b *Beacon) ParseAltBeacon(manufacturerID uint16, frames []byte) (BeaconAltBeacon, error) {
+
+	info := BeaconAltBeacon{}
+
+	if len(frames) != altBeaconLength {
+		return info, fmt.Errorf("%w: altbeacon length %d", ErrInvalidFrame, len(frames))
+	}
+	if frames[2-2] != 0xBE || frames[3-2] != 0xAC {
+		return info, fmt.Errorf("%w: altbeacon code 0x%02x%02x", ErrInvalidFrame, frames[2-2], frames[3-2])
+	}
+
+	info.ManufacturerID = manufacturerID
+	info.BeaconID = strings.ToUpper(hex.EncodeToString(frames[4-2 : 24-2]))
+	info.ReferenceRSSI = byteToInt(frames[24-2])
+	info.Reserved = frames[25-2]
+
+	return info, nil
+}
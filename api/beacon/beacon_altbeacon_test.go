@@ -0,0 +1,64 @@
+package beacon
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAltBeacon(t *testing.T) {
+
+	beaconID := "0102030405060708090A0B0C0D0E0F1011121314"
+	b1, err := CreateAltBeacon(RadiusNetworksID, beaconID, -59, 0x42)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dev := &device.Device1{
+		Properties: &device.Device1Properties{
+			Name: "test_altbeacon",
+			ManufacturerData: map[uint16]interface{}{
+				// not an iBeacon
+				appleBit:         []byte{0x10, 0x05, 0x01},
+				RadiusNetworksID: b1.GetFrames(),
+			},
+		},
+	}
+
+	beacon, err := NewBeacon(dev)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	isBeacon := beacon.Parse()
+
+	assert.True(t, isBeacon)
+	assert.True(t, beacon.IsAltBeacon())
+	assert.False(t, beacon.IsIBeacon())
+
+	info := beacon.GetAltBeacon()
+	assert.Equal(t, uint16(RadiusNetworksID), info.ManufacturerID)
+	assert.Equal(t, beaconID, info.BeaconID)
+	assert.Equal(t, -59, info.ReferenceRSSI)
+	assert.Equal(t, byte(0x42), info.Reserved)
+	assert.Equal(t, b1.GetFrames(), beacon.GetFrames())
+}
+
+func TestParseAltBeaconInvalid(t *testing.T) {
+
+	b := Beacon{}
+
+	_, err := b.ParseAltBeacon(RadiusNetworksID, []byte{0xBE, 0xAC, 0x01})
+	assert.True(t, errors.Is(err, ErrInvalidFrame))
+
+	_, err = b.ParseAltBeacon(RadiusNetworksID, make([]byte, altBeaconLength))
+	assert.True(t, errors.Is(err, ErrInvalidFrame))
+
+	_, err = CreateAltBeacon(RadiusNetworksID, "0102", -59, 0)
+	assert.Error(t, err)
+
+	_, err = CreateAltBeacon(RadiusNetworksID, "0102030405060708090A0B0C0D0E0F1011121314", 200, 0)
+	assert.Error(t, err)
+}
@@ -3,6 +3,7 @@ package beacon
 import (
 	"encoding/binary"
 	"encoding/hex"
+	"fmt"
 	"strings"
 
 	"github.com/muka/go-bluetooth/bluez/profile/advertising"
@@ -15,9 +16,14 @@ func initBeacon() (*Beacon, error) {
 	return b, nil
 }
 
-// CreateIBeacon Create a beacon in the IBeacon format
+// CreateIBeacon Create a beacon in the IBeacon format. measuredPower is sent
+// as a single byte and must not exceed 0xFF.
 func CreateIBeacon(uuid string, major uint16, minor uint16, measuredPower uint16) (*Beacon, error) {
 
+	if measuredPower > 0xff {
+		return nil, fmt.Errorf("Measured power 0x%x does not fit a byte", measuredPower)
+	}
+
 	frames := []byte{
 		0x02, 0x15,
 	}
@@ -27,6 +33,9 @@ func CreateIBeacon(uuid string, major uint16, minor uint16, measuredPower uint16
 	if err != nil {
 		return nil, err
 	}
+	if len(uuidBytes) != 16 {
+		return nil, fmt.Errorf("Proximity UUID must be 16 bytes, got %d", len(uuidBytes))
+	}
 	frames = append(frames, uuidBytes...)
 
 	// major 18,19
@@ -40,9 +49,7 @@ func CreateIBeacon(uuid string, major uint16, minor uint16, measuredPower uint16
 	frames = append(frames, minorb...)
 
 	// pwr 22
-	mpwr := make([]byte, 2)
-	binary.BigEndian.PutUint16(mpwr, measuredPower)
-	frames = append(frames, mpwr[1])
+	frames = append(frames, byte(measuredPower))
 
 	b, err := initBeacon()
 	if err != nil {
@@ -92,6 +99,7 @@ func CreateEddystoneURL(url string, txPower int) (*Beacon, error) {
 	b.props.AddServiceData(eddystoneSrvcUid, []byte(frames))
 	b.Type = BeaconTypeEddystone
 	b.eddystone = BeaconEddystone{
+		Frame:             eddystone.URL,
 		URL:               url,
 		CalibratedTxPower: txPower,
 	}
@@ -116,6 +124,7 @@ func CreateEddystoneTLM(batt uint16, temp float32, advCnt, secCnt uint32) (*Beac
 
 	b.Type = BeaconTypeEddystone
 	b.eddystone = BeaconEddystone{
+		Frame:               eddystone.TLM,
 		TLMVersion:          0,
 		TLMTemperature:      temp,
 		TLMAdvertisingPDU:   advCnt,
@@ -138,10 +147,12 @@ func CreateEddystoneUID(namespace, instance string, txPwr int) (*Beacon, error)
 		return nil, err
 	}
 
+	b.props.AddServiceUUID(eddystoneSrvcUid)
 	b.props.AddServiceData(eddystoneSrvcUid, []byte(frames))
 
 	b.Type = BeaconTypeEddystone
 	b.eddystone = BeaconEddystone{
+		Frame:             eddystone.UID,
 		UID:               namespace,
 		InstanceUID:       instance,
 		CalibratedTxPower: txPwr,
@@ -149,3 +160,115 @@ func CreateEddystoneUID(namespace, instance string, txPwr int) (*Beacon, error)
 
 	return b, nil
 }
+
+// CreateEddystoneEID create an eddystone beacon frame with the ephemeral id
+// computed from the identity key, the beacon time counter and the rotation
+// period exponent
+func CreateEddystoneEID(identityKey []byte, timeCounter uint32, exponent byte, txPwr int) (*Beacon, error) {
+
+	if err := checkExponent(exponent); err != nil {
+		return nil, err
+	}
+
+	eid, err := eddystone.ComputeEIDValue(identityKey, timeCounter, exponent)
+	if err != nil {
+		return nil, err
+	}
+
+	frames, err := eddystone.MakeEIDFrameFromBytes(eid, txPwr)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := initBeacon()
+	if err != nil {
+		return nil, err
+	}
+
+	b.props.AddServiceUUID(eddystoneSrvcUid)
+	b.props.AddServiceData(eddystoneSrvcUid, []byte(frames))
+
+	b.Type = BeaconTypeEddystone
+	b.eddystone = BeaconEddystone{
+		Frame:             eddystone.EID,
+		EID:               strings.ToUpper(hex.EncodeToString(eid)),
+		CalibratedTxPower: txPwr,
+	}
+
+	return b, nil
+}
+
+// CreateEddystoneETLM create an eddystone beacon frame with encrypted tlm.
+// The time counter and rotation exponent are the ones of the advertised EID.
+func CreateEddystoneETLM(identityKey []byte, timeCounter uint32, exponent byte, salt uint16, batt uint16, temp float32, advCnt, secCnt uint32) (*Beacon, error) {
+	plain, err := eddystone.MakeTLMFrame(batt, temp, advCnt, secCnt)
+	if err != nil {
+		return nil, err
+	}
+
+	frames, err := encryptTLM(identityKey, timeCounter, exponent, salt, plain)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := initBeacon()
+	if err != nil {
+		return nil, err
+	}
+
+	b.props.AddServiceUUID(eddystoneSrvcUid)
+	b.props.AddServiceData(eddystoneSrvcUid, frames)
+
+	b.Type = BeaconTypeEddystone
+	b.eddystone = BeaconEddystone{
+		Frame:               eddystone.TLM,
+		TLMVersion:          eddystoneTLMEncrypted,
+		TLMTemperature:      temp,
+		TLMAdvertisingPDU:   advCnt,
+		TLMBatteryVoltage:   batt,
+		TLMLastRebootedTime: secCnt,
+		TLMEncrypted:        frames[2:14],
+		TLMSalt:             salt,
+		TLMIntegrityCheck:   binary.BigEndian.Uint16(frames[16:18]),
+	}
+
+	return b, nil
+}
+
+// CreateAltBeacon create a beacon in the AltBeacon format. beaconID is the
+// 20 bytes hex encoded beacon identifier.
+func CreateAltBeacon(manufacturerID uint16, beaconID string, referenceRSSI int, reserved byte) (*Beacon, error) {
+
+	if referenceRSSI < -128 || referenceRSSI > 127 {
+		return nil, fmt.Errorf("Reference RSSI %d does not fit a signed byte", referenceRSSI)
+	}
+
+	idBytes, err := hex.DecodeString(strings.Replace(beaconID, "-", "", -1))
+	if err != nil {
+		return nil, err
+	}
+	if len(idBytes) != altBeaconIDLength {
+		return nil, fmt.Errorf("Beacon ID must be %d bytes, got %d", altBeaconIDLength, len(idBytes))
+	}
+
+	frames := []byte{0xBE, 0xAC}
+	frames = append(frames, idBytes...)
+	frames = append(frames, byte(referenceRSSI), reserved)
+
+	b, err := initBeacon()
+	if err != nil {
+		return nil, err
+	}
+
+	b.Type = BeaconTypeAltBeacon
+	b.altBeacon = BeaconAltBeacon{
+		ManufacturerID: manufacturerID,
+		BeaconID:       strings.ToUpper(hex.EncodeToString(idBytes)),
+		ReferenceRSSI:  referenceRSSI,
+		Reserved:       reserved,
+	}
+
+	b.props.AddManifacturerData(manufacturerID, frames)
+
+	return b, nil
+}
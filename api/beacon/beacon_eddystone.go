@@ -1,14 +1,29 @@
 package beacon
 
 import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
 	"strings"
 
-	log "github.com/sirupsen/logrus"
 	eddystone "github.com/suapapa/go_eddystone"
 )
 
 const eddystoneSrvcUid = "FEAA"
 
+// Eddystone frames length
+const (
+	eddystoneUIDMinLength  = 18
+	eddystoneURLMinLength  = 3
+	eddystoneURLMaxLength  = 20
+	eddystoneTLMLength     = 14
+	eddystoneETLMLength    = 18
+	eddystoneEIDLength     = 10
+	eddystoneTLMPlain      = 0x00
+	eddystoneTLMEncrypted  = 0x01
+	eddystoneURLSchemeSize = 4
+)
+
 type BeaconEddystone struct {
 	Frame             eddystone.Header
 	CalibratedTxPower int
@@ -18,37 +33,51 @@ type BeaconEddystone struct {
 
 	URL string
 
-	// eddystone-tlm plain
+	// eddystone-tlm plain, or encrypted once decoded with DecryptTLM
 	TLMVersion          int
 	TLMBatteryVoltage   uint16
 	TLMTemperature      float32
 	TLMAdvertisingPDU   uint32
 	TLMLastRebootedTime uint32
+
+	// eddystone-tlm encrypted
+	TLMEncrypted      []byte
+	TLMSalt           uint16
+	TLMIntegrityCheck uint16
+
+	// eddystone-eid
+	EID string
 }
 
-func (b *Beacon) ParseEddystone(frames []byte) BeaconEddystone {
+// ParseEddystone parse the eddystone service data frames
+func (b *Beacon) ParseEddystone(frames []byte) (BeaconEddystone, error) {
+
 	info := BeaconEddystone{}
+	if len(frames) == 0 {
+		return info, fmt.Errorf("%w: eddystone frames are empty", ErrInvalidFrame)
+	}
+
 	frameHeader := eddystone.Header(frames[0])
 
+	var err error
 	switch frameHeader {
 	case eddystone.UID:
-		info.Frame = frameHeader
-		parseEddystoneUID(&info, frames)
+		err = parseEddystoneUID(&info, frames)
 	case eddystone.TLM:
-		info.Frame = frameHeader
-		parseEddystoneTLM(&info, frames)
+		err = parseEddystoneTLM(&info, frames)
 	case eddystone.URL:
-		info.Frame = frameHeader
-		err := parseEddystoneURL(&info, frames)
-		if err != nil {
-			log.Warn(err)
-		}
+		err = parseEddystoneURL(&info, frames)
 	case eddystone.EID:
-		// TODO
-
+		err = parseEddystoneEID(&info, frames)
+	default:
+		err = fmt.Errorf("%w: unknown eddystone frame 0x%02x", ErrInvalidFrame, frames[0])
+	}
+	if err != nil {
+		return BeaconEddystone{}, err
 	}
 
-	return info
+	info.Frame = frameHeader
+	return info, nil
 }
 
 // eddystone-uid
@@ -74,12 +103,20 @@ func (b *Beacon) ParseEddystone(frames []byte) BeaconEddystone {
 // 17	          BID[5]
 // 18	          RFU	Reserved for future use, must be0x00
 // 19	          RFU	Reserved for future use, must be0x00
-func parseEddystoneUID(info *BeaconEddystone, frames []byte) {
+func parseEddystoneUID(info *BeaconEddystone, frames []byte) error {
+
+	// RFU bytes are omitted by some beacons
+	if len(frames) < eddystoneUIDMinLength {
+		return fmt.Errorf("%w: eddystone-uid length %d", ErrInvalidFrame, len(frames))
+	}
+
 	ns, instance, tx := eddystone.ParseUIDFrame(frames)
 
 	info.CalibratedTxPower = tx
 	info.UID = strings.ToUpper(ns)
 	info.InstanceUID = strings.ToUpper(instance)
+
+	return nil
 }
 
 // eddystone-tlm (plain)
@@ -99,14 +136,47 @@ func parseEddystoneUID(info *BeaconEddystone, frames []byte) {
 // 11	           SEC_CNT[1]
 // 12	           SEC_CNT[2]
 // 13	           SEC_CNT[3]
-func parseEddystoneTLM(info *BeaconEddystone, frames []byte) {
-	batt, temp, advCnt, secCnt := eddystone.ParseTLMFrame(frames)
+//
+// eddystone-tlm (encrypted)
+// https://github.com/google/eddystone/blob/master/eddystone-tlm/tlm-encrypted.md
+// Byte offset   Field	Description
+// 0	           Frame Type	Value = 0x20
+// 1	           Version	TLM version, value = 0x01
+// 2-13	         ETLM	Encrypted TLM data
+// 14-15	       SALT	16 bit salt
+// 16-17	       MIC	16 bit message integrity check
+func parseEddystoneTLM(info *BeaconEddystone, frames []byte) error {
+
+	if len(frames) < 2 {
+		return fmt.Errorf("%w: eddystone-tlm length %d", ErrInvalidFrame, len(frames))
+	}
 
 	info.TLMVersion = int(frames[1] & 0xff)
-	info.TLMBatteryVoltage = batt
-	info.TLMTemperature = temp
-	info.TLMAdvertisingPDU = advCnt
-	info.TLMLastRebootedTime = secCnt
+
+	switch frames[1] {
+	case eddystoneTLMPlain:
+		if len(frames) != eddystoneTLMLength {
+			return fmt.Errorf("%w: eddystone-tlm length %d", ErrInvalidFrame, len(frames))
+		}
+		batt, temp, advCnt, secCnt := eddystone.ParseTLMFrame(frames)
+		info.TLMBatteryVoltage = batt
+		info.TLMTemperature = temp
+		info.TLMAdvertisingPDU = advCnt
+		info.TLMLastRebootedTime = secCnt
+
+	case eddystoneTLMEncrypted:
+		if len(frames) != eddystoneETLMLength {
+			return fmt.Errorf("%w: eddystone-etlm length %d", ErrInvalidFrame, len(frames))
+		}
+		info.TLMEncrypted = append([]byte{}, frames[2:14]...)
+		info.TLMSalt = binary.BigEndian.Uint16(frames[14:16])
+		info.TLMIntegrityCheck = binary.BigEndian.Uint16(frames[16:18])
+
+	default:
+		return fmt.Errorf("%w: unknown eddystone-tlm version %d", ErrInvalidFrame, frames[1])
+	}
+
+	return nil
 }
 
 // Byte offset	Field	Description
@@ -141,9 +211,23 @@ func parseEddystoneTLM(info *BeaconEddystone, frames []byte) {
 // 14..32	   0x0e..0x20    Reserved for Future Use
 // 127..255	 0x7F..0xFF    Reserved for Future Use
 func parseEddystoneURL(info *BeaconEddystone, frames []byte) error {
+
+	if len(frames) < eddystoneURLMinLength || len(frames) > eddystoneURLMaxLength {
+		return fmt.Errorf("%w: eddystone-url length %d", ErrInvalidFrame, len(frames))
+	}
+	if frames[2] >= eddystoneURLSchemeSize {
+		return fmt.Errorf("%w: eddystone-url scheme 0x%02x", ErrInvalidFrame, frames[2])
+	}
+	// go_eddystone does not check the reserved range
+	for _, c := range frames[3:] {
+		if (c >= 0x0e && c <= 0x20) || c >= 0x7f {
+			return fmt.Errorf("%w: eddystone-url reserved byte 0x%02x", ErrInvalidFrame, c)
+		}
+	}
+
 	url, tx, err := eddystone.ParseURLFrame(frames)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w: %s", ErrInvalidFrame, err)
 	}
 
 	info.CalibratedTxPower = tx
@@ -151,3 +235,21 @@ func parseEddystoneURL(info *BeaconEddystone, frames []byte) error {
 
 	return nil
 }
+
+// eddystone-eid
+// https://github.com/google/eddystone/tree/master/eddystone-eid
+// Byte offset	Field	Description
+// 0	          Frame Type	Value = 0x30
+// 1	          Ranging Data	Calibrated Tx power at 0 m
+// 2-9	        EID	8-byte Ephemeral Identifier
+func parseEddystoneEID(info *BeaconEddystone, frames []byte) error {
+
+	if len(frames) != eddystoneEIDLength {
+		return fmt.Errorf("%w: eddystone-eid length %d", ErrInvalidFrame, len(frames))
+	}
+
+	info.CalibratedTxPower = byteToInt(frames[1])
+	info.EID = strings.ToUpper(hex.EncodeToString(frames[2:10]))
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package beacon
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/subtle"
+	"encoding/binary"
+	"fmt"
+)
+
+// eTLM uses AES-EAX with the beacon identity key, a 48 bit nonce made of the
+// EID time counter and the salt, no header and a 16 bit tag
+// https://github.com/google/eddystone/blob/master/eddystone-tlm/tlm-encrypted.md
+const (
+	etlmMICLength  = 2
+	eidMaxExponent = 15
+)
+
+// DecryptTLM decrypt an encrypted TLM frame with the beacon identity key. The
+// time counter and rotation exponent are the ones of the current EID.
+func (e *BeaconEddystone) DecryptTLM(identityKey []byte, timeCounter uint32, exponent byte) error {
+
+	if e.TLMVersion != eddystoneTLMEncrypted || len(e.TLMEncrypted) != eddystoneTLMLength-2 {
+		return fmt.Errorf("%w: not an encrypted TLM frame", ErrInvalidFrame)
+	}
+	if err := checkExponent(exponent); err != nil {
+		return err
+	}
+
+	mic := make([]byte, etlmMICLength)
+	binary.BigEndian.PutUint16(mic, e.TLMIntegrityCheck)
+
+	plain, err := eaxOpen(identityKey, etlmNonce(timeCounter, exponent, e.TLMSalt), nil, e.TLMEncrypted, mic)
+	if err != nil {
+		return err
+	}
+
+	// decode as a plain TLM frame
+	info := BeaconEddystone{}
+	err = parseEddystoneTLM(&info, append([]byte{0x20, eddystoneTLMPlain}, plain...))
+	if err != nil {
+		return err
+	}
+
+	e.TLMBatteryVoltage = info.TLMBatteryVoltage
+	e.TLMTemperature = info.TLMTemperature
+	e.TLMAdvertisingPDU = info.TLMAdvertisingPDU
+	e.TLMLastRebootedTime = info.TLMLastRebootedTime
+
+	return nil
+}
+
+// encryptTLM encrypt a plain TLM frame and return the encrypted frame
+func encryptTLM(identityKey []byte, timeCounter uint32, exponent byte, salt uint16, frames []byte) ([]byte, error) {
+
+	if err := checkExponent(exponent); err != nil {
+		return nil, err
+	}
+
+	ciphertext, tag, err := eaxSeal(identityKey, etlmNonce(timeCounter, exponent, salt), nil, frames[2:])
+	if err != nil {
+		return nil, err
+	}
+
+	etlm := []byte{frames[0], eddystoneTLMEncrypted}
+	etlm = append(etlm, ciphertext...)
+	etlm = append(etlm, byte(salt>>8), byte(salt))
+	return append(etlm, tag[:etlmMICLength]...), nil
+}
+
+func checkExponent(exponent byte) error {
+	if exponent > eidMaxExponent {
+		return fmt.Errorf("Rotation exponent must be between 0 and %d", eidMaxExponent)
+	}
+	return nil
+}
+
+func etlmNonce(timeCounter uint32, exponent byte, salt uint16) []byte {
+	nonce := make([]byte, 6)
+	binary.BigEndian.PutUint32(nonce, timeCounter&^(1<<exponent-1))
+	binary.BigEndian.PutUint16(nonce[4:], salt)
+	return nonce
+}
+
+// eaxSeal encrypt plaintext with AES-EAX
+func eaxSeal(key, nonce, header, plaintext []byte) (ciphertext, tag []byte, err error) {
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := omac(block, 0, nonce)
+	h := omac(block, 1, header)
+
+	ciphertext = make([]byte, len(plaintext))
+	cipher.NewCTR(block, n).XORKeyStream(ciphertext, plaintext)
+
+	c := omac(block, 2, ciphertext)
+	tag = make([]byte, aes.BlockSize)
+	for i := range tag {
+		tag[i] = n[i] ^ h[i] ^ c[i]
+	}
+
+	return ciphertext, tag, nil
+}
+
+// eaxOpen verify the (possibly truncated) tag and decrypt ciphertext
+func eaxOpen(key, nonce, header, ciphertext, tag []byte) ([]byte, error) {
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tag) == 0 || len(tag) > aes.BlockSize {
+		return nil, fmt.Errorf("Invalid tag length %d", len(tag))
+	}
+
+	n := omac(block, 0, nonce)
+	h := omac(block, 1, header)
+	c := omac(block, 2, ciphertext)
+
+	expected := make([]byte, len(tag))
+	for i := range expected {
+		expected[i] = n[i] ^ h[i] ^ c[i]
+	}
+	if subtle.ConstantTimeCompare(expected, tag) != 1 {
+		return nil, fmt.Errorf("%w: message integrity check failed", ErrInvalidFrame)
+	}
+
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCTR(block, n).XORKeyStream(plaintext, ciphertext)
+
+	return plaintext, nil
+}
+
+// omac compute the EAX tweaked CMAC of data
+func omac(block cipher.Block, t byte, data []byte) []byte {
+
+	// subkeys
+	k1 := make([]byte, aes.BlockSize)
+	block.Encrypt(k1, k1)
+	k1 = gfDouble(k1)
+	k2 := gfDouble(k1)
+
+	msg := make([]byte, aes.BlockSize, aes.BlockSize+len(data)+aes.BlockSize)
+	msg[aes.BlockSize-1] = t
+	msg = append(msg, data...)
+
+	// the tweak block makes the message never empty
+	if len(msg)%aes.BlockSize == 0 {
+		xorBytes(msg[len(msg)-aes.BlockSize:], k1)
+	} else {
+		msg = append(msg, 0x80)
+		for len(msg)%aes.BlockSize != 0 {
+			msg = append(msg, 0x00)
+		}
+		xorBytes(msg[len(msg)-aes.BlockSize:], k2)
+	}
+
+	mac := make([]byte, aes.BlockSize)
+	for i := 0; i < len(msg); i += aes.BlockSize {
+		xorBytes(mac, msg[i:i+aes.BlockSize])
+		block.Encrypt(mac, mac)
+	}
+	return mac
+}
+
+// gfDouble multiply by x in GF(2^128)
+func gfDouble(b []byte) []byte {
+	out := make([]byte, len(b))
+	carry := b[0] >> 7
+	for i := 0; i < len(b)-1; i++ {
+		out[i] = b[i]<<1 | b[i+1]>>7
+	}
+	out[len(b)-1] = b[len(b)-1] << 1
+	if carry == 1 {
+		out[len(b)-1] ^= 0x87
+	}
+	return out
+}
+
+func xorBytes(dst, src []byte) {
+	for i := range dst {
+		dst[i] ^= src[i]
+	}
+}
@@ -1,6 +1,8 @@
 package beacon
 
 import (
+	"encoding/hex"
+	"errors"
 	"strings"
 	"testing"
 
@@ -108,3 +110,110 @@ func TestParseEddystoneURL(t *testing.T) {
 	assert.Equal(t, txPwr, e.CalibratedTxPower)
 
 }
+
+func TestParseEddystoneEID(t *testing.T) {
+
+	identityKey := []byte("0123456789abcdef")
+	b, err := CreateEddystoneEID(identityKey, 0x12345678, 10, -20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eid, err := eddystone.ComputeEIDValue(identityKey, 0x12345678, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	beacon := testNewBeacon(t, eddystone.Frame(b.GetFrames()))
+	e := beacon.GetEddystone()
+
+	assert.Equal(t, eddystone.Header(eddystone.EID), e.Frame)
+	assert.Equal(t, strings.ToUpper(hex.EncodeToString(eid)), e.EID)
+	assert.Equal(t, -20, e.CalibratedTxPower)
+
+	_, err = CreateEddystoneEID(identityKey, 0x12345678, 16, -20)
+	assert.Error(t, err)
+}
+
+func TestParseEddystoneETLM(t *testing.T) {
+
+	identityKey := []byte("0123456789abcdef")
+	var batt uint16 = 3000
+	var temp float32 = 21.5
+	b, err := CreateEddystoneETLM(identityKey, 0x12345678, 10, 0xCAFE, batt, temp, 100, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	frames := b.GetFrames()
+	assert.Len(t, frames, eddystoneETLMLength)
+
+	beacon := testNewBeacon(t, eddystone.Frame(frames))
+	e := beacon.GetEddystone()
+
+	assert.Equal(t, eddystoneTLMEncrypted, e.TLMVersion)
+	assert.Equal(t, uint16(0xCAFE), e.TLMSalt)
+	assert.Equal(t, uint16(0), e.TLMBatteryVoltage)
+
+	// a wrong key or time counter fails the integrity check
+	wrong := e
+	assert.Error(t, wrong.DecryptTLM([]byte("fedcba9876543210"), 0x12345678, 10))
+	assert.Error(t, wrong.DecryptTLM(identityKey, 0x12345678+1<<10, 10))
+
+	// the time counter is rounded to the EID rotation period
+	err = e.DecryptTLM(identityKey, 0x12345678+1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, batt, e.TLMBatteryVoltage)
+	assert.Equal(t, temp, e.TLMTemperature)
+	assert.Equal(t, uint32(100), e.TLMAdvertisingPDU)
+	assert.Equal(t, uint32(200), e.TLMLastRebootedTime)
+}
+
+func TestEAX(t *testing.T) {
+
+	// test vectors from the EAX paper
+	vectors := []struct {
+		key, nonce, header, msg, cipher string
+	}{
+		{"233952DEE4D5ED5F9B9C6D6FF80FF478", "62EC67F9C3A4A407FCB2A8C49031A8B3", "6BFB914FD07EAE6B", "", "E037830E8389F27B025A2D6527E79D01"},
+		{"91945D3F4DCBEE0BF45EF52255F095A4", "BECAF043B0A23D843194BA972C66DEBD", "FA3BFD4806EB53FA", "F7FB", "19DD5C4C9331049D0BDAB0277408F67967E5"},
+	}
+
+	for _, v := range vectors {
+		key, _ := hex.DecodeString(v.key)
+		nonce, _ := hex.DecodeString(v.nonce)
+		header, _ := hex.DecodeString(v.header)
+		msg, _ := hex.DecodeString(v.msg)
+		expected, _ := hex.DecodeString(v.cipher)
+
+		ciphertext, tag, err := eaxSeal(key, nonce, header, msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, expected, append(ciphertext, tag...))
+	}
+}
+
+func TestParseEddystoneInvalid(t *testing.T) {
+
+	b := Beacon{}
+	frames := [][]byte{
+		{},
+		{0x00, 0x01, 0x02},
+		{0x10, 0x01},
+		{0x10, 0x01, 0x09, 'a'},
+		{0x10, 0x01, 0x00, 0x10},
+		{0x20, 0x00, 0x01},
+		{0x20, 0x01, 0x01},
+		{0x20, 0x05},
+		{0x30, 0x01, 0x02},
+		{0x40},
+	}
+
+	for _, f := range frames {
+		_, err := b.ParseEddystone(f)
+		assert.True(t, errors.Is(err, ErrInvalidFrame), "frames %x", f)
+	}
+}
@@ -0,0 +1,52 @@
+//go:build go1.18
+// +build go1.18
+
+package beacon
+
+import (
+	"testing"
+
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+	eddystone "github.com/suapapa/go_eddystone"
+)
+
+// FuzzParse check Parse does not panic on malformed advertisements
+func FuzzParse(f *testing.F) {
+
+	ibeacon, _ := CreateIBeacon("AAAABBBBCCCCDDDDAAAABBBBCCCCDDDD", 1, 2, 0xb3)
+	altbeacon, _ := CreateAltBeacon(RadiusNetworksID, "0102030405060708090A0B0C0D0E0F1011121314", -59, 0)
+	uid, _ := eddystone.MakeUIDFrame("EDD1EBEAC04E5DEFA017", "0BDB87539B67", -20)
+	url, _ := eddystone.MakeURLFrame("https://example.com", -20)
+	tlm, _ := eddystone.MakeTLMFrame(1000, 25, 10, 50)
+	eid, _ := CreateEddystoneEID([]byte("0123456789abcdef"), 1000, 10, -20)
+	etlm, _ := CreateEddystoneETLM([]byte("0123456789abcdef"), 1000, 10, 1, 1000, 25, 10, 50)
+
+	f.Add(uint16(appleBit), ibeacon.GetFrames(), []byte(uid))
+	f.Add(uint16(RadiusNetworksID), altbeacon.GetFrames(), []byte(url))
+	f.Add(uint16(appleBit), []byte{0x02, 0x15}, []byte(tlm))
+	f.Add(uint16(0), []byte{0xBE, 0xAC}, eid.GetFrames())
+	f.Add(uint16(0), []byte{}, etlm.GetFrames())
+	f.Add(uint16(0), []byte{}, []byte{0x10, 0x00, 0x00, 0x13})
+
+	f.Fuzz(func(t *testing.T, manufacturerID uint16, manufacturerData []byte, serviceData []byte) {
+		dev := &device.Device1{
+			Properties: &device.Device1Properties{
+				UUIDs: []string{"0000feaa-0000-1000-8000-00805f9b34fb"},
+				ServiceData: map[string]interface{}{
+					"0000feaa-0000-1000-8000-00805f9b34fb": serviceData,
+				},
+				ManufacturerData: map[uint16]interface{}{
+					manufacturerID: manufacturerData,
+				},
+			},
+		}
+
+		b, err := NewBeacon(dev)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b.Parse() && b.GetFrames() == nil {
+			t.Fatalf("%s beacon without frames", b.Type)
+		}
+	})
+}
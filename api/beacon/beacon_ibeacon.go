@@ -3,9 +3,13 @@ package beacon
 import (
 	"encoding/binary"
 	"encoding/hex"
+	"fmt"
 	"strings"
 )
 
+// iBeaconMinLength is the length of the frames without Measured Power
+const iBeaconMinLength = 22
+
 type BeaconIBeacon struct {
 	Type          string
 	ProximityUUID string
@@ -30,13 +34,17 @@ type BeaconIBeacon struct {
 // 25-26 		Major 					0xnnnn 		See CLBeaconRegion class in iOS Developer Library. 0x0000 = unset.
 // 27-28 		Minor 					0xnnnn 		See CLBeaconRegion class in iOS Developer Library. 0x0000 = unset.
 // 29 			Measured Power 	0xnn 			See Measured Power (page 7)
-func (b *Beacon) ParseIBeacon(frames []uint8) BeaconIBeacon {
+func (b *Beacon) ParseIBeacon(frames []uint8) (BeaconIBeacon, error) {
 
 	info := BeaconIBeacon{}
 
-	if frames[7-7] == 0x02 && frames[8-7] == 0x15 {
-		info.Type = "proximity"
+	if len(frames) < iBeaconMinLength {
+		return info, fmt.Errorf("%w: ibeacon length %d", ErrInvalidFrame, len(frames))
+	}
+	if frames[7-7] != 0x02 || frames[8-7] != 0x15 {
+		return info, fmt.Errorf("%w: ibeacon type 0x%02x%02x", ErrInvalidFrame, frames[7-7], frames[8-7])
 	}
+	info.Type = "proximity"
 
 	uuid := strings.ToUpper(hex.EncodeToString(frames[9-7 : 25-7]))
 	info.ProximityUUID = strings.ToUpper(uuid)
@@ -44,11 +52,11 @@ func (b *Beacon) ParseIBeacon(frames []uint8) BeaconIBeacon {
 	info.Major = binary.BigEndian.Uint16(frames[25-7 : 27-7])
 	info.Minor = binary.BigEndian.Uint16(frames[27-7 : 29-7])
 
-	if len(frames) < 23 {
-		frames = append(frames, 0xb3)
+	// Measured Power is missing in some advertisements
+	info.MeasuredPower = 0xb3
+	if len(frames) > iBeaconMinLength {
+		info.MeasuredPower = uint16(frames[29-7])
 	}
 
-	info.MeasuredPower = uint16(frames[29-7])
-
-	return info
+	return info, nil
 }
@@ -1,6 +1,7 @@
 package beacon
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/muka/go-bluetooth/bluez/profile/device"
@@ -12,7 +13,7 @@ func TestParseIBeacon(t *testing.T) {
 
 	log.SetLevel(log.DebugLevel)
 
-	uuid := "010203040506070809101112131415"
+	uuid := "01020304050607080910111213141516"
 	major := uint16(999)
 	minor := uint16(111)
 	measuredPower := uint16(80)
@@ -70,3 +71,30 @@ func TestParseInvalidIBeacon(t *testing.T) {
 	assert.False(t, beacon.IsIBeacon())
 	assert.Equal(t, string(beacon.Type), "")
 }
+
+func TestCreateIBeaconInvalid(t *testing.T) {
+
+	_, err := CreateIBeacon("AAAABBBBCCCCDDDDAAAABBBBCCCCDDDD", 1, 1, 0x1b3)
+	assert.Error(t, err)
+
+	_, err = CreateIBeacon("AAAABBBB", 1, 1, 0xb3)
+	assert.Error(t, err)
+}
+
+func TestParseIBeaconFrames(t *testing.T) {
+
+	b := Beacon{}
+
+	_, err := b.ParseIBeacon([]byte{0x02, 0x15, 0x01})
+	assert.True(t, errors.Is(err, ErrInvalidFrame))
+
+	// not a proximity beacon
+	_, err = b.ParseIBeacon(make([]byte, 23))
+	assert.True(t, errors.Is(err, ErrInvalidFrame))
+
+	// measured power is optional
+	frames := append([]byte{0x02, 0x15}, make([]byte, 20)...)
+	info, err := b.ParseIBeacon(frames)
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(0xb3), info.MeasuredPower)
+}
@@ -0,0 +1,444 @@
+package beacon
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/api"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+	"github.com/muka/go-bluetooth/logger"
+	"github.com/muka/go-bluetooth/util"
+	eddystone "github.com/suapapa/go_eddystone"
+)
+
+// DefaultExitTimeout is the time without advertisements after which a device
+// is considered out of range
+const DefaultExitTimeout = 10 * time.Second
+
+type ScanEventType string
+
+const (
+	// ScanEventEnter a device has been seen for the first time or after an exit
+	ScanEventEnter ScanEventType = "enter"
+	// ScanEventUpdate a device advertised again
+	ScanEventUpdate ScanEventType = "update"
+	// ScanEventExit a device has not been seen for ExitTimeout or has been removed
+	ScanEventExit ScanEventType = "exit"
+)
+
+// ScanEvent is emitted by the Scanner on device changes
+type ScanEvent struct {
+	Type    ScanEventType
+	Path    dbus.ObjectPath
+	Address string
+	// Beacon information, Beacon.Type is empty if the device is not a beacon
+	Beacon Beacon
+	// Region is the first region matching the beacon, nil if no regions are set
+	Region *Region
+	// RSSI is the last reading
+	RSSI int16
+	// SmoothedRSSI is the filtered RSSI
+	SmoothedRSSI float64
+	// Distance is the estimated distance in meters, 0 if unknown
+	Distance float64
+}
+
+// ScannerOptions configure a Scanner
+type ScannerOptions struct {
+	// Regions limit the events to the matching beacons, empty match any device
+	Regions []Region
+	// BeaconsOnly skip the devices which are not beacons
+	BeaconsOnly bool
+	// ExitTimeout defaults to DefaultExitTimeout
+	ExitTimeout time.Duration
+	// NewRSSIFilter create the RSSI filter of a device, defaults to an EMA
+	// filter with alpha 0.3
+	NewRSSIFilter func() RSSIFilter
+	// PathLossExponent used to estimate the distance, defaults to 2 (free space)
+	PathLossExponent float64
+	// DiscoveryFilter defaults to LE transport with duplicate data, so that
+	// every advertisement update the RSSI
+	DiscoveryFilter *adapter.DiscoveryFilter
+}
+
+// Scanner run discovery on an adapter and classify the discovered devices
+type Scanner struct {
+	adapter adapter.Adapter1API
+	devices api.Devices
+	log     *logger.Entry
+	options ScannerOptions
+}
+
+// scannedDevice is the state of a device tracked by the scanner
+type scannedDevice struct {
+	dev device.Device1API
+	// props is updated by the run loop from the watched changes
+	props    *device.Device1Properties
+	watch    chan *bluez.PropertyChanged
+	beacon   Beacon
+	region   *Region
+	filter   RSSIFilter
+	rssi     int16
+	smoothed float64
+	power    int
+	hasPower bool
+	present  bool
+	lastSeen time.Time
+}
+
+// scannedChange is a property change of the device at path
+type scannedChange struct {
+	path   dbus.ObjectPath
+	change *bluez.PropertyChanged
+}
+
+// NewScanner create a Scanner on adapter a
+func NewScanner(a *adapter.Adapter1, options ScannerOptions) *Scanner {
+	s := NewScannerAPI(a, api.AdapterDevices(a), options)
+	s.log = a.Client().Logger()
+	return s
+}
+
+// NewScannerAPI create a Scanner on adapter a, discovering its devices through
+// devices, eg. adapter.FakeAdapter1 and api.FakeDevices in tests
+func NewScannerAPI(a adapter.Adapter1API, devices api.Devices, options ScannerOptions) *Scanner {
+
+	if options.ExitTimeout <= 0 {
+		options.ExitTimeout = DefaultExitTimeout
+	}
+	if options.NewRSSIFilter == nil {
+		options.NewRSSIFilter = func() RSSIFilter {
+			return NewEMAFilter(0.3)
+		}
+	}
+	if options.PathLossExponent <= 0 {
+		options.PathLossExponent = 2
+	}
+	if options.DiscoveryFilter == nil {
+		filter := adapter.NewDiscoveryFilter()
+		filter.Transport = adapter.DiscoveryFilterTransportLE
+		filter.DuplicateData = true
+		options.DiscoveryFilter = &filter
+	}
+
+	return &Scanner{
+		adapter: a,
+		devices: devices,
+		log:     logger.WithObject(a.Path(), a.Interface()),
+		options: options,
+	}
+}
+
+// Scan start discovery and return the events channel. Call cancel to stop
+// discovery, the channel is closed afterwards.
+func (s *Scanner) Scan() (chan *ScanEvent, func(), error) {
+
+	discovery, discoveryCancel, err := s.devices.Discover(s.options.DiscoveryFilter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// devices already known do not emit InterfacesAdded
+	known, err := s.devices.GetDeviceList()
+	if err != nil {
+		discoveryCancel()
+		return nil, nil, err
+	}
+
+	events := make(chan *ScanEvent)
+	updates := make(chan scannedChange)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		s.run(known, discovery, updates, events, stop)
+		// unblock the OnDeviceDiscovered goroutine while discovery stops
+		go func() {
+			for range discovery {
+			}
+		}()
+		discoveryCancel()
+	}()
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(stop)
+			<-done
+			close(events)
+		})
+	}
+
+	return events, cancel, nil
+}
+
+func (s *Scanner) run(known []dbus.ObjectPath, discovery chan *adapter.DeviceDiscovered, updates chan scannedChange, events chan *ScanEvent, stop chan struct{}) {
+
+	devices := map[dbus.ObjectPath]*scannedDevice{}
+
+	emit := func(ev *ScanEvent) bool {
+		select {
+		case events <- ev:
+			return true
+		case <-stop:
+			return false
+		}
+	}
+
+	defer func() {
+		for _, d := range devices {
+			s.unwatch(d)
+		}
+	}()
+
+	for _, path := range known {
+		s.track(devices, path, updates, stop)
+	}
+
+	ticker := time.NewTicker(s.options.ExitTimeout / 4)
+	defer ticker.Stop()
+
+	for {
+		select {
+
+		case <-stop:
+			return
+
+		case ev, ok := <-discovery:
+			if !ok {
+				return
+			}
+			if !s.ownDevice(ev.Path) {
+				continue
+			}
+
+			if ev.Type == adapter.DeviceRemoved {
+				d, ok := devices[ev.Path]
+				if !ok {
+					continue
+				}
+				delete(devices, ev.Path)
+				s.unwatch(d)
+				if d.present && !emit(s.event(ScanEventExit, ev.Path, d)) {
+					return
+				}
+				continue
+			}
+
+			d := s.track(devices, ev.Path, updates, stop)
+			if d != nil && !s.seen(ev.Path, d, emit) {
+				return
+			}
+
+		case update := <-updates:
+			d, ok := devices[update.path]
+			if !ok {
+				continue
+			}
+			err := util.MapToStruct(d.props, map[string]dbus.Variant{
+				update.change.Name: dbus.MakeVariant(update.change.Value),
+			})
+			if err != nil {
+				s.log.WithField(logger.FieldPath, update.path).Warnf("Scanner: cannot update %s: %s", update.change.Name, err)
+				continue
+			}
+			if !s.seen(update.path, d, emit) {
+				return
+			}
+
+		case now := <-ticker.C:
+			for path, d := range devices {
+				if d.present && now.Sub(d.lastSeen) > s.options.ExitTimeout {
+					d.present = false
+					if !emit(s.event(ScanEventExit, path, d)) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// ownDevice check if path is a device of the scanner adapter
+func (s *Scanner) ownDevice(path dbus.ObjectPath) bool {
+	return strings.HasPrefix(string(path), string(s.adapter.Path())+"/")
+}
+
+// track start watching a device properties
+func (s *Scanner) track(devices map[dbus.ObjectPath]*scannedDevice, path dbus.ObjectPath, updates chan scannedChange, stop chan struct{}) *scannedDevice {
+
+	if d, ok := devices[path]; ok {
+		return d
+	}
+
+	log := s.log.WithField(logger.FieldPath, path)
+
+	dev, err := s.devices.GetDevice(path)
+	if err != nil {
+		log.Warnf("Scanner: cannot load device %s: %s", path, err)
+		return nil
+	}
+
+	// watch first, so that no change is missed after the properties are loaded
+	watch, err := dev.WatchProperties()
+	if err != nil {
+		log.Warnf("Scanner: cannot watch device %s: %s", path, err)
+		return nil
+	}
+
+	props, err := copyProperties(dev)
+	if err != nil {
+		log.Warnf("Scanner: cannot load device %s properties: %s", path, err)
+		s.unwatch(&scannedDevice{dev: dev, watch: watch})
+		return nil
+	}
+
+	go func() {
+		for change := range watch {
+			if change == nil || change.Interface != device.Device1Interface {
+				continue
+			}
+			switch change.Name {
+			case "RSSI", "ManufacturerData", "ServiceData", "AdvertisingData", "UUIDs":
+				select {
+				case updates <- scannedChange{path, change}:
+				case <-stop:
+				}
+			}
+		}
+	}()
+
+	d := &scannedDevice{
+		dev:    dev,
+		props:  props,
+		watch:  watch,
+		filter: s.options.NewRSSIFilter(),
+	}
+	devices[path] = d
+
+	return d
+}
+
+// copyProperties return a copy of the device properties, as a *device.Device1
+// update the ones it return on property changes
+func copyProperties(dev device.Device1API) (*device.Device1Properties, error) {
+	props, err := dev.GetProperties()
+	if err != nil {
+		return nil, err
+	}
+	props.Lock()
+	values, err := props.ToMap()
+	props.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return new(device.Device1Properties).FromMap(values)
+}
+
+func (s *Scanner) unwatch(d *scannedDevice) {
+	// the forwarding goroutine drain the channel until it is closed
+	go func() {
+		err := d.dev.UnwatchProperties(d.watch)
+		if err != nil {
+			s.log.Warnf("Scanner: unwatch %s: %s", d.dev.Path(), err)
+		}
+	}()
+}
+
+// seen update the device state after an advertisement and emit an event
+func (s *Scanner) seen(path dbus.ObjectPath, d *scannedDevice, emit func(*ScanEvent) bool) bool {
+
+	rssi := d.props.RSSI
+	b := newScannedBeacon(d)
+	isBeacon := b.Parse()
+
+	if isBeacon {
+		// eddystone frames other than UID keep the region of the UID frame
+		region := s.matchRegion(&b)
+		if region != nil || !b.IsEddystone() || b.GetEddystone().Frame == eddystone.UID {
+			d.region = region
+		}
+		if power, ok := b.ReferencePower(); ok {
+			d.power = power
+			d.hasPower = true
+		}
+		d.beacon = b
+	}
+
+	if len(s.options.Regions) > 0 && d.region == nil {
+		return true
+	}
+	if (s.options.BeaconsOnly || len(s.options.Regions) > 0) && d.beacon.Type == "" {
+		return true
+	}
+
+	// a cached device has no RSSI until it is in range
+	if rssi == 0 {
+		return true
+	}
+	if rssi != d.rssi || !d.present {
+		d.smoothed = d.filter.Filter(float64(rssi))
+	}
+	d.rssi = rssi
+	d.lastSeen = time.Now()
+
+	evType := ScanEventUpdate
+	if !d.present {
+		evType = ScanEventEnter
+		d.present = true
+	}
+
+	return emit(s.event(evType, path, d))
+}
+
+// newScannedBeacon return a Beacon on a copy of the advertised data of d, as
+// the events are read while the scanner updates d
+func newScannedBeacon(d *scannedDevice) Beacon {
+	b := Beacon{
+		Name: "gobluetooth",
+		devProps: &device.Device1Properties{
+			UUIDs:            d.props.UUIDs,
+			ManufacturerData: d.props.ManufacturerData,
+			ServiceData:      d.props.ServiceData,
+		},
+	}
+	if dev, ok := d.dev.(*device.Device1); ok {
+		b.Device = dev
+	}
+	return b
+}
+
+// matchRegion return the first region matching the beacon
+func (s *Scanner) matchRegion(b *Beacon) *Region {
+	for i := range s.options.Regions {
+		if s.options.Regions[i].Match(b) {
+			return &s.options.Regions[i]
+		}
+	}
+	return nil
+}
+
+func (s *Scanner) event(evType ScanEventType, path dbus.ObjectPath, d *scannedDevice) *ScanEvent {
+
+	ev := &ScanEvent{
+		Type:         evType,
+		Path:         path,
+		Beacon:       d.beacon,
+		Region:       d.region,
+		RSSI:         d.rssi,
+		SmoothedRSSI: d.smoothed,
+	}
+
+	ev.Address = d.props.Address
+
+	if d.hasPower {
+		ev.Distance = EstimateDistance(d.smoothed, d.power, s.options.PathLossExponent)
+	}
+
+	return ev
+}
@@ -0,0 +1,92 @@
+package beacon
+
+import (
+	"strings"
+
+	eddystone "github.com/suapapa/go_eddystone"
+)
+
+// Region match beacons by identifier, empty fields match any value
+type Region struct {
+	Name string
+
+	// iBeacon
+	ProximityUUID string
+	Major         *uint16
+	Minor         *uint16
+
+	// Eddystone-UID
+	Namespace string
+	Instance  string
+}
+
+// NewIBeaconRegion create a region matching iBeacons with uuid. The optional
+// major and minor narrow the region.
+func NewIBeaconRegion(name, uuid string, majorMinor ...uint16) Region {
+	r := Region{
+		Name:          name,
+		ProximityUUID: uuid,
+	}
+	if len(majorMinor) > 0 {
+		r.Major = &majorMinor[0]
+	}
+	if len(majorMinor) > 1 {
+		r.Minor = &majorMinor[1]
+	}
+	return r
+}
+
+// NewEddystoneRegion create a region matching Eddystone-UID beacons with
+// namespace and, if not empty, instance
+func NewEddystoneRegion(name, namespace, instance string) Region {
+	return Region{
+		Name:      name,
+		Namespace: namespace,
+		Instance:  instance,
+	}
+}
+
+// Match return true if the beacon belongs to the region
+func (r *Region) Match(b *Beacon) bool {
+
+	switch b.Type {
+	case BeaconTypeIBeacon:
+		if r.Namespace != "" || r.Instance != "" {
+			return false
+		}
+		info := b.GetIBeacon()
+		if r.ProximityUUID != "" && !equalID(r.ProximityUUID, info.ProximityUUID) {
+			return false
+		}
+		if r.Major != nil && *r.Major != info.Major {
+			return false
+		}
+		if r.Minor != nil && *r.Minor != info.Minor {
+			return false
+		}
+		return true
+
+	case BeaconTypeEddystone:
+		if r.ProximityUUID != "" || r.Major != nil || r.Minor != nil {
+			return false
+		}
+		info := b.GetEddystone()
+		if info.Frame != eddystone.UID {
+			return false
+		}
+		if r.Namespace != "" && !equalID(r.Namespace, info.UID) {
+			return false
+		}
+		if r.Instance != "" && !equalID(r.Instance, info.InstanceUID) {
+			return false
+		}
+		return true
+	}
+
+	return false
+}
+
+// equalID compare hex identifiers ignoring case and dashes
+func equalID(a, b string) bool {
+	return strings.EqualFold(strings.Replace(a, "-", "", -1), strings.Replace(b, "-", "", -1))
+}
@@ -0,0 +1,106 @@
+package beacon
+
+import (
+	"math"
+
+	eddystone "github.com/suapapa/go_eddystone"
+)
+
+// eddystoneLossAt1m is the signal loss at 1m, used to convert the eddystone
+// calibrated power measured at 0m
+const eddystoneLossAt1m = 41
+
+// RSSIFilter smooth the RSSI readings of a device
+type RSSIFilter interface {
+	// Filter add a reading and return the smoothed value
+	Filter(rssi float64) float64
+}
+
+// EMAFilter is an exponential moving average filter
+type EMAFilter struct {
+	// Alpha is the weight of a new reading, between 0 and 1
+	Alpha float64
+	value float64
+	init  bool
+}
+
+// NewEMAFilter create an exponential moving average filter
+func NewEMAFilter(alpha float64) *EMAFilter {
+	return &EMAFilter{Alpha: alpha}
+}
+
+// Filter add a reading and return the smoothed value
+func (f *EMAFilter) Filter(rssi float64) float64 {
+	if !f.init {
+		f.value = rssi
+		f.init = true
+		return f.value
+	}
+	f.value = f.Alpha*rssi + (1-f.Alpha)*f.value
+	return f.value
+}
+
+// KalmanFilter is a one dimensional Kalman filter for a static signal
+type KalmanFilter struct {
+	// ProcessNoise is the expected variation of the signal between readings
+	ProcessNoise float64
+	// MeasurementNoise is the variance of the readings
+	MeasurementNoise float64
+	estimate         float64
+	covariance       float64
+	init             bool
+}
+
+// NewKalmanFilter create a Kalman filter
+func NewKalmanFilter(processNoise, measurementNoise float64) *KalmanFilter {
+	return &KalmanFilter{
+		ProcessNoise:     processNoise,
+		MeasurementNoise: measurementNoise,
+	}
+}
+
+// Filter add a reading and return the smoothed value
+func (f *KalmanFilter) Filter(rssi float64) float64 {
+
+	if !f.init {
+		f.estimate = rssi
+		f.covariance = f.MeasurementNoise
+		f.init = true
+		return f.estimate
+	}
+
+	// predict
+	covariance := f.covariance + f.ProcessNoise
+
+	// update
+	gain := covariance / (covariance + f.MeasurementNoise)
+	f.estimate = f.estimate + gain*(rssi-f.estimate)
+	f.covariance = (1 - gain) * covariance
+
+	return f.estimate
+}
+
+// EstimateDistance return the distance in meters using the log-distance path
+// loss model. power is the RSSI expected at 1m, exponent is 2 in free space
+// and 2.5 to 4 indoor.
+func EstimateDistance(rssi float64, power int, exponent float64) float64 {
+	return math.Pow(10, (float64(power)-rssi)/(10*exponent))
+}
+
+// ReferencePower return the RSSI expected at 1m as advertised by the beacon.
+// It returns false if the beacon frames do not include it.
+func (b *Beacon) ReferencePower() (int, bool) {
+	switch b.Type {
+	case BeaconTypeIBeacon:
+		return int(int8(b.iBeacon.MeasuredPower)), true
+	case BeaconTypeAltBeacon:
+		return b.altBeacon.ReferenceRSSI, true
+	case BeaconTypeEddystone:
+		// TLM frames do not include the tx power
+		if b.eddystone.Frame == eddystone.TLM {
+			return 0, false
+		}
+		return b.eddystone.CalibratedTxPower - eddystoneLossAt1m, true
+	}
+	return 0, false
+}
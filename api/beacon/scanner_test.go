@@ -0,0 +1,337 @@
+package beacon
+
+import (
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/muka/go-bluetooth/api"
+	"github.com/muka/go-bluetooth/bluez/mock"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+	"github.com/stretchr/testify/assert"
+	eddystone "github.com/suapapa/go_eddystone"
+)
+
+const testRegionUUID = "AAAABBBBCCCCDDDDAAAABBBBCCCCDDDD"
+
+func TestRSSIFilters(t *testing.T) {
+
+	ema := NewEMAFilter(0.5)
+	assert.Equal(t, -60.0, ema.Filter(-60))
+	assert.Equal(t, -65.0, ema.Filter(-70))
+
+	kalman := NewKalmanFilter(0.01, 4)
+	assert.Equal(t, -60.0, kalman.Filter(-60))
+	value := kalman.Filter(-70)
+	assert.True(t, value < -60 && value > -70)
+
+	// once settled an outlier moves the estimate less
+	for i := 0; i < 20; i++ {
+		kalman.Filter(-60)
+	}
+	value = kalman.Filter(-70)
+	assert.True(t, value < -60 && value > -62)
+}
+
+func TestEstimateDistance(t *testing.T) {
+	assert.InDelta(t, 1.0, EstimateDistance(-59, -59, 2), 0.001)
+	assert.InDelta(t, 10.0, EstimateDistance(-79, -59, 2), 0.001)
+
+	b, err := CreateIBeacon(testRegionUUID, 1, 2, 0xC5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	power, ok := b.ReferencePower()
+	assert.True(t, ok)
+	assert.Equal(t, -59, power)
+
+	b, err = CreateEddystoneTLM(1000, 20, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ok = b.ReferencePower()
+	assert.False(t, ok)
+}
+
+func TestRegionMatch(t *testing.T) {
+
+	ibeacon, err := CreateIBeacon(testRegionUUID, 1, 2, 0xC5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uid, err := CreateEddystoneUID("EDD1EBEAC04E5DEFA017", "0BDB87539B67", -20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	region := NewIBeaconRegion("all", "aaaabbbb-cccc-dddd-aaaa-bbbbccccdddd")
+	assert.True(t, region.Match(ibeacon))
+	assert.False(t, region.Match(uid))
+
+	region = NewIBeaconRegion("major", testRegionUUID, 1, 3)
+	assert.False(t, region.Match(ibeacon))
+	region = NewIBeaconRegion("minor", testRegionUUID, 1, 2)
+	assert.True(t, region.Match(ibeacon))
+
+	region = NewEddystoneRegion("ns", "edd1ebeac04e5defa017", "")
+	assert.True(t, region.Match(uid))
+	assert.False(t, region.Match(ibeacon))
+	region = NewEddystoneRegion("instance", "edd1ebeac04e5defa017", "000000000000")
+	assert.False(t, region.Match(uid))
+}
+
+func startScannerMock(t *testing.T) *mock.Bluez {
+	if _, err := exec.LookPath(mock.DBusDaemonBin); err != nil {
+		t.Skip("dbus-daemon not available")
+	}
+	b, err := mock.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = b.AddAdapter("hci0", nil)
+	if err != nil {
+		b.Close()
+		t.Fatal(err)
+	}
+	return b
+}
+
+func nextEvent(t *testing.T, events chan *ScanEvent) *ScanEvent {
+	select {
+	case ev := <-events:
+		return ev
+	case <-time.After(time.Second * 5):
+		t.Fatal("Timeout waiting for scan event")
+	}
+	return nil
+}
+
+func TestScanner(t *testing.T) {
+
+	b := startScannerMock(t)
+	defer b.Close()
+
+	a, err := adapter.NewAdapter1FromAdapterID("hci0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scanner := NewScanner(a, ScannerOptions{
+		Regions:       []Region{NewIBeaconRegion("test", testRegionUUID)},
+		ExitTimeout:   time.Millisecond * 400,
+		NewRSSIFilter: func() RSSIFilter { return NewEMAFilter(0.5) },
+	})
+
+	events, cancel, err := scanner.Scan()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+
+	inRegion, err := CreateIBeacon(testRegionUUID, 1, 2, 0xC5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outRegion, err := CreateIBeacon("11112222333344441111222233334444", 1, 2, 0xC5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// devices out of the region are not reported
+	_, err = b.AddDevice("hci0", "00:00:00:00:00:01", map[string]interface{}{
+		"RSSI":             int16(-40),
+		"ManufacturerData": map[uint16]interface{}{appleBit: outRegion.GetFrames()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = b.AddDevice("hci0", "00:00:00:00:00:02", map[string]interface{}{
+		"RSSI": int16(-40),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path, err := b.AddDevice("hci0", "00:00:00:00:00:03", map[string]interface{}{
+		"RSSI":             int16(-59),
+		"ManufacturerData": map[uint16]interface{}{appleBit: inRegion.GetFrames()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ev := nextEvent(t, events)
+	assert.Equal(t, ScanEventEnter, ev.Type)
+	assert.Equal(t, path, ev.Path)
+	assert.Equal(t, "00:00:00:00:00:03", ev.Address)
+	assert.True(t, ev.Beacon.IsIBeacon())
+	assert.Equal(t, "test", ev.Region.Name)
+	assert.Equal(t, -59.0, ev.SmoothedRSSI)
+	assert.InDelta(t, 1.0, ev.Distance, 0.001)
+
+	err = b.SetProperty(path, device.Device1Interface, "RSSI", int16(-79))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ev = nextEvent(t, events)
+	assert.Equal(t, ScanEventUpdate, ev.Type)
+	assert.Equal(t, int16(-79), ev.RSSI)
+	assert.Equal(t, -69.0, ev.SmoothedRSSI)
+	assert.True(t, ev.Distance > 1)
+
+	// no advertisements until the timeout
+	ev = nextEvent(t, events)
+	assert.Equal(t, ScanEventExit, ev.Type)
+	assert.Equal(t, path, ev.Path)
+
+	err = b.SetProperty(path, device.Device1Interface, "RSSI", int16(-60))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev = nextEvent(t, events)
+	assert.Equal(t, ScanEventEnter, ev.Type)
+
+	err = b.RemoveObject(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev = nextEvent(t, events)
+	assert.Equal(t, ScanEventExit, ev.Type)
+
+	cancel()
+	_, ok := <-events
+	assert.False(t, ok)
+}
+
+func TestScannerAPI(t *testing.T) {
+
+	inRegion, err := CreateIBeacon(testRegionUUID, 1, 2, 0xC5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := adapter.NewFakeAdapter1("/org/bluez/hci0", nil)
+	known := device.NewFakeDevice1("/org/bluez/hci0/dev_00_00_00_00_00_01", &device.Device1Properties{
+		Address:          "00:00:00:00:00:01",
+		RSSI:             -59,
+		ManufacturerData: map[uint16]interface{}{appleBit: inRegion.GetFrames()},
+	})
+	devices := api.NewFakeDevices(known)
+
+	scanner := NewScannerAPI(a, devices, ScannerOptions{
+		BeaconsOnly:   true,
+		NewRSSIFilter: func() RSSIFilter { return NewEMAFilter(0.5) },
+	})
+
+	events, cancel, err := scanner.Scan()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+
+	// devices which are not beacons are skipped, the known ones are
+	// watched once the scanner receive the added devices
+	devices.AddDevice(device.NewFakeDevice1("/org/bluez/hci0/dev_00_00_00_00_00_02", &device.Device1Properties{
+		Address: "00:00:00:00:00:02",
+		RSSI:    -40,
+	}))
+
+	err = known.SetProperty("RSSI", int16(-79))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev := nextEvent(t, events)
+	assert.Equal(t, ScanEventEnter, ev.Type)
+	assert.Equal(t, known.Path(), ev.Path)
+	assert.Equal(t, "00:00:00:00:00:01", ev.Address)
+	assert.True(t, ev.Beacon.IsIBeacon())
+	assert.Nil(t, ev.Beacon.Device)
+	assert.Equal(t, int16(-79), ev.RSSI)
+
+	err = known.SetProperty("RSSI", int16(-59))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev = nextEvent(t, events)
+	assert.Equal(t, ScanEventUpdate, ev.Type)
+	assert.Equal(t, int16(-59), ev.RSSI)
+	assert.Equal(t, -69.0, ev.SmoothedRSSI)
+
+	added := device.NewFakeDevice1("/org/bluez/hci0/dev_00_00_00_00_00_03", &device.Device1Properties{
+		Address:          "00:00:00:00:00:03",
+		RSSI:             -60,
+		ManufacturerData: map[uint16]interface{}{appleBit: inRegion.GetFrames()},
+	})
+	devices.AddDevice(added)
+	ev = nextEvent(t, events)
+	assert.Equal(t, ScanEventEnter, ev.Type)
+	assert.Equal(t, added.Path(), ev.Path)
+
+	devices.RemoveDevice(added.Path())
+	ev = nextEvent(t, events)
+	assert.Equal(t, ScanEventExit, ev.Type)
+	assert.Equal(t, added.Path(), ev.Path)
+
+	cancel()
+	_, ok := <-events
+	assert.False(t, ok)
+	assert.Equal(t, 0, devices.Discovering())
+}
+
+func TestScannerEddystoneFrames(t *testing.T) {
+
+	b := startScannerMock(t)
+	defer b.Close()
+
+	a, err := adapter.NewAdapter1FromAdapterID("hci0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scanner := NewScanner(a, ScannerOptions{
+		Regions: []Region{NewEddystoneRegion("test", "EDD1EBEAC04E5DEFA017", "")},
+	})
+	events, cancel, err := scanner.Scan()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+
+	uid, err := eddystone.MakeUIDFrame("EDD1EBEAC04E5DEFA017", "0BDB87539B67", -18)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tlm, err := eddystone.MakeTLMFrame(3000, 20, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	uuid := "0000feaa-0000-1000-8000-00805f9b34fb"
+	path, err := b.AddDevice("hci0", "00:00:00:00:00:04", map[string]interface{}{
+		"RSSI":        int16(-59),
+		"UUIDs":       []string{uuid},
+		"ServiceData": map[string]interface{}{uuid: []byte(uid)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ev := nextEvent(t, events)
+	assert.Equal(t, ScanEventEnter, ev.Type)
+	assert.InDelta(t, 1.0, ev.Distance, 0.001)
+
+	// the TLM frame keeps the region and the tx power of the UID frame
+	err = b.SetProperty(path, device.Device1Interface, "ServiceData", map[string]interface{}{uuid: []byte(tlm)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ev = nextEvent(t, events)
+	assert.Equal(t, ScanEventUpdate, ev.Type)
+	assert.Equal(t, eddystone.Header(eddystone.TLM), ev.Beacon.GetEddystone().Frame)
+	assert.Equal(t, uint16(3000), ev.Beacon.GetEddystone().TLMBatteryVoltage)
+	assert.Equal(t, "test", ev.Region.Name)
+	assert.InDelta(t, 1.0, ev.Distance, 0.001)
+}
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+	"github.com/muka/go-bluetooth/logger"
+)
+
+// ConnectDevice connect the device with address, even if it has not been
+// discovered yet. Adapter1.ConnectDevice is used when bluetoothd implements
+// it, as it is experimental, otherwise the device is discovered first through
+// the DiscoveryBroker of the adapter. addressType is "public", "random" or
+// empty for a BR/EDR device, it only applies to Adapter1.ConnectDevice.
+func ConnectDevice(ctx context.Context, a *adapter.Adapter1, address string, addressType string) (*device.Device1, error) {
+	dev, err := connectDevice(ctx, a, AdapterDevices(a), address, addressType, a.Client().Logger())
+	if err != nil {
+		return nil, err
+	}
+	return dev.(*device.Device1), nil
+}
+
+// ConnectDeviceAPI connect the device with address as ConnectDevice does,
+// using the adapter and the devices through their API interfaces, eg.
+// adapter.FakeAdapter1 and device.FakeDevice1 in tests.
+func ConnectDeviceAPI(ctx context.Context, a adapter.Adapter1API, devices Devices, address string, addressType string) (device.Device1API, error) {
+	return connectDevice(ctx, a, devices, address, addressType, logger.WithObject(a.Path(), a.Interface()))
+}
+
+// connectDevice implement ConnectDevice and ConnectDeviceAPI, logging on log
+func connectDevice(ctx context.Context, a adapter.Adapter1API, devices Devices, address string, addressType string, log *logger.Entry) (device.Device1API, error) {
+
+	dev, err := devices.GetDeviceByAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if dev == nil {
+		options := adapter.Adapter1ConnectDeviceOptions{
+			Address:     address,
+			AddressType: addressType,
+		}
+		path, err := a.ConnectDeviceContext(ctx, options.ToMap())
+		if err == nil {
+			return devices.GetDevice(path)
+		}
+		if !errors.Is(err, bluez.ErrNotSupportedByDaemon) {
+			return nil, err
+		}
+
+		log.WithMethod("ConnectDevice").Debugf("Not supported, discovering %s", address)
+		dev, err = discoverDevice(ctx, a, devices, address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = dev.ConnectContext(ctx)
+	if err != nil && !errors.Is(err, bluez.ErrAlreadyConnected) {
+		return nil, err
+	}
+
+	return dev, nil
+}
+
+// discoverDevice run discovery until the device with address is found or ctx is done
+func discoverDevice(ctx context.Context, a adapter.Adapter1API, devices Devices, address string) (device.Device1API, error) {
+
+	discovery, cancel, err := devices.Discover(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	// the device may have been added before discovery started
+	dev, err := devices.GetDeviceByAddress(address)
+	if err != nil || dev != nil {
+		return dev, err
+	}
+
+	path := dbus.ObjectPath(fmt.Sprintf("%s/dev_%s", a.Path(), strings.Replace(strings.ToUpper(address), ":", "_", -1)))
+	for {
+		select {
+		case ev, ok := <-discovery:
+			if !ok {
+				return nil, errors.New("Discovery stopped")
+			}
+			if ev.Type == adapter.DeviceAdded && ev.Path == path {
+				return devices.GetDevice(path)
+			}
+		case <-ctx.Done():
+			return nil, &bluez.ContextError{
+				Method: "ConnectDevice",
+				Err:    ctx.Err(),
+			}
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/api"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testFakeAdapterPath = dbus.ObjectPath("/org/bluez/hci0")
+	testFakeAddress     = "00:11:22:33:44:55"
+	testFakeDevicePath  = dbus.ObjectPath("/org/bluez/hci0/dev_00_11_22_33_44_55")
+)
+
+func newTestFakeDevice(connected *bool) *device.FakeDevice1 {
+	dev := device.NewFakeDevice1(testFakeDevicePath, &device.Device1Properties{
+		Address: testFakeAddress,
+	})
+	dev.ConnectFunc = func(ctx context.Context) error {
+		*connected = true
+		return nil
+	}
+	return dev
+}
+
+func TestConnectDeviceAPIKnown(t *testing.T) {
+
+	connected := false
+	devices := api.NewFakeDevices(newTestFakeDevice(&connected))
+
+	a := adapter.NewFakeAdapter1(testFakeAdapterPath, nil)
+	a.ConnectDeviceFunc = func(ctx context.Context, properties map[string]interface{}) (dbus.ObjectPath, error) {
+		t.Error("ConnectDevice called for a known device")
+		return "", nil
+	}
+
+	dev, err := api.ConnectDeviceAPI(context.Background(), a, devices, testFakeAddress, "")
+	assert.NoError(t, err)
+	assert.Equal(t, testFakeDevicePath, dev.Path())
+	assert.True(t, connected)
+}
+
+func TestConnectDeviceAPIConnectDevice(t *testing.T) {
+
+	connected := false
+	devices := api.NewFakeDevices()
+
+	a := adapter.NewFakeAdapter1(testFakeAdapterPath, nil)
+	a.ConnectDeviceFunc = func(ctx context.Context, properties map[string]interface{}) (dbus.ObjectPath, error) {
+		assert.Equal(t, testFakeAddress, properties["Address"])
+		assert.Equal(t, "public", properties["AddressType"])
+		devices.AddDevice(device.NewFakeDevice1(testFakeDevicePath, &device.Device1Properties{
+			Address:   testFakeAddress,
+			Connected: true,
+		}))
+		return testFakeDevicePath, nil
+	}
+
+	dev, err := api.ConnectDeviceAPI(context.Background(), a, devices, testFakeAddress, "public")
+	assert.NoError(t, err)
+	assert.Equal(t, testFakeDevicePath, dev.Path())
+	assert.False(t, connected)
+}
+
+func TestConnectDeviceAPIDiscover(t *testing.T) {
+
+	connected := false
+	devices := api.NewFakeDevices()
+
+	a := adapter.NewFakeAdapter1(testFakeAdapterPath, nil)
+	a.ConnectDeviceFunc = func(ctx context.Context, properties map[string]interface{}) (dbus.ObjectPath, error) {
+		return "", bluez.ErrNotSupportedByDaemon
+	}
+
+	go func() {
+		for devices.Discovering() == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		devices.AddDevice(newTestFakeDevice(&connected))
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	dev, err := api.ConnectDeviceAPI(ctx, a, devices, testFakeAddress, "")
+	assert.NoError(t, err)
+	assert.Equal(t, testFakeDevicePath, dev.Path())
+	assert.True(t, connected)
+	assert.Equal(t, 0, devices.Discovering())
+}
+
+func TestConnectDeviceAPIDiscoverTimeout(t *testing.T) {
+
+	devices := api.NewFakeDevices()
+
+	a := adapter.NewFakeAdapter1(testFakeAdapterPath, nil)
+	a.ConnectDeviceFunc = func(ctx context.Context, properties map[string]interface{}) (dbus.ObjectPath, error) {
+		return "", bluez.ErrNotSupportedByDaemon
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := api.ConnectDeviceAPI(ctx, a, devices, testFakeAddress, "")
+	var ctxErr *bluez.ContextError
+	assert.True(t, errors.As(err, &ctxErr))
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, 0, devices.Discovering())
+}
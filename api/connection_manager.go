@@ -0,0 +1,488 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+)
+
+// ConnectionState is the state of a device kept connected by a ConnectionManager
+type ConnectionState string
+
+const (
+	// StateConnecting a connection attempt is in progress
+	StateConnecting ConnectionState = "connecting"
+	// StateConnected the device is connected, services are being resolved
+	StateConnected ConnectionState = "connected"
+	// StateResolved the services are resolved and the hooks have run, the
+	// device is ready to use
+	StateResolved ConnectionState = "resolved"
+	// StateDisconnected the device disconnected or an attempt failed, it is
+	// connected again after a backoff
+	StateDisconnected ConnectionState = "disconnected"
+	// StateFailed the attempts are exhausted or the device properties cannot be
+	// watched anymore, the device is not managed anymore
+	StateFailed ConnectionState = "failed"
+)
+
+var (
+	// DefaultReconnectBackoff is the wait before the first reconnection attempt
+	// after a failure, doubled on each attempt up to DefaultMaxReconnectBackoff
+	DefaultReconnectBackoff = time.Second
+	// DefaultMaxReconnectBackoff is the maximum wait between two attempts
+	DefaultMaxReconnectBackoff = time.Minute
+	// DefaultResolveTimeout is the time to wait for ServicesResolved once connected
+	DefaultResolveTimeout = 30 * time.Second
+)
+
+// ErrResolveTimeout is reported when the services of a connected device are
+// not resolved within ConnectionManagerOptions.ResolveTimeout
+var ErrResolveTimeout = errors.New("Timeout waiting for ServicesResolved")
+
+// ErrWatchClosed is reported with StateFailed when the properties of a device
+// cannot be watched anymore, eg. once the connection to the bus is closed
+var ErrWatchClosed = errors.New("Properties watch closed")
+
+// ConnectionEvent is emitted on each state change of a managed device
+type ConnectionEvent struct {
+	Device dbus.ObjectPath
+	State  ConnectionState
+	// Attempt is the number of connection attempts since the device was
+	// last ready
+	Attempt int
+	// Err is the cause of a Disconnected or Failed state, if any
+	Err error
+}
+
+// ReadyHook is run each time a device is connected and its services
+// resolved, eg. to start notifications again. A failing hook disconnects the
+// device and the connection is attempted again.
+type ReadyHook func(dev *device.Device1) error
+
+// NotifyHook return a ReadyHook starting the notifications of the
+// characteristic with uuid
+func NotifyHook(uuid string) ReadyHook {
+	return func(dev *device.Device1) error {
+		char, err := dev.GetCharByUUID(uuid)
+		if err != nil {
+			return err
+		}
+		return char.StartNotify()
+	}
+}
+
+// ConnectionManagerOptions configure a ConnectionManager, zero values use the
+// defaults
+type ConnectionManagerOptions struct {
+	// Backoff is the wait before the first reconnection attempt after a
+	// failure, doubled on each attempt
+	Backoff time.Duration
+	// MaxBackoff is the maximum wait between two attempts
+	MaxBackoff time.Duration
+	// MaxAttempts is the number of failed attempts after which a device is
+	// reported as Failed, 0 retries forever
+	MaxAttempts int
+	// ResolveTimeout is the time to wait for ServicesResolved once connected
+	ResolveTimeout time.Duration
+}
+
+// ConnectionManager keeps a set of devices connected, connecting them again
+// with exponential backoff when they disconnect
+type ConnectionManager struct {
+	options ConnectionManagerOptions
+
+	lock    sync.Mutex
+	devices map[dbus.ObjectPath]*managedDevice
+	events  chan *ConnectionEvent
+	closed  bool
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewConnectionManager create a ConnectionManager
+func NewConnectionManager(options ConnectionManagerOptions) *ConnectionManager {
+
+	if options.Backoff <= 0 {
+		options.Backoff = DefaultReconnectBackoff
+	}
+	if options.MaxBackoff <= 0 {
+		options.MaxBackoff = DefaultMaxReconnectBackoff
+	}
+	if options.ResolveTimeout <= 0 {
+		options.ResolveTimeout = DefaultResolveTimeout
+	}
+
+	return &ConnectionManager{
+		options: options,
+		devices: make(map[dbus.ObjectPath]*managedDevice),
+		done:    make(chan struct{}),
+	}
+}
+
+// managedDevice is a device kept connected
+type managedDevice struct {
+	dev   *device.Device1
+	hooks []ReadyHook
+
+	lock  sync.Mutex
+	state ConnectionState
+
+	stop   chan struct{}
+	exited chan struct{}
+}
+
+// Events return the state changes of the managed devices. Once called, the
+// events must be consumed as the devices wait for them to be received. The
+// channel is closed by Close.
+func (m *ConnectionManager) Events() <-chan *ConnectionEvent {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.events == nil {
+		m.events = make(chan *ConnectionEvent)
+		if m.closed {
+			close(m.events)
+		}
+	}
+	return m.events
+}
+
+// Add start keeping dev connected, hooks are run each time it is ready
+func (m *ConnectionManager) Add(dev *device.Device1, hooks ...ReadyHook) error {
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.closed {
+		return errors.New("ConnectionManager is closed")
+	}
+	if _, ok := m.devices[dev.Path()]; ok {
+		return fmt.Errorf("Device %s is already managed", dev.Path())
+	}
+
+	d := &managedDevice{
+		dev:    dev,
+		hooks:  hooks,
+		state:  StateDisconnected,
+		stop:   make(chan struct{}),
+		exited: make(chan struct{}),
+	}
+	m.devices[dev.Path()] = d
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		defer close(d.exited)
+		m.manage(d)
+	}()
+
+	return nil
+}
+
+// Remove stop managing a device, its connection is left as is
+func (m *ConnectionManager) Remove(path dbus.ObjectPath) {
+
+	m.lock.Lock()
+	d, ok := m.devices[path]
+	delete(m.devices, path)
+	m.lock.Unlock()
+
+	if !ok {
+		return
+	}
+	close(d.stop)
+	<-d.exited
+}
+
+// State return the state of a managed device, an empty state if it is not managed
+func (m *ConnectionManager) State(path dbus.ObjectPath) ConnectionState {
+	m.lock.Lock()
+	d, ok := m.devices[path]
+	m.lock.Unlock()
+	if !ok {
+		return ""
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.state
+}
+
+// Close stop managing all the devices and close the events channel
+func (m *ConnectionManager) Close() {
+
+	m.lock.Lock()
+	if m.closed {
+		m.lock.Unlock()
+		return
+	}
+	m.closed = true
+	close(m.done)
+	m.lock.Unlock()
+
+	m.wg.Wait()
+
+	m.lock.Lock()
+	m.devices = make(map[dbus.ObjectPath]*managedDevice)
+	if m.events != nil {
+		close(m.events)
+	}
+	m.lock.Unlock()
+}
+
+// setState record and emit a state change, it return false once the device
+// is not managed anymore
+func (m *ConnectionManager) setState(d *managedDevice, state ConnectionState, attempt int, err error) bool {
+
+	d.lock.Lock()
+	d.state = state
+	d.lock.Unlock()
+
+	ev := &ConnectionEvent{
+		Device:  d.dev.Path(),
+		State:   state,
+		Attempt: attempt,
+		Err:     err,
+	}
+
+	log := d.dev.Client().Logger()
+	if err != nil {
+		log.Debugf("ConnectionManager: %s (attempt %d): %s", state, attempt, err)
+	} else {
+		log.Debugf("ConnectionManager: %s", state)
+	}
+
+	m.lock.Lock()
+	events := m.events
+	m.lock.Unlock()
+
+	if events == nil {
+		return !d.stopped(m)
+	}
+
+	select {
+	case events <- ev:
+		return true
+	case <-d.stop:
+	case <-m.done:
+	}
+	return false
+}
+
+func (d *managedDevice) stopped(m *ConnectionManager) bool {
+	select {
+	case <-d.stop:
+		return true
+	case <-m.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// wait for the timeout or the device to be stopped, it return false if stopped
+func (m *ConnectionManager) wait(d *managedDevice, timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-d.stop:
+	case <-m.done:
+	}
+	return false
+}
+
+// manage connect the device and connect it again each time it disconnects
+func (m *ConnectionManager) manage(d *managedDevice) {
+
+	watch, err := d.dev.WatchProperties()
+	if err != nil {
+		m.setState(d, StateFailed, 0, err)
+		return
+	}
+	defer d.dev.UnwatchProperties(watch)
+
+	// stop pending calls when the device is removed
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-d.stop:
+		case <-m.done:
+		case <-ctx.Done():
+		}
+		cancel()
+	}()
+
+	attempt := 0
+	backoff := m.options.Backoff
+
+	for {
+
+		attempt++
+		if !m.setState(d, StateConnecting, attempt, nil) {
+			return
+		}
+
+		err := m.connect(ctx, d, attempt, watch)
+		if err == nil {
+			attempt = 0
+			backoff = m.options.Backoff
+			if !m.setState(d, StateResolved, attempt, nil) {
+				return
+			}
+			err = m.waitDisconnected(ctx, d, watch)
+			if d.stopped(m) {
+				return
+			}
+			if errors.Is(err, ErrWatchClosed) {
+				m.setState(d, StateFailed, attempt, err)
+				return
+			}
+			if !m.setState(d, StateDisconnected, attempt, err) {
+				return
+			}
+			// connect again right away after a disconnection
+			continue
+		}
+
+		if d.stopped(m) {
+			return
+		}
+
+		if errors.Is(err, ErrWatchClosed) ||
+			(m.options.MaxAttempts > 0 && attempt >= m.options.MaxAttempts) {
+			m.setState(d, StateFailed, attempt, err)
+			return
+		}
+
+		if !m.setState(d, StateDisconnected, attempt, err) {
+			return
+		}
+		if !m.wait(d, backoff) {
+			return
+		}
+
+		backoff *= 2
+		if backoff > m.options.MaxBackoff {
+			backoff = m.options.MaxBackoff
+		}
+	}
+}
+
+// connect the device, wait for the services to be resolved and run the hooks
+func (m *ConnectionManager) connect(ctx context.Context, d *managedDevice, attempt int, watch chan *bluez.PropertyChanged) error {
+
+	err := d.dev.Queue().Connect(ctx)
+	if err != nil && !errors.Is(err, bluez.ErrAlreadyConnected) {
+		return err
+	}
+
+	if !m.setState(d, StateConnected, attempt, nil) {
+		return ctx.Err()
+	}
+
+	err = m.waitResolved(ctx, d, watch)
+	if err == nil {
+		for _, hook := range d.hooks {
+			err = hook(d.dev)
+			if err != nil {
+				break
+			}
+		}
+	}
+
+	if err != nil {
+		// start the next attempt from a clean state
+		disconnectErr := d.dev.Queue().Disconnect(ctx)
+		if disconnectErr != nil && !errors.Is(disconnectErr, bluez.ErrNotConnected) {
+			d.dev.Client().Logger().Debugf("ConnectionManager: Disconnect: %s", disconnectErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// waitResolved wait for ServicesResolved to become true
+func (m *ConnectionManager) waitResolved(ctx context.Context, d *managedDevice, watch chan *bluez.PropertyChanged) error {
+
+	resolved, err := d.dev.GetServicesResolved()
+	if err != nil {
+		return err
+	}
+	if resolved {
+		return nil
+	}
+
+	timer := time.NewTimer(m.options.ResolveTimeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case change, ok := <-watch:
+			if !ok {
+				return ErrWatchClosed
+			}
+			if change.Interface != device.Device1Interface {
+				continue
+			}
+			switch change.Name {
+			case "ServicesResolved":
+				if resolved, ok := change.Value.(bool); ok && resolved {
+					return nil
+				}
+			case "Connected":
+				if connected, ok := change.Value.(bool); !ok || connected {
+					continue
+				}
+				// skip changes received before the connection
+				connected, err := d.dev.GetConnected()
+				if err != nil {
+					return err
+				}
+				if !connected {
+					return errors.New("Disconnected while resolving services")
+				}
+			}
+		case <-timer.C:
+			return ErrResolveTimeout
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// waitDisconnected wait for Connected to become false
+func (m *ConnectionManager) waitDisconnected(ctx context.Context, d *managedDevice, watch chan *bluez.PropertyChanged) error {
+	for {
+		select {
+		case change, ok := <-watch:
+			if !ok {
+				return ErrWatchClosed
+			}
+			if change.Interface != device.Device1Interface || change.Name != "Connected" {
+				continue
+			}
+			if connected, ok := change.Value.(bool); !ok || connected {
+				continue
+			}
+			// skip changes received before the last connection
+			connected, err := d.dev.GetConnected()
+			if err != nil {
+				return err
+			}
+			if !connected {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package api_test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/muka/go-bluetooth/api"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/mock"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+	"github.com/stretchr/testify/assert"
+)
+
+func nextConnectionEvent(t *testing.T, events <-chan *api.ConnectionEvent) *api.ConnectionEvent {
+	select {
+	case ev := <-events:
+		return ev
+	case <-time.After(mock.WaitFor):
+		t.Fatal("Timeout waiting for connection event")
+	}
+	return nil
+}
+
+func assertStates(t *testing.T, events <-chan *api.ConnectionEvent, states ...api.ConnectionState) {
+	for _, state := range states {
+		ev := nextConnectionEvent(t, events)
+		assert.Equal(t, state, ev.State)
+	}
+}
+
+func TestConnectionManager(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	devPath, charPath := mock.AddTestDevice(t, b)
+	dev, err := device.NewDevice1(devPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := api.NewConnectionManager(api.ConnectionManagerOptions{
+		Backoff: mock.Tick,
+	})
+	defer m.Close()
+	events := m.Events()
+
+	var ready int32
+	err = m.Add(dev, api.NotifyHook(mock.TestCharUUID), func(dev *device.Device1) error {
+		atomic.AddInt32(&ready, 1)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Error(t, m.Add(dev))
+
+	assertStates(t, events, api.StateConnecting, api.StateConnected, api.StateResolved)
+	assert.Equal(t, api.StateResolved, m.State(devPath))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&ready))
+	notifying, err := b.GetProperty(charPath, gatt.GattCharacteristic1Interface, "Notifying")
+	assert.NoError(t, err)
+	assert.Equal(t, true, notifying)
+
+	// the device is connected again after a disconnection, hooks run again
+	err = b.SetProperty(charPath, gatt.GattCharacteristic1Interface, "Notifying", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = b.SetProperties(devPath, device.Device1Interface, map[string]interface{}{
+		"Connected":        false,
+		"ServicesResolved": false,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertStates(t, events, api.StateDisconnected, api.StateConnecting, api.StateConnected, api.StateResolved)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&ready))
+	notifying, err = b.GetProperty(charPath, gatt.GattCharacteristic1Interface, "Notifying")
+	assert.NoError(t, err)
+	assert.Equal(t, true, notifying)
+
+	m.Remove(devPath)
+	assert.Equal(t, api.ConnectionState(""), m.State(devPath))
+}
+
+func TestConnectionManagerResolve(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	// connected, services not yet resolved
+	devPath, err := b.AddDevice(mock.TestAdapterID, mock.TestAddress, map[string]interface{}{
+		"Connected": true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dev, err := device.NewDevice1(devPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := api.NewConnectionManager(api.ConnectionManagerOptions{
+		Backoff:        mock.Tick,
+		ResolveTimeout: mock.WaitFor,
+	})
+	defer m.Close()
+	events := m.Events()
+
+	err = m.Add(dev)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertStates(t, events, api.StateConnecting, api.StateConnected)
+
+	err = b.SetProperty(devPath, device.Device1Interface, "ServicesResolved", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertStates(t, events, api.StateResolved)
+}
+
+func TestConnectionManagerFailed(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	devPath, _ := mock.AddTestDevice(t, b)
+	dev, err := device.NewDevice1(devPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dev.Queue().SetOptions(device.QueueOptions{Retries: -1})
+	defer dev.Queue().SetOptions(device.QueueOptions{})
+
+	b.InjectError(devPath, device.Device1Interface, "Connect", mock.Error("ConnectionAttemptFailed"), 0)
+
+	m := api.NewConnectionManager(api.ConnectionManagerOptions{
+		Backoff:     mock.Tick,
+		MaxAttempts: 3,
+	})
+	defer m.Close()
+	events := m.Events()
+
+	err = m.Add(dev)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for attempt := 1; attempt <= 2; attempt++ {
+		ev := nextConnectionEvent(t, events)
+		assert.Equal(t, api.StateConnecting, ev.State)
+		assert.Equal(t, attempt, ev.Attempt)
+		ev = nextConnectionEvent(t, events)
+		assert.Equal(t, api.StateDisconnected, ev.State)
+		assert.True(t, errors.Is(ev.Err, bluez.ErrConnectionAttemptFailed))
+	}
+	assertStates(t, events, api.StateConnecting)
+	ev := nextConnectionEvent(t, events)
+	assert.Equal(t, api.StateFailed, ev.State)
+	assert.Equal(t, 3, ev.Attempt)
+	assert.Equal(t, api.StateFailed, m.State(devPath))
+
+	m.Close()
+	_, ok := <-events
+	assert.False(t, ok)
+}
+
+func TestConnectionManagerWatchClosed(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	devPath, _ := mock.AddTestDevice(t, b)
+	dev, err := device.NewDevice1(devPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := api.NewConnectionManager(api.ConnectionManagerOptions{
+		Backoff: mock.Tick,
+	})
+	defer m.Close()
+	events := m.Events()
+
+	err = m.Add(dev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertStates(t, events, api.StateConnecting, api.StateConnected, api.StateResolved)
+
+	// the properties watch is closed with the connection
+	err = b.ClientConn().Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ev := nextConnectionEvent(t, events)
+	assert.Equal(t, api.StateFailed, ev.State)
+	assert.True(t, errors.Is(ev.Err, api.ErrWatchClosed))
+	assert.Equal(t, api.StateFailed, m.State(devPath))
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+)
+
+// Devices provide the devices of an adapter through device.Device1API, so
+// that the helpers accepting it can be tested with device.FakeDevice1.
+// AdapterDevices implement it for an *adapter.Adapter1.
+type Devices interface {
+	// Discover start discovery with filter, see Discover
+	Discover(filter *adapter.DiscoveryFilter) (chan *adapter.DeviceDiscovered, func(), error)
+	// GetDeviceList return the paths of the devices known by the adapter
+	GetDeviceList() ([]dbus.ObjectPath, error)
+	// GetDevice return the device at path
+	GetDevice(path dbus.ObjectPath) (device.Device1API, error)
+	// GetDeviceByAddress return the device with address, nil if not known
+	GetDeviceByAddress(address string) (device.Device1API, error)
+}
+
+// adapterDevices is the Devices of an *adapter.Adapter1, the devices are
+// *device.Device1 on the connection of the adapter
+type adapterDevices struct {
+	adapter *adapter.Adapter1
+}
+
+// AdapterDevices return the devices of a, discovered through its DiscoveryBroker
+func AdapterDevices(a *adapter.Adapter1) Devices {
+	return &adapterDevices{a}
+}
+
+func (d *adapterDevices) Discover(filter *adapter.DiscoveryFilter) (chan *adapter.DeviceDiscovered, func(), error) {
+	return Discover(d.adapter, filter)
+}
+
+func (d *adapterDevices) GetDeviceList() ([]dbus.ObjectPath, error) {
+	return d.adapter.GetDeviceList()
+}
+
+func (d *adapterDevices) GetDevice(path dbus.ObjectPath) (device.Device1API, error) {
+	dev, err := device.NewDevice1WithConn(d.adapter.Client().Conn(), path)
+	if err != nil {
+		return nil, err
+	}
+	return dev, nil
+}
+
+func (d *adapterDevices) GetDeviceByAddress(address string) (device.Device1API, error) {
+	dev, err := d.adapter.GetDeviceByAddress(address)
+	if err != nil || dev == nil {
+		return nil, err
+	}
+	return dev, nil
+}
@@ -0,0 +1,156 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+)
+
+// FakeDevices is an in-memory Devices of device.FakeDevice1, to test the
+// helpers accepting Devices without D-Bus. The discovery channels receive the
+// devices added with AddDevice and removed with RemoveDevice.
+type FakeDevices struct {
+	lock        sync.Mutex
+	devices     map[dbus.ObjectPath]*device.FakeDevice1
+	subscribers map[*fakeDiscovery]bool
+	// Filters are the filters of the Discover calls
+	Filters []*adapter.DiscoveryFilter
+}
+
+var _ Devices = (*FakeDevices)(nil)
+
+// fakeDiscovery is a channel returned by FakeDevices.Discover
+type fakeDiscovery struct {
+	lock sync.Mutex
+	ch   chan *adapter.DeviceDiscovered
+	done chan struct{}
+	once sync.Once
+}
+
+// NewFakeDevices create a FakeDevices with the initial devices
+func NewFakeDevices(devices ...*device.FakeDevice1) *FakeDevices {
+	f := &FakeDevices{
+		devices:     make(map[dbus.ObjectPath]*device.FakeDevice1),
+		subscribers: make(map[*fakeDiscovery]bool),
+	}
+	for _, dev := range devices {
+		f.devices[dev.Path()] = dev
+	}
+	return f
+}
+
+// AddDevice add a device and send it to the discovery channels
+func (f *FakeDevices) AddDevice(dev *device.FakeDevice1) {
+	f.lock.Lock()
+	f.devices[dev.Path()] = dev
+	f.lock.Unlock()
+	f.send(&adapter.DeviceDiscovered{
+		Path: dev.Path(),
+		Type: adapter.DeviceAdded,
+	})
+}
+
+// RemoveDevice remove a device and send it to the discovery channels
+func (f *FakeDevices) RemoveDevice(path dbus.ObjectPath) {
+	f.lock.Lock()
+	delete(f.devices, path)
+	f.lock.Unlock()
+	f.send(&adapter.DeviceDiscovered{
+		Path: path,
+		Type: adapter.DeviceRemoved,
+	})
+}
+
+// send ev to the discovery channels, waiting for them to receive it
+func (f *FakeDevices) send(ev *adapter.DeviceDiscovered) {
+	f.lock.Lock()
+	subscribers := []*fakeDiscovery{}
+	for s := range f.subscribers {
+		subscribers = append(subscribers, s)
+	}
+	f.lock.Unlock()
+
+	for _, s := range subscribers {
+		s.lock.Lock()
+		select {
+		case s.ch <- ev:
+		case <-s.done:
+		}
+		s.lock.Unlock()
+	}
+}
+
+// Discover return a channel receiving the devices added and removed until
+// cancel is called
+func (f *FakeDevices) Discover(filter *adapter.DiscoveryFilter) (chan *adapter.DeviceDiscovered, func(), error) {
+
+	s := &fakeDiscovery{
+		ch:   make(chan *adapter.DeviceDiscovered),
+		done: make(chan struct{}),
+	}
+
+	f.lock.Lock()
+	f.subscribers[s] = true
+	f.Filters = append(f.Filters, filter)
+	f.lock.Unlock()
+
+	cancel := func() {
+		s.once.Do(func() {
+			f.lock.Lock()
+			delete(f.subscribers, s)
+			f.lock.Unlock()
+			close(s.done)
+			s.lock.Lock()
+			close(s.ch)
+			s.lock.Unlock()
+		})
+	}
+
+	return s.ch, cancel, nil
+}
+
+// Discovering return the number of discovery channels not cancelled
+func (f *FakeDevices) Discovering() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return len(f.subscribers)
+}
+
+// GetDeviceList return the paths of the devices
+func (f *FakeDevices) GetDeviceList() ([]dbus.ObjectPath, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	list := []dbus.ObjectPath{}
+	for path := range f.devices {
+		list = append(list, path)
+	}
+	return list, nil
+}
+
+// GetDevice return the device at path, failing if there is none
+func (f *FakeDevices) GetDevice(path dbus.ObjectPath) (device.Device1API, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	dev, ok := f.devices[path]
+	if !ok {
+		return nil, fmt.Errorf("Device %s not found", path)
+	}
+	return dev, nil
+}
+
+// GetDeviceByAddress return the device with address, nil if there is none
+func (f *FakeDevices) GetDeviceByAddress(address string) (device.Device1API, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for _, dev := range f.devices {
+		devAddress, err := dev.GetAddress()
+		if err == nil && strings.EqualFold(devAddress, address) {
+			return dev, nil
+		}
+	}
+	return nil, nil
+}
@@ -1,56 +1,335 @@
 package api
 
 import (
+	"errors"
+	"reflect"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
 	"github.com/muka/go-bluetooth/bluez/profile/adapter"
-	log "github.com/sirupsen/logrus"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
 )
 
-// Discover start device discovery
+// Discover start device discovery through the DiscoveryBroker of the adapter.
+// Discovery is started again if bluetoothd restarts before cancel is called.
 func Discover(
 	a *adapter.Adapter1, filter *adapter.DiscoveryFilter,
 ) (
 	chan *adapter.DeviceDiscovered, func(), error,
 ) {
+	return GetDiscoveryBroker(a).Subscribe(filter)
+}
 
-	err := a.SetPairable(false)
-	if err != nil {
-		return nil, nil, err
+// DiscoveryBroker share the discovery of an adapter between subscribers. The
+// filters of the subscribers are merged in the one set on the adapter,
+// discovery runs while there is at least a subscriber and each of them
+// receives only the devices matching its own filter.
+type DiscoveryBroker struct {
+	adapter *adapter.Adapter1
+	key     brokerKey
+}
+
+// brokerState is the discovery state shared by the brokers of an adapter. It
+// is dropped once there are no subscribers.
+type brokerState struct {
+	lock        sync.Mutex
+	subscribers []*discoverySubscriber
+	filter      *adapter.DiscoveryFilter
+	// adapter started discovery, it is used until it stops
+	adapter       *adapter.Adapter1
+	removeRestore func()
+
+	// guarded by brokersLock
+	users int
+}
+
+type discoverySubscriber struct {
+	filter adapter.DiscoveryFilter
+}
+
+type brokerKey struct {
+	conn *bluez.Conn
+	path dbus.ObjectPath
+}
+
+var (
+	brokersLock sync.Mutex
+	brokers     = make(map[brokerKey]*brokerState)
+)
+
+// GetDiscoveryBroker return the discovery broker of the adapter, shared by all
+// the Adapter1 instances with the same path and connection
+func GetDiscoveryBroker(a *adapter.Adapter1) *DiscoveryBroker {
+	return &DiscoveryBroker{
+		adapter: a,
+		key:     brokerKey{a.Client().Conn(), a.Path()},
 	}
-	err = a.SetDiscoverable(false)
-	if err != nil {
-		return nil, nil, err
+}
+
+// state return the discovery state of the adapter, nil if there are no subscribers
+func (b *DiscoveryBroker) state() *brokerState {
+	brokersLock.Lock()
+	defer brokersLock.Unlock()
+	return brokers[b.key]
+}
+
+// acquire return the discovery state of the adapter, kept until release is called
+func (b *DiscoveryBroker) acquire() *brokerState {
+	brokersLock.Lock()
+	defer brokersLock.Unlock()
+	state, ok := brokers[b.key]
+	if !ok {
+		state = &brokerState{}
+		brokers[b.key] = state
 	}
-	err = a.SetPowered(true)
-	if err != nil {
-		return nil, nil, err
+	state.users++
+	return state
+}
+
+// release the state returned by acquire, dropping it once unused
+func (b *DiscoveryBroker) release(state *brokerState) {
+	brokersLock.Lock()
+	defer brokersLock.Unlock()
+	state.users--
+	if state.users == 0 && brokers[b.key] == state {
+		delete(brokers, b.key)
 	}
+}
 
-	filterMap := make(map[string]interface{})
+// Subscribers return the number of active subscriptions
+func (b *DiscoveryBroker) Subscribers() int {
+	s := b.state()
+	if s == nil {
+		return 0
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.subscribers)
+}
+
+// Filter return the filter set on the adapter, nil if discovery is not running
+func (b *DiscoveryBroker) Filter() *adapter.DiscoveryFilter {
+	s := b.state()
+	if s == nil {
+		return nil
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.filter == nil {
+		return nil
+	}
+	filter := *s.filter
+	return &filter
+}
+
+// Subscribe start discovery, or update the filter if it is already running,
+// and return the devices matching filter. A nil filter matches any device.
+// Discovery stops once all the subscribers have called cancel.
+func (b *DiscoveryBroker) Subscribe(filter *adapter.DiscoveryFilter) (chan *adapter.DeviceDiscovered, func(), error) {
+
+	sub := &discoverySubscriber{
+		filter: adapter.NewDiscoveryFilter(),
+	}
 	if filter != nil {
-		filterMap = filter.ToMap()
+		sub.filter = *filter
 	}
-	err = a.SetDiscoveryFilter(filterMap)
+
+	// listen before starting to not miss the first devices
+	devices, devicesCancel, err := b.adapter.OnDeviceDiscovered()
 	if err != nil {
 		return nil, nil, err
 	}
 
-	err = a.StartDiscovery()
+	state := b.acquire()
+	err = state.add(b.adapter, sub)
 	if err != nil {
+		b.release(state)
+		devicesCancel()
 		return nil, nil, err
 	}
 
-	ch, discoveryCancel, err := a.OnDeviceDiscovered()
+	var (
+		ch   = make(chan *adapter.DeviceDiscovered)
+		done = make(chan struct{})
+	)
+
+	go func() {
+		defer close(ch)
+		for ev := range devices {
+			if ev.Type == adapter.DeviceAdded && !b.match(sub, ev.Path) {
+				continue
+			}
+			select {
+			case ch <- ev:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(done)
+			devicesCancel()
+			state.remove(sub)
+			b.release(state)
+		})
+	}
+
+	return ch, cancel, nil
+}
+
+// add a subscriber, starting discovery with a or updating the filter
+func (s *brokerState) add(a *adapter.Adapter1, sub *discoverySubscriber) error {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.subscribers = append(s.subscribers, sub)
+	filter := s.merge()
+
+	if len(s.subscribers) > 1 {
+		err := s.setFilter(filter)
+		if err != nil {
+			s.subscribers = s.subscribers[:len(s.subscribers)-1]
+		}
+		return err
+	}
+
+	s.adapter = a
+	err := s.start(filter)
 	if err != nil {
-		return nil, nil, err
+		s.subscribers = nil
+		s.filter = nil
+		s.adapter = nil
+		return err
 	}
 
-	cancel := func() {
-		err := a.StopDiscovery()
+	s.removeRestore, err = bluez.OnServiceRestartWithConn(a.Client().Conn(), s.restore)
+	if err != nil {
+		s.stop()
+		s.subscribers = nil
+		s.adapter = nil
+		return err
+	}
+
+	return nil
+}
+
+// remove a subscriber, stopping discovery with the last one
+func (s *brokerState) remove(sub *discoverySubscriber) {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for i, s1 := range s.subscribers {
+		if s1 == sub {
+			s.subscribers = append(s.subscribers[:i], s.subscribers[i+1:]...)
+			break
+		}
+	}
+
+	if len(s.subscribers) > 0 {
+		err := s.setFilter(s.merge())
 		if err != nil {
-			log.Warnf("Error stopping discovery: %s", err)
+			s.adapter.Client().Logger().WithMethod("SetDiscoveryFilter").Warnf("Error updating discovery filter: %s", err)
 		}
-		discoveryCancel()
+		return
 	}
 
-	return ch, cancel, nil
+	if s.removeRestore != nil {
+		s.removeRestore()
+		s.removeRestore = nil
+	}
+	s.stop()
+	s.adapter = nil
+}
+
+// merge the filters of the subscribers
+func (s *brokerState) merge() adapter.DiscoveryFilter {
+	filters := make([]*adapter.DiscoveryFilter, len(s.subscribers))
+	for i, sub := range s.subscribers {
+		filters[i] = &sub.filter
+	}
+	return adapter.MergeDiscoveryFilters(filters...)
+}
+
+// setFilter set the filter on the adapter if it changed
+func (s *brokerState) setFilter(filter adapter.DiscoveryFilter) error {
+	if s.filter != nil && reflect.DeepEqual(*s.filter, filter) {
+		return nil
+	}
+	err := s.adapter.SetDiscoveryFilter(filter.ToMap())
+	if errors.Is(err, bluez.ErrNotSupportedByDaemon) {
+		// the subscribers still get only the devices matching their filter
+		s.adapter.Client().Logger().WithMethod("SetDiscoveryFilter").Debugf("Discovery filter not supported, filtering discovered devices only")
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+	s.filter = &filter
+	return nil
+}
+
+// start power the adapter, set the filter and start discovery
+func (s *brokerState) start(filter adapter.DiscoveryFilter) error {
+
+	err := s.adapter.SetPowered(true)
+	if err != nil {
+		return err
+	}
+
+	s.filter = nil
+	err = s.setFilter(filter)
+	if err != nil {
+		return err
+	}
+
+	return s.adapter.StartDiscovery()
+}
+
+// stop discovery
+func (s *brokerState) stop() {
+	s.filter = nil
+	err := s.adapter.StopDiscovery()
+	if err != nil {
+		s.adapter.Client().Logger().WithMethod("StopDiscovery").Warnf("Error stopping discovery: %s", err)
+	}
+}
+
+// restore start discovery again after a bluetoothd restart
+func (s *brokerState) restore() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.subscribers) == 0 {
+		return nil
+	}
+	return s.start(s.merge())
+}
+
+// match report if a discovered device matches the filter of sub
+func (b *DiscoveryBroker) match(sub *discoverySubscriber, path dbus.ObjectPath) bool {
+
+	// the transport is checked too as it is lost when merged with another
+	transport := sub.filter.Transport == adapter.DiscoveryFilterTransportLE ||
+		sub.filter.Transport == adapter.DiscoveryFilterTransportBrEdr
+	if len(sub.filter.UUIDs) == 0 && sub.filter.RSSI == 0 && sub.filter.Pathloss == 0 && !transport {
+		return true
+	}
+
+	dev, err := device.NewDevice1WithConn(b.adapter.Client().Conn(), path)
+	if err != nil {
+		b.adapter.Client().Logger().Debugf("Discovery: %s: %s", path, err)
+		return false
+	}
+	props, err := dev.GetProperties()
+	if err != nil {
+		b.adapter.Client().Logger().Debugf("Discovery: %s: %s", path, err)
+		return false
+	}
+
+	return sub.filter.Match(props)
 }
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/muka/go-bluetooth/bluez/mock"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/stretchr/testify/assert"
+)
+
+func hasBrokerState(b *DiscoveryBroker) bool {
+	brokersLock.Lock()
+	defer brokersLock.Unlock()
+	_, ok := brokers[b.key]
+	return ok
+}
+
+func TestDiscoveryBrokerState(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	a, err := adapter.NewAdapter1FromAdapterID(mock.TestAdapterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	broker := GetDiscoveryBroker(a)
+	assert.False(t, hasBrokerState(broker))
+
+	_, cancel1, err := broker.Subscribe(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, cancel2, err := broker.Subscribe(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, hasBrokerState(broker))
+
+	cancel1()
+	assert.True(t, hasBrokerState(broker))
+
+	// the state is dropped on the last cancel
+	cancel2()
+	assert.False(t, hasBrokerState(broker))
+}
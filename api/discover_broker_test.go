@@ -0,0 +1,195 @@
+package api_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/api"
+	"github.com/muka/go-bluetooth/bluez/mock"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testUUID1 = "0000180d-0000-1000-8000-00805f9b34fb"
+	testUUID2 = "0000180f-0000-1000-8000-00805f9b34fb"
+)
+
+func nextDiscovered(t *testing.T, discovery <-chan *adapter.DeviceDiscovered) dbus.ObjectPath {
+	select {
+	case ev := <-discovery:
+		return ev.Path
+	case <-time.After(mock.WaitFor):
+		t.Fatal("Timeout waiting for discovered device")
+	}
+	return ""
+}
+
+func TestDiscoveryBroker(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	a, err := adapter.NewAdapter1FromAdapterID(mock.TestAdapterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	broker := api.GetDiscoveryBroker(a)
+
+	f1 := adapter.NewDiscoveryFilter()
+	f1.AddUUIDs(testUUID1)
+	f1.RSSI = -70
+	discovery1, cancel1, err := api.Discover(a, &f1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel1()
+
+	f2 := adapter.NewDiscoveryFilter()
+	f2.AddUUIDs(testUUID2)
+	f2.RSSI = -80
+	discovery2, cancel2, err := broker.Subscribe(&f2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel2()
+
+	assert.Equal(t, 2, broker.Subscribers())
+	filter := broker.Filter()
+	if assert.NotNil(t, filter) {
+		assert.Equal(t, []string{testUUID1, testUUID2}, filter.UUIDs)
+		assert.Equal(t, int16(-80), filter.RSSI)
+	}
+
+	// pairable and discoverable are left as is
+	pairable, err := a.GetPairable()
+	assert.NoError(t, err)
+	assert.True(t, pairable)
+
+	discovering, err := a.GetDiscovering()
+	assert.NoError(t, err)
+	assert.True(t, discovering)
+
+	// each subscriber receives the devices matching its filter
+	addDevice := func(address string, uuids []string, rssi int16) dbus.ObjectPath {
+		path, err := b.AddDevice(mock.TestAdapterID, address, map[string]interface{}{
+			"UUIDs": uuids,
+			"RSSI":  rssi,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	found1 := make(chan *adapter.DeviceDiscovered, 10)
+	found2 := make(chan *adapter.DeviceDiscovered, 10)
+	go func() {
+		for ev := range discovery1 {
+			found1 <- ev
+		}
+	}()
+	go func() {
+		for ev := range discovery2 {
+			found2 <- ev
+		}
+	}()
+
+	dev1 := addDevice("00:00:00:00:00:01", []string{testUUID1}, -60)
+	dev2 := addDevice("00:00:00:00:00:02", []string{testUUID2}, -75)
+	addDevice("00:00:00:00:00:03", []string{testUUID1}, -75)
+	dev4 := addDevice("00:00:00:00:00:04", []string{testUUID1, testUUID2}, -50)
+
+	assert.Equal(t, dev1, nextDiscovered(t, found1))
+	assert.Equal(t, dev4, nextDiscovered(t, found1))
+	assert.Equal(t, dev2, nextDiscovered(t, found2))
+	assert.Equal(t, dev4, nextDiscovered(t, found2))
+
+	// the first cancel does not stop discovery for the others
+	cancel1()
+	assert.Equal(t, 1, broker.Subscribers())
+	filter = broker.Filter()
+	if assert.NotNil(t, filter) {
+		assert.Equal(t, []string{testUUID2}, filter.UUIDs)
+	}
+	discovering, err = a.GetDiscovering()
+	assert.NoError(t, err)
+	assert.True(t, discovering)
+
+	cancel2()
+	assert.Equal(t, 0, broker.Subscribers())
+	assert.Nil(t, broker.Filter())
+	discovering, err = a.GetDiscovering()
+	assert.NoError(t, err)
+	assert.False(t, discovering)
+}
+
+func TestDiscoveryBrokerTransport(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	a, err := adapter.NewAdapter1FromAdapterID(mock.TestAdapterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	broker := api.GetDiscoveryBroker(a)
+
+	fle := adapter.NewDiscoveryFilter()
+	fle.Transport = adapter.DiscoveryFilterTransportLE
+	discoveryLE, cancelLE, err := broker.Subscribe(&fle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancelLE()
+
+	fbredr := adapter.NewDiscoveryFilter()
+	fbredr.Transport = adapter.DiscoveryFilterTransportBrEdr
+	discoveryBrEdr, cancelBrEdr, err := broker.Subscribe(&fbredr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancelBrEdr()
+
+	// the merged filter scans both transports
+	filter := broker.Filter()
+	if assert.NotNil(t, filter) {
+		assert.Equal(t, adapter.DiscoveryFilterTransportAuto, filter.Transport)
+	}
+
+	devLE, err := b.AddDevice(mock.TestAdapterID, "00:00:00:00:00:01", map[string]interface{}{
+		"AddressType": "random",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	devBrEdr, err := b.AddDevice(mock.TestAdapterID, "00:00:00:00:00:02", map[string]interface{}{
+		"Class": uint32(0x240404),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, devLE, nextDiscovered(t, discoveryLE))
+	assert.Equal(t, devBrEdr, nextDiscovered(t, discoveryBrEdr))
+
+	select {
+	case ev := <-discoveryLE:
+		t.Fatalf("Unexpected device %s", ev.Path)
+	case ev := <-discoveryBrEdr:
+		t.Fatalf("Unexpected device %s", ev.Path)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// the broker is dropped after the last cancel and created again on subscribe
+	cancelLE()
+	cancelBrEdr()
+	assert.Equal(t, 0, broker.Subscribers())
+
+	_, cancel, err := api.Discover(a, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, broker.Subscribers())
+	cancel()
+	assert.Equal(t, 0, broker.Subscribers())
+}
@@ -6,7 +6,7 @@ import (
 	"github.com/godbus/dbus/v5"
 	"github.com/muka/go-bluetooth/bluez"
 	"github.com/muka/go-bluetooth/bluez/profile"
-	log "github.com/sirupsen/logrus"
+	"github.com/muka/go-bluetooth/logger"
 )
 
 // NewDBusObjectManager create a new instance
@@ -24,6 +24,27 @@ func NewDBusObjectManager(conn *dbus.Conn) (*DBusObjectManager, error) {
 type DBusObjectManager struct {
 	conn    *dbus.Conn
 	objects map[dbus.ObjectPath]map[string]bluez.Properties
+	logger  logger.Logger
+}
+
+// SetLogger set the logger of the object manager and of the services exposed
+// with it, nil for the default logger
+func (o *DBusObjectManager) SetLogger(l logger.Logger) {
+	o.logger = l
+}
+
+// Logger return the logger of the object manager
+func (o *DBusObjectManager) Logger() logger.Logger {
+	if o.logger == nil {
+		return logger.Default()
+	}
+	return o.logger
+}
+
+func (o *DBusObjectManager) log(method string) *logger.Entry {
+	return logger.New(o.logger).
+		WithField(logger.FieldInterface, bluez.ObjectManagerInterface).
+		WithMethod(method)
 }
 
 // SignalAdded notify of interfaces being added
@@ -71,7 +92,7 @@ func (o *DBusObjectManager) GetManagedObjects() (map[dbus.ObjectPath]map[string]
 			}
 			l, err := m.ToMap()
 			if err != nil {
-				log.Errorf("Failed to serialize properties: %s", err.Error())
+				o.log("GetManagedObjects").WithObject(path, i).Errorf("Failed to serialize properties: %s", err.Error())
 				return nil, &profile.ErrInvalidArguments
 			}
 			for k, v := range l {
@@ -80,20 +101,20 @@ func (o *DBusObjectManager) GetManagedObjects() (map[dbus.ObjectPath]map[string]
 			}
 		}
 	}
-	log.Tracef("ObjectManager.GetManagedObjects \n %v", props)
+	o.log("GetManagedObjects").Tracef("ObjectManager.GetManagedObjects: %d objects", len(props))
 	return props, nil
 }
 
 //AddObject add an object to the list
 func (o *DBusObjectManager) AddObject(path dbus.ObjectPath, val map[string]bluez.Properties) error {
-	log.Tracef("ObjectManager.AddObject: %s", path)
+	o.log("AddObject").WithField(logger.FieldPath, path).Tracef("ObjectManager.AddObject")
 	o.objects[path] = val
 	return o.SignalAdded(path)
 }
 
 //RemoveObject remove an object from the list
 func (o *DBusObjectManager) RemoveObject(path dbus.ObjectPath) error {
-	log.Tracef("ObjectManager.RemoveObject: %s", path)
+	o.log("RemoveObject").WithField(logger.FieldPath, path).Tracef("ObjectManager.RemoveObject")
 	if s, ok := o.objects[path]; ok {
 		delete(o.objects, path)
 		ifaces := make([]string, len(s))
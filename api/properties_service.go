@@ -7,8 +7,8 @@ import (
 	"github.com/godbus/dbus/v5/prop"
 	"github.com/muka/go-bluetooth/bluez"
 	"github.com/muka/go-bluetooth/bluez/profile"
+	"github.com/muka/go-bluetooth/logger"
 	"github.com/muka/go-bluetooth/props"
-	log "github.com/sirupsen/logrus"
 )
 
 // NewDBusProperties create a new instance
@@ -30,6 +30,13 @@ type DBusProperties struct {
 	props       map[string]bluez.Properties
 	propsConfig map[string]map[string]*props.PropInfo
 	instance    *prop.Properties
+	path        dbus.ObjectPath
+	logger      logger.Logger
+}
+
+// SetLogger set the logger of the properties, nil for the default logger
+func (p *DBusProperties) SetLogger(l logger.Logger) {
+	p.logger = l
 }
 
 func (p *DBusProperties) parseProperties() error {
@@ -46,6 +53,7 @@ func (p *DBusProperties) onChange(ev *prop.Change) *dbus.Error {
 	if _, ok := p.propsConfig[ev.Iface]; ok {
 		if conf, ok := p.propsConfig[ev.Iface][ev.Name]; ok {
 			if conf.Writable {
+				log := logger.New(p.logger).WithObject(p.path, ev.Iface).WithMethod("Set")
 				log.Debugf("Set %s.%s", ev.Iface, ev.Name)
 				prop := p.props[ev.Iface]
 				s := structs.New(prop)
@@ -85,6 +93,7 @@ func (p *DBusProperties) Expose(path dbus.ObjectPath) {
 		}
 	}
 
+	p.path = path
 	p.instance = prop.New(p.conn, path, propsConfig)
 }
 
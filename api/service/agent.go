@@ -4,6 +4,7 @@ import "github.com/muka/go-bluetooth/bluez/profile/agent"
 
 func (app *App) createAgent() (agent.Agent1Client, error) {
 	a := agent.NewDefaultSimpleAgent()
+	a.SetLogger(app.Logger())
 	return a, nil
 }
 
@@ -13,7 +13,7 @@ import (
 	"github.com/muka/go-bluetooth/bluez/profile/advertising"
 	"github.com/muka/go-bluetooth/bluez/profile/agent"
 	"github.com/muka/go-bluetooth/bluez/profile/gatt"
-	log "github.com/sirupsen/logrus"
+	"github.com/muka/go-bluetooth/logger"
 )
 
 // AppPath default app path
@@ -28,6 +28,11 @@ type AppOptions struct {
 	AgentSetAsDefault bool
 	UUIDSuffix        string
 	UUID              string
+	// Conn is the connection to expose the app on, defaults to the shared
+	// system bus connection
+	Conn *bluez.Conn
+	// Logger of the app, its services and agent, defaults to the logger of Conn
+	Logger logger.Logger
 }
 
 // NewApp initialize a new bluetooth service (app)
@@ -85,6 +90,7 @@ type App struct {
 	services      map[dbus.ObjectPath]*Service
 	advertisement *advertising.LEAdvertisement1Properties
 	gm            *gatt.GattManager1
+	removeRestore func()
 }
 
 func (app *App) init() error {
@@ -92,7 +98,7 @@ func (app *App) init() error {
 	// log.Tracef("Exposing %s", app.Path())
 
 	// log.Trace("Load adapter")
-	a, err := adapter.NewAdapter1FromAdapterID(app.adapterID)
+	a, err := adapter.NewAdapter1FromAdapterIDWithConn(app.Options.Conn, app.adapterID)
 	if err != nil {
 		return err
 	}
@@ -104,7 +110,7 @@ func (app *App) init() error {
 	}
 	app.agent = agent1
 
-	conn, err := dbus.SystemBus()
+	conn, err := app.Options.Conn.DBusConn()
 	if err != nil {
 		return err
 	}
@@ -114,6 +120,7 @@ func (app *App) init() error {
 	if err != nil {
 		return err
 	}
+	om.SetLogger(app.Logger())
 	app.objectManager = om
 
 	return err
@@ -121,6 +128,10 @@ func (app *App) init() error {
 
 // GenerateUUID generate a 128bit UUID
 func (app *App) GenerateUUID(uuidVal string) string {
+	// already a 128bit UUID
+	if len(uuidVal) == 36 {
+		return uuidVal
+	}
 	base := app.Options.UUID
 	if len(uuidVal) == 8 {
 		base = ""
@@ -184,7 +195,7 @@ func (app *App) ExportTree() (err error) {
 // Run initialize the application
 func (app *App) Run() (err error) {
 
-	log.Tracef("Expose %s (%s)", app.Path(), bluez.ObjectManagerInterface)
+	app.log(app.Path(), bluez.ObjectManagerInterface, "").Tracef("Expose %s (%s)", app.Path(), bluez.ObjectManagerInterface)
 	err = app.conn.Export(app.objectManager, app.Path(), bluez.ObjectManagerInterface)
 	if err != nil {
 		return err
@@ -200,7 +211,7 @@ func (app *App) Run() (err error) {
 		return fmt.Errorf("ExposeAgent: %s", err)
 	}
 
-	gm, err := gatt.NewGattManager1FromAdapterID(app.adapterID)
+	gm, err := gatt.NewGattManager1FromAdapterIDWithConn(app.Options.Conn, app.adapterID)
 	if err != nil {
 		return err
 	}
@@ -208,6 +219,14 @@ func (app *App) Run() (err error) {
 
 	options := map[string]interface{}{}
 	err = gm.RegisterApplication(app.Path(), options)
+	if err != nil {
+		return err
+	}
+
+	// register again when bluetoothd restarts
+	app.removeRestore, err = bluez.OnServiceRestartWithConn(app.Options.Conn, func() error {
+		return gm.RegisterApplication(app.Path(), options)
+	})
 
 	return err
 }
@@ -215,11 +234,16 @@ func (app *App) Run() (err error) {
 // Close close the app
 func (app *App) Close() {
 
+	if app.removeRestore != nil {
+		app.removeRestore()
+		app.removeRestore = nil
+	}
+
 	if app.agent != nil {
 
 		err := agent.RemoveAgent(app.agent)
 		if err != nil {
-			log.Warnf("RemoveAgent: %s", err)
+			app.log(app.agent.Path(), app.agent.Interface(), "").Warnf("RemoveAgent: %s", err)
 		}
 
 		// err =
@@ -232,7 +256,7 @@ func (app *App) Close() {
 	if app.gm != nil {
 		err1 := app.gm.UnregisterApplication(app.Path())
 		if err1 != nil {
-			log.Warnf("GattManager1.UnregisterApplication: %s", err1)
+			app.gm.Client().Logger().WithMethod("UnregisterApplication").Warnf("GattManager1.UnregisterApplication: %s", err1)
 		}
 	}
 }
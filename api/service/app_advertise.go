@@ -21,6 +21,6 @@ func (app *App) Advertise(timeout uint32) (func(), error) {
 		serviceUUIDs = append(serviceUUIDs, string(serviceUUID))
 	}
 
-	cancel, err := api.ExposeAdvertisement(app.adapterID, adv, timeout)
+	cancel, err := api.ExposeAdvertisementWithConn(app.Options.Conn, app.adapterID, adv, timeout)
 	return cancel, err
 }
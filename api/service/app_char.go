@@ -2,17 +2,33 @@ package service
 
 import (
 	"fmt"
+	"os"
+	"sync"
 
 	"github.com/godbus/dbus/v5"
 	"github.com/muka/go-bluetooth/api"
 	"github.com/muka/go-bluetooth/bluez"
 	"github.com/muka/go-bluetooth/bluez/profile/gatt"
-	log "github.com/sirupsen/logrus"
+	"github.com/muka/go-bluetooth/logger"
 )
 
 type CharReadCallback func(c *Char, options map[string]interface{}) ([]byte, error)
 type CharWriteCallback func(c *Char, value []byte) ([]byte, error)
 
+// CharReadOptionsCallback is a read callback receiving the decoded options,
+// eg. the offset of a long read
+type CharReadOptionsCallback func(c *Char, options *gatt.GattCharacteristic1ReadValueOptions) ([]byte, error)
+
+// CharWriteOptionsCallback is a write callback receiving the decoded options,
+// eg. the offset of a long write or the write type
+type CharWriteOptionsCallback func(c *Char, value []byte, options *gatt.GattCharacteristic1WriteValueOptions) ([]byte, error)
+
+// CharNotifyCallback is called when a client subscribe or unsubscribe to notifications
+type CharNotifyCallback func(c *Char) error
+
+// CharConfirmCallback is called when a client confirm an indication
+type CharConfirmCallback func(c *Char)
+
 type Char struct {
 	UUID    string
 	app     *App
@@ -24,8 +40,23 @@ type Char struct {
 	Properties *gatt.GattCharacteristic1Properties
 	iprops     *api.DBusProperties
 
-	readCallback  CharReadCallback
-	writeCallback CharWriteCallback
+	readCallback        CharReadCallback
+	writeCallback       CharWriteCallback
+	readOptsCallback    CharReadOptionsCallback
+	writeOptsCallback   CharWriteOptionsCallback
+	subscribeCallback   CharNotifyCallback
+	unsubscribeCallback CharNotifyCallback
+	confirmCallback     CharConfirmCallback
+
+	notifyLock    sync.Mutex
+	indications   int
+	confirmations int
+
+	// the local ends of the acquired sockets
+	acquireLock  sync.Mutex
+	writeSocket  *os.File
+	notifySocket *os.File
+	notifyMTU    uint16
 }
 
 func (s *Char) Path() dbus.ObjectPath {
@@ -59,6 +90,11 @@ func (c *Char) App() *App {
 	return c.app
 }
 
+// log return an entry of the app logger, with the method field if not empty
+func (c *Char) log(method string) *logger.Entry {
+	return c.App().log(c.Path(), c.Interface(), method)
+}
+
 func (c *Char) Service() *Service {
 	return c.service
 }
@@ -94,6 +130,7 @@ func (s *Char) Expose() error {
 
 // Remove char from dbus
 func (s *Char) Remove() error {
+	s.releaseSockets()
 	return api.RemoveDBusService(s)
 }
 
@@ -113,6 +150,7 @@ func (s *Char) NewDescr(uuid string) (*Descr, error) {
 	if err != nil {
 		return nil, err
 	}
+	iprops.SetLogger(s.App().Logger())
 	descr.iprops = iprops
 
 	return descr, nil
@@ -143,7 +181,7 @@ func (s *Char) AddDescr(descr *Descr) error {
 		return err
 	}
 
-	log.Tracef("Added GATT Descriptor UUID=%s %s", descr.UUID, descr.Path())
+	descr.log("").Tracef("Added GATT Descriptor UUID=%s", descr.UUID)
 
 	err = s.App().ExportTree()
 	return err
@@ -152,11 +190,50 @@ func (s *Char) AddDescr(descr *Descr) error {
 // OnRead Set the Read callback, called when a client attempt to read
 func (s *Char) OnRead(fx CharReadCallback) *Char {
 	s.readCallback = fx
+	s.readOptsCallback = nil
 	return s
 }
 
 // OnWrite Set the Write callback, called when a client attempt to write
 func (s *Char) OnWrite(fx CharWriteCallback) *Char {
 	s.writeCallback = fx
+	s.writeOptsCallback = nil
+	return s
+}
+
+// OnReadOptions Set the Read callback receiving the typed options, it
+// replaces the callback set by OnRead
+func (s *Char) OnReadOptions(fx CharReadOptionsCallback) *Char {
+	s.readOptsCallback = fx
+	s.readCallback = nil
+	return s
+}
+
+// OnWriteOptions Set the Write callback receiving the typed options, it
+// replaces the callback set by OnWrite
+func (s *Char) OnWriteOptions(fx CharWriteOptionsCallback) *Char {
+	s.writeOptsCallback = fx
+	s.writeCallback = nil
+	return s
+}
+
+// OnSubscribe Set the Subscribe callback, called when a client enable
+// notifications or indications. Returning an error reject the subscription
+func (s *Char) OnSubscribe(fx CharNotifyCallback) *Char {
+	s.subscribeCallback = fx
+	return s
+}
+
+// OnUnsubscribe Set the Unsubscribe callback, called when the last client
+// disable notifications or indications
+func (s *Char) OnUnsubscribe(fx CharNotifyCallback) *Char {
+	s.unsubscribeCallback = fx
+	return s
+}
+
+// OnConfirm Set the Confirm callback, called when a client confirm an
+// indication. bluez does not report which device sent the confirmation.
+func (s *Char) OnConfirm(fx CharConfirmCallback) *Char {
+	s.confirmCallback = fx
 	return s
 }
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez/profile"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+)
+
+// AcquireWrite Acquire file descriptor and MTU for writing.
+// The values written by the client on the socket are passed to the
+// OnWrite callback, one packet per write.
+//
+// It is enabled setting Properties.WriteAcquired before exposing the
+// characteristic, which also requires the write-without-response flag.
+// bluez acquires the socket again once it dropped the previous one, which is
+// then released.
+//
+// Possible options: "device": Object Device (Server only)
+// 			"mtu": Exchanged MTU (Server only)
+// 			"link": Link type (Server only)
+//
+// Possible Errors: org.bluez.Error.Failed
+// 		 org.bluez.Error.NotSupported
+func (s *Char) AcquireWrite(options map[string]interface{}) (dbus.UnixFD, uint16, *dbus.Error) {
+
+	s.log("AcquireWrite").Debugf("Char.AcquireWrite")
+
+	if !s.Properties.WriteAcquired || !s.hasFlag(gatt.FlagCharacteristicWriteWithoutResponse) {
+		return 0, 0, &profile.ErrNotSupported
+	}
+
+	local, remote, err := gatt.NewSocketPair()
+	if err != nil {
+		return 0, 0, dbus.MakeFailedError(err)
+	}
+
+	s.acquireLock.Lock()
+	defer s.acquireLock.Unlock()
+
+	closeSockets(s.writeSocket)
+	s.writeSocket = local
+	go s.serveWrite(local)
+
+	return gatt.PassFD(remote), acquireMTU(options), nil
+}
+
+// AcquireNotify Acquire file descriptor and MTU for notify.
+// While acquired, Notify and Indicate send the values on the socket and the
+// Value property is not updated. The OnSubscribe callback is called when
+// the socket is acquired and OnUnsubscribe when bluez closes it or on Remove.
+// bluez acquires the socket again once it dropped the previous one, which is
+// then replaced without calling the callbacks.
+//
+// It is enabled setting Properties.NotifyAcquired before exposing the
+// characteristic, which also requires the notify flag.
+//
+// Possible options: "device": Object Device (Server only)
+// 			"mtu": Exchanged MTU (Server only)
+// 			"link": Link type (Server only)
+//
+// Possible Errors: org.bluez.Error.Failed
+// 		 org.bluez.Error.NotSupported
+func (s *Char) AcquireNotify(options map[string]interface{}) (dbus.UnixFD, uint16, *dbus.Error) {
+
+	s.log("AcquireNotify").Debugf("Char.AcquireNotify")
+
+	if !s.Properties.NotifyAcquired || !s.hasFlag(gatt.FlagCharacteristicNotify) {
+		return 0, 0, &profile.ErrNotSupported
+	}
+
+	local, remote, err := gatt.NewSocketPair()
+	if err != nil {
+		return 0, 0, dbus.MakeFailedError(err)
+	}
+
+	s.notifyLock.Lock()
+	if s.Properties.Notifying {
+		s.notifyLock.Unlock()
+		closeSockets(local, remote)
+		return 0, 0, &profile.ErrFailed
+	}
+	subscribed := s.notifySocket != nil
+	closeSockets(s.notifySocket)
+	mtu := acquireMTU(options)
+	s.notifySocket = local
+	s.notifyMTU = mtu
+	s.notifyLock.Unlock()
+
+	// the callback may push values, it is called without holding the lock
+	if !subscribed && s.subscribeCallback != nil {
+		err := s.subscribeCallback(s)
+		if err != nil {
+			s.notifyLock.Lock()
+			if s.notifySocket == local {
+				s.notifySocket = nil
+			}
+			s.notifyLock.Unlock()
+			closeSockets(local, remote)
+			return 0, 0, dbus.MakeFailedError(err)
+		}
+	}
+
+	go s.serveNotify(local)
+
+	return gatt.PassFD(remote), mtu, nil
+}
+
+// serveWrite pass the values received on the AcquireWrite socket to the
+// write callback until the socket is released
+func (s *Char) serveWrite(local *os.File) {
+
+	buf := make([]byte, gatt.MaxValueLength)
+	for {
+		n, err := local.Read(buf)
+		if err != nil {
+			break
+		}
+		dbusErr := s.WriteValue(append([]byte{}, buf[:n]...), map[string]interface{}{})
+		if dbusErr != nil {
+			s.log("AcquireWrite").Warnf("Acquired write failed: %s", dbusErr)
+		}
+	}
+
+	s.acquireLock.Lock()
+	if s.writeSocket == local {
+		closeSockets(s.writeSocket)
+		s.writeSocket = nil
+	}
+	s.acquireLock.Unlock()
+
+	s.log("AcquireWrite").Debugf("Write released")
+}
+
+// serveNotify wait for the AcquireNotify socket to be released
+func (s *Char) serveNotify(local *os.File) {
+
+	buf := make([]byte, 1)
+	for {
+		_, err := local.Read(buf)
+		if err != nil {
+			break
+		}
+	}
+
+	s.notifyLock.Lock()
+	released := s.notifySocket == local
+	if released {
+		closeSockets(s.notifySocket)
+		s.notifySocket = nil
+	}
+	s.notifyLock.Unlock()
+
+	if !released {
+		return
+	}
+	s.log("AcquireNotify").Debugf("Notify released")
+
+	if s.unsubscribeCallback != nil {
+		err := s.unsubscribeCallback(s)
+		if err != nil {
+			s.log("AcquireNotify").Warnf("Unsubscribe callback: %s", err)
+		}
+	}
+}
+
+// sendNotifySocket write a value on the AcquireNotify socket, notifyLock must be held
+func (s *Char) sendNotifySocket(value []byte) error {
+	max := int(s.notifyMTU) - gatt.ATTHeaderLen
+	if len(value) > max {
+		return fmt.Errorf("Value length %d exceeds the MTU, max is %d", len(value), max)
+	}
+	_, err := s.notifySocket.Write(value)
+	return err
+}
+
+// releaseSockets close the acquired sockets
+func (s *Char) releaseSockets() {
+	s.acquireLock.Lock()
+	closeSockets(s.writeSocket)
+	s.acquireLock.Unlock()
+
+	s.notifyLock.Lock()
+	closeSockets(s.notifySocket)
+	s.notifyLock.Unlock()
+}
+
+// closeSockets close the ends of an acquired socket, if set
+func closeSockets(files ...*os.File) {
+	for _, f := range files {
+		if f != nil {
+			f.Close()
+		}
+	}
+}
+
+// acquireMTU return the mtu option sent by bluez
+func acquireMTU(options map[string]interface{}) uint16 {
+	switch mtu := options["mtu"].(type) {
+	case uint16:
+		return mtu
+	case dbus.Variant:
+		if v, ok := mtu.Value().(uint16); ok {
+			return v
+		}
+	}
+	return gatt.DefaultMTU
+}
@@ -0,0 +1,253 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+	"github.com/stretchr/testify/assert"
+)
+
+// acquire call an Acquire method on the exposed characteristic as bluez would do
+func acquire(t *testing.T, c *Char, srv, client *dbus.Conn, method string, mtu uint16) (*gatt.GattCharacteristic1Properties, dbus.UnixFD, uint16, error) {
+
+	obj := client.Object(srv.Names()[0], c.Path())
+
+	props := map[string]dbus.Variant{}
+	err := obj.Call(bluez.PropertiesInterface+".GetAll", 0, c.Interface()).Store(&props)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exposed := new(gatt.GattCharacteristic1Properties)
+	_, exposed.WriteAcquired = props["WriteAcquired"]
+	_, exposed.NotifyAcquired = props["NotifyAcquired"]
+
+	var fd dbus.UnixFD
+	var resMTU uint16
+	err = obj.Call(c.Interface()+"."+method, 0, map[string]dbus.Variant{
+		"mtu": dbus.MakeVariant(mtu),
+	}).Store(&fd, &resMTU)
+
+	return exposed, fd, resMTU, err
+}
+
+func TestCharAcquireWrite(t *testing.T) {
+
+	props := NewGattCharacteristic1Properties("00002a19-0000-1000-8000-00805f9b34fb")
+	props.Flags = []string{gatt.FlagCharacteristicWriteWithoutResponse}
+	props.WriteAcquired = true
+
+	c := newTestChar(props)
+	written := make(chan []byte, 1)
+	c.OnWrite(func(c *Char, value []byte) ([]byte, error) {
+		written <- value
+		return value, nil
+	})
+
+	srv, client, cleanup := exposeTestChar(t, c)
+	defer cleanup()
+
+	exposed, fd, mtu, err := acquire(t, c, srv, client, "AcquireWrite", 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, exposed.WriteAcquired)
+	assert.False(t, exposed.NotifyAcquired)
+	assert.Equal(t, uint16(50), mtu)
+
+	file, err := gatt.NewFileFD(fd, "write")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = file.Write([]byte{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case value := <-written:
+		assert.Equal(t, []byte{1, 2}, value)
+	case <-time.After(time.Second * 5):
+		t.Fatal("Timeout waiting for write")
+	}
+
+	// bluez acquires again once it dropped the socket, which is replaced
+	file.Close()
+	_, fd, _, err = acquire(t, c, srv, client, "AcquireWrite", 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err = gatt.NewFileFD(fd, "write")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	_, err = file.Write([]byte{3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case value := <-written:
+		assert.Equal(t, []byte{3}, value)
+	case <-time.After(time.Second * 5):
+		t.Fatal("Timeout waiting for write")
+	}
+
+	c.releaseSockets()
+	assert.Eventually(t, func() bool {
+		c.acquireLock.Lock()
+		defer c.acquireLock.Unlock()
+		return c.writeSocket == nil
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func TestCharAcquireNotify(t *testing.T) {
+
+	props := NewGattCharacteristic1Properties("00002a19-0000-1000-8000-00805f9b34fb")
+	props.Flags = []string{gatt.FlagCharacteristicNotify}
+	props.NotifyAcquired = true
+
+	c := newTestChar(props)
+	// the callbacks can read the state
+	subscribed := make(chan bool, 2)
+	c.OnSubscribe(func(c *Char) error {
+		subscribed <- c.IsNotifying()
+		return nil
+	}).OnUnsubscribe(func(c *Char) error {
+		subscribed <- c.IsNotifying()
+		return nil
+	})
+
+	srv, client, cleanup := exposeTestChar(t, c)
+	defer cleanup()
+
+	exposed, fd, mtu, err := acquire(t, c, srv, client, "AcquireNotify", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, exposed.NotifyAcquired)
+	assert.Equal(t, uint16(10), mtu)
+	assert.True(t, <-subscribed)
+	assert.True(t, c.IsNotifying())
+
+	file, err := gatt.NewFileFD(fd, "notify")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = c.Notify([]byte{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 20)
+	n, err := file.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []byte{1, 2, 3}, buf[:n])
+
+	// the value is sent on the socket only
+	assert.Nil(t, c.Properties.Value)
+
+	// at most MTU - 3 bytes fit a notification
+	assert.Error(t, c.Notify(make([]byte, 8)))
+
+	// bluez acquires again once it dropped the socket, the session goes on
+	file.Close()
+	_, fd, _, err = acquire(t, c, srv, client, "AcquireNotify", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err = gatt.NewFileFD(fd, "notify")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	err = c.Notify([]byte{4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err = file.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []byte{4}, buf[:n])
+	assert.Len(t, subscribed, 0)
+
+	c.releaseSockets()
+	select {
+	case sub := <-subscribed:
+		assert.False(t, sub)
+	case <-time.After(time.Second * 5):
+		t.Fatal("Timeout waiting for release")
+	}
+	assert.False(t, c.IsNotifying())
+}
+
+func TestCharAcquireNotifyPeerRelease(t *testing.T) {
+
+	props := NewGattCharacteristic1Properties("00002a19-0000-1000-8000-00805f9b34fb")
+	props.Flags = []string{gatt.FlagCharacteristicNotify}
+	props.NotifyAcquired = true
+
+	c := newTestChar(props)
+	unsubscribed := make(chan struct{}, 1)
+	c.OnUnsubscribe(func(c *Char) error {
+		unsubscribed <- struct{}{}
+		return nil
+	})
+
+	srv, client, cleanup := exposeTestChar(t, c)
+	defer cleanup()
+
+	_, fd, _, err := acquire(t, c, srv, client, "AcquireNotify", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := gatt.NewFileFD(fd, "notify")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, c.IsNotifying())
+
+	// bluez closing its end releases the socket
+	file.Close()
+	select {
+	case <-unsubscribed:
+	case <-time.After(time.Second * 5):
+		t.Fatal("Timeout waiting for release")
+	}
+	assert.False(t, c.IsNotifying())
+
+	done := make(chan error, 1)
+	go func() {
+		done <- c.Notify([]byte{1})
+	}()
+	select {
+	case err := <-done:
+		assert.Equal(t, ErrNotNotifying, err)
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for Notify")
+	}
+}
+
+func TestCharAcquireNotSupported(t *testing.T) {
+
+	props := NewGattCharacteristic1Properties("00002a19-0000-1000-8000-00805f9b34fb")
+	props.Flags = []string{gatt.FlagCharacteristicWriteWithoutResponse, gatt.FlagCharacteristicNotify}
+
+	c := newTestChar(props)
+	srv, client, cleanup := exposeTestChar(t, c)
+	defer cleanup()
+
+	exposed, _, _, err := acquire(t, c, srv, client, "AcquireWrite", 50)
+	assert.False(t, exposed.WriteAcquired)
+	assert.False(t, exposed.NotifyAcquired)
+	assert.NotNil(t, err)
+	assert.Equal(t, "org.bluez.Error.NotSupported", err.(dbus.Error).Name)
+
+	_, _, _, err = acquire(t, c, srv, client, "AcquireNotify", 50)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+)
+
+// ErrNotNotifying is returned when pushing a value while no client is subscribed
+var ErrNotNotifying = errors.New("Characteristic is not notifying")
+
+// IsNotifying return true if a client is subscribed to notifications or
+// indications, or has acquired notifications
+func (s *Char) IsNotifying() bool {
+	s.notifyLock.Lock()
+	defer s.notifyLock.Unlock()
+	return s.Properties.Notifying || s.notifySocket != nil
+}
+
+// Notify update the characteristic value and send a notification to the
+// subscribed clients. It fails with ErrNotNotifying if there are no subscribers
+func (s *Char) Notify(value []byte) error {
+	if !s.hasFlag(gatt.FlagCharacteristicNotify) {
+		return errors.New("Characteristic does not support notify")
+	}
+	return s.pushValue(value)
+}
+
+// Indicate update the characteristic value and send an indication to the
+// subscribed clients, which will confirm it calling Confirm.
+// It fails with ErrNotNotifying if there are no subscribers
+func (s *Char) Indicate(value []byte) error {
+	if !s.hasFlag(gatt.FlagCharacteristicIndicate) {
+		return errors.New("Characteristic does not support indicate")
+	}
+	err := s.pushValue(value)
+	if err != nil {
+		return err
+	}
+	s.notifyLock.Lock()
+	s.indications++
+	s.notifyLock.Unlock()
+	return nil
+}
+
+// Confirmations return the number of indications sent and the number of
+// confirmations received from clients
+func (s *Char) Confirmations() (indications int, confirmations int) {
+	s.notifyLock.Lock()
+	defer s.notifyLock.Unlock()
+	return s.indications, s.confirmations
+}
+
+// pushValue emit PropertiesChanged on Value if a client is subscribed, or
+// write the value on the AcquireNotify socket
+func (s *Char) pushValue(value []byte) error {
+
+	s.notifyLock.Lock()
+	defer s.notifyLock.Unlock()
+
+	if s.notifySocket != nil {
+		return s.sendNotifySocket(value)
+	}
+
+	if !s.Properties.Notifying {
+		return ErrNotNotifying
+	}
+
+	if s.iprops.Instance() == nil {
+		return errors.New("Characteristic is not exposed")
+	}
+
+	s.Properties.Value = value
+	dbusErr := s.iprops.Instance().Set(s.Interface(), "Value", dbus.MakeVariant(value))
+	if dbusErr != nil {
+		return dbusErr
+	}
+
+	return nil
+}
+
+// setNotifying update the Notifying property, notifyLock must be held
+func (s *Char) setNotifying(notifying bool) {
+	s.Properties.Notifying = notifying
+	if s.iprops.Instance() != nil {
+		s.iprops.Instance().SetMust(s.Interface(), "Notifying", notifying)
+	}
+}
+
+func (s *Char) hasFlag(flag string) bool {
+	for _, f := range s.Properties.Flags {
+		if f == flag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"errors"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/api"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/mock"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+	"github.com/stretchr/testify/assert"
+)
+
+// createNotifyChar expose a characteristic properties on a private bus and
+// return a channel receiving its PropertiesChanged signals
+func createNotifyChar(t *testing.T, flags ...string) (*Char, chan *dbus.Signal, func()) {
+	props := NewGattCharacteristic1Properties("00002a19-0000-1000-8000-00805f9b34fb")
+	props.Flags = flags
+	c := newTestChar(props)
+	_, listener, cleanup := exposeTestChar(t, c)
+
+	err := listener.AddMatchSignal(
+		dbus.WithMatchInterface(bluez.PropertiesInterface),
+		dbus.WithMatchObjectPath(c.Path()),
+	)
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	signals := make(chan *dbus.Signal, 10)
+	listener.Signal(signals)
+
+	return c, signals, cleanup
+}
+
+// newTestChar create a characteristic with the given properties
+func newTestChar(props *gatt.GattCharacteristic1Properties) *Char {
+	props.Service = dbus.ObjectPath("/test/service0")
+	return &Char{
+		UUID:       props.UUID,
+		path:       dbus.ObjectPath("/test/service0/char0"),
+		descr:      make(map[dbus.ObjectPath]*Descr),
+		Properties: props,
+	}
+}
+
+// exposeTestChar export a characteristic with its properties on a private
+// bus, returning the exporting connection and a second connection to the bus
+func exposeTestChar(t *testing.T, c *Char) (*dbus.Conn, *dbus.Conn, func()) {
+
+	if _, err := exec.LookPath(mock.DBusDaemonBin); err != nil {
+		t.Skip("dbus-daemon not available")
+	}
+
+	bus, err := mock.StartBus()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := bus.Connect()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	iprops, err := api.NewDBusProperties(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.iprops = iprops
+	err = iprops.AddProperties(c.Interface(), c.Properties)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iprops.Expose(c.Path())
+
+	// export the characteristic once set up, the calls are served on
+	// other goroutines
+	err = conn.Export(c, c.Path(), c.Interface())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listener, err := bus.Connect()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return conn, listener, func() {
+		c.releaseSockets()
+		listener.Close()
+		conn.Close()
+		bus.Close()
+	}
+}
+
+func waitChange(t *testing.T, signals chan *dbus.Signal, name string) dbus.Variant {
+	timeout := time.After(time.Second * 5)
+	for {
+		select {
+		case sig := <-signals:
+			changed := sig.Body[1].(map[string]dbus.Variant)
+			if v, ok := changed[name]; ok {
+				return v
+			}
+		case <-timeout:
+			t.Fatalf("Timeout waiting for %s change", name)
+		}
+	}
+}
+
+func TestCharNotify(t *testing.T) {
+
+	c, signals, cleanup := createNotifyChar(t, gatt.FlagCharacteristicRead, gatt.FlagCharacteristicNotify)
+	defer cleanup()
+
+	subscribed := 0
+	unsubscribed := 0
+	c.OnSubscribe(func(c *Char) error {
+		subscribed++
+		return nil
+	}).OnUnsubscribe(func(c *Char) error {
+		unsubscribed++
+		return nil
+	})
+
+	err := c.Notify([]byte{1})
+	assert.Equal(t, ErrNotNotifying, err)
+
+	assert.Nil(t, c.StartNotify())
+	assert.True(t, c.IsNotifying())
+	assert.Equal(t, 1, subscribed)
+
+	// a second session does not trigger the callback again
+	assert.Nil(t, c.StartNotify())
+	assert.Equal(t, 1, subscribed)
+
+	err = c.Notify([]byte{42})
+	if err != nil {
+		t.Fatal(err)
+	}
+	value := waitChange(t, signals, "Value")
+	assert.Equal(t, []byte{42}, value.Value())
+	assert.Equal(t, []byte{42}, c.Properties.Value)
+
+	assert.Nil(t, c.StopNotify())
+	assert.False(t, c.IsNotifying())
+	assert.Equal(t, 1, unsubscribed)
+	assert.NotNil(t, c.StopNotify())
+
+	err = c.Notify([]byte{43})
+	assert.Equal(t, ErrNotNotifying, err)
+
+	// indications are not enabled
+	assert.Error(t, c.Indicate([]byte{1}))
+}
+
+func TestCharNotifyFromCallbacks(t *testing.T) {
+
+	c, signals, cleanup := createNotifyChar(t, gatt.FlagCharacteristicNotify)
+	defer cleanup()
+
+	// the callbacks can push values and read the state
+	c.OnSubscribe(func(c *Char) error {
+		assert.True(t, c.IsNotifying())
+		return c.Notify([]byte{1})
+	}).OnUnsubscribe(func(c *Char) error {
+		assert.False(t, c.IsNotifying())
+		return nil
+	})
+
+	done := make(chan bool)
+	go func() {
+		assert.Nil(t, c.StartNotify())
+		assert.Nil(t, c.StopNotify())
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second * 5):
+		t.Fatal("Timeout waiting for the notify session")
+	}
+	value := waitChange(t, signals, "Value")
+	assert.Equal(t, []byte{1}, value.Value())
+
+	// a rejected subscription does not start notifying
+	c.OnSubscribe(func(c *Char) error {
+		return errors.New("rejected")
+	})
+	assert.NotNil(t, c.StartNotify())
+	assert.False(t, c.IsNotifying())
+}
+
+func TestCharIndicate(t *testing.T) {
+
+	c, signals, cleanup := createNotifyChar(t, gatt.FlagCharacteristicIndicate)
+	defer cleanup()
+
+	confirmed := make(chan bool, 1)
+	c.OnConfirm(func(c *Char) {
+		confirmed <- true
+	})
+
+	assert.Nil(t, c.StartNotify())
+
+	err := c.Indicate([]byte{7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	value := waitChange(t, signals, "Value")
+	assert.Equal(t, []byte{7}, value.Value())
+
+	assert.Nil(t, c.Confirm())
+	<-confirmed
+
+	indications, confirmations := c.Confirmations()
+	assert.Equal(t, 1, indications)
+	assert.Equal(t, 1, confirmations)
+}
+
+func TestCharStartNotifyNotSupported(t *testing.T) {
+
+	c, _, cleanup := createNotifyChar(t, gatt.FlagCharacteristicRead)
+	defer cleanup()
+
+	err := c.StartNotify()
+	assert.NotNil(t, err)
+	assert.Equal(t, "org.bluez.Error.NotSupported", err.Name)
+	assert.False(t, c.IsNotifying())
+}
@@ -2,15 +2,25 @@ package service
 
 import (
 	"github.com/godbus/dbus/v5"
-	log "github.com/sirupsen/logrus"
+	"github.com/muka/go-bluetooth/bluez/profile"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
 )
 
 // Confirm This method doesn't expect a reply so it is just a
-// confirmation that value was received.
+// confirmation that value was received. bluez does not pass the device
+// confirming, only the confirmations are counted.
 //
 // Possible Errors: org.bluez.Error.Failed
 func (s *Char) Confirm() *dbus.Error {
-	log.Debug("Char.Confirm")
+	s.log("Confirm").Debugf("Char.Confirm")
+
+	s.notifyLock.Lock()
+	s.confirmations++
+	s.notifyLock.Unlock()
+
+	if s.confirmCallback != nil {
+		s.confirmCallback(s)
+	}
 	return nil
 }
 
@@ -22,7 +32,31 @@ func (s *Char) Confirm() *dbus.Error {
 // 		 org.bluez.Error.InProgress
 // 		 org.bluez.Error.NotSupported
 func (s *Char) StartNotify() *dbus.Error {
-	log.Debug("Char.StartNotify")
+	s.log("StartNotify").Debugf("Char.StartNotify")
+
+	if !s.hasFlag(gatt.FlagCharacteristicNotify) && !s.hasFlag(gatt.FlagCharacteristicIndicate) {
+		return &profile.ErrNotSupported
+	}
+
+	s.notifyLock.Lock()
+	if s.Properties.Notifying {
+		s.notifyLock.Unlock()
+		return nil
+	}
+	s.setNotifying(true)
+	s.notifyLock.Unlock()
+
+	// the callback may push values, it is called without holding the lock
+	if s.subscribeCallback != nil {
+		err := s.subscribeCallback(s)
+		if err != nil {
+			s.notifyLock.Lock()
+			s.setNotifying(false)
+			s.notifyLock.Unlock()
+			return dbus.MakeFailedError(err)
+		}
+	}
+
 	return nil
 }
 
@@ -33,7 +67,23 @@ func (s *Char) StartNotify() *dbus.Error {
 //
 // Possible Errors: org.bluez.Error.Failed
 func (s *Char) StopNotify() *dbus.Error {
-	log.Debug("Char.StopNotify")
+	s.log("StopNotify").Debugf("Char.StopNotify")
+
+	s.notifyLock.Lock()
+	if !s.Properties.Notifying {
+		s.notifyLock.Unlock()
+		return &profile.ErrFailed
+	}
+	s.setNotifying(false)
+	s.notifyLock.Unlock()
+
+	if s.unsubscribeCallback != nil {
+		err := s.unsubscribeCallback(s)
+		if err != nil {
+			return dbus.MakeFailedError(err)
+		}
+	}
+
 	return nil
 }
 
@@ -50,9 +100,27 @@ func (s *Char) StopNotify() *dbus.Error {
 // 		 org.bluez.Error.NotAuthorized
 // 		 org.bluez.Error.InvalidOffset
 // 		 org.bluez.Error.NotSupported
+//
+// The options are decoded in GattCharacteristic1ReadValueOptions only for the
+// callback set by OnReadOptions, an option with an unexpected type is logged and
+// left unset.
 func (s *Char) ReadValue(options map[string]interface{}) ([]byte, *dbus.Error) {
 
-	log.Debug("Characteristic.ReadValue")
+	s.log("ReadValue").Debugf("Characteristic.ReadValue")
+
+	if s.readOptsCallback != nil {
+		opts := new(gatt.GattCharacteristic1ReadValueOptions)
+		err := opts.FromMap(options)
+		if err != nil {
+			s.log("ReadValue").Warnf("Characteristic.ReadValue: %s", err)
+		}
+		b, err := s.readOptsCallback(s, opts)
+		if err != nil {
+			return nil, dbus.MakeFailedError(err)
+		}
+		return b, nil
+	}
+
 	if s.readCallback != nil {
 		b, err := s.readCallback(s, options)
 		if err != nil {
@@ -61,6 +129,8 @@ func (s *Char) ReadValue(options map[string]interface{}) ([]byte, *dbus.Error) {
 		return b, nil
 	}
 
+	s.notifyLock.Lock()
+	defer s.notifyLock.Unlock()
 	return s.Properties.Value, nil
 }
 
@@ -80,25 +150,42 @@ func (s *Char) ReadValue(options map[string]interface{}) ([]byte, *dbus.Error) {
 // 		 org.bluez.Error.InvalidValueLength
 // 		 org.bluez.Error.NotAuthorized
 // 		 org.bluez.Error.NotSupported
+//
+// The options are decoded in GattCharacteristic1WriteValueOptions only for the
+// callback set by OnWriteOptions, an option with an unexpected type is logged and
+// left unset.
 func (s *Char) WriteValue(value []byte, options map[string]interface{}) *dbus.Error {
 
-	log.Trace("Characteristic.WriteValue")
+	log := s.log("WriteValue")
+	log.Tracef("Characteristic.WriteValue")
 
 	val := value
-	if s.writeCallback != nil {
-		log.Trace("Used write callback")
+	if s.writeOptsCallback != nil {
+		log.Tracef("Used write callback")
+		opts := new(gatt.GattCharacteristic1WriteValueOptions)
+		err := opts.FromMap(options)
+		if err != nil {
+			log.Warnf("Characteristic.WriteValue: %s", err)
+		}
+		b, err := s.writeOptsCallback(s, value, opts)
+		val = b
+		if err != nil {
+			return dbus.MakeFailedError(err)
+		}
+	} else if s.writeCallback != nil {
+		log.Tracef("Used write callback")
 		b, err := s.writeCallback(s, value)
 		val = b
 		if err != nil {
 			return dbus.MakeFailedError(err)
 		}
 	} else {
-		log.Trace("Store directly to value (no callback)")
+		log.Tracef("Store directly to value (no callback)")
 	}
 
 	// TODO update on Properties interface
+	s.notifyLock.Lock()
+	defer s.notifyLock.Unlock()
 	s.Properties.Value = val
-	err := s.iprops.Instance().Set(s.Interface(), "Value", dbus.MakeVariant(value))
-
-	return err
+	return s.iprops.Instance().Set(s.Interface(), "Value", dbus.MakeVariant(value))
 }
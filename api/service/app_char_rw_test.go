@@ -0,0 +1,103 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCharReadWriteOptions(t *testing.T) {
+
+	props := NewGattCharacteristic1Properties("00002a19-0000-1000-8000-00805f9b34fb")
+	props.Flags = []string{gatt.FlagCharacteristicRead, gatt.FlagCharacteristicWrite}
+	c := newTestChar(props)
+
+	// the callbacks run on the goroutine serving the calls, the options are
+	// returned on channels
+	readOptions := make(chan *gatt.GattCharacteristic1ReadValueOptions, 1)
+	writeOptions := make(chan *gatt.GattCharacteristic1WriteValueOptions, 1)
+	c.OnReadOptions(func(c *Char, options *gatt.GattCharacteristic1ReadValueOptions) ([]byte, error) {
+		readOptions <- options
+		return []byte{1, 2, 3}[options.Offset:], nil
+	}).OnWriteOptions(func(c *Char, value []byte, options *gatt.GattCharacteristic1WriteValueOptions) ([]byte, error) {
+		writeOptions <- options
+		return value, nil
+	})
+
+	conn, client, cleanup := exposeTestChar(t, c)
+	defer cleanup()
+
+	obj := client.Object(conn.Names()[0], c.Path())
+
+	read := gatt.GattCharacteristic1ReadValueOptions{
+		Offset: 1,
+		MTU:    23,
+		Device: dbus.ObjectPath("/org/bluez/hci0/dev_00_00_00_00_00_01"),
+	}
+	var value []byte
+	err := obj.Call(gatt.GattCharacteristic1Interface+".ReadValue", 0, read.ToMap()).Store(&value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []byte{2, 3}, value)
+	assert.Equal(t, read, *<-readOptions)
+
+	write := gatt.GattCharacteristic1WriteValueOptions{
+		Type:             "request",
+		Link:             "LE",
+		PrepareAuthorize: true,
+	}
+	err = obj.Call(gatt.GattCharacteristic1Interface+".WriteValue", 0, []byte{4}, write.ToMap()).Store()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, write, *<-writeOptions)
+	stored, err := obj.GetProperty(gatt.GattCharacteristic1Interface + ".Value")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []byte{4}, stored.Value())
+
+	// an option with the wrong type is left unset
+	err = obj.Call(gatt.GattCharacteristic1Interface+".ReadValue", 0, map[string]interface{}{
+		"offset": "1",
+		"mtu":    uint16(23),
+	}).Store(&value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []byte{1, 2, 3}, value)
+	assert.Equal(t, gatt.GattCharacteristic1ReadValueOptions{MTU: 23}, *<-readOptions)
+}
+
+func TestCharWriteValueNotify(t *testing.T) {
+
+	props := NewGattCharacteristic1Properties("00002a19-0000-1000-8000-00805f9b34fb")
+	props.Flags = []string{gatt.FlagCharacteristicWrite, gatt.FlagCharacteristicNotify}
+	c := newTestChar(props)
+
+	conn, client, cleanup := exposeTestChar(t, c)
+	defer cleanup()
+
+	assert.Nil(t, c.StartNotify())
+
+	// the client writes while the server pushes values
+	obj := client.Object(conn.Names()[0], c.Path())
+	done := make(chan error)
+	go func() {
+		for i := 0; i < 20; i++ {
+			call := obj.Call(gatt.GattCharacteristic1Interface+".WriteValue", 0, []byte{byte(i)}, map[string]dbus.Variant{})
+			if call.Err != nil {
+				done <- call.Err
+				return
+			}
+		}
+		done <- nil
+	}()
+	for i := 0; i < 20; i++ {
+		assert.NoError(t, c.Notify([]byte{byte(i)}))
+	}
+	assert.NoError(t, <-done)
+}
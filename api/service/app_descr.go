@@ -5,11 +5,18 @@ import (
 	"github.com/muka/go-bluetooth/api"
 	"github.com/muka/go-bluetooth/bluez"
 	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+	"github.com/muka/go-bluetooth/logger"
 )
 
 type DescrReadCallback func(c *Descr, options map[string]interface{}) ([]byte, error)
 type DescrWriteCallback func(c *Descr, value []byte) ([]byte, error)
 
+// DescrReadOptionsCallback is a read callback receiving the decoded options
+type DescrReadOptionsCallback func(c *Descr, options *gatt.GattDescriptor1ReadValueOptions) ([]byte, error)
+
+// DescrWriteOptionsCallback is a write callback receiving the decoded options
+type DescrWriteOptionsCallback func(c *Descr, value []byte, options *gatt.GattDescriptor1WriteValueOptions) ([]byte, error)
+
 type Descr struct {
 	UUID string
 	app  *App
@@ -20,8 +27,10 @@ type Descr struct {
 	Properties *gatt.GattDescriptor1Properties
 	iprops     *api.DBusProperties
 
-	readCallback  DescrReadCallback
-	writeCallback DescrWriteCallback
+	readCallback      DescrReadCallback
+	writeCallback     DescrWriteCallback
+	readOptsCallback  DescrReadOptionsCallback
+	writeOptsCallback DescrWriteOptionsCallback
 }
 
 func (s *Descr) DBusProperties() *api.DBusProperties {
@@ -57,6 +66,11 @@ func (s *Descr) App() *App {
 	return s.app
 }
 
+// log return an entry of the app logger, with the method field if not empty
+func (s *Descr) log(method string) *logger.Entry {
+	return s.App().log(s.Path(), s.Interface(), method)
+}
+
 // Expose descr to dbus
 func (s *Descr) Expose() error {
 	return api.ExposeDBusService(s)
@@ -2,25 +2,56 @@ package service
 
 import (
 	"github.com/godbus/dbus/v5"
-	log "github.com/sirupsen/logrus"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
 )
 
 // Set the Read callback, called when a client attempt to read
 func (s *Descr) OnRead(fx DescrReadCallback) *Descr {
 	s.readCallback = fx
+	s.readOptsCallback = nil
 	return s
 }
 
 // Set the Write callback, called when a client attempt to write
 func (s *Descr) OnWrite(fx DescrWriteCallback) *Descr {
 	s.writeCallback = fx
+	s.writeOptsCallback = nil
+	return s
+}
+
+// OnReadOptions Set the Read callback receiving the typed options, it
+// replaces the callback set by OnRead
+func (s *Descr) OnReadOptions(fx DescrReadOptionsCallback) *Descr {
+	s.readOptsCallback = fx
+	s.readCallback = nil
+	return s
+}
+
+// OnWriteOptions Set the Write callback receiving the typed options, it
+// replaces the callback set by OnWrite
+func (s *Descr) OnWriteOptions(fx DescrWriteOptionsCallback) *Descr {
+	s.writeOptsCallback = fx
+	s.writeCallback = nil
 	return s
 }
 
 //ReadValue read a value
 func (s *Descr) ReadValue(options map[string]interface{}) ([]byte, *dbus.Error) {
 
-	log.Trace("Descr.ReadValue")
+	s.log("ReadValue").Tracef("Descr.ReadValue")
+
+	if s.readOptsCallback != nil {
+		opts := new(gatt.GattDescriptor1ReadValueOptions)
+		err := opts.FromMap(options)
+		if err != nil {
+			s.log("ReadValue").Warnf("Descr.ReadValue: %s", err)
+		}
+		b, err := s.readOptsCallback(s, opts)
+		if err != nil {
+			return nil, dbus.MakeFailedError(err)
+		}
+		return b, nil
+	}
 
 	if s.readCallback != nil {
 		b, err := s.readCallback(s, options)
@@ -36,23 +67,34 @@ func (s *Descr) ReadValue(options map[string]interface{}) ([]byte, *dbus.Error)
 //WriteValue write a value
 func (s *Descr) WriteValue(value []byte, options map[string]interface{}) *dbus.Error {
 
-	log.Trace("Descr.WriteValue")
+	log := s.log("WriteValue")
+	log.Tracef("Descr.WriteValue")
 
 	val := value
-	if s.writeCallback != nil {
-		log.Trace("Used write callback")
+	if s.writeOptsCallback != nil {
+		log.Tracef("Used write callback")
+		opts := new(gatt.GattDescriptor1WriteValueOptions)
+		err := opts.FromMap(options)
+		if err != nil {
+			log.Warnf("Descr.WriteValue: %s", err)
+		}
+		b, err := s.writeOptsCallback(s, value, opts)
+		val = b
+		if err != nil {
+			return dbus.MakeFailedError(err)
+		}
+	} else if s.writeCallback != nil {
+		log.Tracef("Used write callback")
 		b, err := s.writeCallback(s, value)
 		val = b
 		if err != nil {
 			return dbus.MakeFailedError(err)
 		}
 	} else {
-		log.Trace("Store directly to value (no callback)")
+		log.Tracef("Store directly to value (no callback)")
 	}
 
 	// TODO update on Properties interface
 	s.Properties.Value = val
-	err := s.iprops.Instance().Set(s.Interface(), "Value", dbus.MakeVariant(value))
-
-	return err
+	return s.iprops.Instance().Set(s.Interface(), "Value", dbus.MakeVariant(value))
 }
@@ -5,6 +5,7 @@ import (
 	"github.com/muka/go-bluetooth/api"
 	"github.com/muka/go-bluetooth/bluez/profile/adapter"
 	"github.com/muka/go-bluetooth/bluez/profile/agent"
+	"github.com/muka/go-bluetooth/logger"
 )
 
 func (app *App) AdapterID() string {
@@ -29,6 +30,28 @@ func (app *App) DBusConn() *dbus.Conn {
 	return app.conn
 }
 
+// Logger return the logger of the app, Options.Logger or the logger of
+// Options.Conn
+func (app *App) Logger() logger.Logger {
+	// objects not yet added to an app
+	if app == nil {
+		return logger.Default()
+	}
+	if app.Options.Logger != nil {
+		return app.Options.Logger
+	}
+	return app.Options.Conn.Logger()
+}
+
+// log return an entry of the app logger for an exposed object
+func (app *App) log(path dbus.ObjectPath, iface, method string) *logger.Entry {
+	l := logger.New(app.Logger()).WithObject(path, iface)
+	if method != "" {
+		l = l.WithMethod(method)
+	}
+	return l
+}
+
 func (app *App) DBusObjectManager() *api.DBusObjectManager {
 	return app.objectManager
 }
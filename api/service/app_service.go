@@ -7,7 +7,7 @@ import (
 	"github.com/muka/go-bluetooth/api"
 	"github.com/muka/go-bluetooth/bluez"
 	"github.com/muka/go-bluetooth/bluez/profile/gatt"
-	log "github.com/sirupsen/logrus"
+	"github.com/muka/go-bluetooth/logger"
 )
 
 type Service struct {
@@ -46,6 +46,11 @@ func (s *Service) App() *App {
 	return s.app
 }
 
+// log return an entry of the app logger, with the method field if not empty
+func (s *Service) log(method string) *logger.Entry {
+	return s.App().log(s.Path(), s.Interface(), method)
+}
+
 func (s *Service) DBusObjectManager() *api.DBusObjectManager {
 	return s.App().DBusObjectManager()
 }
@@ -86,6 +91,7 @@ func (s *Service) NewChar(uuid string) (*Char, error) {
 	if err != nil {
 		return nil, err
 	}
+	iprops.SetLogger(s.App().Logger())
 	char.iprops = iprops
 
 	return char, nil
@@ -115,7 +121,7 @@ func (s *Service) AddChar(char *Char) error {
 		return err
 	}
 
-	log.Tracef("Added GATT Characteristic UUID=%s %s", char.UUID, char.Path())
+	char.log("").Tracef("Added GATT Characteristic UUID=%s", char.UUID)
 
 	err = s.App().ExportTree()
 	return err
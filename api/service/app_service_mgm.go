@@ -7,7 +7,6 @@ import (
 	"github.com/godbus/dbus/v5"
 	"github.com/muka/go-bluetooth/api"
 	"github.com/muka/go-bluetooth/bluez"
-	log "github.com/sirupsen/logrus"
 )
 
 func (app *App) GetServices() map[dbus.ObjectPath]*Service {
@@ -21,20 +20,34 @@ func (app *App) NewService(uuid string) (*Service, error) {
 
 	s.app = app
 	s.chars = make(map[dbus.ObjectPath]*Char)
-	s.path = dbus.ObjectPath(fmt.Sprintf("%s/service%s", app.Path(), strings.Replace(s.UUID, "-", "_", -1)[:8]))
+	s.path = app.servicePath(s.UUID)
 	s.Properties = NewGattService1Properties(s.UUID)
 
-	iprops, err := api.NewDBusProperties(s.App().DBusConn())
+	iprops, err := api.NewDBusProperties(app.DBusConn())
 	if err != nil {
 		return nil, err
 	}
+	iprops.SetLogger(app.Logger())
 	s.iprops = iprops
 
 	return s, nil
 }
 
+// servicePath return the object path of a service, derived from the first 8
+// characters of its 128bit UUID
+func (app *App) servicePath(uuid string) dbus.ObjectPath {
+	return dbus.ObjectPath(fmt.Sprintf("%s/service%s", app.Path(), servicePathID(uuid)))
+}
+
+func servicePathID(uuid string) string {
+	return strings.Replace(uuid, "-", "_", -1)[:8]
+}
+
 func (app *App) AddService(s *Service) error {
 
+	if _, ok := app.services[s.Path()]; ok {
+		return fmt.Errorf("service path %s is already in use", s.Path())
+	}
 	app.services[s.Path()] = s
 
 	err := s.Expose()
@@ -49,7 +62,7 @@ func (app *App) AddService(s *Service) error {
 		return err
 	}
 
-	log.Tracef("Added GATT Service UUID=%s %s", s.UUID, s.Path())
+	s.log("").Tracef("Added GATT Service UUID=%s", s.UUID)
 
 	return nil
 }
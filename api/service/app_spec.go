@@ -0,0 +1,437 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+	"gopkg.in/yaml.v3"
+)
+
+// AppSpec describe the services of an App, see NewAppFromSpec.
+// It can be declared in Go or loaded from a YAML or JSON document like
+//
+//	services:
+//	- uuid: "2233"
+//	  characteristics:
+//	  - uuid: "3344"
+//	    flags: [read, write]
+//	    value: "hello"
+//	    onWrite: store
+//	    descriptors:
+//	    - uuid: "4455"
+//	      flags: [read]
+//	      value: [0x01, 0x02]
+//
+// UUIDs can be 16 or 32 bit values, completed with AppOptions.UUID and
+// AppOptions.UUIDSuffix, or full 128 bit UUIDs.
+type AppSpec struct {
+	Services []ServiceSpec `json:"services" yaml:"services"`
+}
+
+// ServiceSpec describe a GATT service
+type ServiceSpec struct {
+	UUID string `json:"uuid" yaml:"uuid"`
+	// Secondary expose the service as not primary
+	Secondary       bool       `json:"secondary" yaml:"secondary"`
+	Characteristics []CharSpec `json:"characteristics" yaml:"characteristics"`
+}
+
+// CharSpec describe a GATT characteristic, handlers are referenced by
+// their name in SpecHandlers
+type CharSpec struct {
+	UUID  string   `json:"uuid" yaml:"uuid"`
+	Flags []string `json:"flags" yaml:"flags"`
+	// Value is the initial value
+	Value SpecValue `json:"value" yaml:"value"`
+
+	OnRead        string `json:"onRead" yaml:"onRead"`
+	OnWrite       string `json:"onWrite" yaml:"onWrite"`
+	OnSubscribe   string `json:"onSubscribe" yaml:"onSubscribe"`
+	OnUnsubscribe string `json:"onUnsubscribe" yaml:"onUnsubscribe"`
+
+	// AcquireWrite expose WriteAcquired, requires write-without-response
+	AcquireWrite bool `json:"acquireWrite" yaml:"acquireWrite"`
+	// AcquireNotify expose NotifyAcquired, requires notify
+	AcquireNotify bool `json:"acquireNotify" yaml:"acquireNotify"`
+
+	Descriptors []DescrSpec `json:"descriptors" yaml:"descriptors"`
+}
+
+// DescrSpec describe a GATT descriptor. If Flags is empty the descriptor is
+// readable and writable.
+type DescrSpec struct {
+	UUID    string    `json:"uuid" yaml:"uuid"`
+	Flags   []string  `json:"flags" yaml:"flags"`
+	Value   SpecValue `json:"value" yaml:"value"`
+	OnRead  string    `json:"onRead" yaml:"onRead"`
+	OnWrite string    `json:"onWrite" yaml:"onWrite"`
+}
+
+// SpecValue is an initial value, declared as a string or a list of bytes
+type SpecValue []byte
+
+// UnmarshalYAML decode a string or a list of bytes
+func (v *SpecValue) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*v = SpecValue(node.Value)
+		return nil
+	}
+	var list []byte
+	err := node.Decode(&list)
+	if err != nil {
+		return err
+	}
+	*v = list
+	return nil
+}
+
+// UnmarshalJSON decode a string or a list of bytes
+func (v *SpecValue) UnmarshalJSON(data []byte) error {
+	var str string
+	if json.Unmarshal(data, &str) == nil {
+		*v = SpecValue(str)
+		return nil
+	}
+	var list []int
+	err := json.Unmarshal(data, &list)
+	if err != nil {
+		return err
+	}
+	*v = make(SpecValue, len(list))
+	for i, b := range list {
+		if b < 0 || b > 255 {
+			return fmt.Errorf("value: %d is not a byte", b)
+		}
+		(*v)[i] = byte(b)
+	}
+	return nil
+}
+
+// SpecHandlers contains the callbacks referenced by name in an AppSpec
+type SpecHandlers struct {
+	CharRead   map[string]CharReadCallback
+	CharWrite  map[string]CharWriteCallback
+	CharNotify map[string]CharNotifyCallback
+	DescrRead  map[string]DescrReadCallback
+	DescrWrite map[string]DescrWriteCallback
+}
+
+var specUUID = regexp.MustCompile(`^([0-9a-fA-F]{4}|[0-9a-fA-F]{8}|[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})$`)
+
+var charFlags = map[string]bool{
+	gatt.FlagCharacteristicBroadcast:                 true,
+	gatt.FlagCharacteristicRead:                      true,
+	gatt.FlagCharacteristicWriteWithoutResponse:      true,
+	gatt.FlagCharacteristicWrite:                     true,
+	gatt.FlagCharacteristicNotify:                    true,
+	gatt.FlagCharacteristicIndicate:                  true,
+	gatt.FlagCharacteristicAuthenticatedSignedWrites: true,
+	gatt.FlagCharacteristicReliableWrite:             true,
+	gatt.FlagCharacteristicWritableAuxiliaries:       true,
+	gatt.FlagCharacteristicEncryptRead:               true,
+	gatt.FlagCharacteristicEncryptWrite:              true,
+	gatt.FlagCharacteristicEncryptAuthenticatedRead:  true,
+	gatt.FlagCharacteristicEncryptAuthenticatedWrite: true,
+	gatt.FlagCharacteristicSecureRead:                true,
+	gatt.FlagCharacteristicSecureWrite:               true,
+}
+
+var descrFlags = map[string]bool{
+	gatt.FlagDescriptorRead:                      true,
+	gatt.FlagDescriptorWrite:                     true,
+	gatt.FlagDescriptorEncryptRead:               true,
+	gatt.FlagDescriptorEncryptWrite:              true,
+	gatt.FlagDescriptorEncryptAuthenticatedRead:  true,
+	gatt.FlagDescriptorEncryptAuthenticatedWrite: true,
+	gatt.FlagDescriptorSecureRead:                true,
+	gatt.FlagDescriptorSecureWrite:               true,
+}
+
+// ParseAppSpec parse a YAML or JSON document describing an App
+func ParseAppSpec(data []byte) (*AppSpec, error) {
+	spec := new(AppSpec)
+	err := yaml.Unmarshal(data, spec)
+	if err != nil {
+		return nil, fmt.Errorf("Parse spec: %s", err)
+	}
+	return spec, nil
+}
+
+// LoadAppSpec read an App description from a YAML or JSON file
+func LoadAppSpec(filename string) (*AppSpec, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppSpec(data)
+}
+
+// Validate check UUIDs, flags and handler names of the spec
+func (spec *AppSpec) Validate(handlers SpecHandlers) error {
+
+	if len(spec.Services) == 0 {
+		return fmt.Errorf("services: at least one service is required")
+	}
+
+	uuids := map[string]bool{}
+	paths := map[string]string{}
+	for i, s := range spec.Services {
+		where := fmt.Sprintf("services[%d]", i)
+		if !specUUID.MatchString(s.UUID) {
+			return fmt.Errorf("%s.uuid: invalid UUID %q", where, s.UUID)
+		}
+		if uuids[strings.ToLower(s.UUID)] {
+			return fmt.Errorf("%s.uuid: duplicated service %q", where, s.UUID)
+		}
+		uuids[strings.ToLower(s.UUID)] = true
+		// the service path is derived from the first 8 characters of the
+		// UUID, the 16bit UUIDs are prefixed by the same base
+		pathID := s.UUID
+		if len(pathID) > 4 {
+			pathID = servicePathID(pathID)
+		}
+		if other, ok := paths[pathID]; ok {
+			return fmt.Errorf("%s.uuid: service %q has the same path of %q", where, s.UUID, other)
+		}
+		paths[pathID] = s.UUID
+		for j, c := range s.Characteristics {
+			err := c.validate(fmt.Sprintf("%s.characteristics[%d]", where, j), handlers)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *CharSpec) validate(where string, handlers SpecHandlers) error {
+
+	if !specUUID.MatchString(c.UUID) {
+		return fmt.Errorf("%s.uuid: invalid UUID %q", where, c.UUID)
+	}
+
+	err := validateFlags(where, c.Flags, charFlags)
+	if err != nil {
+		return err
+	}
+
+	if c.AcquireWrite && !hasSpecFlag(c.Flags, gatt.FlagCharacteristicWriteWithoutResponse) {
+		return fmt.Errorf("%s.acquireWrite: requires flag %s", where, gatt.FlagCharacteristicWriteWithoutResponse)
+	}
+	if c.AcquireNotify && !hasSpecFlag(c.Flags, gatt.FlagCharacteristicNotify) {
+		return fmt.Errorf("%s.acquireNotify: requires flag %s", where, gatt.FlagCharacteristicNotify)
+	}
+	if (c.OnSubscribe != "" || c.OnUnsubscribe != "") &&
+		!hasSpecFlag(c.Flags, gatt.FlagCharacteristicNotify) &&
+		!hasSpecFlag(c.Flags, gatt.FlagCharacteristicIndicate) {
+		return fmt.Errorf("%s: subscription handlers require flag %s or %s", where, gatt.FlagCharacteristicNotify, gatt.FlagCharacteristicIndicate)
+	}
+
+	if _, ok := handlers.CharRead[c.OnRead]; c.OnRead != "" && !ok {
+		return fmt.Errorf("%s.onRead: handler %q not found", where, c.OnRead)
+	}
+	if _, ok := handlers.CharWrite[c.OnWrite]; c.OnWrite != "" && !ok {
+		return fmt.Errorf("%s.onWrite: handler %q not found", where, c.OnWrite)
+	}
+	if _, ok := handlers.CharNotify[c.OnSubscribe]; c.OnSubscribe != "" && !ok {
+		return fmt.Errorf("%s.onSubscribe: handler %q not found", where, c.OnSubscribe)
+	}
+	if _, ok := handlers.CharNotify[c.OnUnsubscribe]; c.OnUnsubscribe != "" && !ok {
+		return fmt.Errorf("%s.onUnsubscribe: handler %q not found", where, c.OnUnsubscribe)
+	}
+
+	for i, d := range c.Descriptors {
+		err := d.validate(fmt.Sprintf("%s.descriptors[%d]", where, i), handlers)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *DescrSpec) validate(where string, handlers SpecHandlers) error {
+
+	if !specUUID.MatchString(d.UUID) {
+		return fmt.Errorf("%s.uuid: invalid UUID %q", where, d.UUID)
+	}
+
+	err := validateFlags(where, d.Flags, descrFlags)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := handlers.DescrRead[d.OnRead]; d.OnRead != "" && !ok {
+		return fmt.Errorf("%s.onRead: handler %q not found", where, d.OnRead)
+	}
+	if _, ok := handlers.DescrWrite[d.OnWrite]; d.OnWrite != "" && !ok {
+		return fmt.Errorf("%s.onWrite: handler %q not found", where, d.OnWrite)
+	}
+
+	return nil
+}
+
+func validateFlags(where string, flags []string, valid map[string]bool) error {
+	seen := map[string]bool{}
+	for _, flag := range flags {
+		if !valid[flag] {
+			return fmt.Errorf("%s.flags: unknown flag %q", where, flag)
+		}
+		if seen[flag] {
+			return fmt.Errorf("%s.flags: duplicated flag %q", where, flag)
+		}
+		seen[flag] = true
+	}
+	return nil
+}
+
+func hasSpecFlag(flags []string, flag string) bool {
+	for _, f := range flags {
+		if f == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// NewAppFromSpec validate the spec, create the App with its services,
+// characteristics and descriptors and register it with Run.
+// Nothing is exposed on DBus if the spec is not valid.
+func NewAppFromSpec(options AppOptions, spec *AppSpec, handlers SpecHandlers) (*App, error) {
+
+	err := spec.Validate(handlers)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := NewApp(options)
+	if err != nil {
+		return nil, err
+	}
+
+	err = app.addSpec(spec, handlers)
+	if err != nil {
+		app.Close()
+		return nil, err
+	}
+
+	err = app.Run()
+	if err != nil {
+		app.Close()
+		return nil, err
+	}
+
+	return app, nil
+}
+
+// AddSpec validate the spec and add its services to the App
+func (app *App) AddSpec(spec *AppSpec, handlers SpecHandlers) error {
+
+	err := spec.Validate(handlers)
+	if err != nil {
+		return err
+	}
+	return app.addSpec(spec, handlers)
+}
+
+func (app *App) addSpec(spec *AppSpec, handlers SpecHandlers) error {
+
+	// check the paths with the base UUID of the app before adding any service
+	paths := map[dbus.ObjectPath]bool{}
+	for i, serviceSpec := range spec.Services {
+		path := app.servicePath(app.GenerateUUID(serviceSpec.UUID))
+		if _, ok := app.services[path]; ok || paths[path] {
+			return fmt.Errorf("services[%d].uuid: service path %s is already in use", i, path)
+		}
+		paths[path] = true
+	}
+
+	for _, serviceSpec := range spec.Services {
+		err := app.addServiceSpec(serviceSpec, handlers)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (app *App) addServiceSpec(spec ServiceSpec, handlers SpecHandlers) error {
+
+	s, err := app.NewService(spec.UUID)
+	if err != nil {
+		return err
+	}
+	s.Properties.Primary = !spec.Secondary
+
+	err = app.AddService(s)
+	if err != nil {
+		return fmt.Errorf("AddService %s: %s", spec.UUID, err)
+	}
+
+	for _, charSpec := range spec.Characteristics {
+
+		c, err := s.NewChar(charSpec.UUID)
+		if err != nil {
+			return err
+		}
+
+		c.Properties.Flags = append([]string{}, charSpec.Flags...)
+		c.Properties.Value = []byte(charSpec.Value)
+		c.Properties.WriteAcquired = charSpec.AcquireWrite
+		c.Properties.NotifyAcquired = charSpec.AcquireNotify
+
+		if charSpec.OnRead != "" {
+			c.OnRead(handlers.CharRead[charSpec.OnRead])
+		}
+		if charSpec.OnWrite != "" {
+			c.OnWrite(handlers.CharWrite[charSpec.OnWrite])
+		}
+		if charSpec.OnSubscribe != "" {
+			c.OnSubscribe(handlers.CharNotify[charSpec.OnSubscribe])
+		}
+		if charSpec.OnUnsubscribe != "" {
+			c.OnUnsubscribe(handlers.CharNotify[charSpec.OnUnsubscribe])
+		}
+
+		err = s.AddChar(c)
+		if err != nil {
+			return fmt.Errorf("AddChar %s: %s", charSpec.UUID, err)
+		}
+
+		for _, descrSpec := range charSpec.Descriptors {
+
+			d, err := c.NewDescr(descrSpec.UUID)
+			if err != nil {
+				return err
+			}
+
+			if len(descrSpec.Flags) > 0 {
+				d.Properties.Flags = append([]string{}, descrSpec.Flags...)
+			}
+			d.Properties.Value = []byte(descrSpec.Value)
+
+			if descrSpec.OnRead != "" {
+				d.OnRead(handlers.DescrRead[descrSpec.OnRead])
+			}
+			if descrSpec.OnWrite != "" {
+				d.OnWrite(handlers.DescrWrite[descrSpec.OnWrite])
+			}
+
+			err = c.AddDescr(d)
+			if err != nil {
+				return fmt.Errorf("AddDescr %s: %s", descrSpec.UUID, err)
+			}
+		}
+	}
+
+	s.log("").Tracef("Added service %s from spec", s.UUID)
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSpecYAML = `
+services:
+- uuid: "2233"
+  characteristics:
+  - uuid: "3344"
+    flags: [read, write, notify]
+    value: "hello"
+    onRead: read
+    onWrite: write
+    onSubscribe: subscribe
+    descriptors:
+    - uuid: "4455"
+      flags: [read]
+      value: [0x01, 0x02]
+- uuid: "0000180f-0000-1000-8000-00805f9b34fb"
+  secondary: true
+`
+
+const testSpecJSON = `{
+  "services": [{
+    "uuid": "2233",
+    "characteristics": [{"uuid": "3344", "flags": ["write-without-response"], "acquireWrite": true}]
+  }]
+}`
+
+func testSpecHandlers() SpecHandlers {
+	return SpecHandlers{
+		CharRead: map[string]CharReadCallback{
+			"read": func(c *Char, options map[string]interface{}) ([]byte, error) {
+				return c.Properties.Value, nil
+			},
+		},
+		CharWrite: map[string]CharWriteCallback{
+			"write": func(c *Char, value []byte) ([]byte, error) {
+				return value, nil
+			},
+		},
+		CharNotify: map[string]CharNotifyCallback{
+			"subscribe": func(c *Char) error {
+				return nil
+			},
+		},
+	}
+}
+
+func TestParseAppSpec(t *testing.T) {
+
+	spec, err := ParseAppSpec([]byte(testSpecYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, spec.Services, 2)
+	assert.False(t, spec.Services[0].Secondary)
+	assert.True(t, spec.Services[1].Secondary)
+
+	char := spec.Services[0].Characteristics[0]
+	assert.Equal(t, []string{gatt.FlagCharacteristicRead, gatt.FlagCharacteristicWrite, gatt.FlagCharacteristicNotify}, char.Flags)
+	assert.Equal(t, SpecValue("hello"), char.Value)
+	assert.Equal(t, "read", char.OnRead)
+	assert.Equal(t, SpecValue{1, 2}, char.Descriptors[0].Value)
+
+	assert.Nil(t, spec.Validate(testSpecHandlers()))
+
+	spec, err = ParseAppSpec([]byte(testSpecJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, spec.Services[0].Characteristics[0].AcquireWrite)
+	assert.Nil(t, spec.Validate(SpecHandlers{}))
+
+	// the same document can be loaded with encoding/json
+	spec = new(AppSpec)
+	err = json.Unmarshal([]byte(`{"services": [{"uuid": "2233", "characteristics": [{"uuid": "3344", "value": [1, 2]}]}]}`), spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, SpecValue{1, 2}, spec.Services[0].Characteristics[0].Value)
+}
+
+func TestAppSpecValidate(t *testing.T) {
+
+	tests := map[string]AppSpec{
+		"services: at least one service is required": {},
+		`services[0].uuid: invalid UUID "22"`: {
+			Services: []ServiceSpec{{UUID: "22"}},
+		},
+		`services[1].uuid: duplicated service "2233"`: {
+			Services: []ServiceSpec{{UUID: "2233"}, {UUID: "2233"}},
+		},
+		`services[1].uuid: service "12345678-0000-1000-8000-00805f9b34fc" has the same path of "12345678-0000-1000-8000-00805f9b34fb"`: {
+			Services: []ServiceSpec{
+				{UUID: "12345678-0000-1000-8000-00805f9b34fb"},
+				{UUID: "12345678-0000-1000-8000-00805f9b34fc"},
+			},
+		},
+		`services[0].characteristics[0].flags: unknown flag "reads"`: {
+			Services: []ServiceSpec{{UUID: "2233", Characteristics: []CharSpec{
+				{UUID: "3344", Flags: []string{"reads"}},
+			}}},
+		},
+		`services[0].characteristics[0].flags: duplicated flag "read"`: {
+			Services: []ServiceSpec{{UUID: "2233", Characteristics: []CharSpec{
+				{UUID: "3344", Flags: []string{"read", "read"}},
+			}}},
+		},
+		`services[0].characteristics[0].onWrite: handler "missing" not found`: {
+			Services: []ServiceSpec{{UUID: "2233", Characteristics: []CharSpec{
+				{UUID: "3344", Flags: []string{"write"}, OnWrite: "missing"},
+			}}},
+		},
+		"services[0].characteristics[0].acquireNotify: requires flag notify": {
+			Services: []ServiceSpec{{UUID: "2233", Characteristics: []CharSpec{
+				{UUID: "3344", Flags: []string{"indicate"}, AcquireNotify: true},
+			}}},
+		},
+		"services[0].characteristics[0]: subscription handlers require flag notify or indicate": {
+			Services: []ServiceSpec{{UUID: "2233", Characteristics: []CharSpec{
+				{UUID: "3344", Flags: []string{"read"}, OnSubscribe: "subscribe"},
+			}}},
+		},
+		`services[0].characteristics[0].descriptors[0].flags: unknown flag "notify"`: {
+			Services: []ServiceSpec{{UUID: "2233", Characteristics: []CharSpec{
+				{UUID: "3344", Descriptors: []DescrSpec{{UUID: "4455", Flags: []string{"notify"}}}},
+			}}},
+		},
+	}
+
+	for expected, spec := range tests {
+		err := spec.Validate(testSpecHandlers())
+		if assert.Error(t, err, expected) {
+			assert.Equal(t, expected, err.Error())
+		}
+	}
+}
+
+func TestNewAppFromSpecInvalid(t *testing.T) {
+	// validation fails before connecting to DBus
+	_, err := NewAppFromSpec(AppOptions{AdapterID: "hci0"}, &AppSpec{}, SpecHandlers{})
+	assert.Error(t, err)
+}
@@ -1,10 +1,15 @@
 package service
 
 import (
+	"os/exec"
 	"testing"
 
+	"github.com/godbus/dbus/v5"
 	"github.com/muka/go-bluetooth/api"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/mock"
 	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
 )
 
 func createTestApp(t *testing.T) *App {
@@ -68,3 +73,68 @@ func TestApp(t *testing.T) {
 	a := createTestApp(t)
 	defer a.Close()
 }
+
+func TestAppWithConn(t *testing.T) {
+
+	if _, err := exec.LookPath(mock.DBusDaemonBin); err != nil {
+		t.Skip("dbus-daemon not available")
+	}
+
+	// a bus not used by the shared connections
+	bus, err := mock.StartBus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bus.Close()
+
+	srvConn, err := bus.Connect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := mock.New(srvConn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+
+	adapterPath, err := b.AddAdapter("hci0", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := bluez.Dial(bus.Address, bluez.ConnOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	a, err := NewApp(AppOptions{
+		AdapterID: "hci0",
+		Conn:      conn,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, conn, a.GetAdapter().Client().Conn())
+
+	s1, err := a.NewService("2233")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = a.AddService(s1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []dbus.ObjectPath{a.Path()}, b.Applications(adapterPath))
+	assert.Contains(t, b.Agents(), a.agent.Path())
+
+	a.Close()
+	assert.Empty(t, b.Applications(adapterPath))
+	assert.Empty(t, b.Agents())
+}
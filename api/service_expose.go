@@ -5,7 +5,7 @@ import (
 	"github.com/godbus/dbus/v5/introspect"
 	"github.com/godbus/dbus/v5/prop"
 	"github.com/muka/go-bluetooth/bluez"
-	log "github.com/sirupsen/logrus"
+	"github.com/muka/go-bluetooth/logger"
 )
 
 type ExposedDBusService interface {
@@ -37,12 +37,13 @@ func ExposeDBusService(s ExposedDBusService) (err error) {
 	conn := s.DBusConn()
 
 	if conn == nil {
-		conn, err = dbus.SystemBus()
+		conn, err = bluez.GetConnection(bluez.SystemBus)
 		if err != nil {
 			return err
 		}
 	}
 
+	log := logger.New(s.DBusObjectManager().Logger()).WithObject(s.Path(), s.Interface())
 	log.Tracef("Expose %s (%s)", s.Path(), s.Interface())
 	err = conn.Export(s, s.Path(), s.Interface())
 	if err != nil {
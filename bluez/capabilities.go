@@ -0,0 +1,342 @@
+package bluez
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/godbus/dbus/v5/introspect"
+)
+
+// ErrNotSupportedByDaemon is matched with errors.Is by the NotSupportedError
+// returned when calling a method or accessing a property the running bluez
+// daemon does not implement, eg. as it is older than the generated bindings or
+// experimental features are not enabled
+var ErrNotSupportedByDaemon = errors.New("not supported by the bluez daemon")
+
+// NotSupportedError is returned without calling bluez when the daemon does not
+// implement a method or a property. It matches ErrNotSupportedByDaemon.
+type NotSupportedError struct {
+	// Interface is the DBus interface, eg. org.bluez.Adapter1
+	Interface string
+	// Member is the method or property name, eg. ConnectDevice
+	Member string
+	// Path is the object path of the call
+	Path dbus.ObjectPath
+}
+
+func (e *NotSupportedError) Error() string {
+	return fmt.Sprintf("%s.%s %s: %s", e.Interface, e.Member, e.Path, ErrNotSupportedByDaemon)
+}
+
+// Unwrap return ErrNotSupportedByDaemon
+func (e *NotSupportedError) Unwrap() error {
+	return ErrNotSupportedByDaemon
+}
+
+var (
+	capabilitiesLock sync.Mutex
+	capabilities     = make(map[*dbus.Conn]*capabilitiesProbe)
+)
+
+// capabilitiesProbe is the result of probing the capabilities of a
+// connection, done is closed once caps or err are set
+type capabilitiesProbe struct {
+	done chan struct{}
+	caps *Capabilities
+	err  error
+}
+
+// Capabilities list the interfaces, with their methods, signals and
+// properties, implemented by the running bluez daemon. They are introspected
+// from one object per interface exposed by bluez. Interfaces without objects,
+// eg. GattCharacteristic1 while no device is connected, are unknown until a
+// client calls an object implementing them.
+type Capabilities struct {
+	conn *dbus.Conn
+
+	lock       sync.RWMutex
+	interfaces map[string]introspect.Interface
+	probed     map[dbus.ObjectPath]bool
+}
+
+// GetCapabilities return the capabilities of the running bluez daemon,
+// probing them on first use. They are probed again after bluez restarts.
+func GetCapabilities() (*Capabilities, error) {
+	return GetCapabilitiesWithConn(nil)
+}
+
+// GetCapabilitiesWithConn is GetCapabilities on a connection, nil for the
+// shared system bus connection
+func GetCapabilitiesWithConn(c *Conn) (*Capabilities, error) {
+	conn, err := c.DBusConn()
+	if err != nil {
+		return nil, err
+	}
+	return getCapabilities(context.Background(), conn)
+}
+
+// getCapabilities return the cached capabilities of a connection, probing
+// them if missing. A connection is probed once at a time, without holding
+// capabilitiesLock during the calls. A failure is cached as the capabilities
+// until bluez restarts, except if ctx expired.
+func getCapabilities(ctx context.Context, conn *dbus.Conn) (*Capabilities, error) {
+	for {
+		capabilitiesLock.Lock()
+		probe, ok := capabilities[conn]
+		if !ok {
+			probe = &capabilitiesProbe{done: make(chan struct{})}
+			capabilities[conn] = probe
+		}
+		capabilitiesLock.Unlock()
+
+		if !ok {
+			probe.run(ctx, conn)
+		}
+
+		select {
+		case <-probe.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		capabilitiesLock.Lock()
+		dropped := capabilities[conn] != probe
+		capabilitiesLock.Unlock()
+		// the probe of another caller expired, probe again
+		if dropped && probe.err != nil && ctx.Err() == nil {
+			continue
+		}
+		return probe.caps, probe.err
+	}
+}
+
+// run probe the capabilities and cache them until bluez restarts
+func (p *capabilitiesProbe) run(ctx context.Context, conn *dbus.Conn) {
+
+	defer close(p.done)
+
+	drop := func() {
+		capabilitiesLock.Lock()
+		if capabilities[conn] == p {
+			delete(capabilities, conn)
+		}
+		capabilitiesLock.Unlock()
+	}
+
+	// subscribe before probing to not miss a restart meanwhile
+	sub, err := GetDispatcher(conn).Subscribe(serviceMatch)
+	if err != nil {
+		p.err = err
+		drop()
+		return
+	}
+
+	p.caps, p.err = probeCapabilities(ctx, conn)
+	if p.err != nil && ctx.Err() != nil {
+		sub.Unsubscribe()
+		drop()
+		return
+	}
+
+	go func() {
+		// bluez restarted, possibly with another version, or the connection
+		// has been closed
+		<-sub.Signals()
+		sub.Unsubscribe()
+		drop()
+	}()
+}
+
+// probeCapabilities introspect an object for each of the interfaces exposed
+// by bluez
+func probeCapabilities(ctx context.Context, conn *dbus.Conn) (*Capabilities, error) {
+
+	caps := &Capabilities{
+		conn:       conn,
+		interfaces: make(map[string]introspect.Interface),
+		probed:     make(map[dbus.ObjectPath]bool),
+	}
+
+	objects := make(map[dbus.ObjectPath]map[string]map[string]dbus.Variant)
+	err := conn.Object(OrgBluezInterface, "/").
+		CallWithContext(ctx, ObjectManagerInterface+".GetManagedObjects", 0).
+		Store(&objects)
+	if err != nil {
+		return nil, contextError(ctx, ObjectManagerInterface+".GetManagedObjects", err)
+	}
+
+	paths := []string{}
+	for path := range objects {
+		paths = append(paths, string(path))
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		for iface := range objects[dbus.ObjectPath(path)] {
+			if caps.HasInterface(iface) {
+				continue
+			}
+			err := caps.probe(ctx, dbus.ObjectPath(path))
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	return caps, nil
+}
+
+// probe introspect an object and add its interfaces, once per path
+func (c *Capabilities) probe(ctx context.Context, path dbus.ObjectPath) error {
+
+	c.lock.RLock()
+	probed := c.probed[path]
+	c.lock.RUnlock()
+	if probed {
+		return nil
+	}
+
+	method := Introspectable + ".Introspect"
+	var data string
+	err := c.conn.Object(OrgBluezInterface, path).CallWithContext(ctx, method, 0).Store(&data)
+	if err != nil {
+		return contextError(ctx, method, err)
+	}
+
+	node := introspect.Node{}
+	err = xml.Unmarshal([]byte(data), &node)
+	if err != nil {
+		return fmt.Errorf("Introspect %s: %s", path, err)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.probed[path] = true
+	for _, iface := range node.Interfaces {
+		c.interfaces[iface.Name] = iface
+	}
+
+	return nil
+}
+
+// Interfaces return the names of the known interfaces
+func (c *Capabilities) Interfaces() []string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	names := []string{}
+	for name := range c.interfaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Interface return the introspection data of an interface, if known
+func (c *Capabilities) Interface(name string) (introspect.Interface, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	iface, ok := c.interfaces[name]
+	return iface, ok
+}
+
+// HasInterface report if bluez is known to implement an interface
+func (c *Capabilities) HasInterface(name string) bool {
+	_, ok := c.Interface(name)
+	return ok
+}
+
+// HasMethod report if bluez is known to implement a method of an interface
+func (c *Capabilities) HasMethod(iface, method string) bool {
+	i, ok := c.Interface(iface)
+	if !ok {
+		return false
+	}
+	for _, m := range i.Methods {
+		if m.Name == method {
+			return true
+		}
+	}
+	return false
+}
+
+// HasProperty report if bluez is known to implement a property of an interface
+func (c *Capabilities) HasProperty(iface, property string) bool {
+	i, ok := c.Interface(iface)
+	if !ok {
+		return false
+	}
+	for _, p := range i.Properties {
+		if p.Name == property {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckMethod return a NotSupportedError if bluez implements the interface
+// without the method. Unknown interfaces are not checked.
+func (c *Capabilities) CheckMethod(path dbus.ObjectPath, iface, method string) error {
+	if c.HasInterface(iface) && !c.HasMethod(iface, method) {
+		return &NotSupportedError{Interface: iface, Member: method, Path: path}
+	}
+	return nil
+}
+
+// CheckProperty return a NotSupportedError if bluez implements the interface
+// without the property. Unknown interfaces are not checked.
+func (c *Capabilities) CheckProperty(path dbus.ObjectPath, iface, property string) error {
+	if c.HasInterface(iface) && !c.HasProperty(iface, property) {
+		return &NotSupportedError{Interface: iface, Member: property, Path: path}
+	}
+	return nil
+}
+
+// capabilities return the capabilities of the daemon the client calls, nil if
+// not a bluez interface or they cannot be probed. An interface still unknown is
+// looked up on the client object.
+func (c *Client) capabilities(ctx context.Context) *Capabilities {
+
+	if c.Config.Name != OrgBluezInterface || !strings.HasPrefix(c.Config.Iface, OrgBluezInterface+".") {
+		return nil
+	}
+
+	caps, err := getCapabilities(ctx, c.conn)
+	if err != nil {
+		c.Logger().Tracef("Capabilities not available: %s", err)
+		return nil
+	}
+
+	if !caps.HasInterface(c.Config.Iface) {
+		err = caps.probe(ctx, c.Config.Path)
+		if err != nil {
+			c.Logger().Tracef("Introspection failed: %s", err)
+		}
+	}
+
+	return caps
+}
+
+// checkMethod fail fast if the daemon does not implement a method
+func (c *Client) checkMethod(ctx context.Context, method string) error {
+	caps := c.capabilities(ctx)
+	if caps == nil {
+		return nil
+	}
+	return caps.CheckMethod(c.Config.Path, c.Config.Iface, method)
+}
+
+// checkProperty fail fast if the daemon does not implement a property
+func (c *Client) checkProperty(ctx context.Context, property string) error {
+	caps := c.capabilities(ctx)
+	if caps == nil {
+		return nil
+	}
+	return caps.CheckProperty(c.Config.Path, c.Config.Iface, property)
+}
@@ -0,0 +1,208 @@
+package bluez_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/muka/go-bluetooth/api"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/mock"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapabilities(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	devPath, _ := mock.AddTestDevice(t, b)
+	b.Unsupported(adapter.Adapter1Interface, "ConnectDevice")
+	b.Unsupported(device.Device1Interface, "AdvertisingData")
+
+	caps, err := bluez.GetCapabilities()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Contains(t, caps.Interfaces(), adapter.Adapter1Interface)
+	assert.True(t, caps.HasMethod(adapter.Adapter1Interface, "StartDiscovery"))
+	assert.False(t, caps.HasMethod(adapter.Adapter1Interface, "ConnectDevice"))
+	assert.True(t, caps.HasProperty(device.Device1Interface, "RSSI"))
+	assert.False(t, caps.HasProperty(device.Device1Interface, "AdvertisingData"))
+	// no object implements it
+	assert.False(t, caps.HasInterface("org.bluez.MediaPlayer1"))
+	assert.NoError(t, caps.CheckMethod("/", "org.bluez.MediaPlayer1", "Play"))
+
+	// unsupported methods fail without calling bluez
+	a, err := adapter.NewAdapter1FromAdapterID(mock.TestAdapterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = a.ConnectDevice(map[string]interface{}{"Address": "00:00:00:00:00:01"})
+	assert.True(t, errors.Is(err, bluez.ErrNotSupportedByDaemon))
+	var notSupported *bluez.NotSupportedError
+	if assert.True(t, errors.As(err, &notSupported)) {
+		assert.Equal(t, adapter.Adapter1Interface, notSupported.Interface)
+		assert.Equal(t, "ConnectDevice", notSupported.Member)
+		assert.Equal(t, a.Path(), notSupported.Path)
+	}
+	assert.Equal(t, 0, b.CountCalls("ConnectDevice"))
+
+	dev, err := device.NewDevice1(devPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = dev.GetAdvertisingData()
+	assert.True(t, errors.Is(err, bluez.ErrNotSupportedByDaemon))
+
+	// supported members are called as usual
+	rssi, err := dev.GetRSSI()
+	assert.NoError(t, err)
+	assert.Equal(t, int16(-50), rssi)
+
+	// capabilities are probed again after a restart
+	err = b.Restart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Eventually(t, func() bool {
+		caps1, err := bluez.GetCapabilities()
+		return err == nil && caps1 != caps
+	}, mock.WaitFor, mock.Tick)
+}
+
+func TestCapabilitiesFailure(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	b.InjectError("/", bluez.ObjectManagerInterface, "GetManagedObjects", mock.Error("Failed"), 0)
+
+	_, err := bluez.GetCapabilities()
+	assert.Error(t, err)
+	// the failure is cached until bluez restarts
+	_, err = bluez.GetCapabilities()
+	assert.Error(t, err)
+	assert.Equal(t, 1, b.CountCalls("GetManagedObjects"))
+
+	b.ClearErrors()
+	err = b.Restart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Eventually(t, func() bool {
+		caps, err := bluez.GetCapabilities()
+		return err == nil && caps.HasInterface(adapter.Adapter1Interface)
+	}, mock.WaitFor, mock.Tick)
+}
+
+func TestCapabilitiesConcurrent(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	// the callers wait for a single probe
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := bluez.GetCapabilities()
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, b.CountCalls("GetManagedObjects"))
+}
+
+func TestConnectDevice(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	a, err := adapter.NewAdapter1FromAdapterID(mock.TestAdapterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), mock.WaitFor)
+	defer cancel()
+
+	dev, err := api.ConnectDevice(ctx, a, mock.TestAddress, "random")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, mock.DevicePath(mock.TestAdapterID, mock.TestAddress), dev.Path())
+	assert.Equal(t, 1, b.CountCalls("ConnectDevice"))
+	assert.Equal(t, 0, b.CountCalls("StartDiscovery"))
+
+	connected, err := dev.GetConnected()
+	assert.NoError(t, err)
+	assert.True(t, connected)
+}
+
+func TestConnectDeviceFallback(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	// an older bluetoothd, or without experimental features
+	b.Unsupported(adapter.Adapter1Interface, "ConnectDevice", "SetDiscoveryFilter")
+
+	a, err := adapter.NewAdapter1FromAdapterID(mock.TestAdapterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the device shows up once discovery started
+	go func() {
+		assert.Eventually(t, func() bool {
+			return b.GetBool(a.Path(), adapter.Adapter1Interface, "Discovering")
+		}, mock.WaitFor, mock.Tick)
+		b.AddDevice(mock.TestAdapterID, mock.TestAddress, nil)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), mock.WaitFor)
+	defer cancel()
+
+	dev, err := api.ConnectDevice(ctx, a, mock.TestAddress, "random")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, mock.DevicePath(mock.TestAdapterID, mock.TestAddress), dev.Path())
+	assert.Equal(t, 0, b.CountCalls("ConnectDevice"))
+	assert.Equal(t, 0, b.CountCalls("SetDiscoveryFilter"))
+	assert.Equal(t, 1, b.CountCalls("StartDiscovery"))
+	assert.Equal(t, 1, b.CountCalls("Connect"))
+
+	connected, err := dev.GetConnected()
+	assert.NoError(t, err)
+	assert.True(t, connected)
+
+	// discovery stopped with the last subscriber
+	discovering, err := a.GetDiscovering()
+	assert.NoError(t, err)
+	assert.False(t, discovering)
+}
+
+func TestConnectDeviceFallbackTimeout(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	b.Unsupported(adapter.Adapter1Interface, "ConnectDevice")
+
+	a, err := adapter.NewAdapter1FromAdapterID(mock.TestAdapterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the device is never found
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = api.ConnectDevice(ctx, a, mock.TestAddress, "random")
+	var ctxErr *bluez.ContextError
+	if assert.True(t, errors.As(err, &ctxErr)) {
+		assert.True(t, ctxErr.Timeout())
+	}
+}
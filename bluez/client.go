@@ -1,9 +1,12 @@
 package bluez
 
 import (
+	"context"
 	"fmt"
+	"sync"
 
 	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/logger"
 	"github.com/muka/go-bluetooth/util"
 )
 
@@ -19,6 +22,10 @@ type Client struct {
 	conn       *dbus.Conn
 	dbusObject dbus.BusObject
 	Config     *Config
+	logger     logger.Logger
+
+	subscriptionsLock sync.Mutex
+	subscriptions     map[chan *dbus.Signal]*Subscription
 }
 
 func (c *Client) isConnected() bool {
@@ -34,6 +41,13 @@ func (c *Client) Disconnect() {
 		return
 	}
 
+	// do not close a connection provided by the caller
+	if c.Config.Conn != nil {
+		c.conn = nil
+		c.dbusObject = nil
+		return
+	}
+
 	if c.isConnected() {
 		c.conn.Close()
 		c.conn = nil
@@ -43,7 +57,15 @@ func (c *Client) Disconnect() {
 
 // Connect connects to DBus
 func (c *Client) Connect() error {
-	dbusConn, err := GetConnection(c.Config.Bus)
+	var (
+		dbusConn *dbus.Conn
+		err      error
+	)
+	if c.Config.Conn != nil {
+		dbusConn, err = c.Config.Conn.DBusConn()
+	} else {
+		dbusConn, err = GetConnection(c.Config.Bus)
+	}
 	if err != nil {
 		return err
 	}
@@ -52,8 +74,34 @@ func (c *Client) Connect() error {
 	return nil
 }
 
+// Conn return the connection the client has been created with, nil for the
+// shared connection of Config.Bus
+func (c *Client) Conn() *Conn {
+	return c.Config.Conn
+}
+
+// SetLogger set the logger of the client, nil for the logger of the connection
+func (c *Client) SetLogger(l logger.Logger) {
+	c.logger = l
+}
+
+// Logger return a log entry with the path and interface of the client
+func (c *Client) Logger() *logger.Entry {
+	l := c.logger
+	if l == nil {
+		l = c.Config.Conn.Logger()
+	}
+	return logger.New(l).WithObject(c.Config.Path, c.Config.Iface)
+}
+
 // Call a DBus method
 func (c *Client) Call(method string, flags dbus.Flags, args ...interface{}) *dbus.Call {
+	return c.CallWithContext(context.Background(), method, flags, args...)
+}
+
+// CallWithContext call a DBus method, failing with a ContextError if ctx is
+// done before a reply is received
+func (c *Client) CallWithContext(ctx context.Context, method string, flags dbus.Flags, args ...interface{}) *dbus.Call {
 
 	if !c.isConnected() {
 		err := c.Connect()
@@ -65,33 +113,76 @@ func (c *Client) Call(method string, flags dbus.Flags, args ...interface{}) *dbu
 	}
 
 	methodPath := fmt.Sprint(c.Config.Iface, ".", method)
-	return c.dbusObject.Call(methodPath, flags, args...)
+
+	if ctx.Err() != nil {
+		return &dbus.Call{
+			Method: methodPath,
+			Err:    contextError(ctx, methodPath, ctx.Err()),
+		}
+	}
+
+	if err := c.checkMethod(ctx, method); err != nil {
+		return &dbus.Call{
+			Method: methodPath,
+			Err:    err,
+		}
+	}
+
+	call := c.dbusObject.CallWithContext(ctx, methodPath, flags, args...)
+	call.Err = c.mapError(ctx, methodPath, call.Err)
+	if call.Err != nil {
+		c.Logger().WithMethod(method).Tracef("Call failed: %s", call.Err)
+	}
+	return call
 }
 
 //GetProperty return a property value
 func (c *Client) GetProperty(p string) (dbus.Variant, error) {
+	return c.GetPropertyContext(context.Background(), p)
+}
+
+//GetPropertyContext return a property value, failing with a ContextError if ctx is done
+func (c *Client) GetPropertyContext(ctx context.Context, p string) (dbus.Variant, error) {
 	if !c.isConnected() {
 		err := c.Connect()
 		if err != nil {
 			return dbus.Variant{}, err
 		}
 	}
-	return c.dbusObject.GetProperty(c.Config.Iface + "." + p)
+	if err := c.checkProperty(ctx, p); err != nil {
+		return dbus.Variant{}, err
+	}
+	var v dbus.Variant
+	err := c.propertiesCall(ctx, "Get", c.Config.Iface, p).Store(&v)
+	return v, err
 }
 
 //SetProperty set a property value
 func (c *Client) SetProperty(p string, v interface{}) error {
+	return c.SetPropertyContext(context.Background(), p, v)
+}
+
+//SetPropertyContext set a property value, failing with a ContextError if ctx is done
+func (c *Client) SetPropertyContext(ctx context.Context, p string, v interface{}) error {
 	if !c.isConnected() {
 		err := c.Connect()
 		if err != nil {
 			return err
 		}
 	}
-	return c.dbusObject.Call("org.freedesktop.DBus.Properties.Set", 0, c.Config.Iface, p, dbus.MakeVariant(v)).Store()
+	if err := c.checkProperty(ctx, p); err != nil {
+		return err
+	}
+	return c.propertiesCall(ctx, "Set", c.Config.Iface, p, dbus.MakeVariant(v)).Store()
 }
 
 //GetProperties load all the properties for an interface
 func (c *Client) GetProperties(props interface{}) error {
+	return c.GetPropertiesContext(context.Background(), props)
+}
+
+//GetPropertiesContext load all the properties for an interface, failing with a ContextError if ctx is done
+func (c *Client) GetPropertiesContext(ctx context.Context, props interface{}) error {
 
 	if !c.isConnected() {
 		err := c.Connect()
@@ -101,8 +192,12 @@ func (c *Client) GetProperties(props interface{}) error {
 	}
 
 	result := make(map[string]dbus.Variant)
-	err := c.dbusObject.Call("org.freedesktop.DBus.Properties.GetAll", 0, c.Config.Iface).Store(&result)
+	err := c.propertiesCall(ctx, "GetAll", c.Config.Iface).Store(&result)
 	if err != nil {
+		switch err.(type) {
+		case *ContextError, *Error:
+			return err
+		}
 		return fmt.Errorf("Properties.GetAll %s: %s", c.Config.Iface, err)
 	}
 
@@ -114,43 +209,98 @@ func (c *Client) GetProperties(props interface{}) error {
 	return nil
 }
 
-func getMatchString(path dbus.ObjectPath, iface string) string {
-	return fmt.Sprintf("type='signal',interface='%s',path='%s'", iface, path)
+// propertiesCall call a method of the Properties interface on the client object
+func (c *Client) propertiesCall(ctx context.Context, method string, args ...interface{}) *dbus.Call {
+	methodPath := PropertiesInterface + "." + method
+	if ctx.Err() != nil {
+		return &dbus.Call{
+			Method: methodPath,
+			Err:    contextError(ctx, methodPath, ctx.Err()),
+		}
+	}
+	call := c.dbusObject.CallWithContext(ctx, methodPath, 0, args...)
+	call.Err = c.mapError(ctx, methodPath, call.Err)
+	return call
 }
 
-//Register for signals
-func (c *Client) Register(path dbus.ObjectPath, iface string) (chan *dbus.Signal, error) {
+// mapError convert a call error to a ContextError or a bluez Error
+func (c *Client) mapError(ctx context.Context, method string, err error) error {
+	err = contextError(ctx, method, err)
+	return MapError(err, c.Config.Path, method)
+}
 
+// Dispatcher return the signal dispatcher of the client connection
+func (c *Client) Dispatcher() (*Dispatcher, error) {
 	if !c.isConnected() {
 		err := c.Connect()
 		if err != nil {
 			return nil, err
 		}
 	}
+	return GetDispatcher(c.conn), nil
+}
+
+// Subscribe to the signals of an interface emitted by path
+func (c *Client) Subscribe(path dbus.ObjectPath, iface string) (*Subscription, error) {
+	d, err := c.Dispatcher()
+	if err != nil {
+		return nil, err
+	}
+	return d.Subscribe(SignalMatch{
+		Path:      path,
+		Interface: iface,
+	})
+}
+
+//Register for signals. The channel is closed by Unregister
+//
+// Deprecated: use Subscribe
+func (c *Client) Register(path dbus.ObjectPath, iface string) (chan *dbus.Signal, error) {
+
+	sub, err := c.Subscribe(path, iface)
+	if err != nil {
+		return nil, err
+	}
 
-	matchstr := getMatchString(path, iface)
-	c.conn.BusObject().Call("org.freedesktop.DBus.AddMatch", 0, matchstr)
+	// forward to a bidirectional channel, as returned by the previous API
+	channel := make(chan *dbus.Signal)
+	go func() {
+		defer close(channel)
+		for sig := range sub.Signals() {
+			channel <- sig
+		}
+	}()
 
-	channel := make(chan *dbus.Signal, 1)
-	c.conn.Signal(channel)
+	c.subscriptionsLock.Lock()
+	if c.subscriptions == nil {
+		c.subscriptions = make(map[chan *dbus.Signal]*Subscription)
+	}
+	c.subscriptions[channel] = sub
+	c.subscriptionsLock.Unlock()
 
 	return channel, nil
 }
 
 //Unregister for signals
+//
+// Deprecated: use Subscribe
 func (c *Client) Unregister(path dbus.ObjectPath, iface string, signal chan *dbus.Signal) error {
-	if !c.isConnected() {
-		err := c.Connect()
-		if err != nil {
-			return err
-		}
-	}
-	matchstr := getMatchString(path, iface)
-	c.conn.BusObject().Call("org.freedesktop.DBus.RemoveMatch", 0, matchstr)
-	if signal != nil {
-		c.conn.RemoveSignal(signal)
+	c.subscriptionsLock.Lock()
+	sub, ok := c.subscriptions[signal]
+	delete(c.subscriptions, signal)
+	c.subscriptionsLock.Unlock()
+
+	if !ok {
+		return nil
 	}
 
+	// the forwarding goroutine may be blocked on a pending signal
+	go func() {
+		for range signal {
+		}
+	}()
+	sub.Unsubscribe()
+
 	return nil
 }
 
@@ -0,0 +1,89 @@
+package bluez
+
+import (
+	"errors"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/logger"
+)
+
+// ConnOptions configure a Conn
+type ConnOptions struct {
+	// Logger used by the clients and apps of the connection, defaults to
+	// logger.Default()
+	Logger logger.Logger
+}
+
+// ErrNoConnection is returned by the clients of a Conn wrapping a nil connection
+var ErrNoConnection = errors.New("bluez: no DBus connection")
+
+// Conn carries a DBus connection and the options of the clients and services
+// using it. A nil *Conn stands for the shared system bus connection returned
+// by GetConnection, so that one process can use multiple buses side by side.
+type Conn struct {
+	conn    *dbus.Conn
+	options ConnOptions
+	// owned connections are closed by Close
+	owned bool
+}
+
+// NewConn wrap an open DBus connection. Close does not close it.
+func NewConn(conn *dbus.Conn, options ConnOptions) *Conn {
+	return &Conn{
+		conn:    conn,
+		options: options,
+	}
+}
+
+// Dial connect to the bus at address, eg. unix:path=/run/dbus/system_bus_socket.
+// Close closes the connection.
+func Dial(address string, options ConnOptions) (*Conn, error) {
+
+	conn, err := dbus.Dial(address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = conn.Auth(nil)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	err = conn.Hello()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	c := NewConn(conn, options)
+	c.owned = true
+	return c, nil
+}
+
+// DBusConn return the DBus connection, the shared system bus connection if c is nil
+func (c *Conn) DBusConn() (*dbus.Conn, error) {
+	if c == nil {
+		return GetConnection(SystemBus)
+	}
+	if c.conn == nil {
+		return nil, ErrNoConnection
+	}
+	return c.conn, nil
+}
+
+// Logger return the logger of the connection, the default logger if not set
+func (c *Conn) Logger() logger.Logger {
+	if c == nil || c.options.Logger == nil {
+		return logger.Default()
+	}
+	return c.options.Logger
+}
+
+// Close the connection if it has been opened by Dial
+func (c *Conn) Close() error {
+	if c == nil || !c.owned {
+		return nil
+	}
+	return c.conn.Close()
+}
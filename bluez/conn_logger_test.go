@@ -0,0 +1,114 @@
+package bluez_test
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/mock"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/muka/go-bluetooth/bluez/profile/agent"
+	"github.com/muka/go-bluetooth/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+type logRecord struct {
+	level  logger.Level
+	msg    string
+	fields logger.Fields
+}
+
+type logRecorder struct {
+	lock    sync.Mutex
+	records []logRecord
+}
+
+func (r *logRecorder) Enabled(level logger.Level) bool {
+	return true
+}
+
+func (r *logRecorder) Log(level logger.Level, msg string, fields logger.Fields) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.records = append(r.records, logRecord{level, msg, fields})
+}
+
+func (r *logRecorder) find(method string) *logRecord {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for i := range r.records {
+		if r.records[i].fields[logger.FieldMethod] == method {
+			return &r.records[i]
+		}
+	}
+	return nil
+}
+
+func (r *logRecorder) String() string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return fmt.Sprint(r.records)
+}
+
+func TestConnLogger(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	r := &logRecorder{}
+	conn := bluez.NewConn(b.ClientConn(), bluez.ConnOptions{Logger: r})
+
+	a, err := adapter.NewAdapter1FromAdapterIDWithConn(conn, mock.TestAdapterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b.InjectError(mock.AdapterPath(mock.TestAdapterID), adapter.Adapter1Interface, "StartDiscovery", mock.Error("org.bluez.Error.Failed"), 1)
+	err = a.StartDiscovery()
+	assert.Error(t, err)
+
+	rec := r.find("StartDiscovery")
+	if assert.NotNil(t, rec, r.String()) {
+		assert.Equal(t, mock.AdapterPath(mock.TestAdapterID), rec.fields[logger.FieldPath])
+		assert.Equal(t, adapter.Adapter1Interface, rec.fields[logger.FieldInterface])
+	}
+}
+
+func TestSimpleAgentLogger(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	devPath, _ := mock.AddTestDevice(t, b)
+
+	r := &logRecorder{}
+	ag := agent.NewDefaultSimpleAgent()
+	ag.SetPassKey(987654)
+	ag.SetPassCode("4321")
+	ag.SetLogger(r)
+
+	passkey, dbusErr := ag.RequestPasskey(devPath)
+	assert.Nil(t, dbusErr)
+	assert.Equal(t, uint32(987654), passkey)
+	pin, dbusErr := ag.RequestPinCode(devPath)
+	assert.Nil(t, dbusErr)
+	assert.Equal(t, "4321", pin)
+	ag.DisplayPasskey(devPath, 135790, 2)
+	ag.DisplayPinCode(devPath, "8642")
+	ag.RequestConfirmation(devPath, 246801)
+
+	rec := r.find("DisplayPasskey")
+	if assert.NotNil(t, rec) {
+		assert.Equal(t, logger.DebugLevel, rec.level)
+		assert.Equal(t, devPath, rec.fields["device"])
+	}
+
+	// secrets are never logged
+	logs := r.String()
+	for _, secret := range []string{"987654", "4321", "135790", "8642", "246801"} {
+		assert.False(t, strings.Contains(logs, secret), secret)
+	}
+	for _, rec := range r.records {
+		assert.True(t, rec.level >= logger.DebugLevel, rec.msg)
+	}
+}
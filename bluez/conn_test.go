@@ -0,0 +1,100 @@
+package bluez_test
+
+import (
+	"testing"
+
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/mock"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConn(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	// a second bluez on its own bus
+	bus2, err := mock.StartBus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bus2.Close()
+
+	srv2, err := bus2.Connect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b2, err := mock.New(srv2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b2.Close()
+
+	_, err = b2.AddAdapter("hci1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn2, err := bluez.Dial(bus2.Address, bluez.ConnOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn2.Close()
+
+	a2, err := adapter.NewAdapter1FromAdapterIDWithConn(conn2, "hci1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, conn2, a2.Client().Conn())
+
+	// the shared connection does not see the second bus
+	_, err = adapter.NewAdapter1FromAdapterID("hci1")
+	assert.Error(t, err)
+
+	a, err := adapter.NewAdapter1FromAdapterID(mock.TestAdapterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	devPath, err := b2.AddDevice("hci1", mock.TestAddress, map[string]interface{}{
+		"Name": "mock2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Eventually(t, func() bool {
+		list, err := a2.GetDeviceList()
+		return err == nil && len(list) == 1 && list[0] == devPath
+	}, mock.WaitFor, mock.Tick)
+
+	dev, err := a2.GetDeviceByAddress(mock.TestAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.NotNil(t, dev) {
+		assert.Equal(t, devPath, dev.Path())
+		assert.Equal(t, conn2, dev.Client().Conn())
+		name, err := dev.GetName()
+		assert.NoError(t, err)
+		assert.Equal(t, "mock2", name)
+	}
+
+	list, err := a.GetDeviceList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, list)
+}
+
+func TestConnNil(t *testing.T) {
+	conn := bluez.NewConn(nil, bluez.ConnOptions{})
+
+	client := bluez.NewClient(&bluez.Config{
+		Name:  bluez.OrgBluezInterface,
+		Iface: adapter.Adapter1Interface,
+		Path:  "/org/bluez/hci0",
+		Conn:  conn,
+	})
+	assert.Equal(t, bluez.ErrNoConnection, client.Connect())
+}
@@ -0,0 +1,41 @@
+package bluez
+
+import (
+	"context"
+	"fmt"
+)
+
+// ContextError is returned when a call is canceled or its deadline expires
+// before bluez replies. Err is context.Canceled or context.DeadlineExceeded.
+type ContextError struct {
+	Method string
+	Err    error
+}
+
+func (e *ContextError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Method, e.Err)
+}
+
+// Unwrap return the context error, to be used with errors.Is
+func (e *ContextError) Unwrap() error {
+	return e.Err
+}
+
+// Timeout report if the call failed because of an expired deadline
+func (e *ContextError) Timeout() bool {
+	return e.Err == context.DeadlineExceeded
+}
+
+// contextError wrap err in a ContextError if it has been caused by ctx
+func contextError(ctx context.Context, method string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil && err == ctxErr {
+		return &ContextError{
+			Method: method,
+			Err:    ctxErr,
+		}
+	}
+	return err
+}
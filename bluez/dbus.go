@@ -4,7 +4,7 @@ import (
 	"errors"
 
 	"github.com/godbus/dbus/v5"
-	log "github.com/sirupsen/logrus"
+	"github.com/muka/go-bluetooth/logger"
 )
 
 //Properties dbus serializable struct
@@ -39,6 +39,8 @@ type Config struct {
 	Iface string
 	Path  dbus.ObjectPath
 	Bus   BusType
+	// Conn is an optional connection to use instead of the shared one for Bus
+	Conn *Conn
 }
 
 // CloseConnections close all open connection to DBus
@@ -47,7 +49,7 @@ func CloseConnections() (err error) {
 		if conn != nil {
 			err = conn.Close()
 			if err != nil {
-				log.Warnf("Close: %s", err)
+				logger.Warnf("Close: %s", err)
 			}
 		}
 	}
@@ -55,6 +57,21 @@ func CloseConnections() (err error) {
 	return err
 }
 
+// SetConnection replace the shared connection used for a bus type, eg. to
+// point the clients to a private bus. Pass nil to restore the default.
+func SetConnection(connType BusType, conn *dbus.Conn) error {
+	switch connType {
+	case SystemBus, SessionBus:
+		conns[connType] = conn
+		// drop cached clients bound to the previous connection
+		closeObjectCache()
+		objectManager = nil
+		return nil
+	default:
+		return errors.New("Unmanged DBus type code")
+	}
+}
+
 //GetConnection get a DBus connection
 func GetConnection(connType BusType) (*dbus.Conn, error) {
 	switch connType {
@@ -0,0 +1,381 @@
+package bluez
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/logger"
+)
+
+var errDispatcherClosed = errors.New("Dispatcher: connection closed")
+
+var (
+	dispatchersLock sync.Mutex
+	dispatchers     = make(map[*dbus.Conn]*Dispatcher)
+)
+
+// SignalMatch select the signals delivered to a subscription. Empty fields
+// match any value.
+type SignalMatch struct {
+	// Path match the object path emitting the signal
+	Path dbus.ObjectPath
+	// PathNamespace match the object path and its children
+	PathNamespace dbus.ObjectPath
+	// Interface match the signal interface, eg. org.freedesktop.DBus.Properties
+	Interface string
+	// Member match the signal name, eg. PropertiesChanged
+	Member string
+	// Arg0 match the first argument if it is a string, eg. a bus name for NameOwnerChanged
+	Arg0 string
+}
+
+// rule return the bus match rule for m
+func (m SignalMatch) rule() string {
+	rule := "type='signal'"
+	if m.Path != "" {
+		rule += fmt.Sprintf(",path='%s'", m.Path)
+	}
+	if m.PathNamespace != "" {
+		rule += fmt.Sprintf(",path_namespace='%s'", m.PathNamespace)
+	}
+	if m.Interface != "" {
+		rule += fmt.Sprintf(",interface='%s'", m.Interface)
+	}
+	if m.Member != "" {
+		rule += fmt.Sprintf(",member='%s'", m.Member)
+	}
+	if m.Arg0 != "" {
+		rule += fmt.Sprintf(",arg0='%s'", m.Arg0)
+	}
+	return rule
+}
+
+// Match check if a signal is selected by m
+func (m SignalMatch) Match(sig *dbus.Signal) bool {
+	if m.Path != "" && sig.Path != m.Path {
+		return false
+	}
+	if m.PathNamespace != "" && !inPathNamespace(sig.Path, m.PathNamespace) {
+		return false
+	}
+	iface, member := splitSignalName(sig.Name)
+	if m.Interface != "" && iface != m.Interface {
+		return false
+	}
+	if m.Member != "" && member != m.Member {
+		return false
+	}
+	if m.Arg0 != "" {
+		if len(sig.Body) == 0 {
+			return false
+		}
+		if arg0, ok := sig.Body[0].(string); !ok || arg0 != m.Arg0 {
+			return false
+		}
+	}
+	return true
+}
+
+func inPathNamespace(path, namespace dbus.ObjectPath) bool {
+	if namespace == "/" || path == namespace {
+		return true
+	}
+	return strings.HasPrefix(string(path), string(namespace)+"/")
+}
+
+// splitSignalName split a signal name in interface and member
+func splitSignalName(name string) (string, string) {
+	i := strings.LastIndex(name, ".")
+	if i == -1 {
+		return "", name
+	}
+	return name[:i], name[i+1:]
+}
+
+// GetDispatcher return the signal dispatcher of a connection, creating it on
+// first use
+func GetDispatcher(conn *dbus.Conn) *Dispatcher {
+	dispatchersLock.Lock()
+	defer dispatchersLock.Unlock()
+
+	if d, ok := dispatchers[conn]; ok {
+		return d
+	}
+
+	d := &Dispatcher{
+		conn:          conn,
+		signal:        make(chan *dbus.Signal, 256),
+		subscriptions: make(map[*Subscription]bool),
+		matches:       make(map[string]*matchRule),
+	}
+	dispatchers[conn] = d
+
+	conn.Signal(d.signal)
+	go d.run()
+
+	return d
+}
+
+// Dispatcher receive the signals of a connection and route them to the
+// matching subscriptions. Bus match rules are reference counted, so that
+// subscriptions with the same match share a single AddMatch.
+type Dispatcher struct {
+	conn   *dbus.Conn
+	signal chan *dbus.Signal
+
+	lock          sync.Mutex
+	subscriptions map[*Subscription]bool
+	matches       map[string]*matchRule
+	closed        bool
+}
+
+// matchRule is a bus match rule in use, ready is closed once AddMatch
+// returned, setting err if it failed
+type matchRule struct {
+	refs  int
+	ready chan struct{}
+	err   error
+}
+
+// Subscribe to the signals selected by any of matches, delivered in the order
+// they are received. Call Unsubscribe to release it.
+func (d *Dispatcher) Subscribe(matches ...SignalMatch) (*Subscription, error) {
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("Subscribe: no match provided")
+	}
+
+	rules := []string{}
+	for _, match := range matches {
+		rule := match.rule()
+		err := d.addMatch(rule)
+		if err != nil {
+			for _, rule := range rules {
+				d.releaseMatch(rule)
+			}
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	s := &Subscription{
+		dispatcher: d,
+		matches:    matches,
+		rules:      rules,
+		ch:         make(chan *dbus.Signal),
+		notify:     make(chan struct{}, 1),
+		done:       make(chan struct{}),
+		exited:     make(chan struct{}),
+	}
+
+	d.lock.Lock()
+	if d.closed {
+		d.lock.Unlock()
+		for _, rule := range rules {
+			d.releaseMatch(rule)
+		}
+		return nil, errDispatcherClosed
+	}
+	d.subscriptions[s] = true
+	d.lock.Unlock()
+
+	go s.run()
+
+	return s, nil
+}
+
+// addMatch increase the references of a rule and add it to the bus on first
+// use. The callers sharing the rule wait for AddMatch, if it fails the rule is
+// dropped and all of them receive the error.
+func (d *Dispatcher) addMatch(rule string) error {
+	d.lock.Lock()
+	if d.closed {
+		d.lock.Unlock()
+		return errDispatcherClosed
+	}
+	m, ok := d.matches[rule]
+	if ok {
+		m.refs++
+		d.lock.Unlock()
+		<-m.ready
+		return m.err
+	}
+	m = &matchRule{
+		refs:  1,
+		ready: make(chan struct{}),
+	}
+	d.matches[rule] = m
+	d.lock.Unlock()
+
+	err := d.conn.BusObject().Call("org.freedesktop.DBus.AddMatch", 0, rule).Store()
+	if err != nil {
+		d.lock.Lock()
+		m.err = fmt.Errorf("AddMatch %s: %s", rule, err)
+		if d.matches[rule] == m {
+			delete(d.matches, rule)
+		}
+		d.lock.Unlock()
+	}
+	close(m.ready)
+
+	return m.err
+}
+
+// releaseMatch decrease the references of a rule and remove it from the bus
+// when it is not used anymore
+func (d *Dispatcher) releaseMatch(rule string) {
+	d.lock.Lock()
+	m, ok := d.matches[rule]
+	if !ok {
+		d.lock.Unlock()
+		return
+	}
+	m.refs--
+	if m.refs > 0 {
+		d.lock.Unlock()
+		return
+	}
+	delete(d.matches, rule)
+	closed := d.closed
+	d.lock.Unlock()
+
+	if closed {
+		return
+	}
+
+	err := d.conn.BusObject().Call("org.freedesktop.DBus.RemoveMatch", 0, rule).Store()
+	if err != nil {
+		logger.WithMethod("RemoveMatch").Debugf("Dispatcher: RemoveMatch %s: %s", rule, err)
+	}
+}
+
+func (d *Dispatcher) run() {
+	for sig := range d.signal {
+		if sig == nil {
+			continue
+		}
+		d.lock.Lock()
+		for s := range d.subscriptions {
+			if s.Match(sig) {
+				s.push(sig)
+			}
+		}
+		d.lock.Unlock()
+	}
+
+	// the connection has been closed
+	dispatchersLock.Lock()
+	if dispatchers[d.conn] == d {
+		delete(dispatchers, d.conn)
+	}
+	dispatchersLock.Unlock()
+
+	d.lock.Lock()
+	d.closed = true
+	subscriptions := d.subscriptions
+	d.subscriptions = make(map[*Subscription]bool)
+	d.lock.Unlock()
+
+	for s := range subscriptions {
+		s.stop()
+	}
+}
+
+// Subscription receive the signals selected by a SignalMatch
+type Subscription struct {
+	dispatcher *Dispatcher
+	matches    []SignalMatch
+	rules      []string
+
+	lock   sync.Mutex
+	queue  []*dbus.Signal
+	ch     chan *dbus.Signal
+	notify chan struct{}
+	done   chan struct{}
+	exited chan struct{}
+	once   sync.Once
+}
+
+// Match check if a signal is selected by the subscription
+func (s *Subscription) Match(sig *dbus.Signal) bool {
+	for _, match := range s.matches {
+		if match.Match(sig) {
+			return true
+		}
+	}
+	return false
+}
+
+// Signals return the channel receiving the signals. It is closed once the
+// subscription is released or the connection closed.
+func (s *Subscription) Signals() <-chan *dbus.Signal {
+	return s.ch
+}
+
+// Unsubscribe stop receiving signals and release the match rule. It can be
+// called multiple times and from any goroutine.
+func (s *Subscription) Unsubscribe() {
+	d := s.dispatcher
+	d.lock.Lock()
+	_, ok := d.subscriptions[s]
+	delete(d.subscriptions, s)
+	d.lock.Unlock()
+
+	s.stop()
+	if ok {
+		for _, rule := range s.rules {
+			d.releaseMatch(rule)
+		}
+	}
+}
+
+// stop the delivery goroutine and wait for it to exit
+func (s *Subscription) stop() {
+	s.once.Do(func() {
+		close(s.done)
+	})
+	<-s.exited
+}
+
+// push queue a signal without blocking the dispatcher
+func (s *Subscription) push(sig *dbus.Signal) {
+	s.lock.Lock()
+	s.queue = append(s.queue, sig)
+	s.lock.Unlock()
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// run deliver the queued signals in order, it is the only writer of the
+// channel and close it on exit
+func (s *Subscription) run() {
+	defer close(s.exited)
+	defer close(s.ch)
+	for {
+		s.lock.Lock()
+		if len(s.queue) == 0 {
+			s.lock.Unlock()
+			select {
+			case <-s.notify:
+				continue
+			case <-s.done:
+				return
+			}
+		}
+		sig := s.queue[0]
+		s.queue[0] = nil
+		s.queue = s.queue[1:]
+		s.lock.Unlock()
+
+		select {
+		case s.ch <- sig:
+		case <-s.done:
+			return
+		}
+	}
+}
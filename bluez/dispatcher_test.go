@@ -0,0 +1,199 @@
+package bluez_test
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/mock"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSignalIface = "org.bluez.Test"
+
+func nextSignal(t *testing.T, ch <-chan *dbus.Signal) *dbus.Signal {
+	select {
+	case sig := <-ch:
+		return sig
+	case <-time.After(mock.WaitFor):
+		t.Fatal("Timeout waiting for signal")
+	}
+	return nil
+}
+
+func TestDispatcher(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	d := bluez.GetDispatcher(b.ClientConn())
+	assert.Equal(t, d, bluez.GetDispatcher(b.ClientConn()))
+
+	path := dbus.ObjectPath("/org/bluez/test")
+	match := bluez.SignalMatch{Path: path, Interface: testSignalIface}
+
+	sub1, err := d.Subscribe(match)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub2, err := d.Subscribe(match)
+	if err != nil {
+		t.Fatal(err)
+	}
+	member, err := d.Subscribe(bluez.SignalMatch{PathNamespace: "/org/bluez", Member: "Pong"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer member.Unsubscribe()
+
+	err = b.Conn().Emit("/org/bluez/other", testSignalIface+".Ping", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = b.Conn().Emit(path, testSignalIface+".Ping", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = b.Conn().Emit(path, testSignalIface+".Pong", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// signals are routed by path and delivered in order
+	for _, sub := range []*bluez.Subscription{sub1, sub2} {
+		assert.Equal(t, []interface{}{int32(1)}, nextSignal(t, sub.Signals()).Body)
+		assert.Equal(t, []interface{}{int32(2)}, nextSignal(t, sub.Signals()).Body)
+	}
+	assert.Equal(t, []interface{}{int32(2)}, nextSignal(t, member.Signals()).Body)
+
+	// a rule shared by subscriptions stays registered until the last one is released
+	sub1.Unsubscribe()
+	sub1.Unsubscribe()
+	_, ok := <-sub1.Signals()
+	assert.False(t, ok)
+
+	err = b.Conn().Emit(path, testSignalIface+".Ping", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []interface{}{int32(3)}, nextSignal(t, sub2.Signals()).Body)
+
+	// pending signals do not block unsubscribe
+	err = b.Conn().Emit(path, testSignalIface+".Ping", 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(mock.Tick * 5)
+	sub2.Unsubscribe()
+	for range sub2.Signals() {
+	}
+}
+
+func TestDispatcherConcurrentSubscribe(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	d := bluez.GetDispatcher(b.ClientConn())
+
+	subscribe := func(match bluez.SignalMatch) ([]*bluez.Subscription, []error) {
+		var wg sync.WaitGroup
+		subs := make([]*bluez.Subscription, 10)
+		errs := make([]error, len(subs))
+		for i := range subs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				subs[i], errs[i] = d.Subscribe(match)
+			}(i)
+		}
+		wg.Wait()
+		return subs, errs
+	}
+
+	// the callers sharing a rule rejected by the bus all fail
+	_, errs := subscribe(bluez.SignalMatch{Path: "invalid path"})
+	for _, err := range errs {
+		assert.Error(t, err)
+	}
+
+	path := dbus.ObjectPath("/org/bluez/test")
+	subs, errs := subscribe(bluez.SignalMatch{Path: path, Interface: testSignalIface})
+	for _, err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := b.Conn().Emit(path, testSignalIface+".Ping", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, sub := range subs {
+		assert.Equal(t, []interface{}{int32(1)}, nextSignal(t, sub.Signals()).Body)
+		sub.Unsubscribe()
+	}
+}
+
+func TestWatchPropertiesUnwatch(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	devPath, _ := mock.AddTestDevice(t, b)
+	dev, err := device.NewDevice1(devPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ch, err := dev.WatchProperties()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = b.SetProperty(devPath, device.Device1Interface, "RSSI", int16(-70))
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case change := <-ch:
+		assert.Equal(t, "RSSI", change.Name)
+		assert.Equal(t, int16(-70), change.Value)
+	case <-time.After(mock.WaitFor):
+		t.Fatal("Timeout waiting for property change")
+	}
+	dev.Properties.Lock()
+	assert.Equal(t, int16(-70), dev.Properties.RSSI)
+	dev.Properties.Unlock()
+
+	err = dev.UnwatchProperties(ch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, ok := <-ch
+	assert.False(t, ok)
+
+	before := runtime.NumGoroutine()
+
+	// changes left in the channel do not block or leak the watchers
+	for i := 0; i < 50; i++ {
+		ch, err := dev.WatchProperties()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = b.SetProperty(devPath, device.Device1Interface, "RSSI", int16(-50-i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = dev.UnwatchProperties(ch)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// assert.Eventually runs the condition in a goroutine, poll instead
+	deadline := time.Now().Add(mock.WaitFor)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(mock.Tick)
+	}
+	assert.LessOrEqual(t, runtime.NumGoroutine(), before)
+}
@@ -0,0 +1,177 @@
+package bluez
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	// ErrorPrefix is the namespace of errors returned by bluetoothd
+	ErrorPrefix = "org.bluez.Error."
+	// ObexErrorPrefix is the namespace of errors returned by obexd
+	ObexErrorPrefix = "org.bluez.obex.Error."
+)
+
+// Sentinel errors mapping the bluez error names, to be used with errors.Is
+var (
+	ErrFailed                  = errors.New(ErrorPrefix + "Failed")
+	ErrInvalidArguments        = errors.New(ErrorPrefix + "InvalidArguments")
+	ErrNotSupported            = errors.New(ErrorPrefix + "NotSupported")
+	ErrRejected                = errors.New(ErrorPrefix + "Rejected")
+	ErrDoesNotExist            = errors.New(ErrorPrefix + "DoesNotExist")
+	ErrNotReady                = errors.New(ErrorPrefix + "NotReady")
+	ErrNotAuthorized           = errors.New(ErrorPrefix + "NotAuthorized")
+	ErrInProgress              = errors.New(ErrorPrefix + "InProgress")
+	ErrNotInProgress           = errors.New(ErrorPrefix + "NotInProgress")
+	ErrCanceled                = errors.New(ErrorPrefix + "Canceled")
+	ErrAlreadyExists           = errors.New(ErrorPrefix + "AlreadyExists")
+	ErrNotPermitted            = errors.New(ErrorPrefix + "NotPermitted")
+	ErrNotConnected            = errors.New(ErrorPrefix + "NotConnected")
+	ErrNotAvailable            = errors.New(ErrorPrefix + "NotAvailable")
+	ErrNotAllowed              = errors.New(ErrorPrefix + "NotAllowed")
+	ErrAlreadyConnected        = errors.New(ErrorPrefix + "AlreadyConnected")
+	ErrNotFound                = errors.New(ErrorPrefix + "NotFound")
+	ErrNotAcquired             = errors.New(ErrorPrefix + "NotAcquired")
+	ErrInvalidValueLength      = errors.New(ErrorPrefix + "InvalidValueLength")
+	ErrInvalidOffset           = errors.New(ErrorPrefix + "InvalidOffset")
+	ErrInvalidLength           = errors.New(ErrorPrefix + "InvalidLength")
+	ErrOutOfRange              = errors.New(ErrorPrefix + "OutOfRange")
+	ErrConnectionAttemptFailed = errors.New(ErrorPrefix + "ConnectionAttemptFailed")
+	ErrAuthenticationFailed    = errors.New(ErrorPrefix + "AuthenticationFailed")
+	ErrAuthenticationCanceled  = errors.New(ErrorPrefix + "AuthenticationCanceled")
+	ErrAuthenticationRejected  = errors.New(ErrorPrefix + "AuthenticationRejected")
+	ErrAuthenticationTimeout   = errors.New(ErrorPrefix + "AuthenticationTimeout")
+	ErrHealthError             = errors.New(ErrorPrefix + "HealthError")
+	ErrNotImplemented          = errors.New(ErrorPrefix + "NotImplemented")
+)
+
+// errorsMap index the sentinel errors by short name, eg. InProgress
+var errorsMap = map[string]error{}
+
+func init() {
+	for _, err := range []error{
+		ErrFailed, ErrInvalidArguments, ErrNotSupported, ErrRejected,
+		ErrDoesNotExist, ErrNotReady, ErrNotAuthorized, ErrInProgress,
+		ErrNotInProgress, ErrCanceled, ErrAlreadyExists, ErrNotPermitted,
+		ErrNotConnected, ErrNotAvailable, ErrNotAllowed, ErrAlreadyConnected,
+		ErrNotFound, ErrNotAcquired, ErrInvalidValueLength, ErrInvalidOffset,
+		ErrInvalidLength, ErrOutOfRange, ErrConnectionAttemptFailed,
+		ErrAuthenticationFailed, ErrAuthenticationCanceled,
+		ErrAuthenticationRejected, ErrAuthenticationTimeout, ErrHealthError,
+		ErrNotImplemented,
+	} {
+		errorsMap[strings.TrimPrefix(err.Error(), ErrorPrefix)] = err
+	}
+}
+
+// Error is an error returned by bluez for a method call. It matches the
+// corresponding sentinel error (eg. ErrInProgress) with errors.Is
+type Error struct {
+	// Name is the DBus error name, eg. org.bluez.Error.InProgress
+	Name string
+	// Message is the error description sent by bluez
+	Message string
+	// Path is the object path of the call
+	Path dbus.ObjectPath
+	// Method is the called method, eg. org.bluez.Device1.Connect
+	Method string
+
+	sentinel error
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("%s %s: %s", e.Method, e.Path, e.Name)
+	}
+	return fmt.Sprintf("%s %s: %s: %s", e.Method, e.Path, e.Name, e.Message)
+}
+
+// Unwrap return the sentinel error matching the error name, if known
+func (e *Error) Unwrap() error {
+	return e.sentinel
+}
+
+// As allow to retrieve the original dbus.Error with errors.As
+func (e *Error) As(target interface{}) bool {
+	if dbusErr, ok := target.(*dbus.Error); ok {
+		*dbusErr = e.DBusError()
+		return true
+	}
+	return false
+}
+
+// DBusError return the error as a dbus.Error
+func (e *Error) DBusError() dbus.Error {
+	return dbus.Error{
+		Name: e.Name,
+		Body: []interface{}{e.Message},
+	}
+}
+
+// MapError convert a bluez dbus.Error returned by a call to an *Error.
+// Other errors are returned unchanged.
+func MapError(err error, path dbus.ObjectPath, method string) error {
+
+	var dbusErr dbus.Error
+	switch e := err.(type) {
+	case dbus.Error:
+		dbusErr = e
+	case *dbus.Error:
+		if e == nil {
+			return err
+		}
+		dbusErr = *e
+	default:
+		return err
+	}
+
+	var name string
+	switch {
+	case strings.HasPrefix(dbusErr.Name, ErrorPrefix):
+		name = strings.TrimPrefix(dbusErr.Name, ErrorPrefix)
+	case strings.HasPrefix(dbusErr.Name, ObexErrorPrefix):
+		name = strings.TrimPrefix(dbusErr.Name, ObexErrorPrefix)
+	default:
+		return err
+	}
+
+	message := ""
+	if len(dbusErr.Body) > 0 {
+		if msg, ok := dbusErr.Body[0].(string); ok {
+			message = msg
+		}
+	}
+
+	return &Error{
+		Name:     dbusErr.Name,
+		Message:  message,
+		Path:     path,
+		Method:   method,
+		sentinel: errorsMap[name],
+	}
+}
+
+// InvalidValueError is returned without calling bluez when a property is set
+// to a value it does not document. It matches ErrInvalidArguments.
+type InvalidValueError struct {
+	// Interface is the DBus interface, eg. org.bluez.MediaPlayer1
+	Interface string
+	// Member is the property name, eg. Repeat
+	Member string
+	// Path is the object path of the call
+	Path dbus.ObjectPath
+	// Value is the rejected value
+	Value string
+}
+
+func (e *InvalidValueError) Error() string {
+	return fmt.Sprintf("%s.%s %s: invalid value %q", e.Interface, e.Member, e.Path, e.Value)
+}
+
+// Unwrap return ErrInvalidArguments
+func (e *InvalidValueError) Unwrap() error {
+	return ErrInvalidArguments
+}
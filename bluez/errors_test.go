@@ -0,0 +1,56 @@
+package bluez
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapError(t *testing.T) {
+
+	path := dbus.ObjectPath("/org/bluez/hci0/dev_00_11_22_33_44_55")
+	method := "org.bluez.Device1.Pair"
+
+	err := MapError(dbus.Error{
+		Name: "org.bluez.Error.AuthenticationFailed",
+		Body: []interface{}{"Authentication Failed"},
+	}, path, method)
+
+	assert.True(t, errors.Is(err, ErrAuthenticationFailed))
+	assert.False(t, errors.Is(err, ErrInProgress))
+
+	bluezErr, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Expected *Error, got %T", err)
+	}
+	assert.Equal(t, path, bluezErr.Path)
+	assert.Equal(t, method, bluezErr.Method)
+	assert.Equal(t, "Authentication Failed", bluezErr.Message)
+
+	var dbusErr dbus.Error
+	assert.True(t, errors.As(err, &dbusErr))
+	assert.Equal(t, "org.bluez.Error.AuthenticationFailed", dbusErr.Name)
+}
+
+func TestMapErrorUnknown(t *testing.T) {
+
+	// unknown bluez errors are still mapped, without a sentinel
+	err := MapError(dbus.Error{Name: "org.bluez.Error.Foobar"}, "/", "Foo")
+	_, ok := err.(*Error)
+	assert.True(t, ok)
+	assert.Nil(t, errors.Unwrap(err))
+
+	// obex errors share the sentinels
+	err = MapError(dbus.Error{Name: "org.bluez.obex.Error.InProgress"}, "/", "Foo")
+	assert.True(t, errors.Is(err, ErrInProgress))
+
+	// other errors are left untouched
+	dbusErr := dbus.Error{Name: "org.freedesktop.DBus.Error.UnknownObject"}
+	assert.Equal(t, dbusErr, MapError(dbusErr, "/", "Foo"))
+
+	other := errors.New("foo")
+	assert.Equal(t, other, MapError(other, "/", "Foo"))
+	assert.Nil(t, MapError(nil, "/", "Foo"))
+}
@@ -0,0 +1,246 @@
+package bluez
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// FakeProperties is the in-memory property store of the generated fakes, eg.
+// device.FakeDevice1. Changes are delivered to the watchers as bluez would
+// emit them with PropertiesChanged.
+type FakeProperties struct {
+	iface string
+	path  dbus.ObjectPath
+
+	lock    sync.Mutex
+	values  map[string]dbus.Variant
+	watches map[chan *PropertyChanged]*fakeWatch
+	signal  chan *dbus.Signal
+	closed  bool
+	done    chan struct{}
+}
+
+// NewFakeProperties create a property store for the interface iface of the
+// object at path, initialized with values
+func NewFakeProperties(iface string, path dbus.ObjectPath, values map[string]interface{}) *FakeProperties {
+	p := &FakeProperties{
+		iface:   iface,
+		path:    path,
+		values:  make(map[string]dbus.Variant),
+		watches: make(map[chan *PropertyChanged]*fakeWatch),
+		done:    make(chan struct{}),
+	}
+	for name, value := range values {
+		p.values[name] = dbus.MakeVariant(value)
+	}
+	return p
+}
+
+// Values return a copy of the properties
+func (p *FakeProperties) Values() map[string]dbus.Variant {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	values := make(map[string]dbus.Variant, len(p.values))
+	for name, value := range p.values {
+		values[name] = value
+	}
+	return values
+}
+
+// Get return a property, it fails as bluez does if the property is not set
+func (p *FakeProperties) Get(name string) (dbus.Variant, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	value, ok := p.values[name]
+	if !ok {
+		return dbus.Variant{}, dbus.Error{
+			Name: "org.freedesktop.DBus.Error.InvalidArgs",
+			Body: []interface{}{fmt.Sprintf("No such property '%s'", name)},
+		}
+	}
+	return value, nil
+}
+
+// Set a property and notify the watchers. Unlike bluez, read-only properties
+// can be set, eg. to simulate a device connecting.
+func (p *FakeProperties) Set(name string, value interface{}) error {
+
+	variant, ok := value.(dbus.Variant)
+	if !ok {
+		variant = dbus.MakeVariant(value)
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.values[name] = variant
+
+	change := &PropertyChanged{
+		Interface: p.iface,
+		Name:      name,
+		Value:     variant.Value(),
+	}
+	for _, w := range p.watches {
+		w.push(change)
+	}
+	return nil
+}
+
+// Watch return a channel receiving the changes, as WatchProperties does
+func (p *FakeProperties) Watch() (chan *PropertyChanged, error) {
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	ch := make(chan *PropertyChanged)
+	w := &fakeWatch{
+		ch:     ch,
+		notify: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+		exited: make(chan struct{}),
+	}
+	if p.closed {
+		close(ch)
+		return ch, nil
+	}
+	p.watches[ch] = w
+	go w.run()
+
+	return ch, nil
+}
+
+// Unwatch stop the changes sent to ch and close it, as UnwatchProperties does
+func (p *FakeProperties) Unwatch(ch chan *PropertyChanged) error {
+	p.lock.Lock()
+	w, ok := p.watches[ch]
+	delete(p.watches, ch)
+	p.lock.Unlock()
+	if ok {
+		w.stop()
+	}
+	return nil
+}
+
+// Signal return a channel receiving the changes as PropertiesChanged signals,
+// as GetPropertiesSignal does. It is closed by Close.
+func (p *FakeProperties) Signal() (<-chan *dbus.Signal, error) {
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.signal != nil {
+		return p.signal, nil
+	}
+	p.signal = make(chan *dbus.Signal)
+
+	changes := make(chan *PropertyChanged)
+	w := &fakeWatch{
+		ch:     changes,
+		notify: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+		exited: make(chan struct{}),
+	}
+	if p.closed {
+		close(p.signal)
+		return p.signal, nil
+	}
+	p.watches[changes] = w
+	go w.run()
+
+	go func(signal chan *dbus.Signal) {
+		defer close(signal)
+		for change := range changes {
+			sig := &dbus.Signal{
+				Path: p.path,
+				Name: PropertiesChanged,
+				Body: []interface{}{
+					change.Interface,
+					map[string]dbus.Variant{change.Name: dbus.MakeVariant(change.Value)},
+					[]string{},
+				},
+			}
+			select {
+			case signal <- sig:
+			case <-p.done:
+				return
+			}
+		}
+	}(p.signal)
+
+	return p.signal, nil
+}
+
+// Close stop the watchers and close their channels
+func (p *FakeProperties) Close() {
+	p.lock.Lock()
+	if p.closed {
+		p.lock.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.done)
+	watches := p.watches
+	p.watches = make(map[chan *PropertyChanged]*fakeWatch)
+	p.lock.Unlock()
+
+	for _, w := range watches {
+		w.stop()
+	}
+}
+
+// fakeWatch deliver the changes in order without blocking Set
+type fakeWatch struct {
+	ch     chan *PropertyChanged
+	lock   sync.Mutex
+	queue  []*PropertyChanged
+	notify chan struct{}
+	done   chan struct{}
+	exited chan struct{}
+	once   sync.Once
+}
+
+func (w *fakeWatch) push(change *PropertyChanged) {
+	w.lock.Lock()
+	w.queue = append(w.queue, change)
+	w.lock.Unlock()
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (w *fakeWatch) stop() {
+	w.once.Do(func() {
+		close(w.done)
+	})
+	<-w.exited
+}
+
+func (w *fakeWatch) run() {
+	defer close(w.exited)
+	defer close(w.ch)
+	for {
+		w.lock.Lock()
+		if len(w.queue) == 0 {
+			w.lock.Unlock()
+			select {
+			case <-w.notify:
+				continue
+			case <-w.done:
+				return
+			}
+		}
+		change := w.queue[0]
+		w.queue[0] = nil
+		w.queue = w.queue[1:]
+		w.lock.Unlock()
+
+		select {
+		case w.ch <- change:
+		case <-w.done:
+			return
+		}
+	}
+}
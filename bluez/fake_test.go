@@ -0,0 +1,65 @@
+package bluez
+
+import (
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeProperties(t *testing.T) {
+
+	path := dbus.ObjectPath("/org/bluez/hci0/dev_00_11_22_33_44_55")
+	p := NewFakeProperties("org.bluez.Device1", path, map[string]interface{}{
+		"Connected": false,
+	})
+	defer p.Close()
+
+	v, err := p.Get("Connected")
+	assert.NoError(t, err)
+	assert.Equal(t, false, v.Value())
+
+	_, err = p.Get("Missing")
+	assert.Error(t, err)
+
+	watch, err := p.Watch()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signal, err := p.Signal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Set does not wait for the watchers
+	assert.NoError(t, p.Set("Connected", true))
+	assert.NoError(t, p.Set("RSSI", int16(-50)))
+
+	for _, name := range []string{"Connected", "RSSI"} {
+		select {
+		case change := <-watch:
+			assert.Equal(t, "org.bluez.Device1", change.Interface)
+			assert.Equal(t, name, change.Name)
+		case <-time.After(time.Second):
+			t.Fatal("Timeout waiting for property change")
+		}
+	}
+
+	select {
+	case sig := <-signal:
+		assert.Equal(t, path, sig.Path)
+		assert.Equal(t, PropertiesChanged, sig.Name)
+		assert.Equal(t, map[string]dbus.Variant{"Connected": dbus.MakeVariant(true)}, sig.Body[1])
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for signal")
+	}
+
+	assert.NoError(t, p.Unwatch(watch))
+	_, ok := <-watch
+	assert.False(t, ok)
+
+	p.Close()
+	for range signal {
+	}
+}
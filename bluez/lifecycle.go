@@ -0,0 +1,288 @@
+package bluez
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/logger"
+)
+
+const (
+	// DBusInterface is the interface of the message bus
+	DBusInterface = "org.freedesktop.DBus"
+	// NameOwnerChanged the DBus signal member emitted when a bus name changes owner
+	NameOwnerChanged = "org.freedesktop.DBus.NameOwnerChanged"
+)
+
+var (
+	// RestoreRetryInterval is the delay between the attempts of a failing
+	// restore hook, eg. while the adapters are not yet available
+	RestoreRetryInterval = 500 * time.Millisecond
+	// RestoreTimeout is the time after which a failing restore hook is dropped
+	RestoreTimeout = 30 * time.Second
+)
+
+// ServiceEventType describe a change of the bluez service
+type ServiceEventType string
+
+const (
+	// ServiceStarted bluez acquired its bus name, eg. bluetoothd (re)started
+	ServiceStarted ServiceEventType = "started"
+	// ServiceStopped bluez released its bus name, eg. bluetoothd exited
+	ServiceStopped ServiceEventType = "stopped"
+)
+
+// ServiceEvent is emitted when the bluez service starts or stops
+type ServiceEvent struct {
+	Type ServiceEventType
+	// Owner is the unique bus name of bluez, empty when stopped
+	Owner string
+}
+
+// serviceMatch select the NameOwnerChanged signals of bluez
+var serviceMatch = SignalMatch{
+	Path:      "/org/freedesktop/DBus",
+	Interface: DBusInterface,
+	Member:    "NameOwnerChanged",
+	Arg0:      OrgBluezInterface,
+}
+
+// parseServiceEvents convert a NameOwnerChanged signal to lifecycle events. An
+// owner replaced without releasing the name stops and starts the service
+func parseServiceEvents(sig *dbus.Signal) []*ServiceEvent {
+
+	if sig.Name != NameOwnerChanged || len(sig.Body) < 3 {
+		return nil
+	}
+	oldOwner, ok1 := sig.Body[1].(string)
+	newOwner, ok2 := sig.Body[2].(string)
+	if !ok1 || !ok2 {
+		return nil
+	}
+
+	events := []*ServiceEvent{}
+	if oldOwner != "" {
+		events = append(events, &ServiceEvent{Type: ServiceStopped})
+	}
+	if newOwner != "" {
+		events = append(events, &ServiceEvent{Type: ServiceStarted, Owner: newOwner})
+	}
+	return events
+}
+
+// WatchService emit an event each time bluez starts or stops. Call cancel to
+// stop receiving events, the channel is closed afterwards.
+func WatchService() (<-chan *ServiceEvent, func(), error) {
+	return WatchServiceWithConn(nil)
+}
+
+// WatchServiceWithConn is WatchService on a connection, nil for the shared
+// system bus connection
+func WatchServiceWithConn(c *Conn) (<-chan *ServiceEvent, func(), error) {
+
+	conn, err := c.DBusConn()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sub, err := GetDispatcher(conn).Subscribe(serviceMatch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ch := make(chan *ServiceEvent)
+	done := make(chan struct{})
+	exited := make(chan struct{})
+
+	go func() {
+		defer close(exited)
+		defer close(ch)
+		for sig := range sub.Signals() {
+			for _, ev := range parseServiceEvents(sig) {
+				select {
+				case ch <- ev:
+				case <-done:
+					return
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(done)
+			sub.Unsubscribe()
+			<-exited
+		})
+	}
+
+	return ch, cancel, nil
+}
+
+var (
+	restorersLock sync.Mutex
+	restorers     = make(map[*dbus.Conn]*serviceRestorer)
+)
+
+// OnServiceRestart register a function called when bluez starts again after
+// it stopped, eg. to register again an application, an advertisement or an
+// agent. A failing hook is retried every RestoreRetryInterval up to
+// RestoreTimeout. Call remove to unregister the hook.
+func OnServiceRestart(hook func() error) (remove func(), err error) {
+	return OnServiceRestartWithConn(nil, hook)
+}
+
+// OnServiceRestartWithConn is OnServiceRestart on a connection, nil for the
+// shared system bus connection
+func OnServiceRestartWithConn(c *Conn, hook func() error) (remove func(), err error) {
+
+	conn, err := c.DBusConn()
+	if err != nil {
+		return nil, err
+	}
+
+	restorersLock.Lock()
+	r, ok := restorers[conn]
+	if !ok {
+		r, err = newServiceRestorer(conn)
+		if err != nil {
+			restorersLock.Unlock()
+			return nil, err
+		}
+		restorers[conn] = r
+	}
+	restorersLock.Unlock()
+
+	id := r.add(hook)
+
+	var once sync.Once
+	remove = func() {
+		once.Do(func() {
+			r.remove(id)
+		})
+	}
+
+	return remove, nil
+}
+
+// serviceRestorer run the restore hooks of a connection
+type serviceRestorer struct {
+	lock   sync.Mutex
+	hooks  map[int]func() error
+	nextID int
+	// generation is increased on each event, pending retries of a previous
+	// generation are dropped
+	generation int
+}
+
+func newServiceRestorer(conn *dbus.Conn) (*serviceRestorer, error) {
+
+	sub, err := GetDispatcher(conn).Subscribe(serviceMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &serviceRestorer{
+		hooks: make(map[int]func() error),
+	}
+
+	go func() {
+		for sig := range sub.Signals() {
+			for _, ev := range parseServiceEvents(sig) {
+				r.handle(ev)
+			}
+		}
+		// the connection has been closed
+		restorersLock.Lock()
+		if restorers[conn] == r {
+			delete(restorers, conn)
+		}
+		restorersLock.Unlock()
+	}()
+
+	return r, nil
+}
+
+func (r *serviceRestorer) add(hook func() error) int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	id := r.nextID
+	r.nextID++
+	r.hooks[id] = hook
+	return id
+}
+
+func (r *serviceRestorer) remove(id int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.hooks, id)
+}
+
+func (r *serviceRestorer) handle(ev *ServiceEvent) {
+
+	r.lock.Lock()
+	r.generation++
+	generation := r.generation
+	ids := []int{}
+	for id := range r.hooks {
+		ids = append(ids, id)
+	}
+	r.lock.Unlock()
+
+	if ev.Type != ServiceStarted {
+		logger.Warnf("%s stopped", OrgBluezInterface)
+		return
+	}
+
+	logger.Debugf("%s started (%s), restoring %d registrations", OrgBluezInterface, ev.Owner, len(ids))
+	// hooks run in registration order, eg. an agent before the application using it
+	sort.Ints(ids)
+	go r.restore(generation, ids)
+}
+
+// restore run the hooks until they succeed, the timeout expires or bluez
+// changes state again
+func (r *serviceRestorer) restore(generation int, ids []int) {
+
+	deadline := time.Now().Add(RestoreTimeout)
+
+	for len(ids) > 0 {
+
+		failed := []int{}
+		for _, id := range ids {
+
+			r.lock.Lock()
+			hook, ok := r.hooks[id]
+			current := r.generation == generation
+			r.lock.Unlock()
+
+			if !current {
+				return
+			}
+			// removed meanwhile
+			if !ok {
+				continue
+			}
+
+			err := hook()
+			if err != nil {
+				logger.Debugf("Restore hook failed: %s", err)
+				failed = append(failed, id)
+			}
+		}
+
+		if len(failed) == 0 {
+			return
+		}
+		if time.Now().After(deadline) {
+			logger.Errorf("Failed to restore %d registrations after %s restart", len(failed), OrgBluezInterface)
+			return
+		}
+
+		ids = failed
+		time.Sleep(RestoreRetryInterval)
+	}
+}
@@ -0,0 +1,123 @@
+package bluez_test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/muka/go-bluetooth/api"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/mock"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/muka/go-bluetooth/bluez/profile/agent"
+	"github.com/stretchr/testify/assert"
+)
+
+func nextServiceEvent(t *testing.T, events <-chan *bluez.ServiceEvent) *bluez.ServiceEvent {
+	select {
+	case ev := <-events:
+		return ev
+	case <-time.After(mock.WaitFor):
+		t.Fatal("Timeout waiting for service event")
+	}
+	return nil
+}
+
+func TestServiceRestart(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	interval := bluez.RestoreRetryInterval
+	bluez.RestoreRetryInterval = mock.Tick
+	defer func() {
+		bluez.RestoreRetryInterval = interval
+	}()
+
+	cache, err := bluez.GetObjectCache()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events, cancel, err := bluez.WatchService()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+
+	ag := agent.NewSimpleAgent()
+	err = agent.ExposeAgent(b.ClientConn(), ag, agent.CapNoInputNoOutput, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := adapter.NewAdapter1FromAdapterID(mock.TestAdapterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, discoveryCancel, err := api.Discover(a, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer discoveryCancel()
+
+	// a failing hook is retried
+	var calls int32
+	remove, err := bluez.OnServiceRestart(func() error {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			return errors.New("not ready")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer remove()
+
+	err = b.Restart()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ev := nextServiceEvent(t, events)
+	assert.Equal(t, bluez.ServiceStopped, ev.Type)
+	ev = nextServiceEvent(t, events)
+	assert.Equal(t, bluez.ServiceStarted, ev.Type)
+	assert.Equal(t, b.Conn().Names()[0], ev.Owner)
+
+	assert.Eventually(t, func() bool {
+		return b.Agents()[ag.Path()] == agent.CapNoInputNoOutput && b.DefaultAgent() == ag.Path()
+	}, mock.WaitFor, mock.Tick)
+	assert.Eventually(t, func() bool {
+		discovering, err := a.GetDiscovering()
+		return err == nil && discovering
+	}, mock.WaitFor, mock.Tick)
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&calls) == 3
+	}, mock.WaitFor, mock.Tick)
+	assert.NotNil(t, cache.GetObject(mock.AdapterPath(mock.TestAdapterID)))
+
+	// removed hooks are not called anymore
+	err = agent.RemoveAgent(ag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	discoveryCancel()
+
+	err = b.Restart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	nextServiceEvent(t, events)
+	nextServiceEvent(t, events)
+
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&calls) == 4
+	}, mock.WaitFor, mock.Tick)
+	assert.Empty(t, b.Agents())
+	discovering, err := a.GetDiscovering()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, discovering)
+}
@@ -0,0 +1,126 @@
+package mock
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// DBusDaemonBin is the dbus-daemon binary used to start a private bus
+var DBusDaemonBin = "dbus-daemon"
+
+const busConfig = `<!DOCTYPE busconfig PUBLIC "-//freedesktop//DTD D-Bus Bus Configuration 1.0//EN"
+ "http://www.freedesktop.org/standards/dbus/1.0/busconfig.dtd">
+<busconfig>
+  <type>session</type>
+  <listen>unix:path=%s</listen>
+  <auth>EXTERNAL</auth>
+  <policy context="default">
+    <allow send_destination="*" eavesdrop="true"/>
+    <allow eavesdrop="true"/>
+    <allow own="*"/>
+  </policy>
+</busconfig>
+`
+
+// Bus is a private dbus-daemon instance, isolated from the system and session buses
+type Bus struct {
+	Address string
+	cmd     *exec.Cmd
+	dir     string
+}
+
+// StartBus launch a private dbus-daemon. It requires the dbus-daemon binary to be available
+func StartBus() (*Bus, error) {
+
+	bin, err := exec.LookPath(DBusDaemonBin)
+	if err != nil {
+		return nil, fmt.Errorf("dbus-daemon not available: %s", err)
+	}
+
+	dir, err := ioutil.TempDir("", "go-bluetooth-mock")
+	if err != nil {
+		return nil, err
+	}
+
+	configFile := path.Join(dir, "bus.conf")
+	config := fmt.Sprintf(busConfig, path.Join(dir, "bus"))
+	err = ioutil.WriteFile(configFile, []byte(config), 0644)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	cmd := exec.Command(bin, "--nofork", "--print-address", "--config-file="+configFile)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	err = cmd.Start()
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	b := &Bus{
+		cmd: cmd,
+		dir: dir,
+	}
+
+	address, err := bufio.NewReader(stdout).ReadString('\n')
+	if err != nil {
+		b.Close()
+		return nil, fmt.Errorf("Failed to read bus address: %s", err)
+	}
+
+	b.Address = strings.TrimSpace(address)
+	if b.Address == "" {
+		b.Close()
+		return nil, errors.New("Empty bus address")
+	}
+
+	return b, nil
+}
+
+// Connect open a new connection to the bus
+func (b *Bus) Connect() (*dbus.Conn, error) {
+
+	conn, err := dbus.Dial(b.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = conn.Auth(nil)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	err = conn.Hello()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// Close stop the dbus-daemon
+func (b *Bus) Close() error {
+	var err error
+	if b.cmd != nil && b.cmd.Process != nil {
+		err = b.cmd.Process.Kill()
+		b.cmd.Wait()
+	}
+	os.RemoveAll(b.dir)
+	return err
+}
@@ -0,0 +1,226 @@
+package mock
+
+import (
+	"fmt"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// Call is a method call received by the fake service
+type Call struct {
+	Path      dbus.ObjectPath
+	Interface string
+	Method    string
+	Args      []interface{}
+}
+
+// CallHook is invoked before a method is handled. Returning an error fails the
+// call, a *dbus.Error is returned as is to the caller.
+type CallHook func(call Call) error
+
+type injectedError struct {
+	err   dbus.Error
+	count int
+}
+
+// Error return a dbus error in the org.bluez.Error namespace, eg. Error("InProgress")
+func Error(name string) dbus.Error {
+	return dbus.Error{
+		Name: "org.bluez.Error." + name,
+		Body: []interface{}{name},
+	}
+}
+
+func newError(name string) *dbus.Error {
+	err := Error(name)
+	return &err
+}
+
+func errorKey(path dbus.ObjectPath, iface, method string) string {
+	return fmt.Sprintf("%s|%s.%s", path, iface, method)
+}
+
+// OnCall register a hook invoked on every method call
+func (b *Bluez) OnCall(hook CallHook) {
+	b.hooksLock.Lock()
+	defer b.hooksLock.Unlock()
+	b.hooks = append(b.hooks, hook)
+}
+
+// InjectError make the next count calls of method fail with err. An empty
+// path match any object, a count <= 0 fails until ClearErrors is called.
+func (b *Bluez) InjectError(path dbus.ObjectPath, iface, method string, err dbus.Error, count int) {
+	b.hooksLock.Lock()
+	defer b.hooksLock.Unlock()
+	b.errors[errorKey(path, iface, method)] = &injectedError{
+		err:   err,
+		count: count,
+	}
+}
+
+// ClearErrors remove all the injected errors
+func (b *Bluez) ClearErrors() {
+	b.hooksLock.Lock()
+	defer b.hooksLock.Unlock()
+	b.errors = make(map[string]*injectedError)
+}
+
+// Calls return the list of method calls received
+func (b *Bluez) Calls() []Call {
+	b.hooksLock.RLock()
+	defer b.hooksLock.RUnlock()
+	calls := make([]Call, len(b.calls))
+	copy(calls, b.calls)
+	return calls
+}
+
+// ResetCalls clear the list of method calls received
+func (b *Bluez) ResetCalls() {
+	b.hooksLock.Lock()
+	defer b.hooksLock.Unlock()
+	b.calls = []Call{}
+}
+
+// CountCalls return the number of calls received for method
+func (b *Bluez) CountCalls(method string) int {
+	count := 0
+	for _, call := range b.Calls() {
+		if call.Method == method {
+			count++
+		}
+	}
+	return count
+}
+
+// popError return an injected error matching the call, if any
+func (b *Bluez) popError(call Call) *dbus.Error {
+	b.hooksLock.Lock()
+	defer b.hooksLock.Unlock()
+
+	keys := []string{
+		errorKey(call.Path, call.Interface, call.Method),
+		errorKey("", call.Interface, call.Method),
+	}
+	for _, key := range keys {
+		injected, ok := b.errors[key]
+		if !ok {
+			continue
+		}
+		if injected.count > 0 {
+			injected.count--
+			if injected.count == 0 {
+				delete(b.errors, key)
+			}
+		}
+		err := injected.err
+		return &err
+	}
+
+	return nil
+}
+
+// handleCall record a call, run hooks and injected errors then invoke fn
+func (b *Bluez) handleCall(path dbus.ObjectPath, iface, method string, args []interface{}, fn func() error) *dbus.Error {
+
+	call := Call{
+		Path:      path,
+		Interface: iface,
+		Method:    method,
+		Args:      args,
+	}
+
+	if !b.supported(iface, method) {
+		return &dbus.ErrMsgUnknownMethod
+	}
+
+	b.hooksLock.Lock()
+	b.calls = append(b.calls, call)
+	hooks := make([]CallHook, len(b.hooks))
+	copy(hooks, b.hooks)
+	b.hooksLock.Unlock()
+
+	if err := b.popError(call); err != nil {
+		return err
+	}
+
+	for _, hook := range hooks {
+		if err := hook(call); err != nil {
+			return toDBusError(err)
+		}
+	}
+
+	if fn == nil {
+		return nil
+	}
+
+	if err := fn(); err != nil {
+		return toDBusError(err)
+	}
+
+	return nil
+}
+
+func toDBusError(err error) *dbus.Error {
+	switch e := err.(type) {
+	case *dbus.Error:
+		return e
+	case dbus.Error:
+		return &e
+	default:
+		return dbus.MakeFailedError(err)
+	}
+}
+
+// Notify update the value of a characteristic the client is subscribed to,
+// emitting PropertiesChanged like a remote notification would do. If the
+// notifications have been acquired the value is sent on the socket instead.
+func (b *Bluez) Notify(charPath dbus.ObjectPath, value []byte) error {
+
+	if local := b.notifySocket(charPath); local != nil {
+		_, err := local.Write(value)
+		return err
+	}
+
+	notifying, err := b.GetProperty(charPath, gattCharacteristic1Interface, "Notifying")
+	if err != nil {
+		return err
+	}
+
+	if n, ok := notifying.(bool); !ok || !n {
+		return fmt.Errorf("Characteristic %s is not notifying", charPath)
+	}
+
+	return b.SetProperty(charPath, gattCharacteristic1Interface, "Value", value)
+}
+
+// Agents return the registered agents with their capability
+func (b *Bluez) Agents() map[dbus.ObjectPath]string {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	agents := make(map[dbus.ObjectPath]string)
+	for path, capability := range b.agents {
+		agents[path] = capability
+	}
+	return agents
+}
+
+// DefaultAgent return the path of the default agent, if any
+func (b *Bluez) DefaultAgent() dbus.ObjectPath {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.defaultAgent
+}
+
+// Applications return the GATT applications registered on an adapter
+func (b *Bluez) Applications(adapterPath dbus.ObjectPath) []dbus.ObjectPath {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return append([]dbus.ObjectPath{}, b.apps[adapterPath]...)
+}
+
+// Advertisements return the advertisements registered on an adapter
+func (b *Bluez) Advertisements(adapterPath dbus.ObjectPath) []dbus.ObjectPath {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return append([]dbus.ObjectPath{}, b.advs[adapterPath]...)
+}
@@ -0,0 +1,135 @@
+package mock
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/godbus/dbus/v5/introspect"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/muka/go-bluetooth/bluez/profile/advertising"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+)
+
+// properties list the properties bluez declares for an interface, set or not
+var properties = map[string]interface{}{
+	adapter1Interface:              adapter.Adapter1Properties{},
+	device1Interface:               device.Device1Properties{},
+	gattService1Interface:          gatt.GattService1Properties{},
+	gattCharacteristic1Interface:   gatt.GattCharacteristic1Properties{},
+	gattDescriptor1Interface:       gatt.GattDescriptor1Properties{},
+	leAdvertisingManager1Interface: advertising.LEAdvertisingManager1Properties{},
+}
+
+// Unsupported simulate a bluetoothd lacking some members of an interface, eg.
+// an older release or without experimental features enabled. The methods and
+// properties are hidden from introspection and from the properties of the
+// objects, calling a hidden method fails with UnknownMethod. Call it before
+// the clients probe the capabilities of the daemon.
+func (b *Bluez) Unsupported(iface string, members ...string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.unsupported[iface] == nil {
+		b.unsupported[iface] = make(map[string]bool)
+	}
+	for _, member := range members {
+		b.unsupported[iface][member] = true
+	}
+}
+
+// supported check if a member has not been hidden by Unsupported
+func (b *Bluez) supported(iface, member string) bool {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return !b.unsupported[iface][member]
+}
+
+// filterUnsupported drop the hidden properties, lock must be held
+func (b *Bluez) filterUnsupported(iface string, props map[string]dbus.Variant) map[string]dbus.Variant {
+	res := make(map[string]dbus.Variant)
+	for name, val := range props {
+		if !b.unsupported[iface][name] {
+			res[name] = val
+		}
+	}
+	return res
+}
+
+// introspect describe the interfaces of an object like bluez does, listing
+// the implemented methods and the declared properties
+func (b *Bluez) introspect(path dbus.ObjectPath) (string, *dbus.Error) {
+
+	b.lock.RLock()
+	obj, ok := b.objects[path]
+	ifaces := []string{}
+	values := map[string]map[string]dbus.Variant{}
+	if ok {
+		for iface, props := range obj.ifaces {
+			ifaces = append(ifaces, iface)
+			values[iface] = b.filterUnsupported(iface, props)
+		}
+	}
+	b.lock.RUnlock()
+
+	if !ok {
+		return "", dbus.MakeFailedError(fmt.Errorf("Object %s not found", path))
+	}
+	sort.Strings(ifaces)
+
+	node := introspect.Node{Name: string(path)}
+	for _, iface := range ifaces {
+
+		i := introspect.Interface{Name: iface}
+
+		methods := []string{}
+		for name := range b.methods(path, iface) {
+			if b.supported(iface, name) {
+				methods = append(methods, name)
+			}
+		}
+		sort.Strings(methods)
+		for _, name := range methods {
+			i.Methods = append(i.Methods, introspect.Method{Name: name})
+		}
+
+		props := map[string]string{}
+		if p, ok := properties[iface]; ok {
+			t := reflect.TypeOf(p)
+			for j := 0; j < t.NumField(); j++ {
+				field := t.Field(j)
+				if field.PkgPath != "" || field.Tag.Get("dbus") == "ignore" {
+					continue
+				}
+				props[field.Name] = dbus.SignatureOfType(field.Type).String()
+			}
+		}
+		for name, val := range values[iface] {
+			props[name] = val.Signature().String()
+		}
+		names := []string{}
+		for name := range props {
+			if b.supported(iface, name) {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			i.Properties = append(i.Properties, introspect.Property{
+				Name:   name,
+				Type:   props[name],
+				Access: "readwrite",
+			})
+		}
+
+		node.Interfaces = append(node.Interfaces, i)
+	}
+
+	data, err := xml.Marshal(node)
+	if err != nil {
+		return "", dbus.MakeFailedError(err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,412 @@
+package mock
+
+import (
+	"strings"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+)
+
+// methods return the method table exported for an interface on path
+func (b *Bluez) methods(path dbus.ObjectPath, iface string) map[string]interface{} {
+	switch iface {
+	case adapter1Interface:
+		return b.adapterMethods(path)
+	case device1Interface:
+		return b.deviceMethods(path)
+	case gattCharacteristic1Interface:
+		return b.characteristicMethods(path)
+	case gattDescriptor1Interface:
+		return b.descriptorMethods(path)
+	case gattManager1Interface:
+		return b.gattManagerMethods(path)
+	case leAdvertisingManager1Interface:
+		return b.advertisingManagerMethods(path)
+	case agentManager1Interface:
+		return b.agentManagerMethods(path)
+	case profileManager1Interface:
+		return b.profileManagerMethods(path)
+	}
+	return map[string]interface{}{}
+}
+
+// GetBool return a boolean property, false if missing
+func (b *Bluez) GetBool(path dbus.ObjectPath, iface, name string) bool {
+	val, err := b.GetProperty(path, iface, name)
+	if err != nil {
+		return false
+	}
+	v, ok := val.(bool)
+	return ok && v
+}
+
+// hasFlag check if a GATT object lists one of flags
+func (b *Bluez) hasFlag(path dbus.ObjectPath, iface string, flags ...string) bool {
+	val, err := b.GetProperty(path, iface, "Flags")
+	if err != nil {
+		return false
+	}
+	list, ok := val.([]string)
+	if !ok {
+		return false
+	}
+	for _, f := range list {
+		for _, flag := range flags {
+			if f == flag {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// readValue return the Value property from the offset option
+func (b *Bluez) readValue(path dbus.ObjectPath, iface string, options map[string]dbus.Variant) ([]byte, error) {
+	val, err := b.GetProperty(path, iface, "Value")
+	if err != nil {
+		return nil, err
+	}
+	value, _ := val.([]byte)
+
+	offset := 0
+	if v, ok := options["offset"]; ok {
+		if o, ok := v.Value().(uint16); ok {
+			offset = int(o)
+		}
+	}
+	if offset > len(value) {
+		return nil, newError("InvalidOffset")
+	}
+
+	return append([]byte{}, value[offset:]...), nil
+}
+
+func (b *Bluez) adapterMethods(path dbus.ObjectPath) map[string]interface{} {
+	return map[string]interface{}{
+		"StartDiscovery": func() *dbus.Error {
+			return b.handleCall(path, adapter1Interface, "StartDiscovery", nil, func() error {
+				if !b.GetBool(path, adapter1Interface, "Powered") {
+					return newError("NotReady")
+				}
+				if b.GetBool(path, adapter1Interface, "Discovering") {
+					return newError("InProgress")
+				}
+				return b.SetProperty(path, adapter1Interface, "Discovering", true)
+			})
+		},
+		"StopDiscovery": func() *dbus.Error {
+			return b.handleCall(path, adapter1Interface, "StopDiscovery", nil, func() error {
+				if !b.GetBool(path, adapter1Interface, "Powered") {
+					return newError("NotReady")
+				}
+				if !b.GetBool(path, adapter1Interface, "Discovering") {
+					return newError("Failed")
+				}
+				return b.SetProperty(path, adapter1Interface, "Discovering", false)
+			})
+		},
+		"SetDiscoveryFilter": func(filter map[string]dbus.Variant) *dbus.Error {
+			return b.handleCall(path, adapter1Interface, "SetDiscoveryFilter", []interface{}{filter}, nil)
+		},
+		"GetDiscoveryFilters": func() ([]string, *dbus.Error) {
+			err := b.handleCall(path, adapter1Interface, "GetDiscoveryFilters", nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			return []string{"UUIDs", "RSSI", "Pathloss", "Transport", "DuplicateData"}, nil
+		},
+		"ConnectDevice": func(properties map[string]dbus.Variant) (dbus.ObjectPath, *dbus.Error) {
+			var devicePath dbus.ObjectPath
+			err := b.handleCall(path, adapter1Interface, "ConnectDevice", []interface{}{properties}, func() error {
+				address, ok := properties["Address"].Value().(string)
+				if !ok {
+					return newError("InvalidArguments")
+				}
+				props := map[string]interface{}{
+					"Connected":        true,
+					"ServicesResolved": true,
+				}
+				if addressType, ok := properties["AddressType"].Value().(string); ok {
+					props["AddressType"] = addressType
+				}
+				adapterID := strings.TrimPrefix(string(path), bluez.OrgBluezPath+"/")
+				if b.HasObject(DevicePath(adapterID, address)) {
+					return newError("AlreadyExists")
+				}
+				var err error
+				devicePath, err = b.AddDevice(adapterID, address, props)
+				return err
+			})
+			return devicePath, err
+		},
+		"RemoveDevice": func(device dbus.ObjectPath) *dbus.Error {
+			return b.handleCall(path, adapter1Interface, "RemoveDevice", []interface{}{device}, func() error {
+				if !isChild(path, device) || !b.HasObject(device) {
+					return newError("DoesNotExist")
+				}
+				return b.removeObject(device)
+			})
+		},
+	}
+}
+
+func (b *Bluez) deviceMethods(path dbus.ObjectPath) map[string]interface{} {
+	return map[string]interface{}{
+		"Connect": func() *dbus.Error {
+			return b.handleCall(path, device1Interface, "Connect", nil, func() error {
+				if b.GetBool(path, device1Interface, "Connected") {
+					return newError("AlreadyConnected")
+				}
+				return b.SetProperties(path, device1Interface, map[string]interface{}{
+					"Connected":        true,
+					"ServicesResolved": true,
+				})
+			})
+		},
+		"Disconnect": func() *dbus.Error {
+			return b.handleCall(path, device1Interface, "Disconnect", nil, func() error {
+				if !b.GetBool(path, device1Interface, "Connected") {
+					return newError("NotConnected")
+				}
+				return b.SetProperties(path, device1Interface, map[string]interface{}{
+					"Connected":        false,
+					"ServicesResolved": false,
+				})
+			})
+		},
+		"ConnectProfile": func(uuid string) *dbus.Error {
+			return b.handleCall(path, device1Interface, "ConnectProfile", []interface{}{uuid}, func() error {
+				return b.SetProperty(path, device1Interface, "Connected", true)
+			})
+		},
+		"DisconnectProfile": func(uuid string) *dbus.Error {
+			return b.handleCall(path, device1Interface, "DisconnectProfile", []interface{}{uuid}, nil)
+		},
+		"Pair": func() *dbus.Error {
+			return b.handleCall(path, device1Interface, "Pair", nil, func() error {
+				if b.GetBool(path, device1Interface, "Paired") {
+					return newError("AlreadyExists")
+				}
+				return b.SetProperty(path, device1Interface, "Paired", true)
+			})
+		},
+		"CancelPairing": func() *dbus.Error {
+			return b.handleCall(path, device1Interface, "CancelPairing", nil, func() error {
+				return newError("DoesNotExist")
+			})
+		},
+	}
+}
+
+func (b *Bluez) characteristicMethods(path dbus.ObjectPath) map[string]interface{} {
+	return map[string]interface{}{
+		"ReadValue": func(options map[string]dbus.Variant) ([]byte, *dbus.Error) {
+			var value []byte
+			err := b.handleCall(path, gattCharacteristic1Interface, "ReadValue", []interface{}{options}, func() error {
+				if !b.hasFlag(path, gattCharacteristic1Interface, "read") {
+					return newError("NotPermitted")
+				}
+				v, err := b.readValue(path, gattCharacteristic1Interface, options)
+				value = v
+				return err
+			})
+			return value, err
+		},
+		"WriteValue": func(value []byte, options map[string]dbus.Variant) *dbus.Error {
+			return b.handleCall(path, gattCharacteristic1Interface, "WriteValue", []interface{}{value, options}, func() error {
+				if !b.hasFlag(path, gattCharacteristic1Interface, "write", "write-without-response") {
+					return newError("NotPermitted")
+				}
+				return b.SetProperty(path, gattCharacteristic1Interface, "Value", value)
+			})
+		},
+		"StartNotify": func() *dbus.Error {
+			return b.handleCall(path, gattCharacteristic1Interface, "StartNotify", nil, func() error {
+				if !b.hasFlag(path, gattCharacteristic1Interface, "notify", "indicate") {
+					return newError("NotSupported")
+				}
+				if b.GetBool(path, gattCharacteristic1Interface, "Notifying") {
+					return nil
+				}
+				return b.SetProperty(path, gattCharacteristic1Interface, "Notifying", true)
+			})
+		},
+		"StopNotify": func() *dbus.Error {
+			return b.handleCall(path, gattCharacteristic1Interface, "StopNotify", nil, func() error {
+				if !b.GetBool(path, gattCharacteristic1Interface, "Notifying") {
+					return newError("Failed")
+				}
+				return b.SetProperty(path, gattCharacteristic1Interface, "Notifying", false)
+			})
+		},
+		"AcquireWrite": func(options map[string]dbus.Variant) (dbus.UnixFD, uint16, *dbus.Error) {
+			var fd dbus.UnixFD
+			var mtu uint16
+			err := b.handleCall(path, gattCharacteristic1Interface, "AcquireWrite", []interface{}{options}, func() error {
+				if !b.hasFlag(path, gattCharacteristic1Interface, "write-without-response") {
+					return newError("NotSupported")
+				}
+				var err error
+				fd, mtu, err = b.acquire(path, "WriteAcquired")
+				return err
+			})
+			return fd, mtu, err
+		},
+		"AcquireNotify": func(options map[string]dbus.Variant) (dbus.UnixFD, uint16, *dbus.Error) {
+			var fd dbus.UnixFD
+			var mtu uint16
+			err := b.handleCall(path, gattCharacteristic1Interface, "AcquireNotify", []interface{}{options}, func() error {
+				if !b.hasFlag(path, gattCharacteristic1Interface, "notify") {
+					return newError("NotSupported")
+				}
+				if b.GetBool(path, gattCharacteristic1Interface, "Notifying") {
+					return newError("NotPermitted")
+				}
+				var err error
+				fd, mtu, err = b.acquire(path, "NotifyAcquired")
+				return err
+			})
+			return fd, mtu, err
+		},
+	}
+}
+
+func (b *Bluez) descriptorMethods(path dbus.ObjectPath) map[string]interface{} {
+	return map[string]interface{}{
+		"ReadValue": func(options map[string]dbus.Variant) ([]byte, *dbus.Error) {
+			var value []byte
+			err := b.handleCall(path, gattDescriptor1Interface, "ReadValue", []interface{}{options}, func() error {
+				v, err := b.readValue(path, gattDescriptor1Interface, options)
+				value = v
+				return err
+			})
+			return value, err
+		},
+		"WriteValue": func(value []byte, options map[string]dbus.Variant) *dbus.Error {
+			return b.handleCall(path, gattDescriptor1Interface, "WriteValue", []interface{}{value, options}, func() error {
+				return b.SetProperty(path, gattDescriptor1Interface, "Value", value)
+			})
+		},
+	}
+}
+
+func (b *Bluez) gattManagerMethods(path dbus.ObjectPath) map[string]interface{} {
+	return map[string]interface{}{
+		"RegisterApplication": func(app dbus.ObjectPath, options map[string]dbus.Variant) *dbus.Error {
+			return b.handleCall(path, gattManager1Interface, "RegisterApplication", []interface{}{app, options}, func() error {
+				b.lock.Lock()
+				defer b.lock.Unlock()
+				if indexOf(b.apps[path], app) > -1 {
+					return newError("AlreadyExists")
+				}
+				b.apps[path] = append(b.apps[path], app)
+				return nil
+			})
+		},
+		"UnregisterApplication": func(app dbus.ObjectPath) *dbus.Error {
+			return b.handleCall(path, gattManager1Interface, "UnregisterApplication", []interface{}{app}, func() error {
+				b.lock.Lock()
+				defer b.lock.Unlock()
+				i := indexOf(b.apps[path], app)
+				if i == -1 {
+					return newError("DoesNotExist")
+				}
+				b.apps[path] = append(b.apps[path][:i], b.apps[path][i+1:]...)
+				return nil
+			})
+		},
+	}
+}
+
+func (b *Bluez) advertisingManagerMethods(path dbus.ObjectPath) map[string]interface{} {
+	return map[string]interface{}{
+		"RegisterAdvertisement": func(adv dbus.ObjectPath, options map[string]dbus.Variant) *dbus.Error {
+			return b.handleCall(path, leAdvertisingManager1Interface, "RegisterAdvertisement", []interface{}{adv, options}, func() error {
+				b.lock.Lock()
+				if indexOf(b.advs[path], adv) > -1 {
+					b.lock.Unlock()
+					return newError("AlreadyExists")
+				}
+				b.advs[path] = append(b.advs[path], adv)
+				active := byte(len(b.advs[path]))
+				b.lock.Unlock()
+				return b.SetProperty(path, leAdvertisingManager1Interface, "ActiveInstances", active)
+			})
+		},
+		"UnregisterAdvertisement": func(adv dbus.ObjectPath) *dbus.Error {
+			return b.handleCall(path, leAdvertisingManager1Interface, "UnregisterAdvertisement", []interface{}{adv}, func() error {
+				b.lock.Lock()
+				i := indexOf(b.advs[path], adv)
+				if i == -1 {
+					b.lock.Unlock()
+					return newError("DoesNotExist")
+				}
+				b.advs[path] = append(b.advs[path][:i], b.advs[path][i+1:]...)
+				active := byte(len(b.advs[path]))
+				b.lock.Unlock()
+				return b.SetProperty(path, leAdvertisingManager1Interface, "ActiveInstances", active)
+			})
+		},
+	}
+}
+
+func (b *Bluez) agentManagerMethods(path dbus.ObjectPath) map[string]interface{} {
+	return map[string]interface{}{
+		"RegisterAgent": func(agent dbus.ObjectPath, capability string) *dbus.Error {
+			return b.handleCall(path, agentManager1Interface, "RegisterAgent", []interface{}{agent, capability}, func() error {
+				b.lock.Lock()
+				defer b.lock.Unlock()
+				if _, ok := b.agents[agent]; ok {
+					return newError("AlreadyExists")
+				}
+				b.agents[agent] = capability
+				return nil
+			})
+		},
+		"UnregisterAgent": func(agent dbus.ObjectPath) *dbus.Error {
+			return b.handleCall(path, agentManager1Interface, "UnregisterAgent", []interface{}{agent}, func() error {
+				b.lock.Lock()
+				defer b.lock.Unlock()
+				if _, ok := b.agents[agent]; !ok {
+					return newError("DoesNotExist")
+				}
+				delete(b.agents, agent)
+				if b.defaultAgent == agent {
+					b.defaultAgent = ""
+				}
+				return nil
+			})
+		},
+		"RequestDefaultAgent": func(agent dbus.ObjectPath) *dbus.Error {
+			return b.handleCall(path, agentManager1Interface, "RequestDefaultAgent", []interface{}{agent}, func() error {
+				b.lock.Lock()
+				defer b.lock.Unlock()
+				if _, ok := b.agents[agent]; !ok {
+					return newError("DoesNotExist")
+				}
+				b.defaultAgent = agent
+				return nil
+			})
+		},
+	}
+}
+
+func (b *Bluez) profileManagerMethods(path dbus.ObjectPath) map[string]interface{} {
+	return map[string]interface{}{
+		"RegisterProfile": func(profile dbus.ObjectPath, uuid string, options map[string]dbus.Variant) *dbus.Error {
+			return b.handleCall(path, profileManager1Interface, "RegisterProfile", []interface{}{profile, uuid, options}, nil)
+		},
+		"UnregisterProfile": func(profile dbus.ObjectPath) *dbus.Error {
+			return b.handleCall(path, profileManager1Interface, "UnregisterProfile", []interface{}{profile}, nil)
+		},
+	}
+}
+
+func indexOf(list []dbus.ObjectPath, path dbus.ObjectPath) int {
+	for i, p := range list {
+		if p == path {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,446 @@
+// Package mock provides an in-process fake of the bluetoothd DBus API.
+//
+// A Bluez instance exports org.bluez objects (adapters, devices, GATT
+// services, characteristics and descriptors plus the manager interfaces) on
+// a private bus, so that the generated clients can be used without a
+// bluetooth stack. Hooks allow to inject discovered devices, notifications
+// and errors from tests.
+package mock
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+)
+
+// Start launch a private bus, export a fake bluez service on it and point the
+// default SystemBus connection to it. Call Close to restore the default.
+func Start() (*Bluez, error) {
+
+	bus, err := StartBus()
+	if err != nil {
+		return nil, err
+	}
+
+	srvConn, err := bus.Connect()
+	if err != nil {
+		bus.Close()
+		return nil, err
+	}
+
+	b, err := New(srvConn)
+	if err != nil {
+		srvConn.Close()
+		bus.Close()
+		return nil, err
+	}
+	b.bus = bus
+
+	clientConn, err := bus.Connect()
+	if err != nil {
+		b.Close()
+		return nil, err
+	}
+	b.clientConn = clientConn
+
+	err = bluez.SetConnection(bluez.SystemBus, clientConn)
+	if err != nil {
+		b.Close()
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// New export a fake bluez service on conn, acquiring the org.bluez name
+func New(conn *dbus.Conn) (*Bluez, error) {
+
+	b := &Bluez{
+		conn:        conn,
+		objects:     make(map[dbus.ObjectPath]*object),
+		errors:      make(map[string]*injectedError),
+		agents:      make(map[dbus.ObjectPath]string),
+		apps:        make(map[dbus.ObjectPath][]dbus.ObjectPath),
+		advs:        make(map[dbus.ObjectPath][]dbus.ObjectPath),
+		unsupported: make(map[string]map[string]bool),
+		sockets:     make(map[socketKey]*acquiredSocket),
+		mtu:         gatt.DefaultMTU,
+	}
+
+	reply, err := conn.RequestName(bluez.OrgBluezInterface, dbus.NameFlagDoNotQueue)
+	if err != nil {
+		return nil, err
+	}
+	if reply != dbus.RequestNameReplyPrimaryOwner {
+		return nil, fmt.Errorf("Name %s already taken", bluez.OrgBluezInterface)
+	}
+
+	err = conn.ExportMethodTable(map[string]interface{}{
+		"GetManagedObjects": b.getManagedObjects,
+	}, "/", bluez.ObjectManagerInterface)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.addObject(bluez.OrgBluezPath, map[string]map[string]interface{}{
+		agentManager1Interface:   {},
+		profileManager1Interface: {},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// Bluez is a fake bluetoothd exposed on a DBus connection
+type Bluez struct {
+	conn       *dbus.Conn
+	bus        *Bus
+	clientConn *dbus.Conn
+
+	lock    sync.RWMutex
+	objects map[dbus.ObjectPath]*object
+
+	hooksLock sync.RWMutex
+	hooks     []CallHook
+	errors    map[string]*injectedError
+	calls     []Call
+
+	agents       map[dbus.ObjectPath]string
+	defaultAgent dbus.ObjectPath
+	apps         map[dbus.ObjectPath][]dbus.ObjectPath
+	advs         map[dbus.ObjectPath][]dbus.ObjectPath
+	// members hidden by Unsupported, by interface
+	unsupported map[string]map[string]bool
+
+	socketsLock sync.Mutex
+	sockets     map[socketKey]*acquiredSocket
+	mtu         uint16
+}
+
+// object is an exported path with its interfaces and properties
+type object struct {
+	path   dbus.ObjectPath
+	ifaces map[string]map[string]dbus.Variant
+}
+
+// Conn return the connection the fake service is exported on
+func (b *Bluez) Conn() *dbus.Conn {
+	return b.conn
+}
+
+// ClientConn return the connection used by clients, available when created with Start
+func (b *Bluez) ClientConn() *dbus.Conn {
+	return b.clientConn
+}
+
+// CallApp call a method of an object exported on the client connection, as
+// bluez does calling the applications, available when created with Start
+func (b *Bluez) CallApp(path dbus.ObjectPath, method string, args ...interface{}) *dbus.Call {
+	return b.conn.Object(b.clientConn.Names()[0], path).Call(method, 0, args...)
+}
+
+// Bus return the private bus, available when created with Start
+func (b *Bluez) Bus() *Bus {
+	return b.bus
+}
+
+// Close release the exported objects and, if created with Start, stop the private bus
+func (b *Bluez) Close() error {
+
+	if b.clientConn != nil {
+		bluez.SetConnection(bluez.SystemBus, nil)
+		b.clientConn.Close()
+		b.clientConn = nil
+	}
+
+	b.closeSockets()
+
+	b.conn.ReleaseName(bluez.OrgBluezInterface)
+	err := b.conn.Close()
+
+	if b.bus != nil {
+		b.bus.Close()
+		b.bus = nil
+	}
+
+	return err
+}
+
+// Restart simulate a bluetoothd restart: the org.bluez name is released and
+// acquired again, agents, applications and advertisements are unregistered
+// and discovery is stopped. The objects are kept.
+func (b *Bluez) Restart() error {
+
+	_, err := b.conn.ReleaseName(bluez.OrgBluezInterface)
+	if err != nil {
+		return err
+	}
+
+	b.lock.Lock()
+	b.agents = make(map[dbus.ObjectPath]string)
+	b.defaultAgent = ""
+	b.apps = make(map[dbus.ObjectPath][]dbus.ObjectPath)
+	b.advs = make(map[dbus.ObjectPath][]dbus.ObjectPath)
+	adapters := []dbus.ObjectPath{}
+	for path, obj := range b.objects {
+		if _, ok := obj.ifaces[adapter1Interface]; ok {
+			adapters = append(adapters, path)
+		}
+	}
+	b.lock.Unlock()
+
+	for _, path := range adapters {
+		err = b.SetProperty(path, adapter1Interface, "Discovering", false)
+		if err != nil {
+			return err
+		}
+	}
+
+	reply, err := b.conn.RequestName(bluez.OrgBluezInterface, dbus.NameFlagDoNotQueue)
+	if err != nil {
+		return err
+	}
+	if reply != dbus.RequestNameReplyPrimaryOwner {
+		return fmt.Errorf("Name %s already taken", bluez.OrgBluezInterface)
+	}
+
+	return nil
+}
+
+func (b *Bluez) getManagedObjects() (map[dbus.ObjectPath]map[string]map[string]dbus.Variant, *dbus.Error) {
+	err := b.handleCall("/", bluez.ObjectManagerInterface, "GetManagedObjects", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	res := make(map[dbus.ObjectPath]map[string]map[string]dbus.Variant)
+	for path, obj := range b.objects {
+		res[path] = obj.copyInterfaces()
+		for iface, props := range res[path] {
+			res[path][iface] = b.filterUnsupported(iface, props)
+		}
+	}
+	return res, nil
+}
+
+func (o *object) copyInterfaces() map[string]map[string]dbus.Variant {
+	res := make(map[string]map[string]dbus.Variant)
+	for iface, props := range o.ifaces {
+		res[iface] = make(map[string]dbus.Variant)
+		for name, val := range props {
+			res[iface][name] = val
+		}
+	}
+	return res
+}
+
+// addObject export a new object and emit InterfacesAdded
+func (b *Bluez) addObject(path dbus.ObjectPath, ifaces map[string]map[string]interface{}) error {
+
+	b.lock.Lock()
+	if _, ok := b.objects[path]; ok {
+		b.lock.Unlock()
+		return fmt.Errorf("Object %s already exists", path)
+	}
+
+	obj := &object{
+		path:   path,
+		ifaces: make(map[string]map[string]dbus.Variant),
+	}
+	for iface, props := range ifaces {
+		obj.ifaces[iface] = make(map[string]dbus.Variant)
+		for name, val := range props {
+			obj.ifaces[iface][name] = dbus.MakeVariant(val)
+		}
+	}
+	b.objects[path] = obj
+	added := obj.copyInterfaces()
+	b.lock.Unlock()
+
+	err := b.conn.ExportMethodTable(b.propertiesMethods(path), path, bluez.PropertiesInterface)
+	if err != nil {
+		return err
+	}
+
+	err = b.conn.ExportMethodTable(map[string]interface{}{
+		"Introspect": func() (string, *dbus.Error) {
+			return b.introspect(path)
+		},
+	}, path, bluez.Introspectable)
+	if err != nil {
+		return err
+	}
+
+	for iface := range ifaces {
+		err := b.conn.ExportMethodTable(b.methods(path, iface), path, iface)
+		if err != nil {
+			return err
+		}
+	}
+
+	return b.conn.Emit("/", bluez.InterfacesAdded, path, added)
+}
+
+// removeObject remove an object and its children, emitting InterfacesRemoved
+func (b *Bluez) removeObject(path dbus.ObjectPath) error {
+
+	b.lock.Lock()
+	removed := map[dbus.ObjectPath][]string{}
+	for p, obj := range b.objects {
+		if p != path && !isChild(path, p) {
+			continue
+		}
+		ifaces := []string{}
+		for iface := range obj.ifaces {
+			ifaces = append(ifaces, iface)
+		}
+		removed[p] = ifaces
+		delete(b.objects, p)
+	}
+	b.lock.Unlock()
+
+	if len(removed) == 0 {
+		return fmt.Errorf("Object %s not found", path)
+	}
+
+	for p, ifaces := range removed {
+		b.ReleaseSockets(p)
+		for _, iface := range ifaces {
+			b.conn.Export(nil, p, iface)
+		}
+		b.conn.Export(nil, p, bluez.PropertiesInterface)
+		b.conn.Export(nil, p, bluez.Introspectable)
+		err := b.conn.Emit("/", bluez.InterfacesRemoved, p, ifaces)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isChild(parent, path dbus.ObjectPath) bool {
+	prefix := string(parent) + "/"
+	return len(path) > len(prefix) && string(path)[:len(prefix)] == prefix
+}
+
+// GetProperty return a property value of an object
+func (b *Bluez) GetProperty(path dbus.ObjectPath, iface, name string) (interface{}, error) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	props, err := b.getInterface(path, iface)
+	if err != nil {
+		return nil, err
+	}
+
+	val, ok := props[name]
+	if !ok {
+		return nil, fmt.Errorf("Property %s.%s not found on %s", iface, name, path)
+	}
+
+	return val.Value(), nil
+}
+
+// SetProperty update a property value of an object and emit PropertiesChanged
+func (b *Bluez) SetProperty(path dbus.ObjectPath, iface, name string, value interface{}) error {
+	return b.SetProperties(path, iface, map[string]interface{}{
+		name: value,
+	})
+}
+
+// SetProperties update some property values of an object and emit a single
+// PropertiesChanged
+func (b *Bluez) SetProperties(path dbus.ObjectPath, iface string, values map[string]interface{}) error {
+
+	b.lock.Lock()
+	props, err := b.getInterface(path, iface)
+	if err != nil {
+		b.lock.Unlock()
+		return err
+	}
+
+	changed := map[string]dbus.Variant{}
+	for name, value := range values {
+		variant := dbus.MakeVariant(value)
+		props[name] = variant
+		changed[name] = variant
+	}
+	b.lock.Unlock()
+
+	return b.conn.Emit(path, bluez.PropertiesChanged, iface, changed, []string{})
+}
+
+// getInterface return the properties of an interface, lock must be held
+func (b *Bluez) getInterface(path dbus.ObjectPath, iface string) (map[string]dbus.Variant, error) {
+	obj, ok := b.objects[path]
+	if !ok {
+		return nil, fmt.Errorf("Object %s not found", path)
+	}
+	props, ok := obj.ifaces[iface]
+	if !ok {
+		return nil, fmt.Errorf("Interface %s not found on %s", iface, path)
+	}
+	return props, nil
+}
+
+// HasObject check if an object path is exported
+func (b *Bluez) HasObject(path dbus.ObjectPath) bool {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	_, ok := b.objects[path]
+	return ok
+}
+
+func (b *Bluez) propertiesMethods(path dbus.ObjectPath) map[string]interface{} {
+	return map[string]interface{}{
+		"Get": func(iface, name string) (dbus.Variant, *dbus.Error) {
+			b.lock.RLock()
+			defer b.lock.RUnlock()
+			props, err := b.getInterface(path, iface)
+			if err != nil {
+				return dbus.Variant{}, dbus.MakeFailedError(err)
+			}
+			val, ok := props[name]
+			if !ok || b.unsupported[iface][name] {
+				return dbus.Variant{}, &dbus.ErrMsgInvalidArg
+			}
+			return val, nil
+		},
+		"GetAll": func(iface string) (map[string]dbus.Variant, *dbus.Error) {
+			b.lock.RLock()
+			defer b.lock.RUnlock()
+			props, err := b.getInterface(path, iface)
+			if err != nil {
+				return nil, dbus.MakeFailedError(err)
+			}
+			return b.filterUnsupported(iface, props), nil
+		},
+		"Set": func(iface, name string, value dbus.Variant) *dbus.Error {
+			err := b.handleCall(path, bluez.PropertiesInterface, "Set", []interface{}{iface, name, value.Value()}, func() error {
+				b.lock.RLock()
+				props, err := b.getInterface(path, iface)
+				if err == nil {
+					if _, ok := props[name]; !ok {
+						err = errors.New("No such property " + name)
+					}
+				}
+				b.lock.RUnlock()
+				if err != nil {
+					return &dbus.ErrMsgInvalidArg
+				}
+				return b.SetProperty(path, iface, name, value.Value())
+			})
+			return err
+		},
+	}
+}
@@ -0,0 +1,329 @@
+package mock_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/mock"
+	"github.com/muka/go-bluetooth/bluez/profile/adapter"
+	"github.com/muka/go-bluetooth/bluez/profile/agent"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdapterDiscovery(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	a, err := adapter.NewAdapter1FromAdapterID(mock.TestAdapterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, mock.TestAdapterID, a.Properties.Name)
+
+	discovery, cancel, err := a.OnDeviceDiscovered()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+
+	err = a.StartDiscovery()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	discovering, err := a.GetDiscovering()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, discovering)
+
+	devPath, _ := mock.AddTestDevice(t, b)
+
+	select {
+	case ev := <-discovery:
+		assert.Equal(t, devPath, ev.Path)
+		assert.Equal(t, adapter.DeviceAdded, ev.Type)
+	case <-time.After(time.Second * 5):
+		t.Fatal("Timeout waiting for discovered device")
+	}
+
+	dev, err := a.GetDeviceByAddress(mock.TestAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotNil(t, dev)
+	assert.Equal(t, "mock", dev.Properties.Name)
+
+	err = a.RemoveDevice(devPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, b.HasObject(devPath))
+
+	select {
+	case ev := <-discovery:
+		assert.Equal(t, devPath, ev.Path)
+		assert.Equal(t, adapter.DeviceRemoved, ev.Type)
+	case <-time.After(time.Second * 5):
+		t.Fatal("Timeout waiting for removed device")
+	}
+
+	err = a.StopDiscovery()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAdapterNotReady(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	a, err := adapter.NewAdapter1FromAdapterID(mock.TestAdapterID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetPowered(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.StartDiscovery()
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, bluez.ErrNotReady))
+}
+
+func TestDeviceGatt(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	devPath, charPath := mock.AddTestDevice(t, b)
+
+	dev, err := device.NewDevice1(devPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = dev.Connect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	connected, err := dev.GetConnected()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, connected)
+
+	char, err := dev.GetCharByUUID(mock.TestCharUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, charPath, char.Path())
+
+	value, err := char.ReadValue(map[string]interface{}{
+		"offset": uint16(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []byte{2, 3}, value)
+
+	err = char.WriteValue([]byte{9}, map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "WriteValue", b.Calls()[len(b.Calls())-1].Method)
+
+	// notifications are rejected until the client subscribes
+	assert.Error(t, b.Notify(charPath, []byte{42}))
+
+	props, err := char.WatchProperties()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = char.StartNotify()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = b.Notify(charPath, []byte{42})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeout := time.After(time.Second * 5)
+	for {
+		select {
+		case change := <-props:
+			if change.Name != "Value" {
+				continue
+			}
+			assert.Equal(t, []byte{42}, change.Value)
+			// drain pending changes while unwatching
+			go func() {
+				for range props {
+				}
+			}()
+			err = char.UnwatchProperties(props)
+			assert.NoError(t, err)
+			return
+		case <-timeout:
+			t.Fatal("Timeout waiting for notification")
+		}
+	}
+}
+
+func TestInjectError(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	devPath, _ := mock.AddTestDevice(t, b)
+
+	dev, err := device.NewDevice1(devPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b.InjectError("", device.Device1Interface, "Connect", mock.Error("InProgress"), 1)
+
+	err = dev.Connect()
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, bluez.ErrInProgress))
+
+	bluezErr, ok := err.(*bluez.Error)
+	if !ok {
+		t.Fatalf("Expected *bluez.Error, got %T", err)
+	}
+	assert.Equal(t, devPath, bluezErr.Path)
+	assert.Equal(t, "org.bluez.Device1.Connect", bluezErr.Method)
+	assert.Equal(t, "InProgress", bluezErr.Message)
+
+	// injected error is consumed
+	err = dev.Connect()
+	assert.NoError(t, err)
+
+	hookCalled := make(chan mock.Call, 1)
+	b.OnCall(func(call mock.Call) error {
+		if call.Method == "Disconnect" {
+			hookCalled <- call
+		}
+		return nil
+	})
+	err = dev.Disconnect()
+	assert.NoError(t, err)
+
+	select {
+	case call := <-hookCalled:
+		assert.Equal(t, devPath, call.Path)
+	default:
+		t.Fatal("Hook not called")
+	}
+}
+
+func TestObjectManager(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	devPath, charPath := mock.AddTestDevice(t, b)
+
+	om, err := bluez.GetObjectManager()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	objects, err := om.GetManagedObjects()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, objects, devPath)
+	assert.Contains(t, objects, charPath)
+	assert.Contains(t, objects[mock.AdapterPath(mock.TestAdapterID)], gatt.GattManager1Interface)
+
+	err = b.RemoveObject(devPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	objects, err = om.GetManagedObjects()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(t, objects, devPath)
+	assert.NotContains(t, objects, charPath)
+}
+
+func TestAgentManager(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	am, err := agent.NewAgentManager1()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	agentPath := dbus.ObjectPath("/test/agent")
+	err = am.RegisterAgent(agentPath, agent.CapNoInputNoOutput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = am.RequestDefaultAgent(agentPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, agent.CapNoInputNoOutput, b.Agents()[agentPath])
+	assert.Equal(t, agentPath, b.DefaultAgent())
+}
+
+func TestCallContext(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	_, charPath := mock.AddTestDevice(t, b)
+
+	char, err := gatt.NewGattCharacteristic1(charPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	release := make(chan bool)
+	defer close(release)
+	b.OnCall(func(call mock.Call) error {
+		if call.Method == "ReadValue" {
+			<-release
+		}
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+
+	_, err = char.ReadValueContext(ctx, map[string]interface{}{})
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	ctxErr, ok := err.(*bluez.ContextError)
+	if !ok {
+		t.Fatalf("Expected *bluez.ContextError, got %T", err)
+	}
+	assert.True(t, ctxErr.Timeout())
+
+	// canceled context fails before calling bluez
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = char.GetUUIDContext(ctx)
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	uuid, err := char.GetUUIDContext(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, mock.TestCharUUID, uuid)
+}
@@ -0,0 +1,213 @@
+package mock
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+)
+
+const (
+	adapter1Interface              = "org.bluez.Adapter1"
+	device1Interface               = "org.bluez.Device1"
+	gattService1Interface          = "org.bluez.GattService1"
+	gattCharacteristic1Interface   = "org.bluez.GattCharacteristic1"
+	gattDescriptor1Interface       = "org.bluez.GattDescriptor1"
+	gattManager1Interface          = "org.bluez.GattManager1"
+	leAdvertisingManager1Interface = "org.bluez.LEAdvertisingManager1"
+	agentManager1Interface         = "org.bluez.AgentManager1"
+	profileManager1Interface       = "org.bluez.ProfileManager1"
+)
+
+// AdapterPath return the object path of an adapter, eg. /org/bluez/hci0
+func AdapterPath(adapterID string) dbus.ObjectPath {
+	return dbus.ObjectPath(fmt.Sprintf("%s/%s", bluez.OrgBluezPath, adapterID))
+}
+
+// DevicePath return the object path of a device, eg. /org/bluez/hci0/dev_00_11_22_33_44_55
+func DevicePath(adapterID, address string) dbus.ObjectPath {
+	return dbus.ObjectPath(fmt.Sprintf("%s/dev_%s", AdapterPath(adapterID), strings.Replace(address, ":", "_", -1)))
+}
+
+// merge copy props over defaults
+func merge(defaults map[string]interface{}, props map[string]interface{}) map[string]interface{} {
+	for k, v := range props {
+		defaults[k] = v
+	}
+	return defaults
+}
+
+// AddAdapter export an adapter with Adapter1, GattManager1 and
+// LEAdvertisingManager1 interfaces. props override the default properties.
+func (b *Bluez) AddAdapter(adapterID string, props map[string]interface{}) (dbus.ObjectPath, error) {
+
+	path := AdapterPath(adapterID)
+
+	adapterProps := merge(map[string]interface{}{
+		"Address":             "00:00:00:00:00:00",
+		"AddressType":         "public",
+		"Name":                adapterID,
+		"Alias":               adapterID,
+		"Class":               uint32(0),
+		"Powered":             true,
+		"Discoverable":        false,
+		"DiscoverableTimeout": uint32(180),
+		"Pairable":            true,
+		"PairableTimeout":     uint32(0),
+		"Discovering":         false,
+		"UUIDs":               []string{},
+		"Modalias":            "usb:v1D6Bp0246d0535",
+	}, props)
+
+	err := b.addObject(path, map[string]map[string]interface{}{
+		adapter1Interface:     adapterProps,
+		gattManager1Interface: {},
+		leAdvertisingManager1Interface: {
+			"ActiveInstances":    byte(0),
+			"SupportedInstances": byte(5),
+			"SupportedIncludes":  []string{"tx-power", "appearance", "local-name"},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// AddDevice export a device on an adapter, as it would appear on discovery.
+// props override the default properties.
+func (b *Bluez) AddDevice(adapterID, address string, props map[string]interface{}) (dbus.ObjectPath, error) {
+
+	adapterPath := AdapterPath(adapterID)
+	if !b.HasObject(adapterPath) {
+		return "", fmt.Errorf("Adapter %s not found", adapterID)
+	}
+
+	path := DevicePath(adapterID, address)
+
+	deviceProps := merge(map[string]interface{}{
+		"Address":          address,
+		"AddressType":      "public",
+		"Alias":            strings.Replace(address, ":", "-", -1),
+		"Paired":           false,
+		"Trusted":          false,
+		"Blocked":          false,
+		"LegacyPairing":    false,
+		"Connected":        false,
+		"UUIDs":            []string{},
+		"Adapter":          adapterPath,
+		"ServicesResolved": false,
+	}, props)
+
+	err := b.addObject(path, map[string]map[string]interface{}{
+		device1Interface: deviceProps,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// AddService export a GATT service on a device
+func (b *Bluez) AddService(devicePath dbus.ObjectPath, handle uint16, uuid string, primary bool) (dbus.ObjectPath, error) {
+
+	if !b.HasObject(devicePath) {
+		return "", fmt.Errorf("Device %s not found", devicePath)
+	}
+
+	path := dbus.ObjectPath(fmt.Sprintf("%s/service%04x", devicePath, handle))
+
+	err := b.addObject(path, map[string]map[string]interface{}{
+		gattService1Interface: {
+			"UUID":     uuid,
+			"Device":   devicePath,
+			"Primary":  primary,
+			"Includes": []dbus.ObjectPath{},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// AddCharacteristic export a GATT characteristic on a service
+func (b *Bluez) AddCharacteristic(servicePath dbus.ObjectPath, handle uint16, uuid string, flags []string, value []byte) (dbus.ObjectPath, error) {
+
+	if !b.HasObject(servicePath) {
+		return "", fmt.Errorf("Service %s not found", servicePath)
+	}
+
+	path := dbus.ObjectPath(fmt.Sprintf("%s/char%04x", servicePath, handle))
+
+	if value == nil {
+		value = []byte{}
+	}
+
+	props := map[string]interface{}{
+		"UUID":      uuid,
+		"Service":   servicePath,
+		"Value":     value,
+		"Notifying": false,
+		"Flags":     flags,
+	}
+
+	// like bluez, expose the acquired state only if the flags allow it
+	for _, flag := range flags {
+		switch flag {
+		case "write-without-response":
+			props["WriteAcquired"] = false
+		case "notify":
+			props["NotifyAcquired"] = false
+		}
+	}
+
+	err := b.addObject(path, map[string]map[string]interface{}{
+		gattCharacteristic1Interface: props,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// AddDescriptor export a GATT descriptor on a characteristic
+func (b *Bluez) AddDescriptor(charPath dbus.ObjectPath, handle uint16, uuid string, flags []string, value []byte) (dbus.ObjectPath, error) {
+
+	if !b.HasObject(charPath) {
+		return "", fmt.Errorf("Characteristic %s not found", charPath)
+	}
+
+	path := dbus.ObjectPath(fmt.Sprintf("%s/desc%04x", charPath, handle))
+
+	if value == nil {
+		value = []byte{}
+	}
+
+	err := b.addObject(path, map[string]map[string]interface{}{
+		gattDescriptor1Interface: {
+			"UUID":           uuid,
+			"Characteristic": charPath,
+			"Value":          value,
+			"Flags":          flags,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// RemoveObject remove an object and its children, emitting InterfacesRemoved
+func (b *Bluez) RemoveObject(path dbus.ObjectPath) error {
+	if path == bluez.OrgBluezPath {
+		return fmt.Errorf("Cannot remove %s", path)
+	}
+	return b.removeObject(path)
+}
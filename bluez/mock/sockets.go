@@ -0,0 +1,123 @@
+package mock
+
+import (
+	"os"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+)
+
+// socketKey identify a socket acquired on a characteristic, property is
+// WriteAcquired or NotifyAcquired
+type socketKey struct {
+	path     dbus.ObjectPath
+	property string
+}
+
+// SetMTU set the MTU returned by AcquireWrite and AcquireNotify
+func (b *Bluez) SetMTU(mtu uint16) {
+	b.socketsLock.Lock()
+	defer b.socketsLock.Unlock()
+	b.mtu = mtu
+}
+
+// acquiredSocket is the local end of a socket pair acquired on a
+// characteristic, the other end is passed to the client
+type acquiredSocket struct {
+	local *os.File
+}
+
+func (s *acquiredSocket) close() {
+	s.local.Close()
+}
+
+// acquire create a socket pair for AcquireWrite or AcquireNotify, property
+// is set to true until the socket is released. Acquiring again replaces the
+// previous socket, as bluez does.
+func (b *Bluez) acquire(path dbus.ObjectPath, property string) (dbus.UnixFD, uint16, error) {
+
+	key := socketKey{path, property}
+
+	local, remote, err := gatt.NewSocketPair()
+	if err != nil {
+		return 0, 0, newError("Failed")
+	}
+	sock := &acquiredSocket{local}
+
+	b.socketsLock.Lock()
+	defer b.socketsLock.Unlock()
+
+	err = b.SetProperty(path, gattCharacteristic1Interface, property, true)
+	if err != nil {
+		sock.close()
+		remote.Close()
+		return 0, 0, err
+	}
+
+	if prev, ok := b.sockets[key]; ok {
+		prev.close()
+	}
+	b.sockets[key] = sock
+	go b.serveSocket(key, sock)
+
+	return gatt.PassFD(remote), b.mtu, nil
+}
+
+// serveSocket apply the values written on an AcquireWrite socket until the
+// socket is released
+func (b *Bluez) serveSocket(key socketKey, sock *acquiredSocket) {
+
+	buf := make([]byte, gatt.MaxValueLength)
+	for {
+		n, err := sock.local.Read(buf)
+		if err != nil {
+			break
+		}
+		if key.property == "WriteAcquired" {
+			b.SetProperty(key.path, gattCharacteristic1Interface, "Value", append([]byte{}, buf[:n]...))
+		}
+	}
+
+	b.socketsLock.Lock()
+	released := b.sockets[key] == sock
+	if released {
+		delete(b.sockets, key)
+	}
+	b.socketsLock.Unlock()
+
+	if released && b.HasObject(key.path) {
+		b.SetProperty(key.path, gattCharacteristic1Interface, key.property, false)
+	}
+}
+
+// notifySocket return the AcquireNotify socket of a characteristic, if any
+func (b *Bluez) notifySocket(path dbus.ObjectPath) *os.File {
+	b.socketsLock.Lock()
+	defer b.socketsLock.Unlock()
+	sock, ok := b.sockets[socketKey{path, "NotifyAcquired"}]
+	if !ok {
+		return nil
+	}
+	return sock.local
+}
+
+// ReleaseSockets release the sockets acquired on a characteristic, as bluez
+// does when the client closes them
+func (b *Bluez) ReleaseSockets(charPath dbus.ObjectPath) {
+	b.socketsLock.Lock()
+	defer b.socketsLock.Unlock()
+	for key, sock := range b.sockets {
+		if key.path == charPath {
+			sock.close()
+		}
+	}
+}
+
+func (b *Bluez) closeSockets() {
+	b.socketsLock.Lock()
+	defer b.socketsLock.Unlock()
+	for key, sock := range b.sockets {
+		sock.close()
+		delete(b.sockets, key)
+	}
+}
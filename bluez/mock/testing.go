@@ -0,0 +1,63 @@
+package mock
+
+import (
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// Values of the fixture created by StartTest and AddTestDevice
+const (
+	TestAdapterID   = "hci0"
+	TestAddress     = "AA:BB:CC:DD:EE:FF"
+	TestServiceUUID = "0000180f-0000-1000-8000-00805f9b34fb"
+	TestCharUUID    = "00002a19-0000-1000-8000-00805f9b34fb"
+)
+
+// Timings of the assertions waiting for the mock, eg. with assert.Eventually
+const (
+	WaitFor = time.Second * 5
+	Tick    = time.Millisecond * 10
+)
+
+// StartTest start a mock on a private bus with the TestAdapterID adapter,
+// skipping the test if dbus-daemon is not available
+func StartTest(t *testing.T) *Bluez {
+	if _, err := exec.LookPath(DBusDaemonBin); err != nil {
+		t.Skip("dbus-daemon not available")
+	}
+	b, err := Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = b.AddAdapter(TestAdapterID, nil)
+	if err != nil {
+		b.Close()
+		t.Fatal(err)
+	}
+	return b
+}
+
+// AddTestDevice add the TestAddress device to the TestAdapterID adapter, with
+// a readable, writable and notifying TestCharUUID characteristic. It return
+// the paths of the device and of the characteristic.
+func AddTestDevice(t *testing.T, b *Bluez) (dbus.ObjectPath, dbus.ObjectPath) {
+	devPath, err := b.AddDevice(TestAdapterID, TestAddress, map[string]interface{}{
+		"Name": "mock",
+		"RSSI": int16(-50),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srvPath, err := b.AddService(devPath, 0x10, TestServiceUUID, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	charPath, err := b.AddCharacteristic(srvPath, 0x11, TestCharUUID, []string{"read", "write", "notify"}, []byte{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return devPath, charPath
+}
@@ -0,0 +1,533 @@
+package bluez
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/logger"
+)
+
+// interfaces indexed by the cache, the profile packages cannot be imported here
+const (
+	cacheDevice1Interface             = "org.bluez.Device1"
+	cacheGattService1Interface        = "org.bluez.GattService1"
+	cacheGattCharacteristic1Interface = "org.bluez.GattCharacteristic1"
+)
+
+var (
+	objectCachesLock sync.Mutex
+	// caches by connection, the nil key is the shared instance of GetObjectCache
+	objectCaches = make(map[*Conn]*ObjectCache)
+)
+
+// GetObjectCacheWithConn return the ObjectCache of a connection, loading it on
+// first use. A nil conn return the shared instance of GetObjectCache
+func GetObjectCacheWithConn(conn *Conn) (*ObjectCache, error) {
+
+	objectCachesLock.Lock()
+	defer objectCachesLock.Unlock()
+
+	if cache, ok := objectCaches[conn]; ok {
+		return cache, nil
+	}
+
+	om, err := GetObjectManagerWithConn(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	cache, err := NewObjectCache(om)
+	if err != nil {
+		return nil, err
+	}
+	cache.conn = conn
+
+	objectCaches[conn] = cache
+	return cache, nil
+}
+
+// GetObjectCache return the shared ObjectCache for the bluez object tree,
+// loading it on first use
+func GetObjectCache() (*ObjectCache, error) {
+	return GetObjectCacheWithConn(nil)
+}
+
+// closeObjectCache close the shared ObjectCache, if loaded
+func closeObjectCache() {
+	objectCachesLock.Lock()
+	cache := objectCaches[nil]
+	objectCachesLock.Unlock()
+	if cache != nil {
+		cache.Close()
+	}
+}
+
+// NewObjectCache load the object tree exposed by an ObjectManager and keep it
+// up to date from InterfacesAdded, InterfacesRemoved and PropertiesChanged.
+// The objects are reloaded when bluez restarts.
+func NewObjectCache(om *ObjectManager) (*ObjectCache, error) {
+
+	if !om.client.isConnected() {
+		err := om.client.Connect()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c := &ObjectCache{
+		om:      om,
+		root:    om.client.Config.Path,
+		objects: make(map[dbus.ObjectPath]map[string]map[string]dbus.Variant),
+		done:    make(chan bool),
+	}
+	c.resetIndexes()
+
+	// subscribe before loading, changes received meanwhile are applied later.
+	// A single subscription keeps the order of added objects and changes
+	sub, err := GetDispatcher(om.client.conn).Subscribe(
+		SignalMatch{
+			Path:      c.root,
+			Interface: ObjectManagerInterface,
+		},
+		SignalMatch{
+			PathNamespace: OrgBluezPath,
+			Interface:     PropertiesInterface,
+			Member:        "PropertiesChanged",
+		},
+		serviceMatch,
+	)
+	if err != nil {
+		return nil, err
+	}
+	c.subscription = sub
+
+	err = c.load()
+	if err != nil {
+		c.close()
+		return nil, err
+	}
+
+	go c.watch()
+
+	return c, nil
+}
+
+// ObjectCache is a local copy of the objects exposed by bluez
+type ObjectCache struct {
+	om           *ObjectManager
+	conn         *Conn
+	root         dbus.ObjectPath
+	subscription *Subscription
+	done         chan bool
+	closed       bool
+
+	lock    sync.RWMutex
+	objects map[dbus.ObjectPath]map[string]map[string]dbus.Variant
+
+	// indexes
+	interfaces     map[string]map[dbus.ObjectPath]bool
+	adapterDevices map[dbus.ObjectPath]map[dbus.ObjectPath]bool
+	addresses      map[string]dbus.ObjectPath
+	serviceUUIDs   map[string]map[dbus.ObjectPath]bool
+	charUUIDs      map[string]map[dbus.ObjectPath]bool
+}
+
+// Close stop receiving updates
+func (c *ObjectCache) Close() {
+	if !c.close() {
+		return
+	}
+
+	objectCachesLock.Lock()
+	if objectCaches[c.conn] == c {
+		delete(objectCaches, c.conn)
+	}
+	objectCachesLock.Unlock()
+}
+
+// close stop receiving updates, it return false if already closed
+func (c *ObjectCache) close() bool {
+	c.lock.Lock()
+	if c.closed {
+		c.lock.Unlock()
+		return false
+	}
+	c.closed = true
+	c.lock.Unlock()
+
+	close(c.done)
+	c.subscription.Unsubscribe()
+	return true
+}
+
+func (c *ObjectCache) watch() {
+	signals := c.subscription.Signals()
+	for {
+		select {
+		case <-c.done:
+			return
+		case sig, ok := <-signals:
+			if !ok {
+				return
+			}
+			c.handleSignal(sig)
+		}
+	}
+}
+
+// load replace the cached objects with the ones exposed by the ObjectManager
+func (c *ObjectCache) load() error {
+
+	objects, err := c.om.GetManagedObjects()
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.objects = make(map[dbus.ObjectPath]map[string]map[string]dbus.Variant)
+	c.resetIndexes()
+	for path, ifaces := range objects {
+		c.objects[path] = ifaces
+		c.index(path)
+	}
+
+	return nil
+}
+
+// clear remove all the cached objects
+func (c *ObjectCache) clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.objects = make(map[dbus.ObjectPath]map[string]map[string]dbus.Variant)
+	c.resetIndexes()
+}
+
+func (c *ObjectCache) handleSignal(sig *dbus.Signal) {
+	switch sig.Name {
+	case NameOwnerChanged:
+		for _, ev := range parseServiceEvents(sig) {
+			if ev.Type == ServiceStopped {
+				c.clear()
+				continue
+			}
+			err := c.load()
+			if err != nil {
+				logger.New(c.conn.Logger()).Warnf("ObjectCache: reload after %s restart: %s", OrgBluezInterface, err)
+			}
+		}
+	case InterfacesAdded:
+		if sig.Path != c.root || len(sig.Body) < 2 {
+			return
+		}
+		path, ok1 := sig.Body[0].(dbus.ObjectPath)
+		ifaces, ok2 := sig.Body[1].(map[string]map[string]dbus.Variant)
+		if !ok1 || !ok2 {
+			return
+		}
+		c.addInterfaces(path, ifaces)
+	case InterfacesRemoved:
+		if sig.Path != c.root || len(sig.Body) < 2 {
+			return
+		}
+		path, ok1 := sig.Body[0].(dbus.ObjectPath)
+		ifaces, ok2 := sig.Body[1].([]string)
+		if !ok1 || !ok2 {
+			return
+		}
+		c.removeInterfaces(path, ifaces)
+	case PropertiesChanged:
+		if len(sig.Body) < 2 {
+			return
+		}
+		iface, ok1 := sig.Body[0].(string)
+		changed, ok2 := sig.Body[1].(map[string]dbus.Variant)
+		if !ok1 || !ok2 {
+			return
+		}
+		invalidated := []string{}
+		if len(sig.Body) > 2 {
+			if list, ok := sig.Body[2].([]string); ok {
+				invalidated = list
+			}
+		}
+		c.updateProperties(sig.Path, iface, changed, invalidated)
+	}
+}
+
+func (c *ObjectCache) addInterfaces(path dbus.ObjectPath, ifaces map[string]map[string]dbus.Variant) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.unindex(path)
+	if _, ok := c.objects[path]; !ok {
+		c.objects[path] = make(map[string]map[string]dbus.Variant)
+	}
+	for iface, props := range ifaces {
+		c.objects[path][iface] = props
+	}
+	c.index(path)
+}
+
+func (c *ObjectCache) removeInterfaces(path dbus.ObjectPath, ifaces []string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	object, ok := c.objects[path]
+	if !ok {
+		return
+	}
+
+	c.unindex(path)
+	for _, iface := range ifaces {
+		delete(object, iface)
+	}
+	if len(object) == 0 {
+		delete(c.objects, path)
+		return
+	}
+	c.index(path)
+}
+
+func (c *ObjectCache) updateProperties(path dbus.ObjectPath, iface string, changed map[string]dbus.Variant, invalidated []string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	object, ok := c.objects[path]
+	if !ok {
+		return
+	}
+	props, ok := object[iface]
+	if !ok {
+		return
+	}
+
+	c.unindex(path)
+	// copy on write, maps returned to callers are not modified
+	updated := make(map[string]dbus.Variant)
+	for name, value := range props {
+		updated[name] = value
+	}
+	for name, value := range changed {
+		updated[name] = value
+	}
+	for _, name := range invalidated {
+		delete(updated, name)
+	}
+	object[iface] = updated
+	c.index(path)
+}
+
+func (c *ObjectCache) resetIndexes() {
+	c.interfaces = make(map[string]map[dbus.ObjectPath]bool)
+	c.adapterDevices = make(map[dbus.ObjectPath]map[dbus.ObjectPath]bool)
+	c.addresses = make(map[string]dbus.ObjectPath)
+	c.serviceUUIDs = make(map[string]map[dbus.ObjectPath]bool)
+	c.charUUIDs = make(map[string]map[dbus.ObjectPath]bool)
+}
+
+func addressKey(adapterPath dbus.ObjectPath, address string) string {
+	return string(adapterPath) + "|" + strings.ToUpper(address)
+}
+
+func addToIndex(index map[string]map[dbus.ObjectPath]bool, key string, path dbus.ObjectPath) {
+	if _, ok := index[key]; !ok {
+		index[key] = make(map[dbus.ObjectPath]bool)
+	}
+	index[key][path] = true
+}
+
+func removeFromIndex(index map[string]map[dbus.ObjectPath]bool, key string, path dbus.ObjectPath) {
+	if _, ok := index[key]; !ok {
+		return
+	}
+	delete(index[key], path)
+	if len(index[key]) == 0 {
+		delete(index, key)
+	}
+}
+
+func variantString(props map[string]dbus.Variant, name string) string {
+	v, ok := props[name]
+	if !ok {
+		return ""
+	}
+	s, _ := v.Value().(string)
+	return s
+}
+
+func variantPath(props map[string]dbus.Variant, name string) dbus.ObjectPath {
+	v, ok := props[name]
+	if !ok {
+		return ""
+	}
+	p, _ := v.Value().(dbus.ObjectPath)
+	return p
+}
+
+// index add an object to the indexes, lock must be held
+func (c *ObjectCache) index(path dbus.ObjectPath) {
+	object, ok := c.objects[path]
+	if !ok {
+		return
+	}
+	for iface, props := range object {
+		addToIndex(c.interfaces, iface, path)
+		switch iface {
+		case cacheDevice1Interface:
+			adapterPath := variantPath(props, "Adapter")
+			if _, ok := c.adapterDevices[adapterPath]; !ok {
+				c.adapterDevices[adapterPath] = make(map[dbus.ObjectPath]bool)
+			}
+			c.adapterDevices[adapterPath][path] = true
+			if address := variantString(props, "Address"); address != "" {
+				c.addresses[addressKey(adapterPath, address)] = path
+			}
+		case cacheGattService1Interface:
+			addToIndex(c.serviceUUIDs, strings.ToLower(variantString(props, "UUID")), path)
+		case cacheGattCharacteristic1Interface:
+			addToIndex(c.charUUIDs, strings.ToLower(variantString(props, "UUID")), path)
+		}
+	}
+}
+
+// unindex remove an object from the indexes, lock must be held
+func (c *ObjectCache) unindex(path dbus.ObjectPath) {
+	object, ok := c.objects[path]
+	if !ok {
+		return
+	}
+	for iface, props := range object {
+		removeFromIndex(c.interfaces, iface, path)
+		switch iface {
+		case cacheDevice1Interface:
+			adapterPath := variantPath(props, "Adapter")
+			if devices, ok := c.adapterDevices[adapterPath]; ok {
+				delete(devices, path)
+				if len(devices) == 0 {
+					delete(c.adapterDevices, adapterPath)
+				}
+			}
+			key := addressKey(adapterPath, variantString(props, "Address"))
+			if c.addresses[key] == path {
+				delete(c.addresses, key)
+			}
+		case cacheGattService1Interface:
+			removeFromIndex(c.serviceUUIDs, strings.ToLower(variantString(props, "UUID")), path)
+		case cacheGattCharacteristic1Interface:
+			removeFromIndex(c.charUUIDs, strings.ToLower(variantString(props, "UUID")), path)
+		}
+	}
+}
+
+// sortedPaths return the paths of a set, sorted
+func sortedPaths(set map[dbus.ObjectPath]bool, filter func(dbus.ObjectPath) bool) []dbus.ObjectPath {
+	list := []dbus.ObjectPath{}
+	for path := range set {
+		if filter != nil && !filter(path) {
+			continue
+		}
+		list = append(list, path)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i] < list[j]
+	})
+	return list
+}
+
+// childOf return a filter matching the paths below parent, any path if parent is empty
+func childOf(parent dbus.ObjectPath) func(dbus.ObjectPath) bool {
+	if parent == "" {
+		return nil
+	}
+	prefix := string(parent) + "/"
+	return func(path dbus.ObjectPath) bool {
+		return strings.HasPrefix(string(path), prefix)
+	}
+}
+
+// GetManagedObjects return a copy of the cached objects
+func (c *ObjectCache) GetManagedObjects() map[dbus.ObjectPath]map[string]map[string]dbus.Variant {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	objects := make(map[dbus.ObjectPath]map[string]map[string]dbus.Variant)
+	for path, object := range c.objects {
+		objects[path] = copyObject(object)
+	}
+	return objects
+}
+
+// GetObject return an object interfaces and properties, nil if not found
+func (c *ObjectCache) GetObject(path dbus.ObjectPath) map[string]map[string]dbus.Variant {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	object, ok := c.objects[path]
+	if !ok {
+		return nil
+	}
+	return copyObject(object)
+}
+
+// GetProperties return the properties of an object interface, nil if not found
+func (c *ObjectCache) GetProperties(path dbus.ObjectPath, iface string) map[string]dbus.Variant {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	object, ok := c.objects[path]
+	if !ok {
+		return nil
+	}
+	props, ok := object[iface]
+	if !ok {
+		return nil
+	}
+	return props
+}
+
+func copyObject(object map[string]map[string]dbus.Variant) map[string]map[string]dbus.Variant {
+	res := make(map[string]map[string]dbus.Variant)
+	for iface, props := range object {
+		res[iface] = props
+	}
+	return res
+}
+
+// GetByInterface return the objects implementing an interface. If parent is
+// not empty only the objects below it are returned
+func (c *ObjectCache) GetByInterface(iface string, parent dbus.ObjectPath) []dbus.ObjectPath {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return sortedPaths(c.interfaces[iface], childOf(parent))
+}
+
+// GetDevices return the devices of an adapter
+func (c *ObjectCache) GetDevices(adapterPath dbus.ObjectPath) []dbus.ObjectPath {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return sortedPaths(c.adapterDevices[adapterPath], nil)
+}
+
+// GetDeviceByAddress return the path of a device by its address
+func (c *ObjectCache) GetDeviceByAddress(adapterPath dbus.ObjectPath, address string) (dbus.ObjectPath, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	path, ok := c.addresses[addressKey(adapterPath, address)]
+	return path, ok
+}
+
+// GetServicesByUUID return the GATT services with an UUID. If parent is not
+// empty (eg. a device path) only the services below it are returned
+func (c *ObjectCache) GetServicesByUUID(uuid string, parent dbus.ObjectPath) []dbus.ObjectPath {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return sortedPaths(c.serviceUUIDs[strings.ToLower(uuid)], childOf(parent))
+}
+
+// GetCharacteristicsByUUID return the GATT characteristics with an UUID. If
+// parent is not empty (eg. a device path) only the characteristics below it are returned
+func (c *ObjectCache) GetCharacteristicsByUUID(uuid string, parent dbus.ObjectPath) []dbus.ObjectPath {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return sortedPaths(c.charUUIDs[strings.ToLower(uuid)], childOf(parent))
+}
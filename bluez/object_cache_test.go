@@ -0,0 +1,113 @@
+package bluez_test
+
+import (
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/mock"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectCache(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	cache, err := bluez.GetObjectCache()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	adapterPath := mock.AdapterPath(mock.TestAdapterID)
+	assert.Empty(t, cache.GetDevices(adapterPath))
+
+	devPath, charPath := mock.AddTestDevice(t, b)
+
+	assert.Eventually(t, func() bool {
+		path, ok := cache.GetDeviceByAddress(adapterPath, mock.TestAddress)
+		return ok && path == devPath
+	}, mock.WaitFor, mock.Tick)
+
+	assert.Eventually(t, func() bool {
+		list := cache.GetCharacteristicsByUUID(mock.TestCharUUID, devPath)
+		return len(list) == 1 && list[0] == charPath
+	}, mock.WaitFor, mock.Tick)
+
+	assert.Equal(t, []dbus.ObjectPath{devPath}, cache.GetDevices(adapterPath))
+	assert.Len(t, cache.GetServicesByUUID("0000180F-0000-1000-8000-00805F9B34FB", ""), 1)
+	assert.Empty(t, cache.GetCharacteristicsByUUID(mock.TestCharUUID, "/org/bluez/hci1"))
+	assert.Equal(t, []dbus.ObjectPath{charPath}, cache.GetByInterface(gatt.GattCharacteristic1Interface, devPath))
+
+	descrPath, err := b.AddDescriptor(charPath, 0x12, "00002902-0000-1000-8000-00805f9b34fb", []string{"read"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Eventually(t, func() bool {
+		return cache.GetObject(descrPath) != nil
+	}, mock.WaitFor, mock.Tick)
+
+	dev, err := device.NewDevice1(devPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	char, err := gatt.NewGattCharacteristic1(charPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	descrs, err := dev.GetDescriptors(char)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, descrs, 1)
+	assert.Equal(t, descrPath, descrs[0].Path())
+
+	err = b.SetProperty(devPath, device.Device1Interface, "Name", "updated")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Eventually(t, func() bool {
+		props := cache.GetProperties(devPath, device.Device1Interface)
+		return props != nil && props["Name"].Value() == "updated"
+	}, mock.WaitFor, mock.Tick)
+
+	err = b.RemoveObject(devPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Eventually(t, func() bool {
+		_, ok := cache.GetDeviceByAddress(adapterPath, mock.TestAddress)
+		return !ok && cache.GetObject(charPath) == nil
+	}, mock.WaitFor, mock.Tick)
+	assert.Empty(t, cache.GetCharacteristicsByUUID(mock.TestCharUUID, ""))
+}
+
+func TestObjectCacheShared(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	// the callers share the instance loaded by the first one
+	caches := make(chan *bluez.ObjectCache, 10)
+	for i := 0; i < cap(caches); i++ {
+		go func() {
+			cache, err := bluez.GetObjectCache()
+			assert.NoError(t, err)
+			caches <- cache
+		}()
+	}
+	cache := <-caches
+	for i := 1; i < cap(caches); i++ {
+		assert.True(t, cache == <-caches)
+	}
+
+	cacheWithConn, err := bluez.GetObjectCacheWithConn(nil)
+	assert.NoError(t, err)
+	assert.True(t, cache == cacheWithConn)
+
+	// a new instance is loaded once closed
+	cache.Close()
+	cache1, err := bluez.GetObjectCache()
+	assert.NoError(t, err)
+	assert.False(t, cache == cache1)
+}
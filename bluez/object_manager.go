@@ -21,8 +21,23 @@ func GetObjectManager() (*ObjectManager, error) {
 	return om, nil
 }
 
+// GetObjectManagerWithConn return a client of the Bluez object manager on a
+// connection. A nil conn return the shared instance of GetObjectManager
+func GetObjectManagerWithConn(conn *Conn) (*ObjectManager, error) {
+	if conn == nil {
+		return GetObjectManager()
+	}
+	return NewObjectManagerWithConn(conn, OrgBluezInterface, "/")
+}
+
 // NewObjectManager create a new ObjectManager client
 func NewObjectManager(name string, path string) (*ObjectManager, error) {
+	return NewObjectManagerWithConn(nil, name, path)
+}
+
+// NewObjectManagerWithConn create a new ObjectManager client on a connection,
+// nil for the shared system bus connection
+func NewObjectManagerWithConn(conn *Conn, name string, path string) (*ObjectManager, error) {
 	om := new(ObjectManager)
 	om.client = NewClient(
 		&Config{
@@ -30,6 +45,7 @@ func NewObjectManager(name string, path string) (*ObjectManager, error) {
 			Iface: "org.freedesktop.DBus.ObjectManager",
 			Path:  dbus.ObjectPath(path),
 			Bus:   SystemBus,
+			Conn:  conn,
 		},
 	)
 	return om, nil
@@ -52,7 +68,14 @@ func (o *ObjectManager) GetManagedObjects() (map[dbus.ObjectPath]map[string]map[
 	return objs, err
 }
 
+// Subscribe to InterfacesAdded and InterfacesRemoved
+func (o *ObjectManager) Subscribe() (*Subscription, error) {
+	return o.client.Subscribe(o.client.Config.Path, o.client.Config.Iface)
+}
+
 //Register watch for signal events
+//
+// Deprecated: use Subscribe
 func (o *ObjectManager) Register() (chan *dbus.Signal, error) {
 	path := o.client.Config.Path
 	iface := o.client.Config.Iface
@@ -60,6 +83,8 @@ func (o *ObjectManager) Register() (chan *dbus.Signal, error) {
 }
 
 //Unregister watch for signal events
+//
+// Deprecated: use Subscribe
 func (o *ObjectManager) Unregister(signal chan *dbus.Signal) error {
 	path := o.client.Config.Path
 	iface := o.client.Config.Iface
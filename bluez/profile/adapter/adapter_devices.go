@@ -2,7 +2,6 @@ package adapter
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/godbus/dbus/v5"
 	"github.com/muka/go-bluetooth/bluez"
@@ -13,55 +12,38 @@ import (
 //GetDeviceByAddress return a Device object based on its address
 func (a *Adapter1) GetDeviceByAddress(address string) (*device.Device1, error) {
 
-	list, err := a.GetDeviceList()
+	cache, err := bluez.GetObjectCacheWithConn(a.Client().Conn())
 	if err != nil {
 		return nil, err
 	}
 
-	for _, path := range list {
-
-		dev, err := device.NewDevice1(path)
-		if err != nil {
-			return nil, err
-		}
-
-		if dev.Properties.Address == address {
-			return dev, nil
-		}
+	path, ok := cache.GetDeviceByAddress(a.Path(), address)
+	if !ok {
+		return nil, nil
 	}
 
-	return nil, nil
+	return device.NewDevice1WithConn(a.Client().Conn(), path)
 }
 
 //GetDevices returns a list of bluetooth discovered Devices
 func (a *Adapter1) GetDevices() ([]*device.Device1, error) {
 
-	list, err := a.GetDeviceList()
-	if err != nil {
-		return nil, err
-	}
-
-	om, err := bluez.GetObjectManager()
-	if err != nil {
-		return nil, err
-	}
-
-	objects, err := om.GetManagedObjects()
+	cache, err := bluez.GetObjectCacheWithConn(a.Client().Conn())
 	if err != nil {
 		return nil, err
 	}
 
 	devices := []*device.Device1{}
 
-	for _, path := range list {
+	for _, path := range cache.GetDevices(a.Path()) {
 
-		object, ok := objects[path]
-		if !ok {
-			return nil, fmt.Errorf("Path %s does not exists", path)
+		props := cache.GetProperties(path, device.Device1Interface)
+		if props == nil {
+			// removed in the meanwhile
+			continue
 		}
 
-		props := object[device.Device1Interface]
-		dev, err := parseDevice(path, props)
+		dev, err := parseDevice(a.Client().Conn(), path, props)
 		if err != nil {
 			return nil, err
 		}
@@ -75,31 +57,12 @@ func (a *Adapter1) GetDevices() ([]*device.Device1, error) {
 // GetDeviceList returns a list of cached device paths
 func (a *Adapter1) GetDeviceList() ([]dbus.ObjectPath, error) {
 
-	om, err := bluez.GetObjectManager()
+	cache, err := bluez.GetObjectCacheWithConn(a.Client().Conn())
 	if err != nil {
 		return nil, err
 	}
 
-	objects, err := om.GetManagedObjects()
-	if err != nil {
-		return nil, err
-	}
-
-	devices := []dbus.ObjectPath{}
-	for path, ifaces := range objects {
-		for iface := range ifaces {
-			switch iface {
-			case device.Device1Interface:
-				{
-					if strings.Contains(string(path), string(a.Path())) {
-						devices = append(devices, path)
-					}
-				}
-			}
-		}
-	}
-
-	return devices, nil
+	return cache.GetDevices(a.Path()), nil
 }
 
 // FlushDevices removes device from bluez cache
@@ -121,9 +84,9 @@ func (a *Adapter1) FlushDevices() error {
 }
 
 // ParseDevice parse a Device from a ObjectManager map
-func parseDevice(path dbus.ObjectPath, propsMap map[string]dbus.Variant) (*device.Device1, error) {
+func parseDevice(conn *bluez.Conn, path dbus.ObjectPath, propsMap map[string]dbus.Variant) (*device.Device1, error) {
 
-	dev, err := device.NewDevice1(path)
+	dev, err := device.NewDevice1WithConn(conn, path)
 	if err != nil {
 		return nil, err
 	}
@@ -1,10 +1,11 @@
 package adapter
 
 import (
+	"sync"
+
 	"github.com/godbus/dbus/v5"
 	"github.com/muka/go-bluetooth/bluez"
 	"github.com/muka/go-bluetooth/bluez/profile/device"
-	log "github.com/sirupsen/logrus"
 )
 
 const (
@@ -31,15 +32,25 @@ func (a *Adapter1) OnDeviceDiscovered() (chan *DeviceDiscovered, func(), error)
 	}
 
 	var (
-		ch = make(chan *DeviceDiscovered)
+		ch   = make(chan *DeviceDiscovered)
+		done = make(chan struct{})
 	)
 
+	// send return false once cancel has been called
+	send := func(ev *DeviceDiscovered) bool {
+		select {
+		case ch <- ev:
+			return true
+		case <-done:
+			return false
+		}
+	}
+
 	go func() {
-		for v := range signal {
+		// the only sender closes the channel
+		defer close(ch)
 
-			if v == nil {
-				return
-			}
+		for v := range signal {
 
 			var op DeviceActions
 			if v.Name == bluez.InterfacesAdded {
@@ -52,44 +63,48 @@ func (a *Adapter1) OnDeviceDiscovered() (chan *DeviceDiscovered, func(), error)
 				}
 			}
 
-			path := v.Body[0].(dbus.ObjectPath)
+			if len(v.Body) < 2 {
+				continue
+			}
+			path, ok := v.Body[0].(dbus.ObjectPath)
+			if !ok {
+				continue
+			}
 
 			if op == DeviceRemoved {
-				ifaces := v.Body[1].([]string)
+				ifaces, _ := v.Body[1].([]string)
 				for _, iface := range ifaces {
 					if iface == device.Device1Interface {
-						log.Tracef("Removed device %s", path)
-						ch <- &DeviceDiscovered{path, op}
+						a.Client().Logger().Tracef("Removed device %s", path)
+						if !send(&DeviceDiscovered{path, op}) {
+							return
+						}
 					}
 				}
 				continue
 			}
 
-			ifaces := v.Body[1].(map[string]map[string]dbus.Variant)
+			ifaces, _ := v.Body[1].(map[string]map[string]dbus.Variant)
 			if p, ok := ifaces[device.Device1Interface]; ok {
 				if p == nil {
 					continue
 				}
-				log.Tracef("Added device %s", path)
-
-				if ch == nil {
+				a.Client().Logger().Tracef("Added device %s", path)
+				if !send(&DeviceDiscovered{path, op}) {
 					return
 				}
-
-				ch <- &DeviceDiscovered{path, op}
-
 			}
 
 		}
 	}()
 
+	var once sync.Once
 	cancel := func() {
-		omSignalCancel()
-		if ch != nil {
-			close(ch)
-		}
-		ch = nil
-		log.Trace("OnDeviceDiscovered: cancel() called")
+		once.Do(func() {
+			close(done)
+			omSignalCancel()
+			a.Client().Logger().Tracef("OnDeviceDiscovered: cancel() called")
+		})
 	}
 
 	return ch, cancel, nil
@@ -1,6 +1,9 @@
 package adapter
 
 import (
+	"strings"
+
+	"github.com/muka/go-bluetooth/bluez/profile/device"
 	"github.com/muka/go-bluetooth/util"
 )
 
@@ -113,3 +116,142 @@ func NewDiscoveryFilter() DiscoveryFilter {
 		Transport:     DiscoveryFilterTransportAuto,
 	}
 }
+
+// MergeDiscoveryFilters return a filter matching the devices matched by any of
+// filters: the union of UUIDs, the lowest RSSI, the highest Pathloss and the
+// common transport, or auto. A nil filter matches any device. As bluez does
+// not accept RSSI and Pathloss together, both are dropped when the filters
+// set one each.
+func MergeDiscoveryFilters(filters ...*DiscoveryFilter) DiscoveryFilter {
+
+	merged := NewDiscoveryFilter()
+	if len(filters) == 0 {
+		return merged
+	}
+
+	anyUUID := false
+	noRSSI := false
+	noPathloss := false
+	transport := ""
+	duplicateData := false
+
+	for i, filter := range filters {
+
+		f := NewDiscoveryFilter()
+		if filter != nil {
+			f = *filter
+		}
+
+		if len(f.UUIDs) == 0 {
+			anyUUID = true
+		}
+		merged.AddUUIDs(f.UUIDs...)
+
+		if f.RSSI == 0 {
+			noRSSI = true
+		} else if merged.RSSI == 0 || f.RSSI < merged.RSSI {
+			merged.RSSI = f.RSSI
+		}
+
+		if f.Pathloss == 0 {
+			noPathloss = true
+		} else if f.Pathloss > merged.Pathloss {
+			merged.Pathloss = f.Pathloss
+		}
+
+		t := f.Transport
+		if t == "" {
+			t = DiscoveryFilterTransportAuto
+		}
+		if i == 0 {
+			transport = t
+		} else if t != transport {
+			transport = DiscoveryFilterTransportAuto
+		}
+
+		duplicateData = duplicateData || f.DuplicateData
+	}
+
+	if anyUUID {
+		merged.UUIDs = nil
+	}
+	if noRSSI {
+		merged.RSSI = 0
+	}
+	if noPathloss {
+		merged.Pathloss = 0
+	}
+	if merged.RSSI != 0 && merged.Pathloss != 0 {
+		merged.RSSI = 0
+		merged.Pathloss = 0
+	}
+	merged.Transport = transport
+	merged.DuplicateData = duplicateData
+
+	return merged
+}
+
+// Match report if a discovered device passes the UUIDs, RSSI, Pathloss and
+// Transport conditions of the filter. DuplicateData only applies to the scan.
+func (a *DiscoveryFilter) Match(props *device.Device1Properties) bool {
+
+	if !a.matchTransport(props) {
+		return false
+	}
+
+	if len(a.UUIDs) > 0 {
+		found := false
+		for _, uuid := range props.UUIDs {
+			for _, uuid1 := range a.UUIDs {
+				if strings.EqualFold(uuid, uuid1) {
+					found = true
+				}
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if a.RSSI != 0 && props.RSSI != 0 && props.RSSI < a.RSSI {
+		return false
+	}
+
+	if a.Pathloss != 0 {
+		// pathloss is computed from the advertised TX power
+		if props.TxPower == 0 || props.RSSI == 0 {
+			return false
+		}
+		if int(props.TxPower)-int(props.RSSI) > int(a.Pathloss) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// advertising flag set by LE only devices
+const advertisingFlagBrEdrNotSupported = 0x04
+
+// matchTransport report if the device may have been found with the transport
+// of the filter. bluez does not expose the bearer of a device, it is guessed
+// from the address type, the class of device (found by BR/EDR inquiry) and the
+// advertising flags (found by LE scan).
+func (a *DiscoveryFilter) matchTransport(props *device.Device1Properties) bool {
+	switch a.Transport {
+	case DiscoveryFilterTransportLE:
+		if props.AddressType == string(device.Device1AddressTypeRandom) || len(props.AdvertisingFlags) > 0 {
+			return true
+		}
+		return props.Class == 0
+	case DiscoveryFilterTransportBrEdr:
+		if props.AddressType == string(device.Device1AddressTypeRandom) {
+			return false
+		}
+		if len(props.AdvertisingFlags) > 0 && props.AdvertisingFlags[0]&advertisingFlagBrEdrNotSupported != 0 {
+			return false
+		}
+		return true
+	}
+	return true
+}
@@ -3,6 +3,7 @@ package adapter
 import (
 	"testing"
 
+	"github.com/muka/go-bluetooth/bluez/profile/device"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -24,3 +25,89 @@ func TestDiscoveryFilter(t *testing.T) {
 	assert.EqualValues(t, m["Transport"].(string), f.Transport)
 
 }
+
+func TestMergeDiscoveryFilters(t *testing.T) {
+
+	f1 := NewDiscoveryFilter()
+	f1.AddUUIDs("AAAA")
+	f1.RSSI = -60
+	f1.Transport = DiscoveryFilterTransportLE
+	f1.DuplicateData = false
+
+	f2 := NewDiscoveryFilter()
+	f2.AddUUIDs("BBBB", "AAAA")
+	f2.RSSI = -80
+	f2.Transport = DiscoveryFilterTransportLE
+	f2.DuplicateData = false
+
+	m := MergeDiscoveryFilters(&f1, &f2)
+	assert.Equal(t, []string{"AAAA", "BBBB"}, m.UUIDs)
+	assert.Equal(t, int16(-80), m.RSSI)
+	assert.Equal(t, DiscoveryFilterTransportLE, m.Transport)
+	assert.False(t, m.DuplicateData)
+
+	f3 := NewDiscoveryFilter()
+	f3.Pathloss = 20
+	f3.Transport = DiscoveryFilterTransportBrEdr
+
+	m = MergeDiscoveryFilters(&f1, &f3)
+	assert.Empty(t, m.UUIDs)
+	assert.Equal(t, int16(0), m.RSSI)
+	assert.Equal(t, uint16(0), m.Pathloss)
+	assert.Equal(t, DiscoveryFilterTransportAuto, m.Transport)
+	assert.True(t, m.DuplicateData)
+
+	m = MergeDiscoveryFilters(&f1, nil)
+	assert.Empty(t, m.UUIDs)
+	assert.Equal(t, int16(0), m.RSSI)
+
+	assert.Equal(t, NewDiscoveryFilter(), MergeDiscoveryFilters())
+}
+
+func TestDiscoveryFilterMatch(t *testing.T) {
+
+	f := NewDiscoveryFilter()
+	assert.True(t, f.Match(&device.Device1Properties{}))
+
+	f.AddUUIDs("0000180F-0000-1000-8000-00805F9B34FB")
+	f.RSSI = -60
+	assert.True(t, f.Match(&device.Device1Properties{
+		UUIDs: []string{"0000180f-0000-1000-8000-00805f9b34fb"},
+		RSSI:  -50,
+	}))
+	assert.False(t, f.Match(&device.Device1Properties{
+		UUIDs: []string{"0000180f-0000-1000-8000-00805f9b34fb"},
+		RSSI:  -70,
+	}))
+	assert.False(t, f.Match(&device.Device1Properties{
+		UUIDs: []string{"0000180d-0000-1000-8000-00805f9b34fb"},
+	}))
+
+	f = NewDiscoveryFilter()
+	f.Pathloss = 60
+	assert.True(t, f.Match(&device.Device1Properties{TxPower: 4, RSSI: -50}))
+	assert.False(t, f.Match(&device.Device1Properties{TxPower: 4, RSSI: -70}))
+	assert.False(t, f.Match(&device.Device1Properties{RSSI: -50}))
+
+	le := &device.Device1Properties{AddressType: "random"}
+	bredr := &device.Device1Properties{AddressType: "public", Class: 0x240404}
+	dual := &device.Device1Properties{AddressType: "public", Class: 0x240404, AdvertisingFlags: []byte{0x1a}}
+	leOnly := &device.Device1Properties{AddressType: "public", AdvertisingFlags: []byte{0x06}}
+
+	f = NewDiscoveryFilter()
+	for _, props := range []*device.Device1Properties{le, bredr, dual, leOnly} {
+		assert.True(t, f.Match(props))
+	}
+
+	f.Transport = DiscoveryFilterTransportLE
+	assert.True(t, f.Match(le))
+	assert.False(t, f.Match(bredr))
+	assert.True(t, f.Match(dual))
+	assert.True(t, f.Match(leOnly))
+
+	f.Transport = DiscoveryFilterTransportBrEdr
+	assert.False(t, f.Match(le))
+	assert.True(t, f.Match(bredr))
+	assert.True(t, f.Match(dual))
+	assert.False(t, f.Match(leOnly))
+}
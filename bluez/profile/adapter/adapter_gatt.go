@@ -10,5 +10,5 @@ func (a *Adapter1) GetGattManager() (*gatt.GattManager1, error) {
 	if err != nil {
 		return nil, err
 	}
-	return gatt.NewGattManager1FromAdapterID(adapterID)
+	return gatt.NewGattManager1FromAdapterIDWithConn(a.Client().Conn(), adapterID)
 }
@@ -5,6 +5,7 @@ package adapter
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -21,6 +22,14 @@ var Adapter1Interface = "org.bluez.Adapter1"
 // Args:
 // - objectPath: [variable prefix]/{hci0,hci1,...}
 func NewAdapter1(objectPath dbus.ObjectPath) (*Adapter1, error) {
+	return NewAdapter1WithConn(nil, objectPath)
+}
+
+// NewAdapter1WithConn create a new instance of Adapter1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [variable prefix]/{hci0,hci1,...}
+func NewAdapter1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*Adapter1, error) {
 	a := new(Adapter1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -28,6 +37,7 @@ func NewAdapter1(objectPath dbus.ObjectPath) (*Adapter1, error) {
 			Iface: Adapter1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -44,6 +54,12 @@ func NewAdapter1(objectPath dbus.ObjectPath) (*Adapter1, error) {
 // NewAdapter1FromAdapterID create a new instance of Adapter1
 // adapterID: ID of an adapter eg. hci0
 func NewAdapter1FromAdapterID(adapterID string) (*Adapter1, error) {
+	return NewAdapter1FromAdapterIDWithConn(nil, adapterID)
+}
+
+// NewAdapter1FromAdapterIDWithConn create a new instance of Adapter1 on a connection, nil for the shared system bus connection
+// adapterID: ID of an adapter eg. hci0
+func NewAdapter1FromAdapterIDWithConn(conn *bluez.Conn, adapterID string) (*Adapter1, error) {
 	a := new(Adapter1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -51,6 +67,7 @@ func NewAdapter1FromAdapterID(adapterID string) (*Adapter1, error) {
 			Iface: Adapter1Interface,
 			Path:  dbus.ObjectPath(fmt.Sprintf("/org/bluez/%s", adapterID)),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -71,8 +88,7 @@ Adapter1 Adapter hierarchy
 */
 type Adapter1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*Adapter1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -83,50 +99,9 @@ type Adapter1Properties struct {
 	lock sync.RWMutex `dbus:"ignore"`
 
 	/*
-	Powered Switch an adapter on or off. This will also set the
-			appropriate connectable state of the controller.
-
-			The value of this property is not persistent. After
-			restart or unplugging of the adapter it will reset
-			back to false.
-	*/
-	Powered bool
-
-	/*
-	Discoverable Switch an adapter to discoverable or non-discoverable
-			to either make it visible or hide it. This is a global
-			setting and should only be used by the settings
-			application.
-
-			If the DiscoverableTimeout is set to a non-zero
-			value then the system will set this value back to
-			false after the timer expired.
-
-			In case the adapter is switched off, setting this
-			value will fail.
-
-			When changing the Powered property the new state of
-			this property will be updated via a PropertiesChanged
-			signal.
-
-			For any new adapter this settings defaults to false.
-	*/
-	Discoverable bool
-
-	/*
-	PairableTimeout The pairable timeout in seconds. A value of zero
-			means that the timeout is disabled and it will stay in
-			pairable mode forever.
-
-			The default value for pairable timeout should be
-			disabled (value 0).
-	*/
-	PairableTimeout uint32
-
-	/*
-	Discovering Indicates that a device discovery procedure is active.
+	Address The Bluetooth device address.
 	*/
-	Discovering bool
+	Address string
 
 	/*
 	AddressType The Bluetooth  Address Type. For dual-mode and BR/EDR
@@ -180,15 +155,35 @@ type Adapter1Properties struct {
 	Class uint32
 
 	/*
-	Modalias Local Device ID information in modalias format
-			used by the kernel and udev.
+	Powered Switch an adapter on or off. This will also set the
+			appropriate connectable state of the controller.
+
+			The value of this property is not persistent. After
+			restart or unplugging of the adapter it will reset
+			back to false.
 	*/
-	Modalias string
+	Powered bool
 
 	/*
-	Address The Bluetooth device address.
+	Discoverable Switch an adapter to discoverable or non-discoverable
+			to either make it visible or hide it. This is a global
+			setting and should only be used by the settings
+			application.
+
+			If the DiscoverableTimeout is set to a non-zero
+			value then the system will set this value back to
+			false after the timer expired.
+
+			In case the adapter is switched off, setting this
+			value will fail.
+
+			When changing the Powered property the new state of
+			this property will be updated via a PropertiesChanged
+			signal.
+
+			For any new adapter this settings defaults to false.
 	*/
-	Address string
+	Discoverable bool
 
 	/*
 	Pairable Switch an adapter to pairable or non-pairable. This is
@@ -202,6 +197,16 @@ type Adapter1Properties struct {
 	*/
 	Pairable bool
 
+	/*
+	PairableTimeout The pairable timeout in seconds. A value of zero
+			means that the timeout is disabled and it will stay in
+			pairable mode forever.
+
+			The default value for pairable timeout should be
+			disabled (value 0).
+	*/
+	PairableTimeout uint32
+
 	/*
 	DiscoverableTimeout The discoverable timeout in seconds. A value of zero
 			means that the timeout is disabled and it will stay in
@@ -212,12 +217,23 @@ type Adapter1Properties struct {
 	*/
 	DiscoverableTimeout uint32
 
+	/*
+	Discovering Indicates that a device discovery procedure is active.
+	*/
+	Discovering bool
+
 	/*
 	UUIDs List of 128-bit UUIDs that represents the available
 			local services.
 	*/
 	UUIDs []string
 
+	/*
+	Modalias Local Device ID information in modalias format
+			used by the kernel and udev.
+	*/
+	Modalias string
+
 }
 
 //Lock access to properties
@@ -233,92 +249,58 @@ func (p *Adapter1Properties) Unlock() {
 
 
 
-// SetPowered set Powered value
-func (a *Adapter1) SetPowered(v bool) error {
-	return a.SetProperty("Powered", v)
+// SetAddress set Address value
+func (a *Adapter1) SetAddress(v string) error {
+	return a.SetAddressContext(context.Background(), v)
 }
 
-
-
-// GetPowered get Powered value
-func (a *Adapter1) GetPowered() (bool, error) {
-	v, err := a.GetProperty("Powered")
-	if err != nil {
-		return false, err
-	}
-	return v.Value().(bool), nil
+// SetAddressContext set Address value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) SetAddressContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Address", v)
 }
 
 
 
-
-// SetDiscoverable set Discoverable value
-func (a *Adapter1) SetDiscoverable(v bool) error {
-	return a.SetProperty("Discoverable", v)
+// GetAddress get Address value
+func (a *Adapter1) GetAddress() (string, error) {
+	return a.GetAddressContext(context.Background())
 }
 
-
-
-// GetDiscoverable get Discoverable value
-func (a *Adapter1) GetDiscoverable() (bool, error) {
-	v, err := a.GetProperty("Discoverable")
+// GetAddressContext get Address value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) GetAddressContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Address")
 	if err != nil {
-		return false, err
+		return "", err
 	}
-	return v.Value().(bool), nil
+	return v.Value().(string), nil
 }
 
 
 
 
-// SetPairableTimeout set PairableTimeout value
-func (a *Adapter1) SetPairableTimeout(v uint32) error {
-	return a.SetProperty("PairableTimeout", v)
+// SetAddressType set AddressType value
+func (a *Adapter1) SetAddressType(v string) error {
+	return a.SetAddressTypeContext(context.Background(), v)
 }
 
-
-
-// GetPairableTimeout get PairableTimeout value
-func (a *Adapter1) GetPairableTimeout() (uint32, error) {
-	v, err := a.GetProperty("PairableTimeout")
-	if err != nil {
-		return uint32(0), err
+// SetAddressTypeContext set AddressType value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) SetAddressTypeContext(ctx context.Context, v string) error {
+	if !Adapter1AddressType(v).Valid() {
+		return &bluez.InvalidValueError{Interface: Adapter1Interface, Member: "AddressType", Path: a.Path(), Value: v}
 	}
-	return v.Value().(uint32), nil
-}
-
-
-
-
-// SetDiscovering set Discovering value
-func (a *Adapter1) SetDiscovering(v bool) error {
-	return a.SetProperty("Discovering", v)
-}
-
-
-
-// GetDiscovering get Discovering value
-func (a *Adapter1) GetDiscovering() (bool, error) {
-	v, err := a.GetProperty("Discovering")
-	if err != nil {
-		return false, err
-	}
-	return v.Value().(bool), nil
-}
-
-
-
-
-// SetAddressType set AddressType value
-func (a *Adapter1) SetAddressType(v string) error {
-	return a.SetProperty("AddressType", v)
+	return a.SetPropertyContext(ctx, "AddressType", v)
 }
 
 
 
 // GetAddressType get AddressType value
 func (a *Adapter1) GetAddressType() (string, error) {
-	v, err := a.GetProperty("AddressType")
+	return a.GetAddressTypeContext(context.Background())
+}
+
+// GetAddressTypeContext get AddressType value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) GetAddressTypeContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "AddressType")
 	if err != nil {
 		return "", err
 	}
@@ -330,14 +312,24 @@ func (a *Adapter1) GetAddressType() (string, error) {
 
 // SetName set Name value
 func (a *Adapter1) SetName(v string) error {
-	return a.SetProperty("Name", v)
+	return a.SetNameContext(context.Background(), v)
+}
+
+// SetNameContext set Name value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) SetNameContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Name", v)
 }
 
 
 
 // GetName get Name value
 func (a *Adapter1) GetName() (string, error) {
-	v, err := a.GetProperty("Name")
+	return a.GetNameContext(context.Background())
+}
+
+// GetNameContext get Name value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) GetNameContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Name")
 	if err != nil {
 		return "", err
 	}
@@ -349,14 +341,24 @@ func (a *Adapter1) GetName() (string, error) {
 
 // SetAlias set Alias value
 func (a *Adapter1) SetAlias(v string) error {
-	return a.SetProperty("Alias", v)
+	return a.SetAliasContext(context.Background(), v)
+}
+
+// SetAliasContext set Alias value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) SetAliasContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Alias", v)
 }
 
 
 
 // GetAlias get Alias value
 func (a *Adapter1) GetAlias() (string, error) {
-	v, err := a.GetProperty("Alias")
+	return a.GetAliasContext(context.Background())
+}
+
+// GetAliasContext get Alias value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) GetAliasContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Alias")
 	if err != nil {
 		return "", err
 	}
@@ -368,14 +370,24 @@ func (a *Adapter1) GetAlias() (string, error) {
 
 // SetClass set Class value
 func (a *Adapter1) SetClass(v uint32) error {
-	return a.SetProperty("Class", v)
+	return a.SetClassContext(context.Background(), v)
+}
+
+// SetClassContext set Class value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) SetClassContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "Class", v)
 }
 
 
 
 // GetClass get Class value
 func (a *Adapter1) GetClass() (uint32, error) {
-	v, err := a.GetProperty("Class")
+	return a.GetClassContext(context.Background())
+}
+
+// GetClassContext get Class value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) GetClassContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "Class")
 	if err != nil {
 		return uint32(0), err
 	}
@@ -385,39 +397,59 @@ func (a *Adapter1) GetClass() (uint32, error) {
 
 
 
-// SetModalias set Modalias value
-func (a *Adapter1) SetModalias(v string) error {
-	return a.SetProperty("Modalias", v)
+// SetPowered set Powered value
+func (a *Adapter1) SetPowered(v bool) error {
+	return a.SetPoweredContext(context.Background(), v)
 }
 
+// SetPoweredContext set Powered value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) SetPoweredContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Powered", v)
+}
 
 
-// GetModalias get Modalias value
-func (a *Adapter1) GetModalias() (string, error) {
-	v, err := a.GetProperty("Modalias")
+
+// GetPowered get Powered value
+func (a *Adapter1) GetPowered() (bool, error) {
+	return a.GetPoweredContext(context.Background())
+}
+
+// GetPoweredContext get Powered value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) GetPoweredContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Powered")
 	if err != nil {
-		return "", err
+		return false, err
 	}
-	return v.Value().(string), nil
+	return v.Value().(bool), nil
 }
 
 
 
 
-// SetAddress set Address value
-func (a *Adapter1) SetAddress(v string) error {
-	return a.SetProperty("Address", v)
+// SetDiscoverable set Discoverable value
+func (a *Adapter1) SetDiscoverable(v bool) error {
+	return a.SetDiscoverableContext(context.Background(), v)
+}
+
+// SetDiscoverableContext set Discoverable value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) SetDiscoverableContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Discoverable", v)
 }
 
 
 
-// GetAddress get Address value
-func (a *Adapter1) GetAddress() (string, error) {
-	v, err := a.GetProperty("Address")
+// GetDiscoverable get Discoverable value
+func (a *Adapter1) GetDiscoverable() (bool, error) {
+	return a.GetDiscoverableContext(context.Background())
+}
+
+// GetDiscoverableContext get Discoverable value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) GetDiscoverableContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Discoverable")
 	if err != nil {
-		return "", err
+		return false, err
 	}
-	return v.Value().(string), nil
+	return v.Value().(bool), nil
 }
 
 
@@ -425,14 +457,24 @@ func (a *Adapter1) GetAddress() (string, error) {
 
 // SetPairable set Pairable value
 func (a *Adapter1) SetPairable(v bool) error {
-	return a.SetProperty("Pairable", v)
+	return a.SetPairableContext(context.Background(), v)
+}
+
+// SetPairableContext set Pairable value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) SetPairableContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Pairable", v)
 }
 
 
 
 // GetPairable get Pairable value
 func (a *Adapter1) GetPairable() (bool, error) {
-	v, err := a.GetProperty("Pairable")
+	return a.GetPairableContext(context.Background())
+}
+
+// GetPairableContext get Pairable value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) GetPairableContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Pairable")
 	if err != nil {
 		return false, err
 	}
@@ -442,16 +484,55 @@ func (a *Adapter1) GetPairable() (bool, error) {
 
 
 
+// SetPairableTimeout set PairableTimeout value
+func (a *Adapter1) SetPairableTimeout(v uint32) error {
+	return a.SetPairableTimeoutContext(context.Background(), v)
+}
+
+// SetPairableTimeoutContext set PairableTimeout value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) SetPairableTimeoutContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "PairableTimeout", v)
+}
+
+
+
+// GetPairableTimeout get PairableTimeout value
+func (a *Adapter1) GetPairableTimeout() (uint32, error) {
+	return a.GetPairableTimeoutContext(context.Background())
+}
+
+// GetPairableTimeoutContext get PairableTimeout value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) GetPairableTimeoutContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "PairableTimeout")
+	if err != nil {
+		return uint32(0), err
+	}
+	return v.Value().(uint32), nil
+}
+
+
+
+
 // SetDiscoverableTimeout set DiscoverableTimeout value
 func (a *Adapter1) SetDiscoverableTimeout(v uint32) error {
-	return a.SetProperty("DiscoverableTimeout", v)
+	return a.SetDiscoverableTimeoutContext(context.Background(), v)
+}
+
+// SetDiscoverableTimeoutContext set DiscoverableTimeout value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) SetDiscoverableTimeoutContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "DiscoverableTimeout", v)
 }
 
 
 
 // GetDiscoverableTimeout get DiscoverableTimeout value
 func (a *Adapter1) GetDiscoverableTimeout() (uint32, error) {
-	v, err := a.GetProperty("DiscoverableTimeout")
+	return a.GetDiscoverableTimeoutContext(context.Background())
+}
+
+// GetDiscoverableTimeoutContext get DiscoverableTimeout value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) GetDiscoverableTimeoutContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "DiscoverableTimeout")
 	if err != nil {
 		return uint32(0), err
 	}
@@ -461,16 +542,55 @@ func (a *Adapter1) GetDiscoverableTimeout() (uint32, error) {
 
 
 
+// SetDiscovering set Discovering value
+func (a *Adapter1) SetDiscovering(v bool) error {
+	return a.SetDiscoveringContext(context.Background(), v)
+}
+
+// SetDiscoveringContext set Discovering value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) SetDiscoveringContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Discovering", v)
+}
+
+
+
+// GetDiscovering get Discovering value
+func (a *Adapter1) GetDiscovering() (bool, error) {
+	return a.GetDiscoveringContext(context.Background())
+}
+
+// GetDiscoveringContext get Discovering value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) GetDiscoveringContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Discovering")
+	if err != nil {
+		return false, err
+	}
+	return v.Value().(bool), nil
+}
+
+
+
+
 // SetUUIDs set UUIDs value
 func (a *Adapter1) SetUUIDs(v []string) error {
-	return a.SetProperty("UUIDs", v)
+	return a.SetUUIDsContext(context.Background(), v)
+}
+
+// SetUUIDsContext set UUIDs value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) SetUUIDsContext(ctx context.Context, v []string) error {
+	return a.SetPropertyContext(ctx, "UUIDs", v)
 }
 
 
 
 // GetUUIDs get UUIDs value
 func (a *Adapter1) GetUUIDs() ([]string, error) {
-	v, err := a.GetProperty("UUIDs")
+	return a.GetUUIDsContext(context.Background())
+}
+
+// GetUUIDsContext get UUIDs value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) GetUUIDsContext(ctx context.Context) ([]string, error) {
+	v, err := a.GetPropertyContext(ctx, "UUIDs")
 	if err != nil {
 		return []string{}, err
 	}
@@ -479,6 +599,35 @@ func (a *Adapter1) GetUUIDs() ([]string, error) {
 
 
 
+
+// SetModalias set Modalias value
+func (a *Adapter1) SetModalias(v string) error {
+	return a.SetModaliasContext(context.Background(), v)
+}
+
+// SetModaliasContext set Modalias value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) SetModaliasContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Modalias", v)
+}
+
+
+
+// GetModalias get Modalias value
+func (a *Adapter1) GetModalias() (string, error) {
+	return a.GetModaliasContext(context.Background())
+}
+
+// GetModaliasContext get Modalias value, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) GetModaliasContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Modalias")
+	if err != nil {
+		return "", err
+	}
+	return v.Value().(string), nil
+}
+
+
+
 // Close the connection
 func (a *Adapter1) Close() {
 	
@@ -502,35 +651,24 @@ func (a *Adapter1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *Adapter1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *Adapter1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
-
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -560,20 +698,31 @@ func (a *Adapter1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Adapter1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Adapter1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *Adapter1) GetProperties() (*Adapter1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) GetPropertiesContext(ctx context.Context) (*Adapter1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -583,29 +732,40 @@ func (a *Adapter1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *Adapter1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *Adapter1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *Adapter1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *Adapter1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *Adapter1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -615,6 +775,7 @@ func (a *Adapter1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *Adapter1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -635,8 +796,13 @@ StartDiscovery 			This method starts the device discovery session. This
 
 */
 func (a *Adapter1) StartDiscovery() error {
+	return a.StartDiscoveryContext(context.Background())
+}
+
+// StartDiscoveryContext call StartDiscovery, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Adapter1) StartDiscoveryContext(ctx context.Context) error {
 	
-	return a.client.Call("StartDiscovery", 0, ).Store()
+	return a.client.CallWithContext(ctx, "StartDiscovery", 0, ).Store()
 	
 }
 
@@ -652,8 +818,13 @@ StopDiscovery 			This method will cancel any previous StartDiscovery
 
 */
 func (a *Adapter1) StopDiscovery() error {
+	return a.StopDiscoveryContext(context.Background())
+}
+
+// StopDiscoveryContext call StopDiscovery, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Adapter1) StopDiscoveryContext(ctx context.Context) error {
 	
-	return a.client.Call("StopDiscovery", 0, ).Store()
+	return a.client.CallWithContext(ctx, "StopDiscovery", 0, ).Store()
 	
 }
 
@@ -665,8 +836,13 @@ RemoveDevice 			This removes the remote device object at the given
 
 */
 func (a *Adapter1) RemoveDevice(device dbus.ObjectPath) error {
+	return a.RemoveDeviceContext(context.Background(), device)
+}
+
+// RemoveDeviceContext call RemoveDevice, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Adapter1) RemoveDeviceContext(ctx context.Context, device dbus.ObjectPath) error {
 	
-	return a.client.Call("RemoveDevice", 0, device).Store()
+	return a.client.CallWithContext(ctx, "RemoveDevice", 0, device).Store()
 	
 }
 
@@ -746,8 +922,13 @@ SetDiscoveryFilter 			This method sets the device discovery filter for the
 
 */
 func (a *Adapter1) SetDiscoveryFilter(filter map[string]interface{}) error {
+	return a.SetDiscoveryFilterContext(context.Background(), filter)
+}
+
+// SetDiscoveryFilterContext call SetDiscoveryFilter, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Adapter1) SetDiscoveryFilterContext(ctx context.Context, filter map[string]interface{}) error {
 	
-	return a.client.Call("SetDiscoveryFilter", 0, filter).Store()
+	return a.client.CallWithContext(ctx, "SetDiscoveryFilter", 0, filter).Store()
 	
 }
 
@@ -758,9 +939,14 @@ GetDiscoveryFilters 			Return available filters that can be given to
 
 */
 func (a *Adapter1) GetDiscoveryFilters() ([]string, error) {
+	return a.GetDiscoveryFiltersContext(context.Background())
+}
+
+// GetDiscoveryFiltersContext call GetDiscoveryFilters, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Adapter1) GetDiscoveryFiltersContext(ctx context.Context) ([]string, error) {
 	
 	 val0 := []string{}
-	err := a.client.Call("GetDiscoveryFilters", 0, ).Store(&val0)
+	err := a.client.CallWithContext(ctx, "GetDiscoveryFilters", 0, ).Store(&val0)
 	return val0, err	
 }
 
@@ -796,9 +982,14 @@ ConnectDevice 			This method connects to device without need of
 
 */
 func (a *Adapter1) ConnectDevice(properties map[string]interface{}) (dbus.ObjectPath, error) {
+	return a.ConnectDeviceContext(context.Background(), properties)
+}
+
+// ConnectDeviceContext call ConnectDevice, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Adapter1) ConnectDeviceContext(ctx context.Context, properties map[string]interface{}) (dbus.ObjectPath, error) {
 	
 	var val0 dbus.ObjectPath
-	err := a.client.Call("ConnectDevice", 0, properties).Store(&val0)
+	err := a.client.CallWithContext(ctx, "ConnectDevice", 0, properties).Store(&val0)
 	return val0, err	
 }
 
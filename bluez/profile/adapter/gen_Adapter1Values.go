@@ -0,0 +1,29 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package adapter
+
+
+// Adapter1AddressType is a value documented for Adapter1.AddressType
+type Adapter1AddressType string
+
+const (
+	Adapter1AddressTypePublic Adapter1AddressType = "public"
+	Adapter1AddressTypeRandom Adapter1AddressType = "random"
+)
+
+// Adapter1AddressTypeValues list the documented values of Adapter1AddressType
+var Adapter1AddressTypeValues = []Adapter1AddressType{
+	Adapter1AddressTypePublic,
+	Adapter1AddressTypeRandom,
+}
+
+// Valid report if v is one of the documented values
+func (v Adapter1AddressType) Valid() bool {
+	for _, value := range Adapter1AddressTypeValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
@@ -5,6 +5,7 @@ package advertising
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var LEAdvertisement1Interface = "org.bluez.LEAdvertisement1"
 // Args:
 // - objectPath: freely definable
 func NewLEAdvertisement1(objectPath dbus.ObjectPath) (*LEAdvertisement1, error) {
+	return NewLEAdvertisement1WithConn(nil, objectPath)
+}
+
+// NewLEAdvertisement1WithConn create a new instance of LEAdvertisement1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: freely definable
+func NewLEAdvertisement1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*LEAdvertisement1, error) {
 	a := new(LEAdvertisement1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewLEAdvertisement1(objectPath dbus.ObjectPath) (*LEAdvertisement1, error)
 			Iface: LEAdvertisement1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -53,8 +63,7 @@ versions of the same UUID will be used in the advertising data as appropriate.
 */
 type LEAdvertisement1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*LEAdvertisement1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -65,10 +74,17 @@ type LEAdvertisement1Properties struct {
 	lock sync.RWMutex `dbus:"ignore"`
 
 	/*
-	SolicitUUIDs Array of UUIDs to include in "Service Solicitation"
-			Advertisement Data.
+	Type Determines the type of advertising packet requested.
+
+			Possible values: "broadcast" or "peripheral"
 	*/
-	SolicitUUIDs []string
+	Type string
+
+	/*
+	ServiceUUIDs List of UUIDs to include in the "Service UUID" field of
+			the Advertising Data.
+	*/
+	ServiceUUIDs []string
 
 	/*
 	ManufacturerData Manufactuer Data fields to include in
@@ -78,17 +94,16 @@ type LEAdvertisement1Properties struct {
 	ManufacturerData map[uint16]interface{}
 
 	/*
-	Type Determines the type of advertising packet requested.
-
-			Possible values: "broadcast" or "peripheral"
+	SolicitUUIDs Array of UUIDs to include in "Service Solicitation"
+			Advertisement Data.
 	*/
-	Type string
+	SolicitUUIDs []string
 
 	/*
-	ServiceUUIDs List of UUIDs to include in the "Service UUID" field of
-			the Advertising Data.
+	ServiceData Service Data elements to include. The keys are the
+			UUID to associate with the data.
 	*/
-	ServiceUUIDs []string
+	ServiceData map[string]interface{}
 
 	/*
 	Data Advertising Type to include in the Advertising
@@ -108,6 +123,25 @@ type LEAdvertisement1Properties struct {
 	*/
 	Data map[byte]interface{}
 
+	/*
+	Discoverable Advertise as general discoverable. When present this
+			will override adapter Discoverable property.
+
+			Note: This property shall not be set when Type is set
+			to broadcast.
+	*/
+	Discoverable bool
+
+	/*
+	DiscoverableTimeout The discoverable timeout in seconds. A value of zero
+			means that the timeout is disabled and it will stay in
+			discoverable/limited mode forever.
+
+			Note: This property shall not be set when Type is set
+			to broadcast.
+	*/
+	DiscoverableTimeout uint16
+
 	/*
 	Includes List of features to be included in the advertising
 			packet.
@@ -117,6 +151,16 @@ type LEAdvertisement1Properties struct {
 	*/
 	Includes []string
 
+	/*
+	LocalName Local name to be used in the advertising report. If the
+			string is too big to fit into the packet it will be
+			truncated.
+
+			If this property is available 'local-name' cannot be
+			present in the Includes.
+	*/
+	LocalName string
+
 	/*
 	Appearance Appearance to be used in the advertising report.
 
@@ -144,69 +188,78 @@ type LEAdvertisement1Properties struct {
 			Possible value: "1M" (default)
 					"2M"
 					"Coded"
+
+	Since BlueZ 5.53
 	*/
 	SecondaryChannel string `dbus:"omitEmpty"`
 
-	/*
-	ServiceData Service Data elements to include. The keys are the
-			UUID to associate with the data.
-	*/
-	ServiceData map[string]interface{}
+}
 
-	/*
-	Discoverable Advertise as general discoverable. When present this
-			will override adapter Discoverable property.
+//Lock access to properties
+func (p *LEAdvertisement1Properties) Lock() {
+	p.lock.Lock()
+}
 
-			Note: This property shall not be set when Type is set
-			to broadcast.
-	*/
-	Discoverable bool
+//Unlock access to properties
+func (p *LEAdvertisement1Properties) Unlock() {
+	p.lock.Unlock()
+}
 
-	/*
-	DiscoverableTimeout The discoverable timeout in seconds. A value of zero
-			means that the timeout is disabled and it will stay in
-			discoverable/limited mode forever.
 
-			Note: This property shall not be set when Type is set
-			to broadcast.
-	*/
-	DiscoverableTimeout uint16
 
-	/*
-	LocalName Local name to be used in the advertising report. If the
-			string is too big to fit into the packet it will be
-			truncated.
 
-			If this property is available 'local-name' cannot be
-			present in the Includes.
-	*/
-	LocalName string
+// SetType set Type value
+func (a *LEAdvertisement1) SetType(v string) error {
+	return a.SetTypeContext(context.Background(), v)
+}
 
+// SetTypeContext set Type value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) SetTypeContext(ctx context.Context, v string) error {
+	if !LEAdvertisement1Type(v).Valid() {
+		return &bluez.InvalidValueError{Interface: LEAdvertisement1Interface, Member: "Type", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "Type", v)
 }
 
-//Lock access to properties
-func (p *LEAdvertisement1Properties) Lock() {
-	p.lock.Lock()
+
+
+// GetType get Type value
+func (a *LEAdvertisement1) GetType() (string, error) {
+	return a.GetTypeContext(context.Background())
 }
 
-//Unlock access to properties
-func (p *LEAdvertisement1Properties) Unlock() {
-	p.lock.Unlock()
+// GetTypeContext get Type value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) GetTypeContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Type")
+	if err != nil {
+		return "", err
+	}
+	return v.Value().(string), nil
 }
 
 
 
 
-// SetSolicitUUIDs set SolicitUUIDs value
-func (a *LEAdvertisement1) SetSolicitUUIDs(v []string) error {
-	return a.SetProperty("SolicitUUIDs", v)
+// SetServiceUUIDs set ServiceUUIDs value
+func (a *LEAdvertisement1) SetServiceUUIDs(v []string) error {
+	return a.SetServiceUUIDsContext(context.Background(), v)
 }
 
+// SetServiceUUIDsContext set ServiceUUIDs value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) SetServiceUUIDsContext(ctx context.Context, v []string) error {
+	return a.SetPropertyContext(ctx, "ServiceUUIDs", v)
+}
 
 
-// GetSolicitUUIDs get SolicitUUIDs value
-func (a *LEAdvertisement1) GetSolicitUUIDs() ([]string, error) {
-	v, err := a.GetProperty("SolicitUUIDs")
+
+// GetServiceUUIDs get ServiceUUIDs value
+func (a *LEAdvertisement1) GetServiceUUIDs() ([]string, error) {
+	return a.GetServiceUUIDsContext(context.Background())
+}
+
+// GetServiceUUIDsContext get ServiceUUIDs value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) GetServiceUUIDsContext(ctx context.Context) ([]string, error) {
+	v, err := a.GetPropertyContext(ctx, "ServiceUUIDs")
 	if err != nil {
 		return []string{}, err
 	}
@@ -218,14 +271,24 @@ func (a *LEAdvertisement1) GetSolicitUUIDs() ([]string, error) {
 
 // SetManufacturerData set ManufacturerData value
 func (a *LEAdvertisement1) SetManufacturerData(v map[string]interface{}) error {
-	return a.SetProperty("ManufacturerData", v)
+	return a.SetManufacturerDataContext(context.Background(), v)
+}
+
+// SetManufacturerDataContext set ManufacturerData value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) SetManufacturerDataContext(ctx context.Context, v map[string]interface{}) error {
+	return a.SetPropertyContext(ctx, "ManufacturerData", v)
 }
 
 
 
 // GetManufacturerData get ManufacturerData value
 func (a *LEAdvertisement1) GetManufacturerData() (map[string]interface{}, error) {
-	v, err := a.GetProperty("ManufacturerData")
+	return a.GetManufacturerDataContext(context.Background())
+}
+
+// GetManufacturerDataContext get ManufacturerData value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) GetManufacturerDataContext(ctx context.Context) (map[string]interface{}, error) {
+	v, err := a.GetPropertyContext(ctx, "ManufacturerData")
 	if err != nil {
 		return map[string]interface{}{}, err
 	}
@@ -235,39 +298,59 @@ func (a *LEAdvertisement1) GetManufacturerData() (map[string]interface{}, error)
 
 
 
-// SetType set Type value
-func (a *LEAdvertisement1) SetType(v string) error {
-	return a.SetProperty("Type", v)
+// SetSolicitUUIDs set SolicitUUIDs value
+func (a *LEAdvertisement1) SetSolicitUUIDs(v []string) error {
+	return a.SetSolicitUUIDsContext(context.Background(), v)
 }
 
+// SetSolicitUUIDsContext set SolicitUUIDs value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) SetSolicitUUIDsContext(ctx context.Context, v []string) error {
+	return a.SetPropertyContext(ctx, "SolicitUUIDs", v)
+}
 
 
-// GetType get Type value
-func (a *LEAdvertisement1) GetType() (string, error) {
-	v, err := a.GetProperty("Type")
+
+// GetSolicitUUIDs get SolicitUUIDs value
+func (a *LEAdvertisement1) GetSolicitUUIDs() ([]string, error) {
+	return a.GetSolicitUUIDsContext(context.Background())
+}
+
+// GetSolicitUUIDsContext get SolicitUUIDs value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) GetSolicitUUIDsContext(ctx context.Context) ([]string, error) {
+	v, err := a.GetPropertyContext(ctx, "SolicitUUIDs")
 	if err != nil {
-		return "", err
+		return []string{}, err
 	}
-	return v.Value().(string), nil
+	return v.Value().([]string), nil
 }
 
 
 
 
-// SetServiceUUIDs set ServiceUUIDs value
-func (a *LEAdvertisement1) SetServiceUUIDs(v []string) error {
-	return a.SetProperty("ServiceUUIDs", v)
+// SetServiceData set ServiceData value
+func (a *LEAdvertisement1) SetServiceData(v map[string]interface{}) error {
+	return a.SetServiceDataContext(context.Background(), v)
 }
 
+// SetServiceDataContext set ServiceData value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) SetServiceDataContext(ctx context.Context, v map[string]interface{}) error {
+	return a.SetPropertyContext(ctx, "ServiceData", v)
+}
 
 
-// GetServiceUUIDs get ServiceUUIDs value
-func (a *LEAdvertisement1) GetServiceUUIDs() ([]string, error) {
-	v, err := a.GetProperty("ServiceUUIDs")
+
+// GetServiceData get ServiceData value
+func (a *LEAdvertisement1) GetServiceData() (map[string]interface{}, error) {
+	return a.GetServiceDataContext(context.Background())
+}
+
+// GetServiceDataContext get ServiceData value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) GetServiceDataContext(ctx context.Context) (map[string]interface{}, error) {
+	v, err := a.GetPropertyContext(ctx, "ServiceData")
 	if err != nil {
-		return []string{}, err
+		return map[string]interface{}{}, err
 	}
-	return v.Value().([]string), nil
+	return v.Value().(map[string]interface{}), nil
 }
 
 
@@ -275,14 +358,24 @@ func (a *LEAdvertisement1) GetServiceUUIDs() ([]string, error) {
 
 // SetData set Data value
 func (a *LEAdvertisement1) SetData(v map[string]interface{}) error {
-	return a.SetProperty("Data", v)
+	return a.SetDataContext(context.Background(), v)
+}
+
+// SetDataContext set Data value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) SetDataContext(ctx context.Context, v map[string]interface{}) error {
+	return a.SetPropertyContext(ctx, "Data", v)
 }
 
 
 
 // GetData get Data value
 func (a *LEAdvertisement1) GetData() (map[string]interface{}, error) {
-	v, err := a.GetProperty("Data")
+	return a.GetDataContext(context.Background())
+}
+
+// GetDataContext get Data value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) GetDataContext(ctx context.Context) (map[string]interface{}, error) {
+	v, err := a.GetPropertyContext(ctx, "Data")
 	if err != nil {
 		return map[string]interface{}{}, err
 	}
@@ -292,54 +385,55 @@ func (a *LEAdvertisement1) GetData() (map[string]interface{}, error) {
 
 
 
-// SetIncludes set Includes value
-func (a *LEAdvertisement1) SetIncludes(v []string) error {
-	return a.SetProperty("Includes", v)
+// SetDiscoverable set Discoverable value
+func (a *LEAdvertisement1) SetDiscoverable(v bool) error {
+	return a.SetDiscoverableContext(context.Background(), v)
 }
 
-
-
-// GetIncludes get Includes value
-func (a *LEAdvertisement1) GetIncludes() ([]string, error) {
-	v, err := a.GetProperty("Includes")
-	if err != nil {
-		return []string{}, err
-	}
-	return v.Value().([]string), nil
+// SetDiscoverableContext set Discoverable value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) SetDiscoverableContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Discoverable", v)
 }
 
 
 
-
-// SetAppearance set Appearance value
-func (a *LEAdvertisement1) SetAppearance(v uint16) error {
-	return a.SetProperty("Appearance", v)
+// GetDiscoverable get Discoverable value
+func (a *LEAdvertisement1) GetDiscoverable() (bool, error) {
+	return a.GetDiscoverableContext(context.Background())
 }
 
-
-
-// GetAppearance get Appearance value
-func (a *LEAdvertisement1) GetAppearance() (uint16, error) {
-	v, err := a.GetProperty("Appearance")
+// GetDiscoverableContext get Discoverable value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) GetDiscoverableContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Discoverable")
 	if err != nil {
-		return uint16(0), err
+		return false, err
 	}
-	return v.Value().(uint16), nil
+	return v.Value().(bool), nil
 }
 
 
 
 
-// SetDuration set Duration value
-func (a *LEAdvertisement1) SetDuration(v uint16) error {
-	return a.SetProperty("Duration", v)
+// SetDiscoverableTimeout set DiscoverableTimeout value
+func (a *LEAdvertisement1) SetDiscoverableTimeout(v uint16) error {
+	return a.SetDiscoverableTimeoutContext(context.Background(), v)
 }
 
+// SetDiscoverableTimeoutContext set DiscoverableTimeout value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) SetDiscoverableTimeoutContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "DiscoverableTimeout", v)
+}
 
 
-// GetDuration get Duration value
-func (a *LEAdvertisement1) GetDuration() (uint16, error) {
-	v, err := a.GetProperty("Duration")
+
+// GetDiscoverableTimeout get DiscoverableTimeout value
+func (a *LEAdvertisement1) GetDiscoverableTimeout() (uint16, error) {
+	return a.GetDiscoverableTimeoutContext(context.Background())
+}
+
+// GetDiscoverableTimeoutContext get DiscoverableTimeout value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) GetDiscoverableTimeoutContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "DiscoverableTimeout")
 	if err != nil {
 		return uint16(0), err
 	}
@@ -349,35 +443,55 @@ func (a *LEAdvertisement1) GetDuration() (uint16, error) {
 
 
 
-// SetTimeout set Timeout value
-func (a *LEAdvertisement1) SetTimeout(v uint16) error {
-	return a.SetProperty("Timeout", v)
+// SetIncludes set Includes value
+func (a *LEAdvertisement1) SetIncludes(v []string) error {
+	return a.SetIncludesContext(context.Background(), v)
 }
 
+// SetIncludesContext set Includes value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) SetIncludesContext(ctx context.Context, v []string) error {
+	return a.SetPropertyContext(ctx, "Includes", v)
+}
 
 
-// GetTimeout get Timeout value
-func (a *LEAdvertisement1) GetTimeout() (uint16, error) {
-	v, err := a.GetProperty("Timeout")
+
+// GetIncludes get Includes value
+func (a *LEAdvertisement1) GetIncludes() ([]string, error) {
+	return a.GetIncludesContext(context.Background())
+}
+
+// GetIncludesContext get Includes value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) GetIncludesContext(ctx context.Context) ([]string, error) {
+	v, err := a.GetPropertyContext(ctx, "Includes")
 	if err != nil {
-		return uint16(0), err
+		return []string{}, err
 	}
-	return v.Value().(uint16), nil
+	return v.Value().([]string), nil
 }
 
 
 
 
-// SetSecondaryChannel set SecondaryChannel value
-func (a *LEAdvertisement1) SetSecondaryChannel(v string) error {
-	return a.SetProperty("SecondaryChannel", v)
+// SetLocalName set LocalName value
+func (a *LEAdvertisement1) SetLocalName(v string) error {
+	return a.SetLocalNameContext(context.Background(), v)
 }
 
+// SetLocalNameContext set LocalName value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) SetLocalNameContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "LocalName", v)
+}
 
 
-// GetSecondaryChannel get SecondaryChannel value
-func (a *LEAdvertisement1) GetSecondaryChannel() (string, error) {
-	v, err := a.GetProperty("SecondaryChannel")
+
+// GetLocalName get LocalName value
+func (a *LEAdvertisement1) GetLocalName() (string, error) {
+	return a.GetLocalNameContext(context.Background())
+}
+
+// GetLocalNameContext get LocalName value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) GetLocalNameContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "LocalName")
 	if err != nil {
 		return "", err
 	}
@@ -387,54 +501,84 @@ func (a *LEAdvertisement1) GetSecondaryChannel() (string, error) {
 
 
 
-// SetServiceData set ServiceData value
-func (a *LEAdvertisement1) SetServiceData(v map[string]interface{}) error {
-	return a.SetProperty("ServiceData", v)
+// SetAppearance set Appearance value
+func (a *LEAdvertisement1) SetAppearance(v uint16) error {
+	return a.SetAppearanceContext(context.Background(), v)
 }
 
+// SetAppearanceContext set Appearance value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) SetAppearanceContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "Appearance", v)
+}
 
 
-// GetServiceData get ServiceData value
-func (a *LEAdvertisement1) GetServiceData() (map[string]interface{}, error) {
-	v, err := a.GetProperty("ServiceData")
+
+// GetAppearance get Appearance value
+func (a *LEAdvertisement1) GetAppearance() (uint16, error) {
+	return a.GetAppearanceContext(context.Background())
+}
+
+// GetAppearanceContext get Appearance value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) GetAppearanceContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Appearance")
 	if err != nil {
-		return map[string]interface{}{}, err
+		return uint16(0), err
 	}
-	return v.Value().(map[string]interface{}), nil
+	return v.Value().(uint16), nil
 }
 
 
 
 
-// SetDiscoverable set Discoverable value
-func (a *LEAdvertisement1) SetDiscoverable(v bool) error {
-	return a.SetProperty("Discoverable", v)
+// SetDuration set Duration value
+func (a *LEAdvertisement1) SetDuration(v uint16) error {
+	return a.SetDurationContext(context.Background(), v)
 }
 
+// SetDurationContext set Duration value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) SetDurationContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "Duration", v)
+}
 
 
-// GetDiscoverable get Discoverable value
-func (a *LEAdvertisement1) GetDiscoverable() (bool, error) {
-	v, err := a.GetProperty("Discoverable")
+
+// GetDuration get Duration value
+func (a *LEAdvertisement1) GetDuration() (uint16, error) {
+	return a.GetDurationContext(context.Background())
+}
+
+// GetDurationContext get Duration value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) GetDurationContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Duration")
 	if err != nil {
-		return false, err
+		return uint16(0), err
 	}
-	return v.Value().(bool), nil
+	return v.Value().(uint16), nil
 }
 
 
 
 
-// SetDiscoverableTimeout set DiscoverableTimeout value
-func (a *LEAdvertisement1) SetDiscoverableTimeout(v uint16) error {
-	return a.SetProperty("DiscoverableTimeout", v)
+// SetTimeout set Timeout value
+func (a *LEAdvertisement1) SetTimeout(v uint16) error {
+	return a.SetTimeoutContext(context.Background(), v)
 }
 
+// SetTimeoutContext set Timeout value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) SetTimeoutContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "Timeout", v)
+}
 
 
-// GetDiscoverableTimeout get DiscoverableTimeout value
-func (a *LEAdvertisement1) GetDiscoverableTimeout() (uint16, error) {
-	v, err := a.GetProperty("DiscoverableTimeout")
+
+// GetTimeout get Timeout value
+func (a *LEAdvertisement1) GetTimeout() (uint16, error) {
+	return a.GetTimeoutContext(context.Background())
+}
+
+// GetTimeoutContext get Timeout value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) GetTimeoutContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Timeout")
 	if err != nil {
 		return uint16(0), err
 	}
@@ -444,16 +588,29 @@ func (a *LEAdvertisement1) GetDiscoverableTimeout() (uint16, error) {
 
 
 
-// SetLocalName set LocalName value
-func (a *LEAdvertisement1) SetLocalName(v string) error {
-	return a.SetProperty("LocalName", v)
+// SetSecondaryChannel set SecondaryChannel value
+func (a *LEAdvertisement1) SetSecondaryChannel(v string) error {
+	return a.SetSecondaryChannelContext(context.Background(), v)
 }
 
+// SetSecondaryChannelContext set SecondaryChannel value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) SetSecondaryChannelContext(ctx context.Context, v string) error {
+	if !LEAdvertisement1SecondaryChannel(v).Valid() {
+		return &bluez.InvalidValueError{Interface: LEAdvertisement1Interface, Member: "SecondaryChannel", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "SecondaryChannel", v)
+}
 
 
-// GetLocalName get LocalName value
-func (a *LEAdvertisement1) GetLocalName() (string, error) {
-	v, err := a.GetProperty("LocalName")
+
+// GetSecondaryChannel get SecondaryChannel value
+func (a *LEAdvertisement1) GetSecondaryChannel() (string, error) {
+	return a.GetSecondaryChannelContext(context.Background())
+}
+
+// GetSecondaryChannelContext get SecondaryChannel value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) GetSecondaryChannelContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "SecondaryChannel")
 	if err != nil {
 		return "", err
 	}
@@ -485,35 +642,24 @@ func (a *LEAdvertisement1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *LEAdvertisement1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *LEAdvertisement1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
-
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -543,20 +689,31 @@ func (a *LEAdvertisement1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *LEAdvertisement1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *LEAdvertisement1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *LEAdvertisement1) GetProperties() (*LEAdvertisement1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) GetPropertiesContext(ctx context.Context) (*LEAdvertisement1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -566,29 +723,40 @@ func (a *LEAdvertisement1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *LEAdvertisement1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *LEAdvertisement1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisement1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *LEAdvertisement1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *LEAdvertisement1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -598,6 +766,7 @@ func (a *LEAdvertisement1) WatchProperties() (chan *bluez.PropertyChanged, error
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *LEAdvertisement1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -614,8 +783,13 @@ Release 			This method gets called when the service daemon
 
 */
 func (a *LEAdvertisement1) Release() error {
+	return a.ReleaseContext(context.Background())
+}
+
+// ReleaseContext call Release, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *LEAdvertisement1) ReleaseContext(ctx context.Context) error {
 	
-	return a.client.Call("Release", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Release", 0, ).Store()
 	
 }
 
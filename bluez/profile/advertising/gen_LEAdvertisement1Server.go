@@ -0,0 +1,154 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package advertising
+
+
+
+import (
+   "github.com/godbus/dbus/v5"
+   "github.com/godbus/dbus/v5/introspect"
+   "github.com/godbus/dbus/v5/prop"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/bluez/profile"
+)
+
+// LEAdvertisement1Handler is implemented by the application to serve
+// org.bluez.LEAdvertisement1, see LEAdvertisement1 for the documentation of the
+// methods. Embed UnimplementedLEAdvertisement1Handler to implement only some of them.
+type LEAdvertisement1Handler interface {
+	Release() *dbus.Error
+}
+
+// UnimplementedLEAdvertisement1Handler reply org.bluez.Error.NotImplemented to
+// every method of LEAdvertisement1Handler
+type UnimplementedLEAdvertisement1Handler struct{}
+
+// Release reply org.bluez.Error.NotImplemented
+func (UnimplementedLEAdvertisement1Handler) Release() *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+var _ LEAdvertisement1Handler = UnimplementedLEAdvertisement1Handler{}
+
+// LEAdvertisement1Server export a LEAdvertisement1Handler on a connection,
+// forwarding the calls of bluez to it
+type LEAdvertisement1Server struct {
+	conn       *dbus.Conn
+	path       dbus.ObjectPath
+	handler    LEAdvertisement1Handler
+	properties *prop.Properties
+}
+
+// ExportLEAdvertisement1 export handler as org.bluez.LEAdvertisement1 at path, with
+// its introspection data and the Properties interface serving properties, nil
+// for their zero values. The Introspectable interface of path only describes
+// org.bluez.LEAdvertisement1, use Introspection to describe objects exporting more interfaces.
+func ExportLEAdvertisement1(conn *dbus.Conn, path dbus.ObjectPath, handler LEAdvertisement1Handler, properties *LEAdvertisement1Properties) (*LEAdvertisement1Server, error) {
+
+	s := &LEAdvertisement1Server{
+		conn:    conn,
+		path:    path,
+		handler: handler,
+	}
+
+	err := conn.Export(s, path, LEAdvertisement1Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	if properties == nil {
+		properties = new(LEAdvertisement1Properties)
+	}
+	propsConfig := make(map[string]*prop.Prop)
+	for name, info := range props.ParseProperties(properties) {
+		if info.Skip {
+			continue
+		}
+		p := info.Prop
+		propsConfig[name] = &p
+	}
+	s.properties = prop.New(conn, path, map[string]map[string]*prop.Prop{
+		LEAdvertisement1Interface: propsConfig,
+	})
+
+	node := &introspect.Node{
+		Interfaces: []introspect.Interface{
+			introspect.IntrospectData,
+			prop.IntrospectData,
+			s.Introspection(),
+		},
+	}
+	err = conn.Export(introspect.NewIntrospectable(node), path, bluez.Introspectable)
+	if err != nil {
+		s.Unexport()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Path return the object path of the server
+func (s *LEAdvertisement1Server) Path() dbus.ObjectPath {
+	return s.path
+}
+
+// Interface return the exported interface
+func (s *LEAdvertisement1Server) Interface() string {
+	return LEAdvertisement1Interface
+}
+
+// Handler return the handler serving the calls
+func (s *LEAdvertisement1Server) Handler() LEAdvertisement1Handler {
+	return s.handler
+}
+
+// Introspection return the introspection data of org.bluez.LEAdvertisement1
+func (s *LEAdvertisement1Server) Introspection() introspect.Interface {
+	return introspect.Interface{
+		Name:       LEAdvertisement1Interface,
+		Methods:    introspect.Methods(s),
+		Properties: s.properties.Introspection(LEAdvertisement1Interface),
+	}
+}
+
+// GetProperty return the value of a served property
+func (s *LEAdvertisement1Server) GetProperty(name string) (dbus.Variant, error) {
+	v, err := s.properties.Get(LEAdvertisement1Interface, name)
+	if err != nil {
+		return dbus.Variant{}, err
+	}
+	return v, nil
+}
+
+// SetProperty update a served property, PropertiesChanged is emitted as set
+// by its dbus tag
+func (s *LEAdvertisement1Server) SetProperty(name string, value interface{}) error {
+	_, err := s.properties.Get(LEAdvertisement1Interface, name)
+	if err != nil {
+		return err
+	}
+	s.properties.SetMust(LEAdvertisement1Interface, name, value)
+	return nil
+}
+
+// Unexport remove the object from the connection
+func (s *LEAdvertisement1Server) Unexport() error {
+	err := s.conn.Export(nil, s.path, LEAdvertisement1Interface)
+	if err != nil {
+		return err
+	}
+	err = s.conn.Export(nil, s.path, bluez.PropertiesInterface)
+	if err != nil {
+		return err
+	}
+	return s.conn.Export(nil, s.path, bluez.Introspectable)
+}
+
+// Release forward the call to the handler
+func (s *LEAdvertisement1Server) Release() *dbus.Error {
+	return s.handler.Release()
+}
+
@@ -0,0 +1,56 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package advertising
+
+
+// LEAdvertisement1Type is a value documented for LEAdvertisement1.Type
+type LEAdvertisement1Type string
+
+const (
+	LEAdvertisement1TypeBroadcast LEAdvertisement1Type = "broadcast"
+	LEAdvertisement1TypePeripheral LEAdvertisement1Type = "peripheral"
+)
+
+// LEAdvertisement1TypeValues list the documented values of LEAdvertisement1Type
+var LEAdvertisement1TypeValues = []LEAdvertisement1Type{
+	LEAdvertisement1TypeBroadcast,
+	LEAdvertisement1TypePeripheral,
+}
+
+// Valid report if v is one of the documented values
+func (v LEAdvertisement1Type) Valid() bool {
+	for _, value := range LEAdvertisement1TypeValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+
+// LEAdvertisement1SecondaryChannel is a value documented for LEAdvertisement1.SecondaryChannel
+type LEAdvertisement1SecondaryChannel string
+
+const (
+	LEAdvertisement1SecondaryChannel1M LEAdvertisement1SecondaryChannel = "1M"
+	LEAdvertisement1SecondaryChannel2M LEAdvertisement1SecondaryChannel = "2M"
+	LEAdvertisement1SecondaryChannelCoded LEAdvertisement1SecondaryChannel = "Coded"
+)
+
+// LEAdvertisement1SecondaryChannelValues list the documented values of LEAdvertisement1SecondaryChannel
+var LEAdvertisement1SecondaryChannelValues = []LEAdvertisement1SecondaryChannel{
+	LEAdvertisement1SecondaryChannel1M,
+	LEAdvertisement1SecondaryChannel2M,
+	LEAdvertisement1SecondaryChannelCoded,
+}
+
+// Valid report if v is one of the documented values
+func (v LEAdvertisement1SecondaryChannel) Valid() bool {
+	for _, value := range LEAdvertisement1SecondaryChannelValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
@@ -5,6 +5,7 @@ package advertising
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -21,6 +22,14 @@ var LEAdvertisingManager1Interface = "org.bluez.LEAdvertisingManager1"
 // Args:
 // - objectPath: /org/bluez/{hci0,hci1,...}
 func NewLEAdvertisingManager1(objectPath dbus.ObjectPath) (*LEAdvertisingManager1, error) {
+	return NewLEAdvertisingManager1WithConn(nil, objectPath)
+}
+
+// NewLEAdvertisingManager1WithConn create a new instance of LEAdvertisingManager1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: /org/bluez/{hci0,hci1,...}
+func NewLEAdvertisingManager1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*LEAdvertisingManager1, error) {
 	a := new(LEAdvertisingManager1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -28,6 +37,7 @@ func NewLEAdvertisingManager1(objectPath dbus.ObjectPath) (*LEAdvertisingManager
 			Iface: LEAdvertisingManager1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -44,6 +54,12 @@ func NewLEAdvertisingManager1(objectPath dbus.ObjectPath) (*LEAdvertisingManager
 // NewLEAdvertisingManager1FromAdapterID create a new instance of LEAdvertisingManager1
 // adapterID: ID of an adapter eg. hci0
 func NewLEAdvertisingManager1FromAdapterID(adapterID string) (*LEAdvertisingManager1, error) {
+	return NewLEAdvertisingManager1FromAdapterIDWithConn(nil, adapterID)
+}
+
+// NewLEAdvertisingManager1FromAdapterIDWithConn create a new instance of LEAdvertisingManager1 on a connection, nil for the shared system bus connection
+// adapterID: ID of an adapter eg. hci0
+func NewLEAdvertisingManager1FromAdapterIDWithConn(conn *bluez.Conn, adapterID string) (*LEAdvertisingManager1, error) {
 	a := new(LEAdvertisingManager1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -51,6 +67,7 @@ func NewLEAdvertisingManager1FromAdapterID(adapterID string) (*LEAdvertisingMana
 			Iface: LEAdvertisingManager1Interface,
 			Path:  dbus.ObjectPath(fmt.Sprintf("/org/bluez/%s", adapterID)),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -75,8 +92,7 @@ follow the API for LE Advertisement Data described above.
 */
 type LEAdvertisingManager1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*LEAdvertisingManager1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -86,17 +102,6 @@ type LEAdvertisingManager1 struct {
 type LEAdvertisingManager1Properties struct {
 	lock sync.RWMutex `dbus:"ignore"`
 
-	/*
-	SupportedSecondaryChannels List of supported Secondary channels. Secondary
-			channels can be used to advertise with the
-			corresponding PHY.
-
-			Possible values: "1M"
-					 "2M"
-					 "Coded"
-	*/
-	SupportedSecondaryChannels []string
-
 	/*
 	ActiveInstances Number of active advertising instances.
 	*/
@@ -116,6 +121,19 @@ type LEAdvertisingManager1Properties struct {
 	*/
 	SupportedIncludes []string
 
+	/*
+	SupportedSecondaryChannels List of supported Secondary channels. Secondary
+			channels can be used to advertise with the
+			corresponding PHY.
+
+			Possible values: "1M"
+					 "2M"
+					 "Coded"
+
+	Since BlueZ 5.53
+	*/
+	SupportedSecondaryChannels []string
+
 }
 
 //Lock access to properties
@@ -131,35 +149,26 @@ func (p *LEAdvertisingManager1Properties) Unlock() {
 
 
 
-// SetSupportedSecondaryChannels set SupportedSecondaryChannels value
-func (a *LEAdvertisingManager1) SetSupportedSecondaryChannels(v []string) error {
-	return a.SetProperty("SupportedSecondaryChannels", v)
-}
-
-
-
-// GetSupportedSecondaryChannels get SupportedSecondaryChannels value
-func (a *LEAdvertisingManager1) GetSupportedSecondaryChannels() ([]string, error) {
-	v, err := a.GetProperty("SupportedSecondaryChannels")
-	if err != nil {
-		return []string{}, err
-	}
-	return v.Value().([]string), nil
-}
-
-
-
-
 // SetActiveInstances set ActiveInstances value
 func (a *LEAdvertisingManager1) SetActiveInstances(v byte) error {
-	return a.SetProperty("ActiveInstances", v)
+	return a.SetActiveInstancesContext(context.Background(), v)
+}
+
+// SetActiveInstancesContext set ActiveInstances value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisingManager1) SetActiveInstancesContext(ctx context.Context, v byte) error {
+	return a.SetPropertyContext(ctx, "ActiveInstances", v)
 }
 
 
 
 // GetActiveInstances get ActiveInstances value
 func (a *LEAdvertisingManager1) GetActiveInstances() (byte, error) {
-	v, err := a.GetProperty("ActiveInstances")
+	return a.GetActiveInstancesContext(context.Background())
+}
+
+// GetActiveInstancesContext get ActiveInstances value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisingManager1) GetActiveInstancesContext(ctx context.Context) (byte, error) {
+	v, err := a.GetPropertyContext(ctx, "ActiveInstances")
 	if err != nil {
 		return byte(0), err
 	}
@@ -171,14 +180,24 @@ func (a *LEAdvertisingManager1) GetActiveInstances() (byte, error) {
 
 // SetSupportedInstances set SupportedInstances value
 func (a *LEAdvertisingManager1) SetSupportedInstances(v byte) error {
-	return a.SetProperty("SupportedInstances", v)
+	return a.SetSupportedInstancesContext(context.Background(), v)
+}
+
+// SetSupportedInstancesContext set SupportedInstances value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisingManager1) SetSupportedInstancesContext(ctx context.Context, v byte) error {
+	return a.SetPropertyContext(ctx, "SupportedInstances", v)
 }
 
 
 
 // GetSupportedInstances get SupportedInstances value
 func (a *LEAdvertisingManager1) GetSupportedInstances() (byte, error) {
-	v, err := a.GetProperty("SupportedInstances")
+	return a.GetSupportedInstancesContext(context.Background())
+}
+
+// GetSupportedInstancesContext get SupportedInstances value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisingManager1) GetSupportedInstancesContext(ctx context.Context) (byte, error) {
+	v, err := a.GetPropertyContext(ctx, "SupportedInstances")
 	if err != nil {
 		return byte(0), err
 	}
@@ -190,14 +209,63 @@ func (a *LEAdvertisingManager1) GetSupportedInstances() (byte, error) {
 
 // SetSupportedIncludes set SupportedIncludes value
 func (a *LEAdvertisingManager1) SetSupportedIncludes(v []string) error {
-	return a.SetProperty("SupportedIncludes", v)
+	return a.SetSupportedIncludesContext(context.Background(), v)
+}
+
+// SetSupportedIncludesContext set SupportedIncludes value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisingManager1) SetSupportedIncludesContext(ctx context.Context, v []string) error {
+	for _, value := range v {
+		if !LEAdvertisingManager1SupportedIncludes(value).Valid() {
+			return &bluez.InvalidValueError{Interface: LEAdvertisingManager1Interface, Member: "SupportedIncludes", Path: a.Path(), Value: value}
+		}
+	}
+	return a.SetPropertyContext(ctx, "SupportedIncludes", v)
 }
 
 
 
 // GetSupportedIncludes get SupportedIncludes value
 func (a *LEAdvertisingManager1) GetSupportedIncludes() ([]string, error) {
-	v, err := a.GetProperty("SupportedIncludes")
+	return a.GetSupportedIncludesContext(context.Background())
+}
+
+// GetSupportedIncludesContext get SupportedIncludes value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisingManager1) GetSupportedIncludesContext(ctx context.Context) ([]string, error) {
+	v, err := a.GetPropertyContext(ctx, "SupportedIncludes")
+	if err != nil {
+		return []string{}, err
+	}
+	return v.Value().([]string), nil
+}
+
+
+
+
+// SetSupportedSecondaryChannels set SupportedSecondaryChannels value
+func (a *LEAdvertisingManager1) SetSupportedSecondaryChannels(v []string) error {
+	return a.SetSupportedSecondaryChannelsContext(context.Background(), v)
+}
+
+// SetSupportedSecondaryChannelsContext set SupportedSecondaryChannels value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisingManager1) SetSupportedSecondaryChannelsContext(ctx context.Context, v []string) error {
+	for _, value := range v {
+		if !LEAdvertisingManager1SupportedSecondaryChannels(value).Valid() {
+			return &bluez.InvalidValueError{Interface: LEAdvertisingManager1Interface, Member: "SupportedSecondaryChannels", Path: a.Path(), Value: value}
+		}
+	}
+	return a.SetPropertyContext(ctx, "SupportedSecondaryChannels", v)
+}
+
+
+
+// GetSupportedSecondaryChannels get SupportedSecondaryChannels value
+func (a *LEAdvertisingManager1) GetSupportedSecondaryChannels() ([]string, error) {
+	return a.GetSupportedSecondaryChannelsContext(context.Background())
+}
+
+// GetSupportedSecondaryChannelsContext get SupportedSecondaryChannels value, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisingManager1) GetSupportedSecondaryChannelsContext(ctx context.Context) ([]string, error) {
+	v, err := a.GetPropertyContext(ctx, "SupportedSecondaryChannels")
 	if err != nil {
 		return []string{}, err
 	}
@@ -229,35 +297,24 @@ func (a *LEAdvertisingManager1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *LEAdvertisingManager1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *LEAdvertisingManager1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -287,20 +344,31 @@ func (a *LEAdvertisingManager1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *LEAdvertisingManager1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *LEAdvertisingManager1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *LEAdvertisingManager1) GetProperties() (*LEAdvertisingManager1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisingManager1) GetPropertiesContext(ctx context.Context) (*LEAdvertisingManager1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -310,29 +378,40 @@ func (a *LEAdvertisingManager1) SetProperty(name string, value interface{}) erro
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisingManager1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *LEAdvertisingManager1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *LEAdvertisingManager1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *LEAdvertisingManager1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *LEAdvertisingManager1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *LEAdvertisingManager1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -342,6 +421,7 @@ func (a *LEAdvertisingManager1) WatchProperties() (chan *bluez.PropertyChanged,
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *LEAdvertisingManager1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -370,8 +450,13 @@ RegisterAdvertisement 			Registers an advertisement object to be sent over the L
 
 */
 func (a *LEAdvertisingManager1) RegisterAdvertisement(advertisement dbus.ObjectPath, options map[string]interface{}) error {
+	return a.RegisterAdvertisementContext(context.Background(), advertisement, options)
+}
+
+// RegisterAdvertisementContext call RegisterAdvertisement, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *LEAdvertisingManager1) RegisterAdvertisementContext(ctx context.Context, advertisement dbus.ObjectPath, options map[string]interface{}) error {
 	
-	return a.client.Call("RegisterAdvertisement", 0, advertisement, options).Store()
+	return a.client.CallWithContext(ctx, "RegisterAdvertisement", 0, advertisement, options).Store()
 	
 }
 
@@ -384,8 +469,13 @@ UnregisterAdvertisement 			This unregisters an advertisement that has been
 
 */
 func (a *LEAdvertisingManager1) UnregisterAdvertisement(advertisement dbus.ObjectPath) error {
+	return a.UnregisterAdvertisementContext(context.Background(), advertisement)
+}
+
+// UnregisterAdvertisementContext call UnregisterAdvertisement, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *LEAdvertisingManager1) UnregisterAdvertisementContext(ctx context.Context, advertisement dbus.ObjectPath) error {
 	
-	return a.client.Call("UnregisterAdvertisement", 0, advertisement).Store()
+	return a.client.CallWithContext(ctx, "UnregisterAdvertisement", 0, advertisement).Store()
 	
 }
 
@@ -0,0 +1,58 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package advertising
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// LEAdvertisingManager1API is the interface of LEAdvertisingManager1, implemented by
+// FakeLEAdvertisingManager1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for LEAdvertisingManager1.
+type LEAdvertisingManager1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*LEAdvertisingManager1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*LEAdvertisingManager1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	SetActiveInstances(v byte) error
+	SetActiveInstancesContext(ctx context.Context, v byte) error
+	GetActiveInstances() (byte, error)
+	GetActiveInstancesContext(ctx context.Context) (byte, error)
+
+	SetSupportedInstances(v byte) error
+	SetSupportedInstancesContext(ctx context.Context, v byte) error
+	GetSupportedInstances() (byte, error)
+	GetSupportedInstancesContext(ctx context.Context) (byte, error)
+
+	SetSupportedIncludes(v []string) error
+	SetSupportedIncludesContext(ctx context.Context, v []string) error
+	GetSupportedIncludes() ([]string, error)
+	GetSupportedIncludesContext(ctx context.Context) ([]string, error)
+
+	SetSupportedSecondaryChannels(v []string) error
+	SetSupportedSecondaryChannelsContext(ctx context.Context, v []string) error
+	GetSupportedSecondaryChannels() ([]string, error)
+	GetSupportedSecondaryChannelsContext(ctx context.Context) ([]string, error)
+
+	RegisterAdvertisement(advertisement dbus.ObjectPath, options map[string]interface{}) error
+	RegisterAdvertisementContext(ctx context.Context, advertisement dbus.ObjectPath, options map[string]interface{}) error
+	UnregisterAdvertisement(advertisement dbus.ObjectPath) error
+	UnregisterAdvertisementContext(ctx context.Context, advertisement dbus.ObjectPath) error
+}
+
+var _ LEAdvertisingManager1API = (*LEAdvertisingManager1)(nil)
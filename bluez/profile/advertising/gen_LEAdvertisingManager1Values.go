@@ -0,0 +1,58 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package advertising
+
+
+// LEAdvertisingManager1SupportedIncludes is a value documented for LEAdvertisingManager1.SupportedIncludes
+type LEAdvertisingManager1SupportedIncludes string
+
+const (
+	LEAdvertisingManager1SupportedIncludesTxPower LEAdvertisingManager1SupportedIncludes = "tx-power"
+	LEAdvertisingManager1SupportedIncludesAppearance LEAdvertisingManager1SupportedIncludes = "appearance"
+	LEAdvertisingManager1SupportedIncludesLocalName LEAdvertisingManager1SupportedIncludes = "local-name"
+)
+
+// LEAdvertisingManager1SupportedIncludesValues list the documented values of LEAdvertisingManager1SupportedIncludes
+var LEAdvertisingManager1SupportedIncludesValues = []LEAdvertisingManager1SupportedIncludes{
+	LEAdvertisingManager1SupportedIncludesTxPower,
+	LEAdvertisingManager1SupportedIncludesAppearance,
+	LEAdvertisingManager1SupportedIncludesLocalName,
+}
+
+// Valid report if v is one of the documented values
+func (v LEAdvertisingManager1SupportedIncludes) Valid() bool {
+	for _, value := range LEAdvertisingManager1SupportedIncludesValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+
+// LEAdvertisingManager1SupportedSecondaryChannels is a value documented for LEAdvertisingManager1.SupportedSecondaryChannels
+type LEAdvertisingManager1SupportedSecondaryChannels string
+
+const (
+	LEAdvertisingManager1SupportedSecondaryChannels1M LEAdvertisingManager1SupportedSecondaryChannels = "1M"
+	LEAdvertisingManager1SupportedSecondaryChannels2M LEAdvertisingManager1SupportedSecondaryChannels = "2M"
+	LEAdvertisingManager1SupportedSecondaryChannelsCoded LEAdvertisingManager1SupportedSecondaryChannels = "Coded"
+)
+
+// LEAdvertisingManager1SupportedSecondaryChannelsValues list the documented values of LEAdvertisingManager1SupportedSecondaryChannels
+var LEAdvertisingManager1SupportedSecondaryChannelsValues = []LEAdvertisingManager1SupportedSecondaryChannels{
+	LEAdvertisingManager1SupportedSecondaryChannels1M,
+	LEAdvertisingManager1SupportedSecondaryChannels2M,
+	LEAdvertisingManager1SupportedSecondaryChannelsCoded,
+}
+
+// Valid report if v is one of the documented values
+func (v LEAdvertisingManager1SupportedSecondaryChannels) Valid() bool {
+	for _, value := range LEAdvertisingManager1SupportedSecondaryChannelsValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
@@ -3,12 +3,13 @@ package agent
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/godbus/dbus/v5"
 	"github.com/godbus/dbus/v5/introspect"
 	"github.com/muka/go-bluetooth/bluez"
 	"github.com/muka/go-bluetooth/bluez/profile/adapter"
-	log "github.com/sirupsen/logrus"
+	"github.com/muka/go-bluetooth/logger"
 )
 
 //All agent capabilities
@@ -20,6 +21,17 @@ const (
 	CapKeyboardDisplay = "KeyboardDisplay"
 )
 
+// registration is an agent exposed by ExposeAgent
+type registration struct {
+	am            *AgentManager1
+	removeRestore func()
+}
+
+var (
+	registrationsLock sync.Mutex
+	registrations     = map[dbus.ObjectPath]*registration{}
+)
+
 type Agent1Client interface {
 	Release() *dbus.Error                                                    // Callback doesn't trigger on unregister
 	RequestPinCode(device dbus.ObjectPath) (pincode string, err *dbus.Error) // Triggers for pairing when SSP is off and cap != CAP_NO_INPUT_NO_OUTPUT
@@ -37,7 +49,8 @@ type Agent1Client interface {
 // SetTrusted lookup for a device by object path and set it to trusted
 func SetTrusted(adapterID string, devicePath dbus.ObjectPath) error {
 
-	log.Tracef("Trust device %s on %s", devicePath, adapterID)
+	log := logger.WithObject(devicePath, "").WithField("adapter", adapterID)
+	log.Tracef("Trust device")
 
 	a, err := adapter.GetAdapter(adapterID)
 	if err != nil {
@@ -52,7 +65,7 @@ func SetTrusted(adapterID string, devicePath dbus.ObjectPath) error {
 	path := string(devicePath)
 	for _, dev := range devices {
 		if strings.Contains(string(dev.Path()), path) {
-			log.Tracef("SetTrusted: Trust device at %s", path)
+			log.Tracef("SetTrusted: Trust device")
 			err := dev.SetTrusted(true)
 			if err != nil {
 				return fmt.Errorf("SetTrusted error: %s", err)
@@ -68,13 +81,20 @@ func SetTrusted(adapterID string, devicePath dbus.ObjectPath) error {
 // RemoveAgent remove an Agent1 implementation from AgentManager1
 func RemoveAgent(ag Agent1Client) error {
 
-	am, err := NewAgentManager1()
-	if err != nil {
-		return fmt.Errorf("NewAgentManager1: %s", err)
+	var am *AgentManager1
+	if reg := removeRegistration(ag.Path()); reg != nil {
+		// use the connection the agent has been registered with
+		am = reg.am
+	} else {
+		var err error
+		am, err = NewAgentManager1()
+		if err != nil {
+			return fmt.Errorf("NewAgentManager1: %s", err)
+		}
 	}
 
 	// Register the exported interface as application agent via AgenManager API
-	err = am.UnregisterAgent(ag.Path())
+	err := am.UnregisterAgent(ag.Path())
 	if err != nil {
 		return fmt.Errorf("UnregisterAgent %s: %s", ag.Path(), err)
 	}
@@ -82,11 +102,26 @@ func RemoveAgent(ag Agent1Client) error {
 	return nil
 }
 
+// removeRegistration drop the registration of an agent and its restore hook
+func removeRegistration(path dbus.ObjectPath) *registration {
+	registrationsLock.Lock()
+	defer registrationsLock.Unlock()
+	reg, ok := registrations[path]
+	if !ok {
+		return nil
+	}
+	reg.removeRestore()
+	delete(registrations, path)
+	return reg
+}
+
 // ExposeAgent expose an Agent1 implementation to DBus and set as default agent
 func ExposeAgent(conn *dbus.Conn, ag Agent1Client, caps string, setAsDefaultAgent bool) error {
 
-	// Register agent
-	am, err := NewAgentManager1()
+	// Register agent on the connection exposing it, as bluez calls the
+	// agent on the bus name which registered it
+	bconn := bluez.NewConn(conn, bluez.ConnOptions{})
+	am, err := NewAgentManager1WithConn(bconn)
 	if err != nil {
 		return fmt.Errorf("NewAgentManager1: %s", err)
 	}
@@ -97,8 +132,34 @@ func ExposeAgent(conn *dbus.Conn, ag Agent1Client, caps string, setAsDefaultAgen
 		return err
 	}
 
+	err = registerAgent(am, ag, caps, setAsDefaultAgent)
+	if err != nil {
+		return err
+	}
+
+	// register again when bluetoothd restarts
+	remove, err := bluez.OnServiceRestartWithConn(bconn, func() error {
+		return registerAgent(am, ag, caps, setAsDefaultAgent)
+	})
+	if err != nil {
+		return err
+	}
+
+	removeRegistration(ag.Path())
+	registrationsLock.Lock()
+	registrations[ag.Path()] = &registration{
+		am:            am,
+		removeRestore: remove,
+	}
+	registrationsLock.Unlock()
+
+	return nil
+}
+
+func registerAgent(am *AgentManager1, ag Agent1Client, caps string, setAsDefaultAgent bool) error {
+
 	// Register the exported interface as application agent via AgenManager API
-	err = am.RegisterAgent(ag.Path(), caps)
+	err := am.RegisterAgent(ag.Path(), caps)
 	if err != nil {
 		return fmt.Errorf("RegisterAgent %s: %s", ag.Path(), err)
 	}
@@ -117,7 +178,7 @@ func ExposeAgent(conn *dbus.Conn, ag Agent1Client, caps string, setAsDefaultAgen
 //ExportAgent exports the xml of a go agent to dbus
 func exportAgent(conn *dbus.Conn, ag Agent1Client) error {
 
-	log.Tracef("Exposing Agent1 at %s", ag.Path())
+	logger.WithObject(ag.Path(), ag.Interface()).Tracef("Exposing Agent1")
 
 	//Export the given agent to the given path as interface "org.bluez.Agent1"
 	err := conn.Export(ag, ag.Path(), ag.Interface())
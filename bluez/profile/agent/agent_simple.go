@@ -5,7 +5,7 @@ import (
 
 	"github.com/godbus/dbus/v5"
 	"github.com/muka/go-bluetooth/bluez/profile/adapter"
-	log "github.com/sirupsen/logrus"
+	"github.com/muka/go-bluetooth/logger"
 )
 
 var agentInstances = 0
@@ -44,6 +44,21 @@ type SimpleAgent struct {
 	path    dbus.ObjectPath
 	pinCode string
 	passKey uint32
+	logger  logger.Logger
+}
+
+// SetLogger set the logger of the agent, nil for the default logger. Pin
+// codes and passkeys are never logged.
+func (self *SimpleAgent) SetLogger(l logger.Logger) {
+	self.logger = l
+}
+
+// log return an entry for a method call about a device
+func (self *SimpleAgent) log(method string, device dbus.ObjectPath) *logger.Entry {
+	return logger.New(self.logger).
+		WithObject(self.path, Agent1Interface).
+		WithMethod(method).
+		WithField("device", device)
 }
 
 func (self *SimpleAgent) SetPassKey(passkey uint32) {
@@ -76,55 +91,59 @@ func (self *SimpleAgent) Release() *dbus.Error {
 
 func (self *SimpleAgent) RequestPinCode(path dbus.ObjectPath) (string, *dbus.Error) {
 
-	log.Debugf("SimpleAgent: RequestPinCode: %s", path)
+	log := self.log("RequestPinCode", path)
+	log.Debugf("SimpleAgent: RequestPinCode")
 
 	adapterID, err := adapter.ParseAdapterID(path)
 	if err != nil {
-		log.Warnf("SimpleAgent.RequestPinCode: Failed to load adapter %s", err)
+		log.Warnf("SimpleAgent: Failed to load adapter %s", err)
 		return "", dbus.MakeFailedError(err)
 	}
 
 	err = SetTrusted(adapterID, path)
 	if err != nil {
-		log.Errorf("SimpleAgent.RequestPinCode SetTrusted failed: %s", err)
+		log.Errorf("SimpleAgent: SetTrusted failed: %s", err)
 		return "", dbus.MakeFailedError(err)
 	}
 
-	log.Debugf("SimpleAgent: Returning pin code: %s", self.pinCode)
+	log.Debugf("SimpleAgent: Returning pin code")
 	return self.pinCode, nil
 }
 
 func (self *SimpleAgent) DisplayPinCode(device dbus.ObjectPath, pincode string) *dbus.Error {
-	log.Info(fmt.Sprintf("SimpleAgent: DisplayPinCode (%s, %s)", device, pincode))
+	self.log("DisplayPinCode", device).Debugf("SimpleAgent: DisplayPinCode")
 	return nil
 }
 
 func (self *SimpleAgent) RequestPasskey(path dbus.ObjectPath) (uint32, *dbus.Error) {
 
+	log := self.log("RequestPasskey", path)
+
 	adapterID, err := adapter.ParseAdapterID(path)
 	if err != nil {
-		log.Warnf("SimpleAgent.RequestPassKey: Failed to load adapter %s", err)
+		log.Warnf("SimpleAgent: Failed to load adapter %s", err)
 		return 0, dbus.MakeFailedError(err)
 	}
 
 	err = SetTrusted(adapterID, path)
 	if err != nil {
-		log.Errorf("SimpleAgent.RequestPassKey: SetTrusted %s", err)
+		log.Errorf("SimpleAgent: SetTrusted failed: %s", err)
 		return 0, dbus.MakeFailedError(err)
 	}
 
-	log.Debugf("RequestPasskey: returning %d", self.passKey)
+	log.Debugf("SimpleAgent: Returning passkey")
 	return self.passKey, nil
 }
 
 func (self *SimpleAgent) DisplayPasskey(device dbus.ObjectPath, passkey uint32, entered uint16) *dbus.Error {
-	log.Debugf("SimpleAgent: DisplayPasskey %s, %06d entered %d", device, passkey, entered)
+	self.log("DisplayPasskey", device).Debugf("SimpleAgent: DisplayPasskey, entered %d", entered)
 	return nil
 }
 
 func (self *SimpleAgent) RequestConfirmation(path dbus.ObjectPath, passkey uint32) *dbus.Error {
 
-	log.Debugf("SimpleAgent: RequestConfirmation (%s, %06d)", path, passkey)
+	log := self.log("RequestConfirmation", path)
+	log.Debugf("SimpleAgent: RequestConfirmation")
 
 	adapterID, err := adapter.ParseAdapterID(path)
 	if err != nil {
@@ -134,25 +153,25 @@ func (self *SimpleAgent) RequestConfirmation(path dbus.ObjectPath, passkey uint3
 
 	err = SetTrusted(adapterID, path)
 	if err != nil {
-		log.Warnf("Failed to set trust for %s: %s", path, err)
+		log.Warnf("SimpleAgent: Failed to set trust: %s", err)
 		return dbus.MakeFailedError(err)
 	}
 
-	log.Debug("SimpleAgent: RequestConfirmation OK")
+	log.Debugf("SimpleAgent: RequestConfirmation OK")
 	return nil
 }
 
 func (self *SimpleAgent) RequestAuthorization(device dbus.ObjectPath) *dbus.Error {
-	log.Debugf("SimpleAgent: RequestAuthorization (%s)", device)
+	self.log("RequestAuthorization", device).Debugf("SimpleAgent: RequestAuthorization")
 	return nil
 }
 
 func (self *SimpleAgent) AuthorizeService(device dbus.ObjectPath, uuid string) *dbus.Error {
-	log.Debugf("SimpleAgent: AuthorizeService (%s, %s)", device, uuid) // directly authorized
+	self.log("AuthorizeService", device).Debugf("SimpleAgent: AuthorizeService %s", uuid) // directly authorized
 	return nil
 }
 
 func (self *SimpleAgent) Cancel() *dbus.Error {
-	log.Debugf("SimpleAgent: Cancel")
+	logger.New(self.logger).WithObject(self.path, Agent1Interface).WithMethod("Cancel").Debugf("SimpleAgent: Cancel")
 	return nil
 }
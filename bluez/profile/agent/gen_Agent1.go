@@ -5,6 +5,7 @@ package agent
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/godbus/dbus/v5"
@@ -19,6 +20,15 @@ var Agent1Interface = "org.bluez.Agent1"
 // - servicePath: unique name
 // - objectPath: freely definable
 func NewAgent1(servicePath string, objectPath dbus.ObjectPath) (*Agent1, error) {
+	return NewAgent1WithConn(nil, servicePath, objectPath)
+}
+
+// NewAgent1WithConn create a new instance of Agent1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - servicePath: unique name
+// - objectPath: freely definable
+func NewAgent1WithConn(conn *bluez.Conn, servicePath string, objectPath dbus.ObjectPath) (*Agent1, error) {
 	a := new(Agent1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -26,6 +36,7 @@ func NewAgent1(servicePath string, objectPath dbus.ObjectPath) (*Agent1, error)
 			Iface: Agent1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -39,8 +50,7 @@ Agent1 Agent hierarchy
 */
 type Agent1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*Agent1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -85,35 +95,24 @@ func (a *Agent1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *Agent1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *Agent1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -128,8 +127,13 @@ Release 			This method gets called when the service daemon
 
 */
 func (a *Agent1) Release() error {
+	return a.ReleaseContext(context.Background())
+}
+
+// ReleaseContext call Release, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Agent1) ReleaseContext(ctx context.Context) error {
 	
-	return a.client.Call("Release", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Release", 0, ).Store()
 	
 }
 
@@ -143,9 +147,14 @@ RequestPinCode 			This method gets called when the service daemon
 
 */
 func (a *Agent1) RequestPinCode(device dbus.ObjectPath) (string, error) {
+	return a.RequestPinCodeContext(context.Background(), device)
+}
+
+// RequestPinCodeContext call RequestPinCode, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Agent1) RequestPinCodeContext(ctx context.Context, device dbus.ObjectPath) (string, error) {
 	
 	var val0 string
-	err := a.client.Call("RequestPinCode", 0, device).Store(&val0)
+	err := a.client.CallWithContext(ctx, "RequestPinCode", 0, device).Store(&val0)
 	return val0, err	
 }
 
@@ -169,8 +178,13 @@ DisplayPinCode 			This method gets called when the service daemon
 
 */
 func (a *Agent1) DisplayPinCode(device dbus.ObjectPath, pincode string) error {
+	return a.DisplayPinCodeContext(context.Background(), device, pincode)
+}
+
+// DisplayPinCodeContext call DisplayPinCode, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Agent1) DisplayPinCodeContext(ctx context.Context, device dbus.ObjectPath, pincode string) error {
 	
-	return a.client.Call("DisplayPinCode", 0, device, pincode).Store()
+	return a.client.CallWithContext(ctx, "DisplayPinCode", 0, device, pincode).Store()
 	
 }
 
@@ -184,9 +198,14 @@ RequestPasskey 			This method gets called when the service daemon
 
 */
 func (a *Agent1) RequestPasskey(device dbus.ObjectPath) (uint32, error) {
+	return a.RequestPasskeyContext(context.Background(), device)
+}
+
+// RequestPasskeyContext call RequestPasskey, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Agent1) RequestPasskeyContext(ctx context.Context, device dbus.ObjectPath) (uint32, error) {
 	
 	var val0 uint32
-	err := a.client.Call("RequestPasskey", 0, device).Store(&val0)
+	err := a.client.CallWithContext(ctx, "RequestPasskey", 0, device).Store(&val0)
 	return val0, err	
 }
 
@@ -206,8 +225,13 @@ DisplayPasskey 			This method gets called when the service daemon
 
 */
 func (a *Agent1) DisplayPasskey(device dbus.ObjectPath, passkey uint32, entered uint16) error {
+	return a.DisplayPasskeyContext(context.Background(), device, passkey, entered)
+}
+
+// DisplayPasskeyContext call DisplayPasskey, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Agent1) DisplayPasskeyContext(ctx context.Context, device dbus.ObjectPath, passkey uint32, entered uint16) error {
 	
-	return a.client.Call("DisplayPasskey", 0, device, passkey, entered).Store()
+	return a.client.CallWithContext(ctx, "DisplayPasskey", 0, device, passkey, entered).Store()
 	
 }
 
@@ -224,8 +248,13 @@ RequestConfirmation 			This method gets called when the service daemon
 
 */
 func (a *Agent1) RequestConfirmation(device dbus.ObjectPath, passkey uint32) error {
+	return a.RequestConfirmationContext(context.Background(), device, passkey)
+}
+
+// RequestConfirmationContext call RequestConfirmation, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Agent1) RequestConfirmationContext(ctx context.Context, device dbus.ObjectPath, passkey uint32) error {
 	
-	return a.client.Call("RequestConfirmation", 0, device, passkey).Store()
+	return a.client.CallWithContext(ctx, "RequestConfirmation", 0, device, passkey).Store()
 	
 }
 
@@ -242,8 +271,13 @@ RequestAuthorization 			This method gets called to request the user to
 
 */
 func (a *Agent1) RequestAuthorization(device dbus.ObjectPath) error {
+	return a.RequestAuthorizationContext(context.Background(), device)
+}
+
+// RequestAuthorizationContext call RequestAuthorization, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Agent1) RequestAuthorizationContext(ctx context.Context, device dbus.ObjectPath) error {
 	
-	return a.client.Call("RequestAuthorization", 0, device).Store()
+	return a.client.CallWithContext(ctx, "RequestAuthorization", 0, device).Store()
 	
 }
 
@@ -255,8 +289,13 @@ AuthorizeService 			This method gets called when the service daemon
 
 */
 func (a *Agent1) AuthorizeService(device dbus.ObjectPath, uuid string) error {
+	return a.AuthorizeServiceContext(context.Background(), device, uuid)
+}
+
+// AuthorizeServiceContext call AuthorizeService, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Agent1) AuthorizeServiceContext(ctx context.Context, device dbus.ObjectPath, uuid string) error {
 	
-	return a.client.Call("AuthorizeService", 0, device, uuid).Store()
+	return a.client.CallWithContext(ctx, "AuthorizeService", 0, device, uuid).Store()
 	
 }
 
@@ -266,8 +305,13 @@ Cancel 			This method gets called to indicate that the agent
 
 */
 func (a *Agent1) Cancel() error {
+	return a.CancelContext(context.Background())
+}
+
+// CancelContext call Cancel, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Agent1) CancelContext(ctx context.Context) error {
 	
-	return a.client.Call("Cancel", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Cancel", 0, ).Store()
 	
 }
 
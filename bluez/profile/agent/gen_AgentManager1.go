@@ -5,6 +5,7 @@ package agent
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/godbus/dbus/v5"
@@ -18,6 +19,14 @@ var AgentManager1Interface = "org.bluez.AgentManager1"
 // Args:
 
 func NewAgentManager1() (*AgentManager1, error) {
+	return NewAgentManager1WithConn(nil)
+}
+
+// NewAgentManager1WithConn create a new instance of AgentManager1 on a connection, nil for the shared system bus connection
+//
+// Args:
+
+func NewAgentManager1WithConn(conn *bluez.Conn) (*AgentManager1, error) {
 	a := new(AgentManager1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -25,6 +34,7 @@ func NewAgentManager1() (*AgentManager1, error) {
 			Iface: AgentManager1Interface,
 			Path:  dbus.ObjectPath("/org/bluez"),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -38,8 +48,7 @@ AgentManager1 Agent Manager hierarchy
 */
 type AgentManager1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*AgentManager1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -84,35 +93,24 @@ func (a *AgentManager1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *AgentManager1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *AgentManager1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -145,8 +143,13 @@ RegisterAgent 			This registers an agent handler.
 
 */
 func (a *AgentManager1) RegisterAgent(agent dbus.ObjectPath, capability string) error {
+	return a.RegisterAgentContext(context.Background(), agent, capability)
+}
+
+// RegisterAgentContext call RegisterAgent, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *AgentManager1) RegisterAgentContext(ctx context.Context, agent dbus.ObjectPath, capability string) error {
 	
-	return a.client.Call("RegisterAgent", 0, agent, capability).Store()
+	return a.client.CallWithContext(ctx, "RegisterAgent", 0, agent, capability).Store()
 	
 }
 
@@ -158,8 +161,13 @@ UnregisterAgent 			This unregisters the agent that has been previously
 
 */
 func (a *AgentManager1) UnregisterAgent(agent dbus.ObjectPath) error {
+	return a.UnregisterAgentContext(context.Background(), agent)
+}
+
+// UnregisterAgentContext call UnregisterAgent, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *AgentManager1) UnregisterAgentContext(ctx context.Context, agent dbus.ObjectPath) error {
 	
-	return a.client.Call("UnregisterAgent", 0, agent).Store()
+	return a.client.CallWithContext(ctx, "UnregisterAgent", 0, agent).Store()
 	
 }
 
@@ -173,8 +181,13 @@ RequestDefaultAgent 			This requests is to make the application agent
 
 */
 func (a *AgentManager1) RequestDefaultAgent(agent dbus.ObjectPath) error {
+	return a.RequestDefaultAgentContext(context.Background(), agent)
+}
+
+// RequestDefaultAgentContext call RequestDefaultAgent, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *AgentManager1) RequestDefaultAgentContext(ctx context.Context, agent dbus.ObjectPath) error {
 	
-	return a.client.Call("RequestDefaultAgent", 0, agent).Store()
+	return a.client.CallWithContext(ctx, "RequestDefaultAgent", 0, agent).Store()
 	
 }
 
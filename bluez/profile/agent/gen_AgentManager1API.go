@@ -0,0 +1,29 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package agent
+
+
+import (
+   "context"
+   "github.com/godbus/dbus/v5"
+)
+
+// AgentManager1API is the interface of AgentManager1, implemented by
+// FakeAgentManager1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for AgentManager1.
+type AgentManager1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+
+	RegisterAgent(agent dbus.ObjectPath, capability string) error
+	RegisterAgentContext(ctx context.Context, agent dbus.ObjectPath, capability string) error
+	UnregisterAgent(agent dbus.ObjectPath) error
+	UnregisterAgentContext(ctx context.Context, agent dbus.ObjectPath) error
+	RequestDefaultAgent(agent dbus.ObjectPath) error
+	RequestDefaultAgentContext(ctx context.Context, agent dbus.ObjectPath) error
+}
+
+var _ AgentManager1API = (*AgentManager1)(nil)
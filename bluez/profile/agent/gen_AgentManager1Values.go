@@ -0,0 +1,35 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package agent
+
+
+// AgentManager1RegisterAgentCapability is a value documented for the capability argument of AgentManager1.RegisterAgent
+type AgentManager1RegisterAgentCapability string
+
+const (
+	AgentManager1RegisterAgentCapabilityDisplayOnly AgentManager1RegisterAgentCapability = "DisplayOnly"
+	AgentManager1RegisterAgentCapabilityDisplayYesNo AgentManager1RegisterAgentCapability = "DisplayYesNo"
+	AgentManager1RegisterAgentCapabilityKeyboardOnly AgentManager1RegisterAgentCapability = "KeyboardOnly"
+	AgentManager1RegisterAgentCapabilityNoInputNoOutput AgentManager1RegisterAgentCapability = "NoInputNoOutput"
+	AgentManager1RegisterAgentCapabilityKeyboardDisplay AgentManager1RegisterAgentCapability = "KeyboardDisplay"
+)
+
+// AgentManager1RegisterAgentCapabilityValues list the documented values of AgentManager1RegisterAgentCapability
+var AgentManager1RegisterAgentCapabilityValues = []AgentManager1RegisterAgentCapability{
+	AgentManager1RegisterAgentCapabilityDisplayOnly,
+	AgentManager1RegisterAgentCapabilityDisplayYesNo,
+	AgentManager1RegisterAgentCapabilityKeyboardOnly,
+	AgentManager1RegisterAgentCapabilityNoInputNoOutput,
+	AgentManager1RegisterAgentCapabilityKeyboardDisplay,
+}
+
+// Valid report if v is one of the documented values
+func (v AgentManager1RegisterAgentCapability) Valid() bool {
+	for _, value := range AgentManager1RegisterAgentCapabilityValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
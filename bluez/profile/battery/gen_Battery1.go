@@ -5,6 +5,7 @@ package battery
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var Battery1Interface = "org.bluez.Battery1"
 // Args:
 // - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX
 func NewBattery1(objectPath dbus.ObjectPath) (*Battery1, error) {
+	return NewBattery1WithConn(nil, objectPath)
+}
+
+// NewBattery1WithConn create a new instance of Battery1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX
+func NewBattery1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*Battery1, error) {
 	a := new(Battery1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewBattery1(objectPath dbus.ObjectPath) (*Battery1, error) {
 			Iface: Battery1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -47,8 +57,7 @@ Battery1 Battery hierarchy
 */
 type Battery1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*Battery1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -80,14 +89,24 @@ func (p *Battery1Properties) Unlock() {
 
 // SetPercentage set Percentage value
 func (a *Battery1) SetPercentage(v byte) error {
-	return a.SetProperty("Percentage", v)
+	return a.SetPercentageContext(context.Background(), v)
+}
+
+// SetPercentageContext set Percentage value, failing with a bluez.ContextError if ctx is done
+func (a *Battery1) SetPercentageContext(ctx context.Context, v byte) error {
+	return a.SetPropertyContext(ctx, "Percentage", v)
 }
 
 
 
 // GetPercentage get Percentage value
 func (a *Battery1) GetPercentage() (byte, error) {
-	v, err := a.GetProperty("Percentage")
+	return a.GetPercentageContext(context.Background())
+}
+
+// GetPercentageContext get Percentage value, failing with a bluez.ContextError if ctx is done
+func (a *Battery1) GetPercentageContext(ctx context.Context) (byte, error) {
+	v, err := a.GetPropertyContext(ctx, "Percentage")
 	if err != nil {
 		return byte(0), err
 	}
@@ -119,35 +138,24 @@ func (a *Battery1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *Battery1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *Battery1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -177,20 +185,31 @@ func (a *Battery1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Battery1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Battery1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *Battery1) GetProperties() (*Battery1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *Battery1) GetPropertiesContext(ctx context.Context) (*Battery1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -200,29 +219,40 @@ func (a *Battery1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *Battery1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *Battery1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *Battery1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *Battery1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *Battery1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *Battery1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -232,6 +262,7 @@ func (a *Battery1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *Battery1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -0,0 +1,39 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package battery
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// Battery1API is the interface of Battery1, implemented by
+// FakeBattery1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for Battery1.
+type Battery1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*Battery1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*Battery1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	SetPercentage(v byte) error
+	SetPercentageContext(ctx context.Context, v byte) error
+	GetPercentage() (byte, error)
+	GetPercentageContext(ctx context.Context) (byte, error)
+
+}
+
+var _ Battery1API = (*Battery1)(nil)
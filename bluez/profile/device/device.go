@@ -10,6 +10,11 @@ import (
 	"github.com/muka/go-bluetooth/bluez/profile/gatt"
 )
 
+// ErrCharacteristicNotFound is returned by GetCharByUUID and GetCharsByUUID if
+// the device exposes no characteristic with the UUID, eg. as the services
+// are not resolved yet
+var ErrCharacteristicNotFound = errors.New("characteristic not found")
+
 func NewDevice(adapterID string, address string) (*Device1, error) {
 	path := fmt.Sprintf("%s/%s/dev_%s", bluez.OrgBluezPath, adapterID, strings.Replace(address, ":", "_", -1))
 	return NewDevice1(dbus.ObjectPath(path))
@@ -18,95 +23,40 @@ func NewDevice(adapterID string, address string) (*Device1, error) {
 // GetCharacteristicsList return device characteristics object path list
 func (d *Device1) GetCharacteristicsList() ([]dbus.ObjectPath, error) {
 
-	var chars []dbus.ObjectPath
-
-	om, err := bluez.GetObjectManager()
-	if err != nil {
-		return nil, err
-	}
-
-	list, err := om.GetManagedObjects()
+	cache, err := bluez.GetObjectCacheWithConn(d.Client().Conn())
 	if err != nil {
 		return nil, err
 	}
 
-	for path := range list {
-
-		spath := string(path)
-
-		// log.Debugf("%s=%s", string(d.Path()), spath)
-
-		if !strings.HasPrefix(spath, string(d.Path())) {
-			continue
-		}
-
-		charPos := strings.Index(spath, "char")
-		if charPos == -1 {
-			continue
-		}
-
-		if strings.Contains(spath[charPos:], "desc") {
-			continue
-		}
-
-		chars = append(chars, path)
-	}
-
-	return chars, nil
+	return cache.GetByInterface(gatt.GattCharacteristic1Interface, d.Path()), nil
 }
 
 // GetDescriptorList returns all descriptors
 func (d *Device1) GetDescriptorList() ([]dbus.ObjectPath, error) {
-	var descr []dbus.ObjectPath
 
-	om, err := bluez.GetObjectManager()
+	cache, err := bluez.GetObjectCacheWithConn(d.Client().Conn())
 	if err != nil {
 		return nil, err
 	}
 
-	list, err := om.GetManagedObjects()
-	if err != nil {
-		return nil, err
-	}
-	for path := range list {
-
-		spath := string(path)
-
-		if !strings.HasPrefix(spath, string(d.Path())) {
-			continue
-		}
-
-		charPos := strings.Index(spath, "char")
-		if charPos == -1 {
-			continue
-		}
-
-		if strings.Contains(spath[charPos:], "desc") {
-			continue
-		}
-
-		descr = append(descr, path)
-	}
-
-	return descr, nil
+	return cache.GetByInterface(gatt.GattDescriptor1Interface, d.Path()), nil
 }
 
 //GetDescriptors returns all descriptors for a given characteristic
 func (d *Device1) GetDescriptors(char *gatt.GattCharacteristic1) ([]*gatt.GattDescriptor1, error) {
-	descrPaths, err := d.GetDescriptorList()
+
+	cache, err := bluez.GetObjectCacheWithConn(d.Client().Conn())
 	if err != nil {
 		return nil, err
 	}
 
 	descrFound := []*gatt.GattDescriptor1{}
-	for _, path := range descrPaths {
-		descr, err := gatt.NewGattDescriptor1(path)
+	for _, path := range cache.GetByInterface(gatt.GattDescriptor1Interface, char.Path()) {
+		descr, err := gatt.NewGattDescriptor1WithConn(d.Client().Conn(), path)
 		if err != nil {
 			return nil, err
 		}
-		if char.Path() == descr.Properties.Characteristic {
-			descrFound = append(descrFound, descr)
-		}
+		descrFound = append(descrFound, descr)
 	}
 
 	if len(descrFound) == 0 {
@@ -127,7 +77,7 @@ func (d *Device1) GetCharacteristics() ([]*gatt.GattCharacteristic1, error) {
 	chars := []*gatt.GattCharacteristic1{}
 	for _, path := range list {
 
-		char, err := gatt.NewGattCharacteristic1(path)
+		char, err := gatt.NewGattCharacteristic1WithConn(d.Client().Conn(), path)
 		if err != nil {
 			return nil, err
 		}
@@ -151,7 +101,7 @@ func (d *Device1) GetAllServicesAndUUID() ([]string, error) {
 	var uuidAndService string
 	for _, path := range list {
 
-		char, err := gatt.NewGattCharacteristic1(path)
+		char, err := gatt.NewGattCharacteristic1WithConn(d.Client().Conn(), path)
 		if err != nil {
 			return nil, err
 		}
@@ -179,31 +129,24 @@ func (d *Device1) GetCharByUUID(uuid string) (*gatt.GattCharacteristic1, error)
 
 // GetCharsByUUID returns all characteristics that match the given UUID.
 func (d *Device1) GetCharsByUUID(uuid string) ([]*gatt.GattCharacteristic1, error) {
-	uuid = strings.ToUpper(uuid)
 
-	list, err := d.GetCharacteristicsList()
+	cache, err := bluez.GetObjectCacheWithConn(d.Client().Conn())
 	if err != nil {
 		return nil, err
 	}
 
 	charsFound := []*gatt.GattCharacteristic1{}
 
-	for _, path := range list {
-
-		char, err := gatt.NewGattCharacteristic1(path)
+	for _, path := range cache.GetCharacteristicsByUUID(uuid, d.Path()) {
+		char, err := gatt.NewGattCharacteristic1WithConn(d.Client().Conn(), path)
 		if err != nil {
 			return nil, err
 		}
-
-		cuuid := strings.ToUpper(char.Properties.UUID)
-
-		if cuuid == uuid {
-			charsFound = append(charsFound, char)
-		}
+		charsFound = append(charsFound, char)
 	}
 
 	if len(charsFound) == 0 {
-		return nil, errors.New("characteristic not found")
+		return nil, ErrCharacteristicNotFound
 	}
 
 	return charsFound, nil
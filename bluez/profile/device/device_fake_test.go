@@ -0,0 +1,74 @@
+package device
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDevicePath = dbus.ObjectPath("/org/bluez/hci0/dev_00_11_22_33_44_55")
+
+// connect is an example of code depending on Device1API
+func connect(dev Device1API) error {
+	connected, err := dev.GetConnected()
+	if err != nil || connected {
+		return err
+	}
+	return dev.Connect()
+}
+
+func TestFakeDevice1(t *testing.T) {
+
+	dev := NewFakeDevice1(testDevicePath, &Device1Properties{
+		Address: "00:11:22:33:44:55",
+		Name:    "fake",
+	})
+	defer dev.Close()
+
+	assert.Equal(t, testDevicePath, dev.Path())
+	assert.Equal(t, Device1Interface, dev.Interface())
+
+	name, err := dev.GetName()
+	assert.NoError(t, err)
+	assert.Equal(t, "fake", name)
+
+	props, err := dev.GetProperties()
+	assert.NoError(t, err)
+	assert.Equal(t, "00:11:22:33:44:55", props.Address)
+
+	watch, err := dev.WatchProperties()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// methods succeed unless a Func is set
+	dev.ConnectFunc = func(ctx context.Context) error {
+		return dev.SetProperty("Connected", true)
+	}
+	assert.NoError(t, connect(dev))
+
+	select {
+	case change := <-watch:
+		assert.Equal(t, "Connected", change.Name)
+		assert.Equal(t, true, change.Value)
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for property change")
+	}
+
+	connected, err := dev.GetConnected()
+	assert.NoError(t, err)
+	assert.True(t, connected)
+
+	dev.PairFunc = func(ctx context.Context) error {
+		return bluez.ErrAuthenticationFailed
+	}
+	assert.True(t, errors.Is(dev.Pair(), bluez.ErrAuthenticationFailed))
+	assert.NoError(t, dev.CancelPairing())
+
+	assert.NoError(t, dev.UnwatchProperties(watch))
+}
@@ -0,0 +1,396 @@
+package device
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+)
+
+// Priority of a queued operation, operations with a higher priority run
+// first, in order of submission for the same priority
+type Priority int
+
+const (
+	// PriorityLow eg. background reads
+	PriorityLow Priority = -10
+	// PriorityNormal GATT reads and writes
+	PriorityNormal Priority = 0
+	// PriorityHigh connect, disconnect and pair
+	PriorityHigh Priority = 10
+)
+
+var (
+	// DefaultOperationTimeout is the maximum time an operation waits in the
+	// queue and runs, retries included
+	DefaultOperationTimeout = 30 * time.Second
+	// DefaultOperationRetries is the number of retries of an operation
+	// failing with a transient error
+	DefaultOperationRetries = 5
+	// DefaultRetryBackoff is the wait before the first retry, doubled on each
+	// attempt up to DefaultMaxRetryBackoff
+	DefaultRetryBackoff = 100 * time.Millisecond
+	// DefaultMaxRetryBackoff is the maximum wait between two retries
+	DefaultMaxRetryBackoff = 2 * time.Second
+)
+
+// IsTransientError report if err is a bluez error worth retrying later,
+// org.bluez.Error.InProgress or org.bluez.Error.NotReady
+func IsTransientError(err error) bool {
+	return errors.Is(err, bluez.ErrInProgress) || errors.Is(err, bluez.ErrNotReady)
+}
+
+// QueueOptions configure an OperationQueue, zero values use the defaults
+type QueueOptions struct {
+	// Timeout of an operation, from submission to completion
+	Timeout time.Duration
+	// Retries of an operation failing with a transient error, -1 to disable
+	Retries int
+	// Backoff is the wait before the first retry, doubled on each attempt
+	Backoff time.Duration
+	// MaxBackoff is the maximum wait between two retries
+	MaxBackoff time.Duration
+	// Retryable report if an error is transient, defaults to IsTransientError
+	Retryable func(err error) bool
+}
+
+func (o QueueOptions) withDefaults() QueueOptions {
+	if o.Timeout <= 0 {
+		o.Timeout = DefaultOperationTimeout
+	}
+	if o.Retries == 0 {
+		o.Retries = DefaultOperationRetries
+	}
+	if o.Retries < 0 {
+		o.Retries = 0
+	}
+	if o.Backoff <= 0 {
+		o.Backoff = DefaultRetryBackoff
+	}
+	if o.MaxBackoff <= 0 {
+		o.MaxBackoff = DefaultMaxRetryBackoff
+	}
+	if o.Retryable == nil {
+		o.Retryable = IsTransientError
+	}
+	return o
+}
+
+// Operation is a call, or a sequence of calls, run by an OperationQueue
+type Operation struct {
+	// Name of the operation, eg. the method called
+	Name string
+	// Priority of the operation in the queue
+	Priority Priority
+	// Retryable overrides QueueOptions.Retryable for this operation
+	Retryable func(err error) bool
+	// Run the operation, ctx expires with the operation timeout
+	Run func(ctx context.Context) error
+}
+
+// OperationQueue serialize the operations on a device. bluez rejects
+// concurrent operations on the same device with org.bluez.Error.InProgress,
+// eg. Connect during Pair or overlapping reads of a characteristic. Operations
+// run one at a time by priority and are retried with backoff when they fail
+// with a transient error.
+type OperationQueue struct {
+	device *Device1
+	key    queueKey
+}
+
+// queueState is the scheduling state shared by the queues of a device. It is
+// dropped once no operation is waiting or running, unless options are set.
+type queueState struct {
+	lock    sync.Mutex
+	options QueueOptions
+	pending operationHeap
+	running bool
+	seq     uint64
+
+	// guarded by queuesLock
+	users  int
+	pinned bool
+}
+
+type queueKey struct {
+	conn *bluez.Conn
+	path dbus.ObjectPath
+}
+
+var (
+	queuesLock sync.Mutex
+	queues     = make(map[queueKey]*queueState)
+)
+
+// Queue return the operation queue of the device, shared by all the Device1
+// instances with the same path and connection
+func (d *Device1) Queue() *OperationQueue {
+	return &OperationQueue{
+		device: d,
+		key:    queueKey{d.Client().Conn(), d.Path()},
+	}
+}
+
+// state return the state of the queue, creating it if missing
+func (q *OperationQueue) state() *queueState {
+	state, ok := queues[q.key]
+	if !ok {
+		state = &queueState{
+			options: QueueOptions{}.withDefaults(),
+		}
+		queues[q.key] = state
+	}
+	return state
+}
+
+// acquire return the state of the queue, kept until release is called
+func (q *OperationQueue) acquire() *queueState {
+	queuesLock.Lock()
+	defer queuesLock.Unlock()
+	state := q.state()
+	state.users++
+	return state
+}
+
+// release the state returned by acquire, dropping it once unused
+func (q *OperationQueue) release(state *queueState) {
+	queuesLock.Lock()
+	defer queuesLock.Unlock()
+	state.users--
+	if state.users == 0 && !state.pinned && queues[q.key] == state {
+		delete(queues, q.key)
+	}
+}
+
+// SetOptions change the options of the queue of the device, applied to the
+// operations submitted afterwards
+func (q *OperationQueue) SetOptions(options QueueOptions) {
+	queuesLock.Lock()
+	state := q.state()
+	state.pinned = true
+	queuesLock.Unlock()
+
+	state.lock.Lock()
+	defer state.lock.Unlock()
+	state.options = options.withDefaults()
+}
+
+// Depth return the number of operations waiting or running
+func (q *OperationQueue) Depth() int {
+	queuesLock.Lock()
+	state, ok := queues[q.key]
+	queuesLock.Unlock()
+	if !ok {
+		return 0
+	}
+
+	state.lock.Lock()
+	defer state.lock.Unlock()
+	depth := state.pending.Len()
+	if state.running {
+		depth++
+	}
+	return depth
+}
+
+// Do run op once the operations submitted before with the same or a higher
+// priority have completed, and wait for its result. A *bluez.ContextError is
+// returned if ctx is done or the timeout expires before op starts.
+func (q *OperationQueue) Do(ctx context.Context, op Operation) error {
+
+	state := q.acquire()
+	defer q.release(state)
+
+	state.lock.Lock()
+	options := state.options
+	state.lock.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, options.Timeout)
+	defer cancel()
+
+	item := &queuedOperation{
+		priority: op.Priority,
+		start:    make(chan struct{}),
+	}
+
+	state.lock.Lock()
+	if !state.running {
+		state.running = true
+		state.lock.Unlock()
+	} else {
+		state.seq++
+		item.seq = state.seq
+		heap.Push(&state.pending, item)
+		state.lock.Unlock()
+
+		select {
+		case <-item.start:
+		case <-ctx.Done():
+			state.lock.Lock()
+			waiting := item.index >= 0
+			if waiting {
+				heap.Remove(&state.pending, item.index)
+			}
+			state.lock.Unlock()
+			if !waiting {
+				// started meanwhile, pass the turn
+				state.next()
+			}
+			return &bluez.ContextError{
+				Method: op.Name,
+				Err:    ctx.Err(),
+			}
+		}
+	}
+	defer state.next()
+
+	return q.run(ctx, op, options)
+}
+
+// run op, retrying transient errors until the retries are exhausted or ctx is done
+func (q *OperationQueue) run(ctx context.Context, op Operation, options QueueOptions) error {
+
+	retryable := op.Retryable
+	if retryable == nil {
+		retryable = options.Retryable
+	}
+
+	backoff := options.Backoff
+	for attempt := 0; ; attempt++ {
+
+		err := op.Run(ctx)
+		if err == nil || attempt >= options.Retries || ctx.Err() != nil || !retryable(err) {
+			return err
+		}
+
+		q.device.Client().Logger().WithMethod(op.Name).Debugf("Retry %d in %s: %s", attempt+1, backoff, err)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		}
+
+		backoff *= 2
+		if backoff > options.MaxBackoff {
+			backoff = options.MaxBackoff
+		}
+	}
+}
+
+// next start the next waiting operation, if any
+func (q *queueState) next() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.pending.Len() == 0 {
+		q.running = false
+		return
+	}
+	item := heap.Pop(&q.pending).(*queuedOperation)
+	close(item.start)
+}
+
+// Connect the device
+func (q *OperationQueue) Connect(ctx context.Context) error {
+	return q.Do(ctx, Operation{
+		Name:     "Connect",
+		Priority: PriorityHigh,
+		Run:      q.device.ConnectContext,
+	})
+}
+
+// Disconnect the device
+func (q *OperationQueue) Disconnect(ctx context.Context) error {
+	return q.Do(ctx, Operation{
+		Name:     "Disconnect",
+		Priority: PriorityHigh,
+		Run:      q.device.DisconnectContext,
+	})
+}
+
+// Pair with the device
+func (q *OperationQueue) Pair(ctx context.Context) error {
+	return q.Do(ctx, Operation{
+		Name:     "Pair",
+		Priority: PriorityHigh,
+		Run:      q.device.PairContext,
+	})
+}
+
+// ReadValue read the value of a characteristic of the device
+func (q *OperationQueue) ReadValue(ctx context.Context, char *gatt.GattCharacteristic1, options map[string]interface{}) ([]byte, error) {
+	var value []byte
+	err := q.Do(ctx, Operation{
+		Name:     "ReadValue",
+		Priority: PriorityNormal,
+		Run: func(ctx context.Context) (err error) {
+			value, err = char.ReadValueContext(ctx, options)
+			return err
+		},
+	})
+	return value, err
+}
+
+// WriteValue write the value of a characteristic of the device
+func (q *OperationQueue) WriteValue(ctx context.Context, char *gatt.GattCharacteristic1, value []byte, options map[string]interface{}) error {
+	return q.Do(ctx, Operation{
+		Name:     "WriteValue",
+		Priority: PriorityNormal,
+		Run: func(ctx context.Context) error {
+			return char.WriteValueContext(ctx, value, options)
+		},
+	})
+}
+
+// queuedOperation is an operation waiting its turn
+type queuedOperation struct {
+	priority Priority
+	seq      uint64
+	// start is closed when the operation is removed from the queue to run
+	start chan struct{}
+	// index in the heap, -1 once removed
+	index int
+}
+
+// operationHeap order the operations by priority then submission
+type operationHeap []*queuedOperation
+
+func (h operationHeap) Len() int {
+	return len(h)
+}
+
+func (h operationHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h operationHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *operationHeap) Push(x interface{}) {
+	item := x.(*queuedOperation)
+	item.index = len(*h)
+	*h = append(*h, item)
+}
+
+func (h *operationHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	item.index = -1
+	*h = old[:n-1]
+	return item
+}
@@ -0,0 +1,70 @@
+package device
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/stretchr/testify/assert"
+)
+
+func hasQueueState(q *OperationQueue) bool {
+	queuesLock.Lock()
+	defer queuesLock.Unlock()
+	_, ok := queues[q.key]
+	return ok
+}
+
+func TestDeviceQueueState(t *testing.T) {
+
+	d := &Device1{
+		client: bluez.NewClient(&bluez.Config{
+			Name:  bluez.OrgBluezInterface,
+			Iface: Device1Interface,
+			Path:  testDevicePath,
+		}),
+	}
+	q := d.Queue()
+	assert.False(t, hasQueueState(q))
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- q.Do(context.Background(), Operation{
+			Name: "Test",
+			Run: func(ctx context.Context) error {
+				close(started)
+				<-release
+				return nil
+			},
+		})
+	}()
+
+	<-started
+	assert.True(t, hasQueueState(q))
+	assert.Equal(t, 1, d.Queue().Depth())
+
+	// the state is dropped once the queue is idle
+	close(release)
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for the operation")
+	}
+	assert.False(t, hasQueueState(q))
+	assert.Equal(t, 0, q.Depth())
+
+	// unless options are set
+	q.SetOptions(QueueOptions{Retries: -1})
+	err := q.Do(context.Background(), Operation{
+		Name: "Test",
+		Run: func(ctx context.Context) error {
+			return nil
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, hasQueueState(q))
+}
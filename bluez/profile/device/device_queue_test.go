@@ -0,0 +1,221 @@
+package device_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/mock"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeviceQueue(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	devPath, charPath := mock.AddTestDevice(t, b)
+
+	// like bluez, reject overlapping operations on the device
+	var running, overlaps int32
+	b.OnCall(func(call mock.Call) error {
+		if !strings.HasPrefix(string(call.Path), string(devPath)) || call.Interface == bluez.PropertiesInterface {
+			return nil
+		}
+		if atomic.AddInt32(&running, 1) > 1 {
+			atomic.AddInt32(&overlaps, 1)
+		}
+		defer atomic.AddInt32(&running, -1)
+		time.Sleep(mock.Tick)
+		return nil
+	})
+
+	dev, err := device.NewDevice1(devPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	char, err := gatt.NewGattCharacteristic1(charPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// queues are shared by the instances of a device
+	dev2, err := device.NewDevice1(devPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := dev.Queue()
+	q2 := dev2.Queue()
+
+	ctx := context.Background()
+	errs := make(chan error, 6)
+	var wg sync.WaitGroup
+	run := func(fn func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- fn()
+		}()
+	}
+
+	run(func() error { return q.Connect(ctx) })
+	run(func() error { return q2.Pair(ctx) })
+	for i := 0; i < 3; i++ {
+		queue := q
+		if i%2 == 1 {
+			queue = q2
+		}
+		run(func() error {
+			_, err := queue.ReadValue(ctx, char, nil)
+			return err
+		})
+	}
+	run(func() error { return q.WriteValue(ctx, char, []byte{4}, nil) })
+
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(0), atomic.LoadInt32(&overlaps))
+	assert.Equal(t, 0, q.Depth())
+}
+
+func TestDeviceQueueRetry(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	devPath, _ := mock.AddTestDevice(t, b)
+	dev, err := device.NewDevice1(devPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	q := dev.Queue()
+	q.SetOptions(device.QueueOptions{
+		Backoff: mock.Tick,
+	})
+	defer q.SetOptions(device.QueueOptions{})
+
+	countCalls := func(method string) int {
+		count := 0
+		for _, call := range b.Calls() {
+			if call.Path == devPath && call.Method == method {
+				count++
+			}
+		}
+		return count
+	}
+
+	// transient errors are retried
+	b.InjectError(devPath, device.Device1Interface, "Connect", mock.Error("InProgress"), 2)
+	err = q.Connect(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, countCalls("Connect"))
+
+	// other errors are not
+	b.InjectError(devPath, device.Device1Interface, "Pair", mock.Error("AuthenticationFailed"), 1)
+	err = q.Pair(context.Background())
+	assert.True(t, errors.Is(err, bluez.ErrAuthenticationFailed))
+	assert.Equal(t, 1, countCalls("Pair"))
+
+	// retries can be disabled
+	q.SetOptions(device.QueueOptions{
+		Retries: -1,
+	})
+	b.InjectError(devPath, device.Device1Interface, "Disconnect", mock.Error("NotReady"), 1)
+	err = q.Disconnect(context.Background())
+	assert.True(t, errors.Is(err, bluez.ErrNotReady))
+	assert.Equal(t, 1, countCalls("Disconnect"))
+}
+
+func TestDeviceQueuePriority(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	devPath, _ := mock.AddTestDevice(t, b)
+	dev, err := device.NewDevice1(devPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := dev.Queue()
+	ctx := context.Background()
+
+	// hold the queue
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- q.Do(ctx, device.Operation{
+			Name: "hold",
+			Run: func(ctx context.Context) error {
+				<-release
+				return nil
+			},
+		})
+	}()
+	assert.Eventually(t, func() bool {
+		return q.Depth() == 1
+	}, mock.WaitFor, mock.Tick)
+
+	var lock sync.Mutex
+	order := []string{}
+	submit := func(name string, priority device.Priority) {
+		go func() {
+			done <- q.Do(ctx, device.Operation{
+				Name:     name,
+				Priority: priority,
+				Run: func(ctx context.Context) error {
+					lock.Lock()
+					order = append(order, name)
+					lock.Unlock()
+					return nil
+				},
+			})
+		}()
+	}
+
+	submit("low", device.PriorityLow)
+	assert.Eventually(t, func() bool { return q.Depth() == 2 }, mock.WaitFor, mock.Tick)
+	submit("normal1", device.PriorityNormal)
+	assert.Eventually(t, func() bool { return q.Depth() == 3 }, mock.WaitFor, mock.Tick)
+	submit("high", device.PriorityHigh)
+	assert.Eventually(t, func() bool { return q.Depth() == 4 }, mock.WaitFor, mock.Tick)
+	submit("normal2", device.PriorityNormal)
+	assert.Eventually(t, func() bool { return q.Depth() == 5 }, mock.WaitFor, mock.Tick)
+
+	// an operation expiring while waiting leaves the queue
+	timeoutCtx, cancel := context.WithTimeout(ctx, mock.Tick)
+	defer cancel()
+	err = q.Do(timeoutCtx, device.Operation{
+		Name: "expired",
+		Run: func(ctx context.Context) error {
+			t.Error("expired operation should not run")
+			return nil
+		},
+	})
+	var ctxErr *bluez.ContextError
+	if assert.True(t, errors.As(err, &ctxErr)) {
+		assert.True(t, ctxErr.Timeout())
+		assert.Equal(t, "expired", ctxErr.Method)
+	}
+	assert.Equal(t, 5, q.Depth())
+
+	close(release)
+	for i := 0; i < 5; i++ {
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(mock.WaitFor):
+			t.Fatal("Timeout waiting for operations")
+		}
+	}
+
+	assert.Equal(t, []string{"high", "normal1", "normal2", "low"}, order)
+	assert.Equal(t, 0, q.Depth())
+}
@@ -5,6 +5,7 @@ package device
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var Device1Interface = "org.bluez.Device1"
 // Args:
 // - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX
 func NewDevice1(objectPath dbus.ObjectPath) (*Device1, error) {
+	return NewDevice1WithConn(nil, objectPath)
+}
+
+// NewDevice1WithConn create a new instance of Device1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX
+func NewDevice1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*Device1, error) {
 	a := new(Device1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewDevice1(objectPath dbus.ObjectPath) (*Device1, error) {
 			Iface: Device1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -47,8 +57,7 @@ Device1 Device hierarchy
 */
 type Device1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*Device1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -64,46 +73,18 @@ type Device1Properties struct {
 	Address string
 
 	/*
-	UUIDs List of 128-bit UUIDs that represents the available
-			remote services.
-	*/
-	UUIDs []string
-
-	/*
-	Blocked If set to true any incoming connections from the
-			device will be immediately rejected. Any device
-			drivers will also be removed and no new ones will
-			be probed as long as the device is blocked.
-	*/
-	Blocked bool
-
-	/*
-	Adapter The object path of the adapter the device belongs to.
-	*/
-	Adapter dbus.ObjectPath
-
-	/*
-	Modalias Remote Device ID information in modalias format
-			used by the kernel and udev.
-	*/
-	Modalias string
-
-	/*
-	AdvertisingData The Advertising Data of the remote device. Keys are
-			are 8 bits AD Type followed by data as byte array.
-
-			Note: Only types considered safe to be handled by
-			application are exposed.
+	AddressType The Bluetooth device Address Type. For dual-mode and
+			BR/EDR only devices this defaults to "public". Single
+			mode LE devices may have either value. If remote device
+			uses privacy than before pairing this represents address
+			type used for connection and Identity Address after
+			pairing.
 
 			Possible values:
-				<type> <byte array>
-				...
-
-			Example:
-				<Transport Discovery> <Organization Flags...>
-				0x26                   0x01         0x01...
+				"public" - Public address
+				"random" - Random address
 	*/
-	AdvertisingData map[string]interface{}
+	AddressType string
 
 	/*
 	Name The Bluetooth remote name. This value can not be
@@ -125,29 +106,32 @@ type Device1Properties struct {
 	Icon string
 
 	/*
-	Alias The name alias for the remote device. The alias can
-			be used to have a different friendly name for the
-			remote device.
+	Class The Bluetooth class of device of the remote device.
+	*/
+	Class uint32
 
-			In case no alias is set, it will return the remote
-			device name. Setting an empty string as alias will
-			convert it back to the remote device name.
+	/*
+	Appearance External appearance of device, as found on GAP service.
+	*/
+	Appearance uint16
 
-			When resetting the alias with an empty string, the
-			property will default back to the remote name.
+	/*
+	UUIDs List of 128-bit UUIDs that represents the available
+			remote services.
 	*/
-	Alias string
+	UUIDs []string
 
 	/*
-	ServiceData Service advertisement data. Keys are the UUIDs in
-			string format followed by its byte array value.
+	Paired Indicates if the remote device is paired.
 	*/
-	ServiceData map[string]interface{}
+	Paired bool
 
 	/*
-	Appearance External appearance of device, as found on GAP service.
+	Connected Indicates if the remote device is currently connected.
+			A PropertiesChanged signal indicate changes to this
+			status.
 	*/
-	Appearance uint16
+	Connected bool
 
 	/*
 	Trusted Indicates if the remote is seen as trusted. This
@@ -155,6 +139,33 @@ type Device1Properties struct {
 	*/
 	Trusted bool
 
+	/*
+	Blocked If set to true any incoming connections from the
+			device will be immediately rejected. Any device
+			drivers will also be removed and no new ones will
+			be probed as long as the device is blocked.
+	*/
+	Blocked bool
+
+	/*
+	Alias The name alias for the remote device. The alias can
+			be used to have a different friendly name for the
+			remote device.
+
+			In case no alias is set, it will return the remote
+			device name. Setting an empty string as alias will
+			convert it back to the remote device name.
+
+			When resetting the alias with an empty string, the
+			property will default back to the remote name.
+	*/
+	Alias string
+
+	/*
+	Adapter The object path of the adapter the device belongs to.
+	*/
+	Adapter dbus.ObjectPath
+
 	/*
 	LegacyPairing Set to true if the device only supports the pre-2.1
 			pairing mechanism. This property is useful during
@@ -168,15 +179,22 @@ type Device1Properties struct {
 	LegacyPairing bool
 
 	/*
-	ServicesResolved Indicate whether or not service discovery has been
-			resolved.
+	Modalias Remote Device ID information in modalias format
+			used by the kernel and udev.
 	*/
-	ServicesResolved bool
+	Modalias string
 
 	/*
-	AdvertisingFlags The Advertising Data Flags of the remote device.
+	RSSI Received Signal Strength Indicator of the remote
+			device (inquiry or advertising).
 	*/
-	AdvertisingFlags []byte
+	RSSI int16
+
+	/*
+	TxPower Advertised transmitted power level (inquiry or
+			advertising).
+	*/
+	TxPower int16
 
 	/*
 	ManufacturerData Manufacturer specific advertisement data. Keys are
@@ -186,47 +204,38 @@ type Device1Properties struct {
 	ManufacturerData map[uint16]interface{}
 
 	/*
-	AddressType The Bluetooth device Address Type. For dual-mode and
-			BR/EDR only devices this defaults to "public". Single
-			mode LE devices may have either value. If remote device
-			uses privacy than before pairing this represents address
-			type used for connection and Identity Address after
-			pairing.
-
-			Possible values:
-				"public" - Public address
-				"random" - Random address
+	ServiceData Service advertisement data. Keys are the UUIDs in
+			string format followed by its byte array value.
 	*/
-	AddressType string
+	ServiceData map[string]interface{}
 
 	/*
-	Class The Bluetooth class of device of the remote device.
+	ServicesResolved Indicate whether or not service discovery has been
+			resolved.
 	*/
-	Class uint32
+	ServicesResolved bool
 
 	/*
-	Paired Indicates if the remote device is paired.
+	AdvertisingFlags The Advertising Data Flags of the remote device.
 	*/
-	Paired bool
+	AdvertisingFlags []byte
 
 	/*
-	Connected Indicates if the remote device is currently connected.
-			A PropertiesChanged signal indicate changes to this
-			status.
-	*/
-	Connected bool
+	AdvertisingData The Advertising Data of the remote device. Keys are
+			are 8 bits AD Type followed by data as byte array.
 
-	/*
-	RSSI Received Signal Strength Indicator of the remote
-			device (inquiry or advertising).
-	*/
-	RSSI int16
+			Note: Only types considered safe to be handled by
+			application are exposed.
 
-	/*
-	TxPower Advertised transmitted power level (inquiry or
-			advertising).
+			Possible values:
+				<type> <byte array>
+				...
+
+			Example:
+				<Transport Discovery> <Organization Flags...>
+				0x26                   0x01         0x01...
 	*/
-	TxPower int16
+	AdvertisingData map[byte]interface{}
 
 }
 
@@ -245,14 +254,24 @@ func (p *Device1Properties) Unlock() {
 
 // SetAddress set Address value
 func (a *Device1) SetAddress(v string) error {
-	return a.SetProperty("Address", v)
+	return a.SetAddressContext(context.Background(), v)
+}
+
+// SetAddressContext set Address value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetAddressContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Address", v)
 }
 
 
 
 // GetAddress get Address value
 func (a *Device1) GetAddress() (string, error) {
-	v, err := a.GetProperty("Address")
+	return a.GetAddressContext(context.Background())
+}
+
+// GetAddressContext get Address value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetAddressContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Address")
 	if err != nil {
 		return "", err
 	}
@@ -262,134 +281,294 @@ func (a *Device1) GetAddress() (string, error) {
 
 
 
-// SetUUIDs set UUIDs value
-func (a *Device1) SetUUIDs(v []string) error {
-	return a.SetProperty("UUIDs", v)
+// SetAddressType set AddressType value
+func (a *Device1) SetAddressType(v string) error {
+	return a.SetAddressTypeContext(context.Background(), v)
 }
 
+// SetAddressTypeContext set AddressType value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetAddressTypeContext(ctx context.Context, v string) error {
+	if !Device1AddressType(v).Valid() {
+		return &bluez.InvalidValueError{Interface: Device1Interface, Member: "AddressType", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "AddressType", v)
+}
 
 
-// GetUUIDs get UUIDs value
-func (a *Device1) GetUUIDs() ([]string, error) {
-	v, err := a.GetProperty("UUIDs")
+
+// GetAddressType get AddressType value
+func (a *Device1) GetAddressType() (string, error) {
+	return a.GetAddressTypeContext(context.Background())
+}
+
+// GetAddressTypeContext get AddressType value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetAddressTypeContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "AddressType")
 	if err != nil {
-		return []string{}, err
+		return "", err
 	}
-	return v.Value().([]string), nil
+	return v.Value().(string), nil
 }
 
 
 
 
-// SetBlocked set Blocked value
-func (a *Device1) SetBlocked(v bool) error {
-	return a.SetProperty("Blocked", v)
+// SetName set Name value
+func (a *Device1) SetName(v string) error {
+	return a.SetNameContext(context.Background(), v)
 }
 
+// SetNameContext set Name value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetNameContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Name", v)
+}
 
 
-// GetBlocked get Blocked value
-func (a *Device1) GetBlocked() (bool, error) {
-	v, err := a.GetProperty("Blocked")
+
+// GetName get Name value
+func (a *Device1) GetName() (string, error) {
+	return a.GetNameContext(context.Background())
+}
+
+// GetNameContext get Name value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetNameContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Name")
 	if err != nil {
-		return false, err
+		return "", err
 	}
-	return v.Value().(bool), nil
+	return v.Value().(string), nil
 }
 
 
 
 
-// SetAdapter set Adapter value
-func (a *Device1) SetAdapter(v dbus.ObjectPath) error {
-	return a.SetProperty("Adapter", v)
+// SetIcon set Icon value
+func (a *Device1) SetIcon(v string) error {
+	return a.SetIconContext(context.Background(), v)
 }
 
+// SetIconContext set Icon value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetIconContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Icon", v)
+}
 
 
-// GetAdapter get Adapter value
-func (a *Device1) GetAdapter() (dbus.ObjectPath, error) {
-	v, err := a.GetProperty("Adapter")
+
+// GetIcon get Icon value
+func (a *Device1) GetIcon() (string, error) {
+	return a.GetIconContext(context.Background())
+}
+
+// GetIconContext get Icon value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetIconContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Icon")
 	if err != nil {
-		return dbus.ObjectPath(""), err
+		return "", err
 	}
-	return v.Value().(dbus.ObjectPath), nil
+	return v.Value().(string), nil
+}
+
+
+
+
+// SetClass set Class value
+func (a *Device1) SetClass(v uint32) error {
+	return a.SetClassContext(context.Background(), v)
+}
+
+// SetClassContext set Class value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetClassContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "Class", v)
+}
+
+
+
+// GetClass get Class value
+func (a *Device1) GetClass() (uint32, error) {
+	return a.GetClassContext(context.Background())
+}
+
+// GetClassContext get Class value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetClassContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "Class")
+	if err != nil {
+		return uint32(0), err
+	}
+	return v.Value().(uint32), nil
+}
+
+
+
+
+// SetAppearance set Appearance value
+func (a *Device1) SetAppearance(v uint16) error {
+	return a.SetAppearanceContext(context.Background(), v)
+}
+
+// SetAppearanceContext set Appearance value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetAppearanceContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "Appearance", v)
+}
+
+
+
+// GetAppearance get Appearance value
+func (a *Device1) GetAppearance() (uint16, error) {
+	return a.GetAppearanceContext(context.Background())
+}
+
+// GetAppearanceContext get Appearance value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetAppearanceContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Appearance")
+	if err != nil {
+		return uint16(0), err
+	}
+	return v.Value().(uint16), nil
+}
+
+
+
+
+// SetUUIDs set UUIDs value
+func (a *Device1) SetUUIDs(v []string) error {
+	return a.SetUUIDsContext(context.Background(), v)
+}
+
+// SetUUIDsContext set UUIDs value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetUUIDsContext(ctx context.Context, v []string) error {
+	return a.SetPropertyContext(ctx, "UUIDs", v)
+}
+
+
+
+// GetUUIDs get UUIDs value
+func (a *Device1) GetUUIDs() ([]string, error) {
+	return a.GetUUIDsContext(context.Background())
+}
+
+// GetUUIDsContext get UUIDs value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetUUIDsContext(ctx context.Context) ([]string, error) {
+	v, err := a.GetPropertyContext(ctx, "UUIDs")
+	if err != nil {
+		return []string{}, err
+	}
+	return v.Value().([]string), nil
+}
+
+
+
+
+// SetPaired set Paired value
+func (a *Device1) SetPaired(v bool) error {
+	return a.SetPairedContext(context.Background(), v)
 }
 
+// SetPairedContext set Paired value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetPairedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Paired", v)
+}
 
 
 
-// SetModalias set Modalias value
-func (a *Device1) SetModalias(v string) error {
-	return a.SetProperty("Modalias", v)
+// GetPaired get Paired value
+func (a *Device1) GetPaired() (bool, error) {
+	return a.GetPairedContext(context.Background())
 }
 
-
-
-// GetModalias get Modalias value
-func (a *Device1) GetModalias() (string, error) {
-	v, err := a.GetProperty("Modalias")
+// GetPairedContext get Paired value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetPairedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Paired")
 	if err != nil {
-		return "", err
+		return false, err
 	}
-	return v.Value().(string), nil
+	return v.Value().(bool), nil
 }
 
 
 
 
-// SetAdvertisingData set AdvertisingData value
-func (a *Device1) SetAdvertisingData(v map[string]interface{}) error {
-	return a.SetProperty("AdvertisingData", v)
+// SetConnected set Connected value
+func (a *Device1) SetConnected(v bool) error {
+	return a.SetConnectedContext(context.Background(), v)
 }
 
+// SetConnectedContext set Connected value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetConnectedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Connected", v)
+}
 
 
-// GetAdvertisingData get AdvertisingData value
-func (a *Device1) GetAdvertisingData() (map[string]interface{}, error) {
-	v, err := a.GetProperty("AdvertisingData")
+
+// GetConnected get Connected value
+func (a *Device1) GetConnected() (bool, error) {
+	return a.GetConnectedContext(context.Background())
+}
+
+// GetConnectedContext get Connected value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetConnectedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Connected")
 	if err != nil {
-		return map[string]interface{}{}, err
+		return false, err
 	}
-	return v.Value().(map[string]interface{}), nil
+	return v.Value().(bool), nil
 }
 
 
 
 
-// SetName set Name value
-func (a *Device1) SetName(v string) error {
-	return a.SetProperty("Name", v)
+// SetTrusted set Trusted value
+func (a *Device1) SetTrusted(v bool) error {
+	return a.SetTrustedContext(context.Background(), v)
 }
 
+// SetTrustedContext set Trusted value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetTrustedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Trusted", v)
+}
 
 
-// GetName get Name value
-func (a *Device1) GetName() (string, error) {
-	v, err := a.GetProperty("Name")
+
+// GetTrusted get Trusted value
+func (a *Device1) GetTrusted() (bool, error) {
+	return a.GetTrustedContext(context.Background())
+}
+
+// GetTrustedContext get Trusted value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetTrustedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Trusted")
 	if err != nil {
-		return "", err
+		return false, err
 	}
-	return v.Value().(string), nil
+	return v.Value().(bool), nil
 }
 
 
 
 
-// SetIcon set Icon value
-func (a *Device1) SetIcon(v string) error {
-	return a.SetProperty("Icon", v)
+// SetBlocked set Blocked value
+func (a *Device1) SetBlocked(v bool) error {
+	return a.SetBlockedContext(context.Background(), v)
 }
 
+// SetBlockedContext set Blocked value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetBlockedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Blocked", v)
+}
 
 
-// GetIcon get Icon value
-func (a *Device1) GetIcon() (string, error) {
-	v, err := a.GetProperty("Icon")
+
+// GetBlocked get Blocked value
+func (a *Device1) GetBlocked() (bool, error) {
+	return a.GetBlockedContext(context.Background())
+}
+
+// GetBlockedContext get Blocked value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetBlockedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Blocked")
 	if err != nil {
-		return "", err
+		return false, err
 	}
-	return v.Value().(string), nil
+	return v.Value().(bool), nil
 }
 
 
@@ -397,14 +576,24 @@ func (a *Device1) GetIcon() (string, error) {
 
 // SetAlias set Alias value
 func (a *Device1) SetAlias(v string) error {
-	return a.SetProperty("Alias", v)
+	return a.SetAliasContext(context.Background(), v)
+}
+
+// SetAliasContext set Alias value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetAliasContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Alias", v)
 }
 
 
 
 // GetAlias get Alias value
 func (a *Device1) GetAlias() (string, error) {
-	v, err := a.GetProperty("Alias")
+	return a.GetAliasContext(context.Background())
+}
+
+// GetAliasContext get Alias value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetAliasContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Alias")
 	if err != nil {
 		return "", err
 	}
@@ -414,54 +603,55 @@ func (a *Device1) GetAlias() (string, error) {
 
 
 
-// SetServiceData set ServiceData value
-func (a *Device1) SetServiceData(v map[string]interface{}) error {
-	return a.SetProperty("ServiceData", v)
+// SetAdapter set Adapter value
+func (a *Device1) SetAdapter(v dbus.ObjectPath) error {
+	return a.SetAdapterContext(context.Background(), v)
 }
 
-
-
-// GetServiceData get ServiceData value
-func (a *Device1) GetServiceData() (map[string]interface{}, error) {
-	v, err := a.GetProperty("ServiceData")
-	if err != nil {
-		return map[string]interface{}{}, err
-	}
-	return v.Value().(map[string]interface{}), nil
+// SetAdapterContext set Adapter value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetAdapterContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Adapter", v)
 }
 
 
 
-
-// SetAppearance set Appearance value
-func (a *Device1) SetAppearance(v uint16) error {
-	return a.SetProperty("Appearance", v)
+// GetAdapter get Adapter value
+func (a *Device1) GetAdapter() (dbus.ObjectPath, error) {
+	return a.GetAdapterContext(context.Background())
 }
 
-
-
-// GetAppearance get Appearance value
-func (a *Device1) GetAppearance() (uint16, error) {
-	v, err := a.GetProperty("Appearance")
+// GetAdapterContext get Adapter value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetAdapterContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Adapter")
 	if err != nil {
-		return uint16(0), err
+		return dbus.ObjectPath(""), err
 	}
-	return v.Value().(uint16), nil
+	return v.Value().(dbus.ObjectPath), nil
 }
 
 
 
 
-// SetTrusted set Trusted value
-func (a *Device1) SetTrusted(v bool) error {
-	return a.SetProperty("Trusted", v)
+// SetLegacyPairing set LegacyPairing value
+func (a *Device1) SetLegacyPairing(v bool) error {
+	return a.SetLegacyPairingContext(context.Background(), v)
 }
 
+// SetLegacyPairingContext set LegacyPairing value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetLegacyPairingContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "LegacyPairing", v)
+}
 
 
-// GetTrusted get Trusted value
-func (a *Device1) GetTrusted() (bool, error) {
-	v, err := a.GetProperty("Trusted")
+
+// GetLegacyPairing get LegacyPairing value
+func (a *Device1) GetLegacyPairing() (bool, error) {
+	return a.GetLegacyPairingContext(context.Background())
+}
+
+// GetLegacyPairingContext get LegacyPairing value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetLegacyPairingContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "LegacyPairing")
 	if err != nil {
 		return false, err
 	}
@@ -471,58 +661,88 @@ func (a *Device1) GetTrusted() (bool, error) {
 
 
 
-// SetLegacyPairing set LegacyPairing value
-func (a *Device1) SetLegacyPairing(v bool) error {
-	return a.SetProperty("LegacyPairing", v)
+// SetModalias set Modalias value
+func (a *Device1) SetModalias(v string) error {
+	return a.SetModaliasContext(context.Background(), v)
 }
 
+// SetModaliasContext set Modalias value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetModaliasContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Modalias", v)
+}
 
 
-// GetLegacyPairing get LegacyPairing value
-func (a *Device1) GetLegacyPairing() (bool, error) {
-	v, err := a.GetProperty("LegacyPairing")
+
+// GetModalias get Modalias value
+func (a *Device1) GetModalias() (string, error) {
+	return a.GetModaliasContext(context.Background())
+}
+
+// GetModaliasContext get Modalias value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetModaliasContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Modalias")
 	if err != nil {
-		return false, err
+		return "", err
 	}
-	return v.Value().(bool), nil
+	return v.Value().(string), nil
 }
 
 
 
 
-// SetServicesResolved set ServicesResolved value
-func (a *Device1) SetServicesResolved(v bool) error {
-	return a.SetProperty("ServicesResolved", v)
+// SetRSSI set RSSI value
+func (a *Device1) SetRSSI(v int16) error {
+	return a.SetRSSIContext(context.Background(), v)
 }
 
+// SetRSSIContext set RSSI value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetRSSIContext(ctx context.Context, v int16) error {
+	return a.SetPropertyContext(ctx, "RSSI", v)
+}
 
 
-// GetServicesResolved get ServicesResolved value
-func (a *Device1) GetServicesResolved() (bool, error) {
-	v, err := a.GetProperty("ServicesResolved")
+
+// GetRSSI get RSSI value
+func (a *Device1) GetRSSI() (int16, error) {
+	return a.GetRSSIContext(context.Background())
+}
+
+// GetRSSIContext get RSSI value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetRSSIContext(ctx context.Context) (int16, error) {
+	v, err := a.GetPropertyContext(ctx, "RSSI")
 	if err != nil {
-		return false, err
+		return int16(0), err
 	}
-	return v.Value().(bool), nil
+	return v.Value().(int16), nil
 }
 
 
 
 
-// SetAdvertisingFlags set AdvertisingFlags value
-func (a *Device1) SetAdvertisingFlags(v []byte) error {
-	return a.SetProperty("AdvertisingFlags", v)
+// SetTxPower set TxPower value
+func (a *Device1) SetTxPower(v int16) error {
+	return a.SetTxPowerContext(context.Background(), v)
 }
 
+// SetTxPowerContext set TxPower value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetTxPowerContext(ctx context.Context, v int16) error {
+	return a.SetPropertyContext(ctx, "TxPower", v)
+}
 
 
-// GetAdvertisingFlags get AdvertisingFlags value
-func (a *Device1) GetAdvertisingFlags() ([]byte, error) {
-	v, err := a.GetProperty("AdvertisingFlags")
+
+// GetTxPower get TxPower value
+func (a *Device1) GetTxPower() (int16, error) {
+	return a.GetTxPowerContext(context.Background())
+}
+
+// GetTxPowerContext get TxPower value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetTxPowerContext(ctx context.Context) (int16, error) {
+	v, err := a.GetPropertyContext(ctx, "TxPower")
 	if err != nil {
-		return []byte{}, err
+		return int16(0), err
 	}
-	return v.Value().([]byte), nil
+	return v.Value().(int16), nil
 }
 
 
@@ -530,14 +750,24 @@ func (a *Device1) GetAdvertisingFlags() ([]byte, error) {
 
 // SetManufacturerData set ManufacturerData value
 func (a *Device1) SetManufacturerData(v map[string]interface{}) error {
-	return a.SetProperty("ManufacturerData", v)
+	return a.SetManufacturerDataContext(context.Background(), v)
+}
+
+// SetManufacturerDataContext set ManufacturerData value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetManufacturerDataContext(ctx context.Context, v map[string]interface{}) error {
+	return a.SetPropertyContext(ctx, "ManufacturerData", v)
 }
 
 
 
 // GetManufacturerData get ManufacturerData value
 func (a *Device1) GetManufacturerData() (map[string]interface{}, error) {
-	v, err := a.GetProperty("ManufacturerData")
+	return a.GetManufacturerDataContext(context.Background())
+}
+
+// GetManufacturerDataContext get ManufacturerData value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetManufacturerDataContext(ctx context.Context) (map[string]interface{}, error) {
+	v, err := a.GetPropertyContext(ctx, "ManufacturerData")
 	if err != nil {
 		return map[string]interface{}{}, err
 	}
@@ -547,73 +777,55 @@ func (a *Device1) GetManufacturerData() (map[string]interface{}, error) {
 
 
 
-// SetAddressType set AddressType value
-func (a *Device1) SetAddressType(v string) error {
-	return a.SetProperty("AddressType", v)
+// SetServiceData set ServiceData value
+func (a *Device1) SetServiceData(v map[string]interface{}) error {
+	return a.SetServiceDataContext(context.Background(), v)
 }
 
-
-
-// GetAddressType get AddressType value
-func (a *Device1) GetAddressType() (string, error) {
-	v, err := a.GetProperty("AddressType")
-	if err != nil {
-		return "", err
-	}
-	return v.Value().(string), nil
+// SetServiceDataContext set ServiceData value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetServiceDataContext(ctx context.Context, v map[string]interface{}) error {
+	return a.SetPropertyContext(ctx, "ServiceData", v)
 }
 
 
 
-
-// SetClass set Class value
-func (a *Device1) SetClass(v uint32) error {
-	return a.SetProperty("Class", v)
+// GetServiceData get ServiceData value
+func (a *Device1) GetServiceData() (map[string]interface{}, error) {
+	return a.GetServiceDataContext(context.Background())
 }
 
-
-
-// GetClass get Class value
-func (a *Device1) GetClass() (uint32, error) {
-	v, err := a.GetProperty("Class")
+// GetServiceDataContext get ServiceData value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetServiceDataContext(ctx context.Context) (map[string]interface{}, error) {
+	v, err := a.GetPropertyContext(ctx, "ServiceData")
 	if err != nil {
-		return uint32(0), err
+		return map[string]interface{}{}, err
 	}
-	return v.Value().(uint32), nil
+	return v.Value().(map[string]interface{}), nil
 }
 
 
 
 
-// SetPaired set Paired value
-func (a *Device1) SetPaired(v bool) error {
-	return a.SetProperty("Paired", v)
+// SetServicesResolved set ServicesResolved value
+func (a *Device1) SetServicesResolved(v bool) error {
+	return a.SetServicesResolvedContext(context.Background(), v)
 }
 
-
-
-// GetPaired get Paired value
-func (a *Device1) GetPaired() (bool, error) {
-	v, err := a.GetProperty("Paired")
-	if err != nil {
-		return false, err
-	}
-	return v.Value().(bool), nil
+// SetServicesResolvedContext set ServicesResolved value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetServicesResolvedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "ServicesResolved", v)
 }
 
 
 
-
-// SetConnected set Connected value
-func (a *Device1) SetConnected(v bool) error {
-	return a.SetProperty("Connected", v)
+// GetServicesResolved get ServicesResolved value
+func (a *Device1) GetServicesResolved() (bool, error) {
+	return a.GetServicesResolvedContext(context.Background())
 }
 
-
-
-// GetConnected get Connected value
-func (a *Device1) GetConnected() (bool, error) {
-	v, err := a.GetProperty("Connected")
+// GetServicesResolvedContext get ServicesResolved value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetServicesResolvedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "ServicesResolved")
 	if err != nil {
 		return false, err
 	}
@@ -623,39 +835,59 @@ func (a *Device1) GetConnected() (bool, error) {
 
 
 
-// SetRSSI set RSSI value
-func (a *Device1) SetRSSI(v int16) error {
-	return a.SetProperty("RSSI", v)
+// SetAdvertisingFlags set AdvertisingFlags value
+func (a *Device1) SetAdvertisingFlags(v []byte) error {
+	return a.SetAdvertisingFlagsContext(context.Background(), v)
 }
 
+// SetAdvertisingFlagsContext set AdvertisingFlags value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetAdvertisingFlagsContext(ctx context.Context, v []byte) error {
+	return a.SetPropertyContext(ctx, "AdvertisingFlags", v)
+}
 
 
-// GetRSSI get RSSI value
-func (a *Device1) GetRSSI() (int16, error) {
-	v, err := a.GetProperty("RSSI")
+
+// GetAdvertisingFlags get AdvertisingFlags value
+func (a *Device1) GetAdvertisingFlags() ([]byte, error) {
+	return a.GetAdvertisingFlagsContext(context.Background())
+}
+
+// GetAdvertisingFlagsContext get AdvertisingFlags value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetAdvertisingFlagsContext(ctx context.Context) ([]byte, error) {
+	v, err := a.GetPropertyContext(ctx, "AdvertisingFlags")
 	if err != nil {
-		return int16(0), err
+		return []byte{}, err
 	}
-	return v.Value().(int16), nil
+	return v.Value().([]byte), nil
 }
 
 
 
 
-// SetTxPower set TxPower value
-func (a *Device1) SetTxPower(v int16) error {
-	return a.SetProperty("TxPower", v)
+// SetAdvertisingData set AdvertisingData value
+func (a *Device1) SetAdvertisingData(v map[string]interface{}) error {
+	return a.SetAdvertisingDataContext(context.Background(), v)
 }
 
+// SetAdvertisingDataContext set AdvertisingData value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetAdvertisingDataContext(ctx context.Context, v map[string]interface{}) error {
+	return a.SetPropertyContext(ctx, "AdvertisingData", v)
+}
 
 
-// GetTxPower get TxPower value
-func (a *Device1) GetTxPower() (int16, error) {
-	v, err := a.GetProperty("TxPower")
+
+// GetAdvertisingData get AdvertisingData value
+func (a *Device1) GetAdvertisingData() (map[string]interface{}, error) {
+	return a.GetAdvertisingDataContext(context.Background())
+}
+
+// GetAdvertisingDataContext get AdvertisingData value, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetAdvertisingDataContext(ctx context.Context) (map[string]interface{}, error) {
+	v, err := a.GetPropertyContext(ctx, "AdvertisingData")
 	if err != nil {
-		return int16(0), err
+		return map[string]interface{}{}, err
 	}
-	return v.Value().(int16), nil
+	return v.Value().(map[string]interface{}), nil
 }
 
 
@@ -683,35 +915,24 @@ func (a *Device1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *Device1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *Device1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
-
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -741,20 +962,31 @@ func (a *Device1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Device1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Device1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *Device1) GetProperties() (*Device1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetPropertiesContext(ctx context.Context) (*Device1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -764,29 +996,40 @@ func (a *Device1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *Device1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *Device1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *Device1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *Device1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *Device1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *Device1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -796,6 +1039,7 @@ func (a *Device1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *Device1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -829,8 +1073,13 @@ Connect 			This is a generic method to connect any profiles
 
 */
 func (a *Device1) Connect() error {
+	return a.ConnectContext(context.Background())
+}
+
+// ConnectContext call Connect, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Device1) ConnectContext(ctx context.Context) error {
 	
-	return a.client.Call("Connect", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Connect", 0, ).Store()
 	
 }
 
@@ -849,8 +1098,13 @@ Disconnect 			This method gracefully disconnects all connected
 
 */
 func (a *Device1) Disconnect() error {
+	return a.DisconnectContext(context.Background())
+}
+
+// DisconnectContext call Disconnect, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Device1) DisconnectContext(ctx context.Context) error {
 	
-	return a.client.Call("Disconnect", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Disconnect", 0, ).Store()
 	
 }
 
@@ -866,8 +1120,13 @@ ConnectProfile 			This method connects a specific profile of this
 
 */
 func (a *Device1) ConnectProfile(uuid string) error {
+	return a.ConnectProfileContext(context.Background(), uuid)
+}
+
+// ConnectProfileContext call ConnectProfile, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Device1) ConnectProfileContext(ctx context.Context, uuid string) error {
 	
-	return a.client.Call("ConnectProfile", 0, uuid).Store()
+	return a.client.CallWithContext(ctx, "ConnectProfile", 0, uuid).Store()
 	
 }
 
@@ -885,8 +1144,13 @@ DisconnectProfile 			This method disconnects a specific profile of
 
 */
 func (a *Device1) DisconnectProfile(uuid string) error {
+	return a.DisconnectProfileContext(context.Background(), uuid)
+}
+
+// DisconnectProfileContext call DisconnectProfile, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Device1) DisconnectProfileContext(ctx context.Context, uuid string) error {
 	
-	return a.client.Call("DisconnectProfile", 0, uuid).Store()
+	return a.client.CallWithContext(ctx, "DisconnectProfile", 0, uuid).Store()
 	
 }
 
@@ -914,8 +1178,13 @@ Pair 			This method will connect to the remote device,
 
 */
 func (a *Device1) Pair() error {
+	return a.PairContext(context.Background())
+}
+
+// PairContext call Pair, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Device1) PairContext(ctx context.Context) error {
 	
-	return a.client.Call("Pair", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Pair", 0, ).Store()
 	
 }
 
@@ -927,8 +1196,13 @@ CancelPairing 			This method can be used to cancel a pairing
 
 */
 func (a *Device1) CancelPairing() error {
+	return a.CancelPairingContext(context.Background())
+}
+
+// CancelPairingContext call CancelPairing, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Device1) CancelPairingContext(ctx context.Context) error {
 	
-	return a.client.Call("CancelPairing", 0, ).Store()
+	return a.client.CallWithContext(ctx, "CancelPairing", 0, ).Store()
 	
 }
 
@@ -0,0 +1,29 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package device
+
+
+// Device1AddressType is a value documented for Device1.AddressType
+type Device1AddressType string
+
+const (
+	Device1AddressTypePublic Device1AddressType = "public"
+	Device1AddressTypeRandom Device1AddressType = "random"
+)
+
+// Device1AddressTypeValues list the documented values of Device1AddressType
+var Device1AddressTypeValues = []Device1AddressType{
+	Device1AddressTypePublic,
+	Device1AddressTypeRandom,
+}
+
+// Valid report if v is one of the documented values
+func (v Device1AddressType) Valid() bool {
+	for _, value := range Device1AddressTypeValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
@@ -0,0 +1,932 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package device
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeDevice1 is an in-memory Device1API to test the code depending on
+// Device1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeDevice1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// ConnectFunc handle the calls to Connect
+	ConnectFunc func(ctx context.Context) error
+	// DisconnectFunc handle the calls to Disconnect
+	DisconnectFunc func(ctx context.Context) error
+	// ConnectProfileFunc handle the calls to ConnectProfile
+	ConnectProfileFunc func(ctx context.Context, uuid string) error
+	// DisconnectProfileFunc handle the calls to DisconnectProfile
+	DisconnectProfileFunc func(ctx context.Context, uuid string) error
+	// PairFunc handle the calls to Pair
+	PairFunc func(ctx context.Context) error
+	// CancelPairingFunc handle the calls to CancelPairing
+	CancelPairingFunc func(ctx context.Context) error
+}
+
+var _ Device1API = (*FakeDevice1)(nil)
+
+
+// NewFakeDevice1 create a FakeDevice1 with the initial properties, nil for none
+func NewFakeDevice1(objectPath dbus.ObjectPath, properties *Device1Properties) *FakeDevice1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeDevice1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(Device1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeDevice1 object path
+func (a *FakeDevice1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return Device1 interface
+func (a *FakeDevice1) Interface() string {
+	return Device1Interface
+}
+
+// Close stop the watchers
+func (a *FakeDevice1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeDevice1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeDevice1) GetProperties() (*Device1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeDevice1) GetPropertiesContext(ctx context.Context) (*Device1Properties, error) {
+	return new(Device1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeDevice1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeDevice1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeDevice1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeDevice1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeDevice1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeDevice1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeDevice1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+// SetAddress set Address value
+func (a *FakeDevice1) SetAddress(v string) error {
+	return a.SetAddressContext(context.Background(), v)
+}
+
+// SetAddressContext set Address value
+func (a *FakeDevice1) SetAddressContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Address", v)
+}
+
+
+
+// GetAddress get Address value
+func (a *FakeDevice1) GetAddress() (string, error) {
+	return a.GetAddressContext(context.Background())
+}
+
+// GetAddressContext get Address value
+func (a *FakeDevice1) GetAddressContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Address")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Address: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetAddressType set AddressType value
+func (a *FakeDevice1) SetAddressType(v string) error {
+	return a.SetAddressTypeContext(context.Background(), v)
+}
+
+// SetAddressTypeContext set AddressType value
+func (a *FakeDevice1) SetAddressTypeContext(ctx context.Context, v string) error {
+	if !Device1AddressType(v).Valid() {
+		return &bluez.InvalidValueError{Interface: Device1Interface, Member: "AddressType", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "AddressType", v)
+}
+
+
+
+// GetAddressType get AddressType value
+func (a *FakeDevice1) GetAddressType() (string, error) {
+	return a.GetAddressTypeContext(context.Background())
+}
+
+// GetAddressTypeContext get AddressType value
+func (a *FakeDevice1) GetAddressTypeContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "AddressType")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("AddressType: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetName set Name value
+func (a *FakeDevice1) SetName(v string) error {
+	return a.SetNameContext(context.Background(), v)
+}
+
+// SetNameContext set Name value
+func (a *FakeDevice1) SetNameContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Name", v)
+}
+
+
+
+// GetName get Name value
+func (a *FakeDevice1) GetName() (string, error) {
+	return a.GetNameContext(context.Background())
+}
+
+// GetNameContext get Name value
+func (a *FakeDevice1) GetNameContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Name")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Name: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetIcon set Icon value
+func (a *FakeDevice1) SetIcon(v string) error {
+	return a.SetIconContext(context.Background(), v)
+}
+
+// SetIconContext set Icon value
+func (a *FakeDevice1) SetIconContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Icon", v)
+}
+
+
+
+// GetIcon get Icon value
+func (a *FakeDevice1) GetIcon() (string, error) {
+	return a.GetIconContext(context.Background())
+}
+
+// GetIconContext get Icon value
+func (a *FakeDevice1) GetIconContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Icon")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Icon: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetClass set Class value
+func (a *FakeDevice1) SetClass(v uint32) error {
+	return a.SetClassContext(context.Background(), v)
+}
+
+// SetClassContext set Class value
+func (a *FakeDevice1) SetClassContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "Class", v)
+}
+
+
+
+// GetClass get Class value
+func (a *FakeDevice1) GetClass() (uint32, error) {
+	return a.GetClassContext(context.Background())
+}
+
+// GetClassContext get Class value
+func (a *FakeDevice1) GetClassContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "Class")
+	if err != nil {
+		return uint32(0), err
+	}
+	value, ok := v.Value().(uint32)
+	if !ok {
+		return uint32(0), fmt.Errorf("Class: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetAppearance set Appearance value
+func (a *FakeDevice1) SetAppearance(v uint16) error {
+	return a.SetAppearanceContext(context.Background(), v)
+}
+
+// SetAppearanceContext set Appearance value
+func (a *FakeDevice1) SetAppearanceContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "Appearance", v)
+}
+
+
+
+// GetAppearance get Appearance value
+func (a *FakeDevice1) GetAppearance() (uint16, error) {
+	return a.GetAppearanceContext(context.Background())
+}
+
+// GetAppearanceContext get Appearance value
+func (a *FakeDevice1) GetAppearanceContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Appearance")
+	if err != nil {
+		return uint16(0), err
+	}
+	value, ok := v.Value().(uint16)
+	if !ok {
+		return uint16(0), fmt.Errorf("Appearance: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetUUIDs set UUIDs value
+func (a *FakeDevice1) SetUUIDs(v []string) error {
+	return a.SetUUIDsContext(context.Background(), v)
+}
+
+// SetUUIDsContext set UUIDs value
+func (a *FakeDevice1) SetUUIDsContext(ctx context.Context, v []string) error {
+	return a.SetPropertyContext(ctx, "UUIDs", v)
+}
+
+
+
+// GetUUIDs get UUIDs value
+func (a *FakeDevice1) GetUUIDs() ([]string, error) {
+	return a.GetUUIDsContext(context.Background())
+}
+
+// GetUUIDsContext get UUIDs value
+func (a *FakeDevice1) GetUUIDsContext(ctx context.Context) ([]string, error) {
+	v, err := a.GetPropertyContext(ctx, "UUIDs")
+	if err != nil {
+		return []string{}, err
+	}
+	value, ok := v.Value().([]string)
+	if !ok {
+		return []string{}, fmt.Errorf("UUIDs: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetPaired set Paired value
+func (a *FakeDevice1) SetPaired(v bool) error {
+	return a.SetPairedContext(context.Background(), v)
+}
+
+// SetPairedContext set Paired value
+func (a *FakeDevice1) SetPairedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Paired", v)
+}
+
+
+
+// GetPaired get Paired value
+func (a *FakeDevice1) GetPaired() (bool, error) {
+	return a.GetPairedContext(context.Background())
+}
+
+// GetPairedContext get Paired value
+func (a *FakeDevice1) GetPairedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Paired")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Paired: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetConnected set Connected value
+func (a *FakeDevice1) SetConnected(v bool) error {
+	return a.SetConnectedContext(context.Background(), v)
+}
+
+// SetConnectedContext set Connected value
+func (a *FakeDevice1) SetConnectedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Connected", v)
+}
+
+
+
+// GetConnected get Connected value
+func (a *FakeDevice1) GetConnected() (bool, error) {
+	return a.GetConnectedContext(context.Background())
+}
+
+// GetConnectedContext get Connected value
+func (a *FakeDevice1) GetConnectedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Connected")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Connected: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetTrusted set Trusted value
+func (a *FakeDevice1) SetTrusted(v bool) error {
+	return a.SetTrustedContext(context.Background(), v)
+}
+
+// SetTrustedContext set Trusted value
+func (a *FakeDevice1) SetTrustedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Trusted", v)
+}
+
+
+
+// GetTrusted get Trusted value
+func (a *FakeDevice1) GetTrusted() (bool, error) {
+	return a.GetTrustedContext(context.Background())
+}
+
+// GetTrustedContext get Trusted value
+func (a *FakeDevice1) GetTrustedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Trusted")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Trusted: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetBlocked set Blocked value
+func (a *FakeDevice1) SetBlocked(v bool) error {
+	return a.SetBlockedContext(context.Background(), v)
+}
+
+// SetBlockedContext set Blocked value
+func (a *FakeDevice1) SetBlockedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Blocked", v)
+}
+
+
+
+// GetBlocked get Blocked value
+func (a *FakeDevice1) GetBlocked() (bool, error) {
+	return a.GetBlockedContext(context.Background())
+}
+
+// GetBlockedContext get Blocked value
+func (a *FakeDevice1) GetBlockedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Blocked")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Blocked: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetAlias set Alias value
+func (a *FakeDevice1) SetAlias(v string) error {
+	return a.SetAliasContext(context.Background(), v)
+}
+
+// SetAliasContext set Alias value
+func (a *FakeDevice1) SetAliasContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Alias", v)
+}
+
+
+
+// GetAlias get Alias value
+func (a *FakeDevice1) GetAlias() (string, error) {
+	return a.GetAliasContext(context.Background())
+}
+
+// GetAliasContext get Alias value
+func (a *FakeDevice1) GetAliasContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Alias")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Alias: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetAdapter set Adapter value
+func (a *FakeDevice1) SetAdapter(v dbus.ObjectPath) error {
+	return a.SetAdapterContext(context.Background(), v)
+}
+
+// SetAdapterContext set Adapter value
+func (a *FakeDevice1) SetAdapterContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Adapter", v)
+}
+
+
+
+// GetAdapter get Adapter value
+func (a *FakeDevice1) GetAdapter() (dbus.ObjectPath, error) {
+	return a.GetAdapterContext(context.Background())
+}
+
+// GetAdapterContext get Adapter value
+func (a *FakeDevice1) GetAdapterContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Adapter")
+	if err != nil {
+		return dbus.ObjectPath(""), err
+	}
+	value, ok := v.Value().(dbus.ObjectPath)
+	if !ok {
+		return dbus.ObjectPath(""), fmt.Errorf("Adapter: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetLegacyPairing set LegacyPairing value
+func (a *FakeDevice1) SetLegacyPairing(v bool) error {
+	return a.SetLegacyPairingContext(context.Background(), v)
+}
+
+// SetLegacyPairingContext set LegacyPairing value
+func (a *FakeDevice1) SetLegacyPairingContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "LegacyPairing", v)
+}
+
+
+
+// GetLegacyPairing get LegacyPairing value
+func (a *FakeDevice1) GetLegacyPairing() (bool, error) {
+	return a.GetLegacyPairingContext(context.Background())
+}
+
+// GetLegacyPairingContext get LegacyPairing value
+func (a *FakeDevice1) GetLegacyPairingContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "LegacyPairing")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("LegacyPairing: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetModalias set Modalias value
+func (a *FakeDevice1) SetModalias(v string) error {
+	return a.SetModaliasContext(context.Background(), v)
+}
+
+// SetModaliasContext set Modalias value
+func (a *FakeDevice1) SetModaliasContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Modalias", v)
+}
+
+
+
+// GetModalias get Modalias value
+func (a *FakeDevice1) GetModalias() (string, error) {
+	return a.GetModaliasContext(context.Background())
+}
+
+// GetModaliasContext get Modalias value
+func (a *FakeDevice1) GetModaliasContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Modalias")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Modalias: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetRSSI set RSSI value
+func (a *FakeDevice1) SetRSSI(v int16) error {
+	return a.SetRSSIContext(context.Background(), v)
+}
+
+// SetRSSIContext set RSSI value
+func (a *FakeDevice1) SetRSSIContext(ctx context.Context, v int16) error {
+	return a.SetPropertyContext(ctx, "RSSI", v)
+}
+
+
+
+// GetRSSI get RSSI value
+func (a *FakeDevice1) GetRSSI() (int16, error) {
+	return a.GetRSSIContext(context.Background())
+}
+
+// GetRSSIContext get RSSI value
+func (a *FakeDevice1) GetRSSIContext(ctx context.Context) (int16, error) {
+	v, err := a.GetPropertyContext(ctx, "RSSI")
+	if err != nil {
+		return int16(0), err
+	}
+	value, ok := v.Value().(int16)
+	if !ok {
+		return int16(0), fmt.Errorf("RSSI: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetTxPower set TxPower value
+func (a *FakeDevice1) SetTxPower(v int16) error {
+	return a.SetTxPowerContext(context.Background(), v)
+}
+
+// SetTxPowerContext set TxPower value
+func (a *FakeDevice1) SetTxPowerContext(ctx context.Context, v int16) error {
+	return a.SetPropertyContext(ctx, "TxPower", v)
+}
+
+
+
+// GetTxPower get TxPower value
+func (a *FakeDevice1) GetTxPower() (int16, error) {
+	return a.GetTxPowerContext(context.Background())
+}
+
+// GetTxPowerContext get TxPower value
+func (a *FakeDevice1) GetTxPowerContext(ctx context.Context) (int16, error) {
+	v, err := a.GetPropertyContext(ctx, "TxPower")
+	if err != nil {
+		return int16(0), err
+	}
+	value, ok := v.Value().(int16)
+	if !ok {
+		return int16(0), fmt.Errorf("TxPower: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetManufacturerData set ManufacturerData value
+func (a *FakeDevice1) SetManufacturerData(v map[string]interface{}) error {
+	return a.SetManufacturerDataContext(context.Background(), v)
+}
+
+// SetManufacturerDataContext set ManufacturerData value
+func (a *FakeDevice1) SetManufacturerDataContext(ctx context.Context, v map[string]interface{}) error {
+	return a.SetPropertyContext(ctx, "ManufacturerData", v)
+}
+
+
+
+// GetManufacturerData get ManufacturerData value
+func (a *FakeDevice1) GetManufacturerData() (map[string]interface{}, error) {
+	return a.GetManufacturerDataContext(context.Background())
+}
+
+// GetManufacturerDataContext get ManufacturerData value
+func (a *FakeDevice1) GetManufacturerDataContext(ctx context.Context) (map[string]interface{}, error) {
+	v, err := a.GetPropertyContext(ctx, "ManufacturerData")
+	if err != nil {
+		return map[string]interface{}{}, err
+	}
+	value, ok := v.Value().(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{}, fmt.Errorf("ManufacturerData: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetServiceData set ServiceData value
+func (a *FakeDevice1) SetServiceData(v map[string]interface{}) error {
+	return a.SetServiceDataContext(context.Background(), v)
+}
+
+// SetServiceDataContext set ServiceData value
+func (a *FakeDevice1) SetServiceDataContext(ctx context.Context, v map[string]interface{}) error {
+	return a.SetPropertyContext(ctx, "ServiceData", v)
+}
+
+
+
+// GetServiceData get ServiceData value
+func (a *FakeDevice1) GetServiceData() (map[string]interface{}, error) {
+	return a.GetServiceDataContext(context.Background())
+}
+
+// GetServiceDataContext get ServiceData value
+func (a *FakeDevice1) GetServiceDataContext(ctx context.Context) (map[string]interface{}, error) {
+	v, err := a.GetPropertyContext(ctx, "ServiceData")
+	if err != nil {
+		return map[string]interface{}{}, err
+	}
+	value, ok := v.Value().(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{}, fmt.Errorf("ServiceData: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetServicesResolved set ServicesResolved value
+func (a *FakeDevice1) SetServicesResolved(v bool) error {
+	return a.SetServicesResolvedContext(context.Background(), v)
+}
+
+// SetServicesResolvedContext set ServicesResolved value
+func (a *FakeDevice1) SetServicesResolvedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "ServicesResolved", v)
+}
+
+
+
+// GetServicesResolved get ServicesResolved value
+func (a *FakeDevice1) GetServicesResolved() (bool, error) {
+	return a.GetServicesResolvedContext(context.Background())
+}
+
+// GetServicesResolvedContext get ServicesResolved value
+func (a *FakeDevice1) GetServicesResolvedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "ServicesResolved")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("ServicesResolved: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetAdvertisingFlags set AdvertisingFlags value
+func (a *FakeDevice1) SetAdvertisingFlags(v []byte) error {
+	return a.SetAdvertisingFlagsContext(context.Background(), v)
+}
+
+// SetAdvertisingFlagsContext set AdvertisingFlags value
+func (a *FakeDevice1) SetAdvertisingFlagsContext(ctx context.Context, v []byte) error {
+	return a.SetPropertyContext(ctx, "AdvertisingFlags", v)
+}
+
+
+
+// GetAdvertisingFlags get AdvertisingFlags value
+func (a *FakeDevice1) GetAdvertisingFlags() ([]byte, error) {
+	return a.GetAdvertisingFlagsContext(context.Background())
+}
+
+// GetAdvertisingFlagsContext get AdvertisingFlags value
+func (a *FakeDevice1) GetAdvertisingFlagsContext(ctx context.Context) ([]byte, error) {
+	v, err := a.GetPropertyContext(ctx, "AdvertisingFlags")
+	if err != nil {
+		return []byte{}, err
+	}
+	value, ok := v.Value().([]byte)
+	if !ok {
+		return []byte{}, fmt.Errorf("AdvertisingFlags: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetAdvertisingData set AdvertisingData value
+func (a *FakeDevice1) SetAdvertisingData(v map[string]interface{}) error {
+	return a.SetAdvertisingDataContext(context.Background(), v)
+}
+
+// SetAdvertisingDataContext set AdvertisingData value
+func (a *FakeDevice1) SetAdvertisingDataContext(ctx context.Context, v map[string]interface{}) error {
+	return a.SetPropertyContext(ctx, "AdvertisingData", v)
+}
+
+
+
+// GetAdvertisingData get AdvertisingData value
+func (a *FakeDevice1) GetAdvertisingData() (map[string]interface{}, error) {
+	return a.GetAdvertisingDataContext(context.Background())
+}
+
+// GetAdvertisingDataContext get AdvertisingData value
+func (a *FakeDevice1) GetAdvertisingDataContext(ctx context.Context) (map[string]interface{}, error) {
+	v, err := a.GetPropertyContext(ctx, "AdvertisingData")
+	if err != nil {
+		return map[string]interface{}{}, err
+	}
+	value, ok := v.Value().(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{}, fmt.Errorf("AdvertisingData: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+// Connect call ConnectFunc
+func (a *FakeDevice1) Connect() error {
+	return a.ConnectContext(context.Background())
+}
+
+// ConnectContext call ConnectFunc
+func (a *FakeDevice1) ConnectContext(ctx context.Context) error {
+	if a.ConnectFunc != nil {
+		return a.ConnectFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// Disconnect call DisconnectFunc
+func (a *FakeDevice1) Disconnect() error {
+	return a.DisconnectContext(context.Background())
+}
+
+// DisconnectContext call DisconnectFunc
+func (a *FakeDevice1) DisconnectContext(ctx context.Context) error {
+	if a.DisconnectFunc != nil {
+		return a.DisconnectFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// ConnectProfile call ConnectProfileFunc
+func (a *FakeDevice1) ConnectProfile(uuid string) error {
+	return a.ConnectProfileContext(context.Background(), uuid)
+}
+
+// ConnectProfileContext call ConnectProfileFunc
+func (a *FakeDevice1) ConnectProfileContext(ctx context.Context, uuid string) error {
+	if a.ConnectProfileFunc != nil {
+		return a.ConnectProfileFunc(ctx, uuid)
+	}
+	
+	return nil
+	
+}
+
+// DisconnectProfile call DisconnectProfileFunc
+func (a *FakeDevice1) DisconnectProfile(uuid string) error {
+	return a.DisconnectProfileContext(context.Background(), uuid)
+}
+
+// DisconnectProfileContext call DisconnectProfileFunc
+func (a *FakeDevice1) DisconnectProfileContext(ctx context.Context, uuid string) error {
+	if a.DisconnectProfileFunc != nil {
+		return a.DisconnectProfileFunc(ctx, uuid)
+	}
+	
+	return nil
+	
+}
+
+// Pair call PairFunc
+func (a *FakeDevice1) Pair() error {
+	return a.PairContext(context.Background())
+}
+
+// PairContext call PairFunc
+func (a *FakeDevice1) PairContext(ctx context.Context) error {
+	if a.PairFunc != nil {
+		return a.PairFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// CancelPairing call CancelPairingFunc
+func (a *FakeDevice1) CancelPairing() error {
+	return a.CancelPairingContext(context.Background())
+}
+
+// CancelPairingContext call CancelPairingFunc
+func (a *FakeDevice1) CancelPairingContext(ctx context.Context) error {
+	if a.CancelPairingFunc != nil {
+		return a.CancelPairingFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
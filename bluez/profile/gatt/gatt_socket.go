@@ -0,0 +1,65 @@
+package gatt
+
+import (
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	// DefaultMTU is the ATT MTU in use before an MTU exchange
+	DefaultMTU = 23
+	// ATTHeaderLen is the size of the ATT header of a write or notification,
+	// a single packet can carry at most MTU - ATTHeaderLen bytes of value
+	ATTHeaderLen = 3
+	// MaxValueLength is the maximum length of an attribute value
+	MaxValueLength = 512
+)
+
+// NewSocketPair create the SOCK_SEQPACKET socket pair used by AcquireWrite and
+// AcquireNotify, every packet maps to a single ATT write or notification.
+// The local end is non blocking, so that closing it unblocks pending reads.
+func NewSocketPair() (local *os.File, remote *os.File, err error) {
+
+	fds, err := syscall.Socketpair(syscall.AF_UNIX, syscall.SOCK_SEQPACKET|syscall.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = syscall.SetNonblock(fds[0], true)
+	if err != nil {
+		syscall.Close(fds[0])
+		syscall.Close(fds[1])
+		return nil, nil, err
+	}
+
+	local = os.NewFile(uintptr(fds[0]), "gatt-local")
+	remote = os.NewFile(uintptr(fds[1]), "gatt-remote")
+	return local, remote, nil
+}
+
+// PassFDDelay is the time the descriptors returned by PassFD are kept open
+var PassFDDelay = time.Second
+
+// PassFD return the descriptor of f to be returned from an exported method,
+// taking ownership of f. godbus sends the reply carrying it once the method
+// returned but does not report it, so f is closed after PassFDDelay. Then the
+// local end sees the peer closing its copy.
+func PassFD(f *os.File) dbus.UnixFD {
+	fd := dbus.UnixFD(f.Fd())
+	time.AfterFunc(PassFDDelay, func() {
+		f.Close()
+	})
+	return fd
+}
+
+// NewFileFD wrap a descriptor received from AcquireWrite or AcquireNotify
+func NewFileFD(fd dbus.UnixFD, name string) (*os.File, error) {
+	err := syscall.SetNonblock(int(fd), true)
+	if err != nil {
+		return nil, err
+	}
+	return os.NewFile(uintptr(fd), name), nil
+}
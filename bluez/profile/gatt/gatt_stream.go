@@ -0,0 +1,341 @@
+package gatt
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/muka/go-bluetooth/bluez"
+)
+
+var (
+	// ErrStreamClosed is returned when using a closed CharStream
+	ErrStreamClosed = errors.New("Stream is closed")
+	// ErrNotWritable is returned writing to a characteristic without write flags
+	ErrNotWritable = errors.New("Characteristic is not writable")
+	// ErrNotReadable is returned reading from a characteristic without notify or indicate flags
+	ErrNotReadable = errors.New("Characteristic does not support notifications")
+)
+
+// NewCharStream open a stream on a characteristic.
+//
+// Writes use the file descriptor from AcquireWrite if the characteristic
+// has the write-without-response flag, otherwise WriteValue. Reads use the
+// file descriptor from AcquireNotify if it has the notify flag, otherwise
+// StartNotify and the Value changes.
+func NewCharStream(char *GattCharacteristic1) (*CharStream, error) {
+
+	s := &CharStream{
+		char: char,
+	}
+	s.cond = sync.NewCond(&s.lock)
+
+	char.Properties.Lock()
+	flags := append([]string{}, char.Properties.Flags...)
+	char.Properties.Unlock()
+
+	s.openWriter(flags)
+
+	err := s.openReader(flags)
+	if err != nil {
+		s.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// CharStream expose a characteristic as an io.ReadWriteCloser. Each Write
+// is split in packets fitting the MTU, each notified value is returned by
+// one or more Read.
+type CharStream struct {
+	char *GattCharacteristic1
+
+	writeLock    sync.Mutex
+	writer       *os.File
+	writeMTU     uint16
+	writeOptions map[string]interface{}
+	frameSize    int
+
+	readLock  sync.Mutex
+	notifier  *os.File
+	notifyMTU uint16
+	buf       []byte
+
+	lock      sync.Mutex
+	cond      *sync.Cond
+	watch     chan *bluez.PropertyChanged
+	values    [][]byte
+	watchDone bool
+	closed    bool
+}
+
+// openWriter try AcquireWrite, falling back to WriteValue
+func (s *CharStream) openWriter(flags []string) {
+
+	switch {
+	case hasFlag(flags, FlagCharacteristicWriteWithoutResponse):
+
+		fd, mtu, err := s.char.AcquireWrite(map[string]interface{}{})
+		if err == nil {
+			s.writer, err = NewFileFD(fd, fmt.Sprintf("%s-write", s.char.Path()))
+			if err == nil {
+				s.writeMTU = mtu
+				s.frameSize = payloadSize(mtu)
+				return
+			}
+		}
+		s.char.Client().Logger().Debugf("AcquireWrite failed, using WriteValue: %s", err)
+
+		s.writeOptions = map[string]interface{}{"type": "command"}
+		s.frameSize = payloadSize(DefaultMTU)
+
+	case hasFlag(flags, FlagCharacteristicWrite):
+		// bluez takes care of long writes
+		s.writeOptions = map[string]interface{}{"type": "request"}
+		s.frameSize = MaxValueLength
+	}
+}
+
+// openReader try AcquireNotify, falling back to StartNotify
+func (s *CharStream) openReader(flags []string) error {
+
+	if hasFlag(flags, FlagCharacteristicNotify) {
+		fd, mtu, err := s.char.AcquireNotify(map[string]interface{}{})
+		if err == nil {
+			s.notifier, err = NewFileFD(fd, fmt.Sprintf("%s-notify", s.char.Path()))
+			if err == nil {
+				s.notifyMTU = mtu
+				return nil
+			}
+		}
+		s.char.Client().Logger().Debugf("AcquireNotify failed, using StartNotify: %s", err)
+	} else if !hasFlag(flags, FlagCharacteristicIndicate) {
+		return nil
+	}
+
+	watch, err := s.char.WatchProperties()
+	if err != nil {
+		return err
+	}
+	s.watch = watch
+	go s.watchValues(watch)
+
+	return s.char.StartNotify()
+}
+
+// watchValues queue the notified values until the watch is removed
+func (s *CharStream) watchValues(watch chan *bluez.PropertyChanged) {
+	for ev := range watch {
+		if ev == nil {
+			break
+		}
+		if ev.Interface != GattCharacteristic1Interface || ev.Name != "Value" {
+			continue
+		}
+		value, ok := ev.Value.([]byte)
+		if !ok {
+			continue
+		}
+		s.lock.Lock()
+		s.values = append(s.values, value)
+		s.cond.Signal()
+		s.lock.Unlock()
+	}
+
+	s.lock.Lock()
+	s.watchDone = true
+	s.cond.Broadcast()
+	s.lock.Unlock()
+}
+
+// WriteAcquired return true if writes use the AcquireWrite file descriptor
+func (s *CharStream) WriteAcquired() bool {
+	return s.writer != nil
+}
+
+// NotifyAcquired return true if reads use the AcquireNotify file descriptor
+func (s *CharStream) NotifyAcquired() bool {
+	return s.notifier != nil
+}
+
+// MTU return the MTU reported by AcquireWrite or AcquireNotify, 0 if not acquired
+func (s *CharStream) MTU() uint16 {
+	if s.writer != nil {
+		return s.writeMTU
+	}
+	return s.notifyMTU
+}
+
+// Write send p to the characteristic in packets of at most MTU - ATTHeaderLen
+// bytes. It returns the number of bytes sent before an error occurred.
+func (s *CharStream) Write(p []byte) (int, error) {
+
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	if s.isClosed() {
+		return 0, ErrStreamClosed
+	}
+	if s.writer == nil && s.writeOptions == nil {
+		return 0, ErrNotWritable
+	}
+
+	written := 0
+	for len(p) > 0 {
+
+		n := len(p)
+		if n > s.frameSize {
+			n = s.frameSize
+		}
+
+		var err error
+		if s.writer != nil {
+			_, err = s.writer.Write(p[:n])
+		} else {
+			err = s.char.WriteValue(p[:n], s.writeOptions)
+		}
+		if err != nil {
+			return written, s.streamError(err)
+		}
+
+		written += n
+		p = p[n:]
+	}
+
+	return written, nil
+}
+
+// Read return the data of the next notified value. A value larger than p is
+// returned by subsequent Read calls. It returns io.EOF once bluez releases
+// the notifications.
+func (s *CharStream) Read(p []byte) (int, error) {
+
+	s.readLock.Lock()
+	defer s.readLock.Unlock()
+
+	if len(s.buf) == 0 {
+
+		var err error
+		switch {
+		case s.notifier != nil:
+			s.buf, err = s.readPacket()
+		case s.watch != nil:
+			s.buf, err = s.nextValue()
+		default:
+			return 0, ErrNotReadable
+		}
+
+		if err != nil {
+			return 0, s.streamError(err)
+		}
+	}
+
+	n := copy(p, s.buf)
+	s.buf = s.buf[n:]
+	return n, nil
+}
+
+// readPacket read a notification from the AcquireNotify socket
+func (s *CharStream) readPacket() ([]byte, error) {
+	size := int(s.notifyMTU)
+	if size < MaxValueLength {
+		size = MaxValueLength
+	}
+	buf := make([]byte, size)
+	n, err := s.notifier.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+// nextValue wait for a Value change
+func (s *CharStream) nextValue() ([]byte, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for len(s.values) == 0 && !s.closed && !s.watchDone {
+		s.cond.Wait()
+	}
+
+	if s.closed {
+		return nil, ErrStreamClosed
+	}
+	if len(s.values) == 0 {
+		return nil, io.EOF
+	}
+
+	value := s.values[0]
+	s.values = s.values[1:]
+	return value, nil
+}
+
+func (s *CharStream) isClosed() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.closed
+}
+
+// streamError report ErrStreamClosed for operations interrupted by Close
+func (s *CharStream) streamError(err error) error {
+	if errors.Is(err, os.ErrClosed) && s.isClosed() {
+		return ErrStreamClosed
+	}
+	return err
+}
+
+// Close release the acquired file descriptors or stop notifications
+func (s *CharStream) Close() error {
+
+	s.lock.Lock()
+	if s.closed {
+		s.lock.Unlock()
+		return nil
+	}
+	s.closed = true
+	s.cond.Broadcast()
+	s.lock.Unlock()
+
+	var err error
+	if s.writer != nil {
+		err = s.writer.Close()
+	}
+	if s.notifier != nil {
+		err1 := s.notifier.Close()
+		if err == nil {
+			err = err1
+		}
+	}
+	if s.watch != nil {
+		err1 := s.char.StopNotify()
+		if err == nil {
+			err = err1
+		}
+		err1 = s.char.UnwatchProperties(s.watch)
+		if err == nil {
+			err = err1
+		}
+	}
+
+	return err
+}
+
+func payloadSize(mtu uint16) int {
+	size := int(mtu) - ATTHeaderLen
+	if size <= 0 {
+		return DefaultMTU - ATTHeaderLen
+	}
+	return size
+}
+
+func hasFlag(flags []string, flag string) bool {
+	for _, f := range flags {
+		if f == flag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,172 @@
+package gatt_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez/mock"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+	"github.com/stretchr/testify/assert"
+)
+
+func addStreamChar(t *testing.T, b *mock.Bluez, flags ...string) *gatt.GattCharacteristic1 {
+	devPath, err := b.AddDevice(mock.TestAdapterID, mock.TestAddress, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srvPath, err := b.AddService(devPath, 0x10, mock.TestServiceUUID, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	charPath, err := b.AddCharacteristic(srvPath, 0x11, mock.TestCharUUID, flags, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	char, err := gatt.NewGattCharacteristic1(charPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return char
+}
+
+func TestCharStreamAcquired(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	b.SetMTU(13)
+	char := addStreamChar(t, b, "write-without-response", "notify")
+
+	stream, err := gatt.NewCharStream(char)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, stream.WriteAcquired())
+	assert.True(t, stream.NotifyAcquired())
+	assert.Equal(t, uint16(13), stream.MTU())
+
+	assert.Eventually(t, func() bool {
+		return b.GetBool(char.Path(), gatt.GattCharacteristic1Interface, "WriteAcquired") &&
+			b.GetBool(char.Path(), gatt.GattCharacteristic1Interface, "NotifyAcquired")
+	}, mock.WaitFor, mock.Tick)
+
+	// 25 bytes are sent as 10 + 10 + 5 bytes packets
+	data := bytes.Repeat([]byte{0xAA}, 20)
+	data = append(data, 1, 2, 3, 4, 5)
+	n, err := stream.Write(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, len(data), n)
+	assert.Eventually(t, func() bool {
+		val, _ := b.GetProperty(char.Path(), gatt.GattCharacteristic1Interface, "Value")
+		return bytes.Equal([]byte{1, 2, 3, 4, 5}, val.([]byte))
+	}, mock.WaitFor, mock.Tick)
+	assert.Equal(t, 0, b.CountCalls("WriteValue"))
+
+	err = b.Notify(char.Path(), []byte{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 2)
+	n, err = stream.Read(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1, 2}, buf[:n])
+	n, err = stream.Read(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{3}, buf[:n])
+
+	assert.Nil(t, stream.Close())
+
+	_, err = stream.Write([]byte{1})
+	assert.Equal(t, gatt.ErrStreamClosed, err)
+	_, err = stream.Read(buf)
+	assert.Equal(t, gatt.ErrStreamClosed, err)
+
+	// the mock releases the sockets once the client closed them, as bluez does
+	assert.Eventually(t, func() bool {
+		return !b.GetBool(char.Path(), gatt.GattCharacteristic1Interface, "WriteAcquired") &&
+			!b.GetBool(char.Path(), gatt.GattCharacteristic1Interface, "NotifyAcquired")
+	}, mock.WaitFor, mock.Tick)
+}
+
+func TestCharStreamFallback(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	char := addStreamChar(t, b, "write-without-response", "notify")
+	b.InjectError("", gatt.GattCharacteristic1Interface, "AcquireWrite", mock.Error("NotSupported"), 0)
+	b.InjectError("", gatt.GattCharacteristic1Interface, "AcquireNotify", mock.Error("NotSupported"), 0)
+
+	stream, err := gatt.NewCharStream(char)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, stream.WriteAcquired())
+	assert.False(t, stream.NotifyAcquired())
+	assert.True(t, b.GetBool(char.Path(), gatt.GattCharacteristic1Interface, "Notifying"))
+
+	// without MTU, commands fit the default one
+	data := bytes.Repeat([]byte{1}, 30)
+	n, err := stream.Write(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, len(data), n)
+	assert.Equal(t, 2, b.CountCalls("WriteValue"))
+
+	var options map[string]dbus.Variant
+	for _, call := range b.Calls() {
+		if call.Method == "WriteValue" {
+			options = call.Args[1].(map[string]dbus.Variant)
+		}
+	}
+	assert.Equal(t, "command", options["type"].Value())
+
+	// skip the values of the writes
+	readValue(t, stream, 20)
+	readValue(t, stream, 10)
+
+	err = b.Notify(char.Path(), []byte{7, 8})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []byte{7, 8}, readValue(t, stream, 2))
+
+	assert.Nil(t, stream.Close())
+	assert.False(t, b.GetBool(char.Path(), gatt.GattCharacteristic1Interface, "Notifying"))
+}
+
+func TestCharStreamWriteRequest(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	char := addStreamChar(t, b, "write")
+
+	stream, err := gatt.NewCharStream(char)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stream.Close()
+
+	n, err := stream.Write(make([]byte, 600))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 600, n)
+	assert.Equal(t, 2, b.CountCalls("WriteValue"))
+
+	_, err = stream.Read(make([]byte, 1))
+	assert.Equal(t, gatt.ErrNotReadable, err)
+}
+
+func readValue(t *testing.T, r io.Reader, size int) []byte {
+	buf := make([]byte, size)
+	_, err := io.ReadFull(r, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf
+}
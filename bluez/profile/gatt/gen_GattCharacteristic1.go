@@ -5,6 +5,7 @@ package gatt
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var GattCharacteristic1Interface = "org.bluez.GattCharacteristic1"
 // Args:
 // - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX/serviceXX/charYYYY
 func NewGattCharacteristic1(objectPath dbus.ObjectPath) (*GattCharacteristic1, error) {
+	return NewGattCharacteristic1WithConn(nil, objectPath)
+}
+
+// NewGattCharacteristic1WithConn create a new instance of GattCharacteristic1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX/serviceXX/charYYYY
+func NewGattCharacteristic1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*GattCharacteristic1, error) {
 	a := new(GattCharacteristic1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewGattCharacteristic1(objectPath dbus.ObjectPath) (*GattCharacteristic1, e
 			Iface: GattCharacteristic1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -50,8 +60,7 @@ path hierarchy and are freely definable.
 */
 type GattCharacteristic1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*GattCharacteristic1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -61,6 +70,37 @@ type GattCharacteristic1 struct {
 type GattCharacteristic1Properties struct {
 	lock sync.RWMutex `dbus:"ignore"`
 
+	/*
+	UUID 128-bit characteristic UUID.
+	*/
+	UUID string
+
+	/*
+	Service Object path of the GATT service the characteristic
+			belongs to.
+	*/
+	Service dbus.ObjectPath
+
+	/*
+	Value The cached value of the characteristic. This property
+			gets updated only after a successful read request and
+			when a notification or indication is received, upon
+			which a PropertiesChanged signal will be emitted.
+	*/
+	Value []byte `dbus:"emit"`
+
+	/*
+	WriteAcquired True, if this characteristic has been acquired by any
+			client using AcquireWrite.
+
+			For client properties is ommited in case
+			'write-without-response' flag is not set.
+
+			For server the presence of this property indicates
+			that AcquireWrite is supported.
+	*/
+	WriteAcquired bool `dbus:"omitEmpty"`
+
 	/*
 	NotifyAcquired True, if this characteristic has been acquired by any
 			client using AcquireNotify.
@@ -71,7 +111,13 @@ type GattCharacteristic1Properties struct {
 			For server the presence of this property indicates
 			that AcquireNotify is supported.
 	*/
-	NotifyAcquired bool `dbus:"ignore"`
+	NotifyAcquired bool `dbus:"omitEmpty"`
+
+	/*
+	Notifying True, if notifications or indications on this
+			characteristic are currently enabled.
+	*/
+	Notifying bool
 
 	/*
 	Flags Defines how the characteristic value can be used. See
@@ -99,44 +145,14 @@ type GattCharacteristic1Properties struct {
 	*/
 	Flags []string
 
-	/*
-	Value The cached value of the characteristic. This property
-			gets updated only after a successful read request and
-			when a notification or indication is received, upon
-			which a PropertiesChanged signal will be emitted.
-	*/
-	Value []byte `dbus:"emit"`
-
-	/*
-	Service Object path of the GATT service the characteristic
-			belongs to.
-	*/
-	Service dbus.ObjectPath
-
-	/*
-	WriteAcquired True, if this characteristic has been acquired by any
-			client using AcquireWrite.
-
-			For client properties is ommited in case
-			'write-without-response' flag is not set.
-
-			For server the presence of this property indicates
-			that AcquireWrite is supported.
-	*/
-	WriteAcquired bool `dbus:"ignore"`
-
-	/*
-	Notifying True, if notifications or indications on this
-			characteristic are currently enabled.
-	*/
-	Notifying bool
-
 	/*
 	Handle Characteristic handle. When available in the server it
 			would attempt to use to allocate into the database
 			which may fail, to auto allocate the value 0x0000
 			shall be used which will cause the allocated handle to
 			be set once registered.
+
+	Since BlueZ 5.53
 	*/
 	Handle uint16
 
@@ -145,11 +161,6 @@ type GattCharacteristic1Properties struct {
 	*/
 	Descriptors []dbus.ObjectPath
 
-	/*
-	UUID 128-bit characteristic UUID.
-	*/
-	UUID string
-
 }
 
 //Lock access to properties
@@ -167,13 +178,18 @@ func (p *GattCharacteristic1Properties) Unlock() {
 
 
 
-// GetNotifyAcquired get NotifyAcquired value
-func (a *GattCharacteristic1) GetNotifyAcquired() (bool, error) {
-	v, err := a.GetProperty("NotifyAcquired")
+// GetUUID get UUID value
+func (a *GattCharacteristic1) GetUUID() (string, error) {
+	return a.GetUUIDContext(context.Background())
+}
+
+// GetUUIDContext get UUID value, failing with a bluez.ContextError if ctx is done
+func (a *GattCharacteristic1) GetUUIDContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "UUID")
 	if err != nil {
-		return false, err
+		return "", err
 	}
-	return v.Value().(bool), nil
+	return v.Value().(string), nil
 }
 
 
@@ -181,13 +197,18 @@ func (a *GattCharacteristic1) GetNotifyAcquired() (bool, error) {
 
 
 
-// GetFlags get Flags value
-func (a *GattCharacteristic1) GetFlags() ([]string, error) {
-	v, err := a.GetProperty("Flags")
+// GetService get Service value
+func (a *GattCharacteristic1) GetService() (dbus.ObjectPath, error) {
+	return a.GetServiceContext(context.Background())
+}
+
+// GetServiceContext get Service value, failing with a bluez.ContextError if ctx is done
+func (a *GattCharacteristic1) GetServiceContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Service")
 	if err != nil {
-		return []string{}, err
+		return dbus.ObjectPath(""), err
 	}
-	return v.Value().([]string), nil
+	return v.Value().(dbus.ObjectPath), nil
 }
 
 
@@ -197,7 +218,12 @@ func (a *GattCharacteristic1) GetFlags() ([]string, error) {
 
 // GetValue get Value value
 func (a *GattCharacteristic1) GetValue() ([]byte, error) {
-	v, err := a.GetProperty("Value")
+	return a.GetValueContext(context.Background())
+}
+
+// GetValueContext get Value value, failing with a bluez.ContextError if ctx is done
+func (a *GattCharacteristic1) GetValueContext(ctx context.Context) ([]byte, error) {
+	v, err := a.GetPropertyContext(ctx, "Value")
 	if err != nil {
 		return []byte{}, err
 	}
@@ -209,13 +235,18 @@ func (a *GattCharacteristic1) GetValue() ([]byte, error) {
 
 
 
-// GetService get Service value
-func (a *GattCharacteristic1) GetService() (dbus.ObjectPath, error) {
-	v, err := a.GetProperty("Service")
+// GetWriteAcquired get WriteAcquired value
+func (a *GattCharacteristic1) GetWriteAcquired() (bool, error) {
+	return a.GetWriteAcquiredContext(context.Background())
+}
+
+// GetWriteAcquiredContext get WriteAcquired value, failing with a bluez.ContextError if ctx is done
+func (a *GattCharacteristic1) GetWriteAcquiredContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "WriteAcquired")
 	if err != nil {
-		return dbus.ObjectPath(""), err
+		return false, err
 	}
-	return v.Value().(dbus.ObjectPath), nil
+	return v.Value().(bool), nil
 }
 
 
@@ -223,9 +254,14 @@ func (a *GattCharacteristic1) GetService() (dbus.ObjectPath, error) {
 
 
 
-// GetWriteAcquired get WriteAcquired value
-func (a *GattCharacteristic1) GetWriteAcquired() (bool, error) {
-	v, err := a.GetProperty("WriteAcquired")
+// GetNotifyAcquired get NotifyAcquired value
+func (a *GattCharacteristic1) GetNotifyAcquired() (bool, error) {
+	return a.GetNotifyAcquiredContext(context.Background())
+}
+
+// GetNotifyAcquiredContext get NotifyAcquired value, failing with a bluez.ContextError if ctx is done
+func (a *GattCharacteristic1) GetNotifyAcquiredContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "NotifyAcquired")
 	if err != nil {
 		return false, err
 	}
@@ -239,7 +275,12 @@ func (a *GattCharacteristic1) GetWriteAcquired() (bool, error) {
 
 // GetNotifying get Notifying value
 func (a *GattCharacteristic1) GetNotifying() (bool, error) {
-	v, err := a.GetProperty("Notifying")
+	return a.GetNotifyingContext(context.Background())
+}
+
+// GetNotifyingContext get Notifying value, failing with a bluez.ContextError if ctx is done
+func (a *GattCharacteristic1) GetNotifyingContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Notifying")
 	if err != nil {
 		return false, err
 	}
@@ -249,16 +290,45 @@ func (a *GattCharacteristic1) GetNotifying() (bool, error) {
 
 
 
+
+
+// GetFlags get Flags value
+func (a *GattCharacteristic1) GetFlags() ([]string, error) {
+	return a.GetFlagsContext(context.Background())
+}
+
+// GetFlagsContext get Flags value, failing with a bluez.ContextError if ctx is done
+func (a *GattCharacteristic1) GetFlagsContext(ctx context.Context) ([]string, error) {
+	v, err := a.GetPropertyContext(ctx, "Flags")
+	if err != nil {
+		return []string{}, err
+	}
+	return v.Value().([]string), nil
+}
+
+
+
+
 // SetHandle set Handle value
 func (a *GattCharacteristic1) SetHandle(v uint16) error {
-	return a.SetProperty("Handle", v)
+	return a.SetHandleContext(context.Background(), v)
+}
+
+// SetHandleContext set Handle value, failing with a bluez.ContextError if ctx is done
+func (a *GattCharacteristic1) SetHandleContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "Handle", v)
 }
 
 
 
 // GetHandle get Handle value
 func (a *GattCharacteristic1) GetHandle() (uint16, error) {
-	v, err := a.GetProperty("Handle")
+	return a.GetHandleContext(context.Background())
+}
+
+// GetHandleContext get Handle value, failing with a bluez.ContextError if ctx is done
+func (a *GattCharacteristic1) GetHandleContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Handle")
 	if err != nil {
 		return uint16(0), err
 	}
@@ -270,32 +340,28 @@ func (a *GattCharacteristic1) GetHandle() (uint16, error) {
 
 // SetDescriptors set Descriptors value
 func (a *GattCharacteristic1) SetDescriptors(v []dbus.ObjectPath) error {
-	return a.SetProperty("Descriptors", v)
+	return a.SetDescriptorsContext(context.Background(), v)
+}
+
+// SetDescriptorsContext set Descriptors value, failing with a bluez.ContextError if ctx is done
+func (a *GattCharacteristic1) SetDescriptorsContext(ctx context.Context, v []dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Descriptors", v)
 }
 
 
 
 // GetDescriptors get Descriptors value
 func (a *GattCharacteristic1) GetDescriptors() ([]dbus.ObjectPath, error) {
-	v, err := a.GetProperty("Descriptors")
-	if err != nil {
-		return []dbus.ObjectPath{}, err
-	}
-	return v.Value().([]dbus.ObjectPath), nil
+	return a.GetDescriptorsContext(context.Background())
 }
 
-
-
-
-
-
-// GetUUID get UUID value
-func (a *GattCharacteristic1) GetUUID() (string, error) {
-	v, err := a.GetProperty("UUID")
+// GetDescriptorsContext get Descriptors value, failing with a bluez.ContextError if ctx is done
+func (a *GattCharacteristic1) GetDescriptorsContext(ctx context.Context) ([]dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Descriptors")
 	if err != nil {
-		return "", err
+		return []dbus.ObjectPath{}, err
 	}
-	return v.Value().(string), nil
+	return v.Value().([]dbus.ObjectPath), nil
 }
 
 
@@ -323,35 +389,24 @@ func (a *GattCharacteristic1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *GattCharacteristic1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *GattCharacteristic1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -381,20 +436,31 @@ func (a *GattCharacteristic1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *GattCharacteristic1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *GattCharacteristic1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *GattCharacteristic1) GetProperties() (*GattCharacteristic1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *GattCharacteristic1) GetPropertiesContext(ctx context.Context) (*GattCharacteristic1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -404,29 +470,40 @@ func (a *GattCharacteristic1) SetProperty(name string, value interface{}) error
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *GattCharacteristic1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *GattCharacteristic1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *GattCharacteristic1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *GattCharacteristic1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *GattCharacteristic1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *GattCharacteristic1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -436,6 +513,7 @@ func (a *GattCharacteristic1) WatchProperties() (chan *bluez.PropertyChanged, er
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *GattCharacteristic1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -459,9 +537,14 @@ ReadValue 			Issues a request to read the value of the
 
 */
 func (a *GattCharacteristic1) ReadValue(options map[string]interface{}) ([]byte, error) {
+	return a.ReadValueContext(context.Background(), options)
+}
+
+// ReadValueContext call ReadValue, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *GattCharacteristic1) ReadValueContext(ctx context.Context, options map[string]interface{}) ([]byte, error) {
 	
 	 val0 := []byte{}
-	err := a.client.Call("ReadValue", 0, options).Store(&val0)
+	err := a.client.CallWithContext(ctx, "ReadValue", 0, options).Store(&val0)
 	return val0, err	
 }
 
@@ -490,8 +573,13 @@ WriteValue 			Issues a request to write the value of the
 
 */
 func (a *GattCharacteristic1) WriteValue(value []byte, options map[string]interface{}) error {
+	return a.WriteValueContext(context.Background(), value, options)
+}
+
+// WriteValueContext call WriteValue, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *GattCharacteristic1) WriteValueContext(ctx context.Context, value []byte, options map[string]interface{}) error {
 	
-	return a.client.Call("WriteValue", 0, value, options).Store()
+	return a.client.CallWithContext(ctx, "WriteValue", 0, value, options).Store()
 	
 }
 
@@ -520,10 +608,15 @@ AcquireWrite 			Acquire file descriptor and MTU for writing. Only
 
 */
 func (a *GattCharacteristic1) AcquireWrite(options map[string]interface{}) (dbus.UnixFD, uint16, error) {
+	return a.AcquireWriteContext(context.Background(), options)
+}
+
+// AcquireWriteContext call AcquireWrite, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *GattCharacteristic1) AcquireWriteContext(ctx context.Context, options map[string]interface{}) (dbus.UnixFD, uint16, error) {
 	
 	var val0 dbus.UnixFD
   var val1 uint16
-	err := a.client.Call("AcquireWrite", 0, options).Store(&val0, &val1)
+	err := a.client.CallWithContext(ctx, "AcquireWrite", 0, options).Store(&val0, &val1)
 	return val0, val1, err	
 }
 
@@ -557,10 +650,15 @@ AcquireNotify 			Acquire file descriptor and MTU for notify. Only
 
 */
 func (a *GattCharacteristic1) AcquireNotify(options map[string]interface{}) (dbus.UnixFD, uint16, error) {
+	return a.AcquireNotifyContext(context.Background(), options)
+}
+
+// AcquireNotifyContext call AcquireNotify, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *GattCharacteristic1) AcquireNotifyContext(ctx context.Context, options map[string]interface{}) (dbus.UnixFD, uint16, error) {
 	
 	var val0 dbus.UnixFD
   var val1 uint16
-	err := a.client.Call("AcquireNotify", 0, options).Store(&val0, &val1)
+	err := a.client.CallWithContext(ctx, "AcquireNotify", 0, options).Store(&val0, &val1)
 	return val0, val1, err	
 }
 
@@ -574,8 +672,13 @@ StartNotify 			Starts a notification session from this characteristic
 
 */
 func (a *GattCharacteristic1) StartNotify() error {
+	return a.StartNotifyContext(context.Background())
+}
+
+// StartNotifyContext call StartNotify, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *GattCharacteristic1) StartNotifyContext(ctx context.Context) error {
 	
-	return a.client.Call("StartNotify", 0, ).Store()
+	return a.client.CallWithContext(ctx, "StartNotify", 0, ).Store()
 	
 }
 
@@ -588,8 +691,13 @@ StopNotify 			This method will cancel any previous StartNotify
 
 */
 func (a *GattCharacteristic1) StopNotify() error {
+	return a.StopNotifyContext(context.Background())
+}
+
+// StopNotifyContext call StopNotify, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *GattCharacteristic1) StopNotifyContext(ctx context.Context) error {
 	
-	return a.client.Call("StopNotify", 0, ).Store()
+	return a.client.CallWithContext(ctx, "StopNotify", 0, ).Store()
 	
 }
 
@@ -600,8 +708,13 @@ Confirm 			This method doesn't expect a reply so it is just a
 
 */
 func (a *GattCharacteristic1) Confirm() error {
+	return a.ConfirmContext(context.Background())
+}
+
+// ConfirmContext call Confirm, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *GattCharacteristic1) ConfirmContext(ctx context.Context) error {
 	
-	return a.client.Call("Confirm", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Confirm", 0, ).Store()
 	
 }
 
@@ -0,0 +1,59 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package gatt
+
+
+// GattCharacteristic1Flags is a value documented for GattCharacteristic1.Flags
+type GattCharacteristic1Flags string
+
+const (
+	GattCharacteristic1FlagsBroadcast GattCharacteristic1Flags = "broadcast"
+	GattCharacteristic1FlagsRead GattCharacteristic1Flags = "read"
+	GattCharacteristic1FlagsWriteWithoutResponse GattCharacteristic1Flags = "write-without-response"
+	GattCharacteristic1FlagsWrite GattCharacteristic1Flags = "write"
+	GattCharacteristic1FlagsNotify GattCharacteristic1Flags = "notify"
+	GattCharacteristic1FlagsIndicate GattCharacteristic1Flags = "indicate"
+	GattCharacteristic1FlagsAuthenticatedSignedWrites GattCharacteristic1Flags = "authenticated-signed-writes"
+	GattCharacteristic1FlagsExtendedProperties GattCharacteristic1Flags = "extended-properties"
+	GattCharacteristic1FlagsReliableWrite GattCharacteristic1Flags = "reliable-write"
+	GattCharacteristic1FlagsWritableAuxiliaries GattCharacteristic1Flags = "writable-auxiliaries"
+	GattCharacteristic1FlagsEncryptRead GattCharacteristic1Flags = "encrypt-read"
+	GattCharacteristic1FlagsEncryptWrite GattCharacteristic1Flags = "encrypt-write"
+	GattCharacteristic1FlagsEncryptAuthenticatedRead GattCharacteristic1Flags = "encrypt-authenticated-read"
+	GattCharacteristic1FlagsEncryptAuthenticatedWrite GattCharacteristic1Flags = "encrypt-authenticated-write"
+	GattCharacteristic1FlagsSecureRead GattCharacteristic1Flags = "secure-read"
+	GattCharacteristic1FlagsSecureWrite GattCharacteristic1Flags = "secure-write"
+	GattCharacteristic1FlagsAuthorize GattCharacteristic1Flags = "authorize"
+)
+
+// GattCharacteristic1FlagsValues list the documented values of GattCharacteristic1Flags
+var GattCharacteristic1FlagsValues = []GattCharacteristic1Flags{
+	GattCharacteristic1FlagsBroadcast,
+	GattCharacteristic1FlagsRead,
+	GattCharacteristic1FlagsWriteWithoutResponse,
+	GattCharacteristic1FlagsWrite,
+	GattCharacteristic1FlagsNotify,
+	GattCharacteristic1FlagsIndicate,
+	GattCharacteristic1FlagsAuthenticatedSignedWrites,
+	GattCharacteristic1FlagsExtendedProperties,
+	GattCharacteristic1FlagsReliableWrite,
+	GattCharacteristic1FlagsWritableAuxiliaries,
+	GattCharacteristic1FlagsEncryptRead,
+	GattCharacteristic1FlagsEncryptWrite,
+	GattCharacteristic1FlagsEncryptAuthenticatedRead,
+	GattCharacteristic1FlagsEncryptAuthenticatedWrite,
+	GattCharacteristic1FlagsSecureRead,
+	GattCharacteristic1FlagsSecureWrite,
+	GattCharacteristic1FlagsAuthorize,
+}
+
+// Valid report if v is one of the documented values
+func (v GattCharacteristic1Flags) Valid() bool {
+	for _, value := range GattCharacteristic1FlagsValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
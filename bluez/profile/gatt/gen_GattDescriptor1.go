@@ -5,6 +5,7 @@ package gatt
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var GattDescriptor1Interface = "org.bluez.GattDescriptor1"
 // Args:
 // - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX/serviceXX/charYYYY/descriptorZZZ
 func NewGattDescriptor1(objectPath dbus.ObjectPath) (*GattDescriptor1, error) {
+	return NewGattDescriptor1WithConn(nil, objectPath)
+}
+
+// NewGattDescriptor1WithConn create a new instance of GattDescriptor1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX/serviceXX/charYYYY/descriptorZZZ
+func NewGattDescriptor1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*GattDescriptor1, error) {
 	a := new(GattDescriptor1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewGattDescriptor1(objectPath dbus.ObjectPath) (*GattDescriptor1, error) {
 			Iface: GattDescriptor1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -49,8 +59,7 @@ Local or remote GATT characteristic descriptors hierarchy.
 */
 type GattDescriptor1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*GattDescriptor1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -101,6 +110,8 @@ type GattDescriptor1Properties struct {
 			which may fail, to auto allocate the value 0x0000
 			shall be used which will cause the allocated handle to
 			be set once registered.
+
+	Since BlueZ 5.53
 	*/
 	Handle uint16
 
@@ -123,7 +134,12 @@ func (p *GattDescriptor1Properties) Unlock() {
 
 // GetUUID get UUID value
 func (a *GattDescriptor1) GetUUID() (string, error) {
-	v, err := a.GetProperty("UUID")
+	return a.GetUUIDContext(context.Background())
+}
+
+// GetUUIDContext get UUID value, failing with a bluez.ContextError if ctx is done
+func (a *GattDescriptor1) GetUUIDContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "UUID")
 	if err != nil {
 		return "", err
 	}
@@ -137,7 +153,12 @@ func (a *GattDescriptor1) GetUUID() (string, error) {
 
 // GetCharacteristic get Characteristic value
 func (a *GattDescriptor1) GetCharacteristic() (dbus.ObjectPath, error) {
-	v, err := a.GetProperty("Characteristic")
+	return a.GetCharacteristicContext(context.Background())
+}
+
+// GetCharacteristicContext get Characteristic value, failing with a bluez.ContextError if ctx is done
+func (a *GattDescriptor1) GetCharacteristicContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Characteristic")
 	if err != nil {
 		return dbus.ObjectPath(""), err
 	}
@@ -151,7 +172,12 @@ func (a *GattDescriptor1) GetCharacteristic() (dbus.ObjectPath, error) {
 
 // GetValue get Value value
 func (a *GattDescriptor1) GetValue() ([]byte, error) {
-	v, err := a.GetProperty("Value")
+	return a.GetValueContext(context.Background())
+}
+
+// GetValueContext get Value value, failing with a bluez.ContextError if ctx is done
+func (a *GattDescriptor1) GetValueContext(ctx context.Context) ([]byte, error) {
+	v, err := a.GetPropertyContext(ctx, "Value")
 	if err != nil {
 		return []byte{}, err
 	}
@@ -165,7 +191,12 @@ func (a *GattDescriptor1) GetValue() ([]byte, error) {
 
 // GetFlags get Flags value
 func (a *GattDescriptor1) GetFlags() ([]string, error) {
-	v, err := a.GetProperty("Flags")
+	return a.GetFlagsContext(context.Background())
+}
+
+// GetFlagsContext get Flags value, failing with a bluez.ContextError if ctx is done
+func (a *GattDescriptor1) GetFlagsContext(ctx context.Context) ([]string, error) {
+	v, err := a.GetPropertyContext(ctx, "Flags")
 	if err != nil {
 		return []string{}, err
 	}
@@ -177,14 +208,24 @@ func (a *GattDescriptor1) GetFlags() ([]string, error) {
 
 // SetHandle set Handle value
 func (a *GattDescriptor1) SetHandle(v uint16) error {
-	return a.SetProperty("Handle", v)
+	return a.SetHandleContext(context.Background(), v)
+}
+
+// SetHandleContext set Handle value, failing with a bluez.ContextError if ctx is done
+func (a *GattDescriptor1) SetHandleContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "Handle", v)
 }
 
 
 
 // GetHandle get Handle value
 func (a *GattDescriptor1) GetHandle() (uint16, error) {
-	v, err := a.GetProperty("Handle")
+	return a.GetHandleContext(context.Background())
+}
+
+// GetHandleContext get Handle value, failing with a bluez.ContextError if ctx is done
+func (a *GattDescriptor1) GetHandleContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Handle")
 	if err != nil {
 		return uint16(0), err
 	}
@@ -216,35 +257,24 @@ func (a *GattDescriptor1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *GattDescriptor1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *GattDescriptor1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -274,20 +304,31 @@ func (a *GattDescriptor1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *GattDescriptor1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *GattDescriptor1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *GattDescriptor1) GetProperties() (*GattDescriptor1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *GattDescriptor1) GetPropertiesContext(ctx context.Context) (*GattDescriptor1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -297,29 +338,40 @@ func (a *GattDescriptor1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *GattDescriptor1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *GattDescriptor1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *GattDescriptor1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *GattDescriptor1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *GattDescriptor1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *GattDescriptor1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -329,6 +381,7 @@ func (a *GattDescriptor1) WatchProperties() (chan *bluez.PropertyChanged, error)
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *GattDescriptor1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -351,9 +404,14 @@ ReadValue 			Issues a request to read the value of the
 
 */
 func (a *GattDescriptor1) ReadValue(flags map[string]interface{}) ([]byte, error) {
+	return a.ReadValueContext(context.Background(), flags)
+}
+
+// ReadValueContext call ReadValue, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *GattDescriptor1) ReadValueContext(ctx context.Context, flags map[string]interface{}) ([]byte, error) {
 	
 	 val0 := []byte{}
-	err := a.client.Call("ReadValue", 0, flags).Store(&val0)
+	err := a.client.CallWithContext(ctx, "ReadValue", 0, flags).Store(&val0)
 	return val0, err	
 }
 
@@ -375,8 +433,13 @@ WriteValue 			Issues a request to write the value of the
 
 */
 func (a *GattDescriptor1) WriteValue(value []byte, flags map[string]interface{}) error {
+	return a.WriteValueContext(context.Background(), value, flags)
+}
+
+// WriteValueContext call WriteValue, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *GattDescriptor1) WriteValueContext(ctx context.Context, value []byte, flags map[string]interface{}) error {
 	
-	return a.client.Call("WriteValue", 0, value, flags).Store()
+	return a.client.CallWithContext(ctx, "WriteValue", 0, value, flags).Store()
 	
 }
 
@@ -0,0 +1,55 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package gatt
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// GattDescriptor1API is the interface of GattDescriptor1, implemented by
+// FakeGattDescriptor1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for GattDescriptor1.
+type GattDescriptor1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*GattDescriptor1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*GattDescriptor1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	GetUUID() (string, error)
+	GetUUIDContext(ctx context.Context) (string, error)
+
+	GetCharacteristic() (dbus.ObjectPath, error)
+	GetCharacteristicContext(ctx context.Context) (dbus.ObjectPath, error)
+
+	GetValue() ([]byte, error)
+	GetValueContext(ctx context.Context) ([]byte, error)
+
+	GetFlags() ([]string, error)
+	GetFlagsContext(ctx context.Context) ([]string, error)
+
+	SetHandle(v uint16) error
+	SetHandleContext(ctx context.Context, v uint16) error
+	GetHandle() (uint16, error)
+	GetHandleContext(ctx context.Context) (uint16, error)
+
+	ReadValue(flags map[string]interface{}) ([]byte, error)
+	ReadValueContext(ctx context.Context, flags map[string]interface{}) ([]byte, error)
+	WriteValue(value []byte, flags map[string]interface{}) error
+	WriteValueContext(ctx context.Context, value []byte, flags map[string]interface{}) error
+}
+
+var _ GattDescriptor1API = (*GattDescriptor1)(nil)
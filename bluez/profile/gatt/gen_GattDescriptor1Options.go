@@ -0,0 +1,166 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package gatt
+
+import (
+   "fmt"
+   "github.com/godbus/dbus/v5"
+)
+
+// GattDescriptor1ReadValueOptions are the options of GattDescriptor1.ReadValue, passed as flags
+type GattDescriptor1ReadValueOptions struct {
+
+	// Offset Start offset
+	Offset uint16
+
+	// Device Device path (Server only)
+	Device dbus.ObjectPath
+
+	// Link Link type (Server only)
+	Link string
+}
+
+// ToMap convert the options to the flags argument, the options not set are omitted
+func (o *GattDescriptor1ReadValueOptions) ToMap() map[string]interface{} {
+	options := map[string]interface{}{}
+	if o.Offset != 0 {
+		options["offset"] = o.Offset
+	}
+	if o.Device != "" {
+		options["device"] = o.Device
+	}
+	if o.Link != "" {
+		options["link"] = o.Link
+	}
+	return options
+}
+
+// FromMap set the options from the flags argument, eg. received by a service.
+// Unknown keys are ignored, the options with an unexpected type are left unset
+// and reported by the error.
+func (o *GattDescriptor1ReadValueOptions) FromMap(options map[string]interface{}) error {
+	var err error
+	for key, value := range options {
+		if variant, ok := value.(dbus.Variant); ok {
+			value = variant.Value()
+		}
+		switch key {
+		case "offset":
+			v, ok := value.(uint16)
+			if !ok {
+				if err == nil {
+					err = fmt.Errorf("GattDescriptor1ReadValueOptions: %s has type %T", key, value)
+				}
+				continue
+			}
+			o.Offset = v
+		case "device":
+			v, ok := value.(dbus.ObjectPath)
+			if !ok {
+				if err == nil {
+					err = fmt.Errorf("GattDescriptor1ReadValueOptions: %s has type %T", key, value)
+				}
+				continue
+			}
+			o.Device = v
+		case "link":
+			v, ok := value.(string)
+			if !ok {
+				if err == nil {
+					err = fmt.Errorf("GattDescriptor1ReadValueOptions: %s has type %T", key, value)
+				}
+				continue
+			}
+			o.Link = v
+		}
+	}
+	return err
+}
+
+// GattDescriptor1WriteValueOptions are the options of GattDescriptor1.WriteValue, passed as flags
+type GattDescriptor1WriteValueOptions struct {
+
+	// Offset Start offset
+	Offset uint16
+
+	// Device Device path (Server only)
+	Device dbus.ObjectPath
+
+	// Link Link type (Server only)
+	Link string
+
+	// PrepareAuthorize boolean Is prepare
+	// authorization
+	// request
+	PrepareAuthorize bool
+}
+
+// ToMap convert the options to the flags argument, the options not set are omitted
+func (o *GattDescriptor1WriteValueOptions) ToMap() map[string]interface{} {
+	options := map[string]interface{}{}
+	if o.Offset != 0 {
+		options["offset"] = o.Offset
+	}
+	if o.Device != "" {
+		options["device"] = o.Device
+	}
+	if o.Link != "" {
+		options["link"] = o.Link
+	}
+	if o.PrepareAuthorize {
+		options["prepare-authorize"] = o.PrepareAuthorize
+	}
+	return options
+}
+
+// FromMap set the options from the flags argument, eg. received by a service.
+// Unknown keys are ignored, the options with an unexpected type are left unset
+// and reported by the error.
+func (o *GattDescriptor1WriteValueOptions) FromMap(options map[string]interface{}) error {
+	var err error
+	for key, value := range options {
+		if variant, ok := value.(dbus.Variant); ok {
+			value = variant.Value()
+		}
+		switch key {
+		case "offset":
+			v, ok := value.(uint16)
+			if !ok {
+				if err == nil {
+					err = fmt.Errorf("GattDescriptor1WriteValueOptions: %s has type %T", key, value)
+				}
+				continue
+			}
+			o.Offset = v
+		case "device":
+			v, ok := value.(dbus.ObjectPath)
+			if !ok {
+				if err == nil {
+					err = fmt.Errorf("GattDescriptor1WriteValueOptions: %s has type %T", key, value)
+				}
+				continue
+			}
+			o.Device = v
+		case "link":
+			v, ok := value.(string)
+			if !ok {
+				if err == nil {
+					err = fmt.Errorf("GattDescriptor1WriteValueOptions: %s has type %T", key, value)
+				}
+				continue
+			}
+			o.Link = v
+		case "prepare-authorize":
+			v, ok := value.(bool)
+			if !ok {
+				if err == nil {
+					err = fmt.Errorf("GattDescriptor1WriteValueOptions: %s has type %T", key, value)
+				}
+				continue
+			}
+			o.PrepareAuthorize = v
+		}
+	}
+	return err
+}
+
@@ -0,0 +1,43 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package gatt
+
+
+// GattDescriptor1Flags is a value documented for GattDescriptor1.Flags
+type GattDescriptor1Flags string
+
+const (
+	GattDescriptor1FlagsRead GattDescriptor1Flags = "read"
+	GattDescriptor1FlagsWrite GattDescriptor1Flags = "write"
+	GattDescriptor1FlagsEncryptRead GattDescriptor1Flags = "encrypt-read"
+	GattDescriptor1FlagsEncryptWrite GattDescriptor1Flags = "encrypt-write"
+	GattDescriptor1FlagsEncryptAuthenticatedRead GattDescriptor1Flags = "encrypt-authenticated-read"
+	GattDescriptor1FlagsEncryptAuthenticatedWrite GattDescriptor1Flags = "encrypt-authenticated-write"
+	GattDescriptor1FlagsSecureRead GattDescriptor1Flags = "secure-read"
+	GattDescriptor1FlagsSecureWrite GattDescriptor1Flags = "secure-write"
+	GattDescriptor1FlagsAuthorize GattDescriptor1Flags = "authorize"
+)
+
+// GattDescriptor1FlagsValues list the documented values of GattDescriptor1Flags
+var GattDescriptor1FlagsValues = []GattDescriptor1Flags{
+	GattDescriptor1FlagsRead,
+	GattDescriptor1FlagsWrite,
+	GattDescriptor1FlagsEncryptRead,
+	GattDescriptor1FlagsEncryptWrite,
+	GattDescriptor1FlagsEncryptAuthenticatedRead,
+	GattDescriptor1FlagsEncryptAuthenticatedWrite,
+	GattDescriptor1FlagsSecureRead,
+	GattDescriptor1FlagsSecureWrite,
+	GattDescriptor1FlagsAuthorize,
+}
+
+// Valid report if v is one of the documented values
+func (v GattDescriptor1Flags) Valid() bool {
+	for _, value := range GattDescriptor1FlagsValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
@@ -5,6 +5,7 @@ package gatt
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -21,6 +22,14 @@ var GattManager1Interface = "org.bluez.GattManager1"
 // Args:
 // - objectPath: [variable prefix]/{hci0,hci1,...}
 func NewGattManager1(objectPath dbus.ObjectPath) (*GattManager1, error) {
+	return NewGattManager1WithConn(nil, objectPath)
+}
+
+// NewGattManager1WithConn create a new instance of GattManager1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [variable prefix]/{hci0,hci1,...}
+func NewGattManager1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*GattManager1, error) {
 	a := new(GattManager1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -28,6 +37,7 @@ func NewGattManager1(objectPath dbus.ObjectPath) (*GattManager1, error) {
 			Iface: GattManager1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -44,6 +54,12 @@ func NewGattManager1(objectPath dbus.ObjectPath) (*GattManager1, error) {
 // NewGattManager1FromAdapterID create a new instance of GattManager1
 // adapterID: ID of an adapter eg. hci0
 func NewGattManager1FromAdapterID(adapterID string) (*GattManager1, error) {
+	return NewGattManager1FromAdapterIDWithConn(nil, adapterID)
+}
+
+// NewGattManager1FromAdapterIDWithConn create a new instance of GattManager1 on a connection, nil for the shared system bus connection
+// adapterID: ID of an adapter eg. hci0
+func NewGattManager1FromAdapterIDWithConn(conn *bluez.Conn, adapterID string) (*GattManager1, error) {
 	a := new(GattManager1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -51,6 +67,7 @@ func NewGattManager1FromAdapterID(adapterID string) (*GattManager1, error) {
 			Iface: GattManager1Interface,
 			Path:  dbus.ObjectPath(fmt.Sprintf("/org/bluez/%s", adapterID)),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -137,8 +154,7 @@ Examples:
 */
 type GattManager1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*GattManager1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -185,35 +201,24 @@ func (a *GattManager1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *GattManager1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *GattManager1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -243,20 +248,31 @@ func (a *GattManager1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *GattManager1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *GattManager1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *GattManager1) GetProperties() (*GattManager1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *GattManager1) GetPropertiesContext(ctx context.Context) (*GattManager1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -266,29 +282,40 @@ func (a *GattManager1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *GattManager1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *GattManager1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *GattManager1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *GattManager1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *GattManager1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *GattManager1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -298,6 +325,7 @@ func (a *GattManager1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *GattManager1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -317,8 +345,13 @@ RegisterApplication 			Registers a local GATT services hierarchy as described
 
 */
 func (a *GattManager1) RegisterApplication(application dbus.ObjectPath, options map[string]interface{}) error {
+	return a.RegisterApplicationContext(context.Background(), application, options)
+}
+
+// RegisterApplicationContext call RegisterApplication, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *GattManager1) RegisterApplicationContext(ctx context.Context, application dbus.ObjectPath, options map[string]interface{}) error {
 	
-	return a.client.Call("RegisterApplication", 0, application, options).Store()
+	return a.client.CallWithContext(ctx, "RegisterApplication", 0, application, options).Store()
 	
 }
 
@@ -332,8 +365,13 @@ UnregisterApplication 			This unregisters the services that has been
 
 */
 func (a *GattManager1) UnregisterApplication(application dbus.ObjectPath) error {
+	return a.UnregisterApplicationContext(context.Background(), application)
+}
+
+// UnregisterApplicationContext call UnregisterApplication, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *GattManager1) UnregisterApplicationContext(ctx context.Context, application dbus.ObjectPath) error {
 	
-	return a.client.Call("UnregisterApplication", 0, application).Store()
+	return a.client.CallWithContext(ctx, "UnregisterApplication", 0, application).Store()
 	
 }
 
@@ -0,0 +1,38 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package gatt
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// GattManager1API is the interface of GattManager1, implemented by
+// FakeGattManager1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for GattManager1.
+type GattManager1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*GattManager1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*GattManager1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	RegisterApplication(application dbus.ObjectPath, options map[string]interface{}) error
+	RegisterApplicationContext(ctx context.Context, application dbus.ObjectPath, options map[string]interface{}) error
+	UnregisterApplication(application dbus.ObjectPath) error
+	UnregisterApplicationContext(ctx context.Context, application dbus.ObjectPath) error
+}
+
+var _ GattManager1API = (*GattManager1)(nil)
@@ -5,6 +5,7 @@ package gatt
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -21,6 +22,15 @@ var GattProfile1Interface = "org.bluez.GattProfile1"
 // - servicePath: <application dependent>
 // - objectPath: <application dependent>
 func NewGattProfile1(servicePath string, objectPath dbus.ObjectPath) (*GattProfile1, error) {
+	return NewGattProfile1WithConn(nil, servicePath, objectPath)
+}
+
+// NewGattProfile1WithConn create a new instance of GattProfile1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - servicePath: <application dependent>
+// - objectPath: <application dependent>
+func NewGattProfile1WithConn(conn *bluez.Conn, servicePath string, objectPath dbus.ObjectPath) (*GattProfile1, error) {
 	a := new(GattProfile1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -28,6 +38,7 @@ func NewGattProfile1(servicePath string, objectPath dbus.ObjectPath) (*GattProfi
 			Iface: GattProfile1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -53,8 +64,7 @@ supporting it.
 */
 type GattProfile1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*GattProfile1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -88,7 +98,12 @@ func (p *GattProfile1Properties) Unlock() {
 
 // GetUUIDs get UUIDs value
 func (a *GattProfile1) GetUUIDs() ([]string, error) {
-	v, err := a.GetProperty("UUIDs")
+	return a.GetUUIDsContext(context.Background())
+}
+
+// GetUUIDsContext get UUIDs value, failing with a bluez.ContextError if ctx is done
+func (a *GattProfile1) GetUUIDsContext(ctx context.Context) ([]string, error) {
+	v, err := a.GetPropertyContext(ctx, "UUIDs")
 	if err != nil {
 		return []string{}, err
 	}
@@ -120,35 +135,24 @@ func (a *GattProfile1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *GattProfile1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *GattProfile1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
-
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -178,20 +182,31 @@ func (a *GattProfile1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *GattProfile1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *GattProfile1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *GattProfile1) GetProperties() (*GattProfile1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *GattProfile1) GetPropertiesContext(ctx context.Context) (*GattProfile1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -201,29 +216,40 @@ func (a *GattProfile1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *GattProfile1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *GattProfile1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *GattProfile1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *GattProfile1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *GattProfile1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *GattProfile1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -233,6 +259,7 @@ func (a *GattProfile1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *GattProfile1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -249,8 +276,13 @@ Release 			This method gets called when the service daemon
 
 */
 func (a *GattProfile1) Release() error {
+	return a.ReleaseContext(context.Background())
+}
+
+// ReleaseContext call Release, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *GattProfile1) ReleaseContext(ctx context.Context) error {
 	
-	return a.client.Call("Release", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Release", 0, ).Store()
 	
 }
 
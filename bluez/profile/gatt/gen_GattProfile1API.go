@@ -0,0 +1,39 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package gatt
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// GattProfile1API is the interface of GattProfile1, implemented by
+// FakeGattProfile1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for GattProfile1.
+type GattProfile1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*GattProfile1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*GattProfile1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	GetUUIDs() ([]string, error)
+	GetUUIDsContext(ctx context.Context) ([]string, error)
+
+	Release() error
+	ReleaseContext(ctx context.Context) error
+}
+
+var _ GattProfile1API = (*GattProfile1)(nil)
@@ -5,6 +5,7 @@ package gatt
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var GattService1Interface = "org.bluez.GattService1"
 // Args:
 // - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX/serviceXX
 func NewGattService1(objectPath dbus.ObjectPath) (*GattService1, error) {
+	return NewGattService1WithConn(nil, objectPath)
+}
+
+// NewGattService1WithConn create a new instance of GattService1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX/serviceXX
+func NewGattService1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*GattService1, error) {
 	a := new(GattService1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewGattService1(objectPath dbus.ObjectPath) (*GattService1, error) {
 			Iface: GattService1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -54,8 +64,7 @@ properties defined in GattService1 interface.
 */
 type GattService1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*GattService1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -65,25 +74,6 @@ type GattService1 struct {
 type GattService1Properties struct {
 	lock sync.RWMutex `dbus:"ignore"`
 
-	/*
-	Handle Service handle. When available in the server it
-			would attempt to use to allocate into the database
-			which may fail, to auto allocate the value 0x0000
-			shall be used which will cause the allocated handle to
-			be set once registered.
-	*/
-	Handle uint16
-
-	/*
-	IsService 
-	*/
-	IsService bool `dbus:"ignore"`
-
-	/*
-	Characteristics 
-	*/
-	Characteristics []dbus.ObjectPath `dbus:"emit"`
-
 	/*
 	UUID 128-bit service UUID.
 	*/
@@ -108,6 +98,27 @@ type GattService1Properties struct {
 	*/
 	Includes []dbus.ObjectPath `dbus:"omitEmpty"`
 
+	/*
+	Handle Service handle. When available in the server it
+			would attempt to use to allocate into the database
+			which may fail, to auto allocate the value 0x0000
+			shall be used which will cause the allocated handle to
+			be set once registered.
+
+	Since BlueZ 5.53
+	*/
+	Handle uint16
+
+	/*
+	Characteristics 
+	*/
+	Characteristics []dbus.ObjectPath `dbus:"emit"`
+
+	/*
+	IsService 
+	*/
+	IsService bool `dbus:"ignore"`
+
 }
 
 //Lock access to properties
@@ -123,35 +134,35 @@ func (p *GattService1Properties) Unlock() {
 
 
 
-// SetHandle set Handle value
-func (a *GattService1) SetHandle(v uint16) error {
-	return a.SetProperty("Handle", v)
-}
 
 
+// GetUUID get UUID value
+func (a *GattService1) GetUUID() (string, error) {
+	return a.GetUUIDContext(context.Background())
+}
 
-// GetHandle get Handle value
-func (a *GattService1) GetHandle() (uint16, error) {
-	v, err := a.GetProperty("Handle")
+// GetUUIDContext get UUID value, failing with a bluez.ContextError if ctx is done
+func (a *GattService1) GetUUIDContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "UUID")
 	if err != nil {
-		return uint16(0), err
+		return "", err
 	}
-	return v.Value().(uint16), nil
+	return v.Value().(string), nil
 }
 
 
 
 
-// SetIsService set IsService value
-func (a *GattService1) SetIsService(v bool) error {
-	return a.SetProperty("IsService", v)
-}
 
 
+// GetPrimary get Primary value
+func (a *GattService1) GetPrimary() (bool, error) {
+	return a.GetPrimaryContext(context.Background())
+}
 
-// GetIsService get IsService value
-func (a *GattService1) GetIsService() (bool, error) {
-	v, err := a.GetProperty("IsService")
+// GetPrimaryContext get Primary value, failing with a bluez.ContextError if ctx is done
+func (a *GattService1) GetPrimaryContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Primary")
 	if err != nil {
 		return false, err
 	}
@@ -161,20 +172,20 @@ func (a *GattService1) GetIsService() (bool, error) {
 
 
 
-// SetCharacteristics set Characteristics value
-func (a *GattService1) SetCharacteristics(v []dbus.ObjectPath) error {
-	return a.SetProperty("Characteristics", v)
-}
 
 
+// GetDevice get Device value
+func (a *GattService1) GetDevice() (dbus.ObjectPath, error) {
+	return a.GetDeviceContext(context.Background())
+}
 
-// GetCharacteristics get Characteristics value
-func (a *GattService1) GetCharacteristics() ([]dbus.ObjectPath, error) {
-	v, err := a.GetProperty("Characteristics")
+// GetDeviceContext get Device value, failing with a bluez.ContextError if ctx is done
+func (a *GattService1) GetDeviceContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Device")
 	if err != nil {
-		return []dbus.ObjectPath{}, err
+		return dbus.ObjectPath(""), err
 	}
-	return v.Value().([]dbus.ObjectPath), nil
+	return v.Value().(dbus.ObjectPath), nil
 }
 
 
@@ -182,55 +193,105 @@ func (a *GattService1) GetCharacteristics() ([]dbus.ObjectPath, error) {
 
 
 
-// GetUUID get UUID value
-func (a *GattService1) GetUUID() (string, error) {
-	v, err := a.GetProperty("UUID")
+// GetIncludes get Includes value
+func (a *GattService1) GetIncludes() ([]dbus.ObjectPath, error) {
+	return a.GetIncludesContext(context.Background())
+}
+
+// GetIncludesContext get Includes value, failing with a bluez.ContextError if ctx is done
+func (a *GattService1) GetIncludesContext(ctx context.Context) ([]dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Includes")
 	if err != nil {
-		return "", err
+		return []dbus.ObjectPath{}, err
 	}
-	return v.Value().(string), nil
+	return v.Value().([]dbus.ObjectPath), nil
 }
 
 
 
 
+// SetHandle set Handle value
+func (a *GattService1) SetHandle(v uint16) error {
+	return a.SetHandleContext(context.Background(), v)
+}
 
+// SetHandleContext set Handle value, failing with a bluez.ContextError if ctx is done
+func (a *GattService1) SetHandleContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "Handle", v)
+}
 
-// GetPrimary get Primary value
-func (a *GattService1) GetPrimary() (bool, error) {
-	v, err := a.GetProperty("Primary")
+
+
+// GetHandle get Handle value
+func (a *GattService1) GetHandle() (uint16, error) {
+	return a.GetHandleContext(context.Background())
+}
+
+// GetHandleContext get Handle value, failing with a bluez.ContextError if ctx is done
+func (a *GattService1) GetHandleContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Handle")
 	if err != nil {
-		return false, err
+		return uint16(0), err
 	}
-	return v.Value().(bool), nil
+	return v.Value().(uint16), nil
 }
 
 
 
 
+// SetCharacteristics set Characteristics value
+func (a *GattService1) SetCharacteristics(v []dbus.ObjectPath) error {
+	return a.SetCharacteristicsContext(context.Background(), v)
+}
 
+// SetCharacteristicsContext set Characteristics value, failing with a bluez.ContextError if ctx is done
+func (a *GattService1) SetCharacteristicsContext(ctx context.Context, v []dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Characteristics", v)
+}
 
-// GetDevice get Device value
-func (a *GattService1) GetDevice() (dbus.ObjectPath, error) {
-	v, err := a.GetProperty("Device")
+
+
+// GetCharacteristics get Characteristics value
+func (a *GattService1) GetCharacteristics() ([]dbus.ObjectPath, error) {
+	return a.GetCharacteristicsContext(context.Background())
+}
+
+// GetCharacteristicsContext get Characteristics value, failing with a bluez.ContextError if ctx is done
+func (a *GattService1) GetCharacteristicsContext(ctx context.Context) ([]dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Characteristics")
 	if err != nil {
-		return dbus.ObjectPath(""), err
+		return []dbus.ObjectPath{}, err
 	}
-	return v.Value().(dbus.ObjectPath), nil
+	return v.Value().([]dbus.ObjectPath), nil
 }
 
 
 
 
+// SetIsService set IsService value
+func (a *GattService1) SetIsService(v bool) error {
+	return a.SetIsServiceContext(context.Background(), v)
+}
+
+// SetIsServiceContext set IsService value, failing with a bluez.ContextError if ctx is done
+func (a *GattService1) SetIsServiceContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "IsService", v)
+}
 
 
-// GetIncludes get Includes value
-func (a *GattService1) GetIncludes() ([]dbus.ObjectPath, error) {
-	v, err := a.GetProperty("Includes")
+
+// GetIsService get IsService value
+func (a *GattService1) GetIsService() (bool, error) {
+	return a.GetIsServiceContext(context.Background())
+}
+
+// GetIsServiceContext get IsService value, failing with a bluez.ContextError if ctx is done
+func (a *GattService1) GetIsServiceContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "IsService")
 	if err != nil {
-		return []dbus.ObjectPath{}, err
+		return false, err
 	}
-	return v.Value().([]dbus.ObjectPath), nil
+	return v.Value().(bool), nil
 }
 
 
@@ -258,35 +319,24 @@ func (a *GattService1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *GattService1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *GattService1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -316,20 +366,31 @@ func (a *GattService1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *GattService1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *GattService1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *GattService1) GetProperties() (*GattService1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *GattService1) GetPropertiesContext(ctx context.Context) (*GattService1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -339,29 +400,40 @@ func (a *GattService1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *GattService1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *GattService1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *GattService1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *GattService1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *GattService1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *GattService1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -371,6 +443,7 @@ func (a *GattService1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *GattService1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -0,0 +1,61 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package gatt
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// GattService1API is the interface of GattService1, implemented by
+// FakeGattService1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for GattService1.
+type GattService1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*GattService1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*GattService1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	GetUUID() (string, error)
+	GetUUIDContext(ctx context.Context) (string, error)
+
+	GetPrimary() (bool, error)
+	GetPrimaryContext(ctx context.Context) (bool, error)
+
+	GetDevice() (dbus.ObjectPath, error)
+	GetDeviceContext(ctx context.Context) (dbus.ObjectPath, error)
+
+	GetIncludes() ([]dbus.ObjectPath, error)
+	GetIncludesContext(ctx context.Context) ([]dbus.ObjectPath, error)
+
+	SetHandle(v uint16) error
+	SetHandleContext(ctx context.Context, v uint16) error
+	GetHandle() (uint16, error)
+	GetHandleContext(ctx context.Context) (uint16, error)
+
+	SetCharacteristics(v []dbus.ObjectPath) error
+	SetCharacteristicsContext(ctx context.Context, v []dbus.ObjectPath) error
+	GetCharacteristics() ([]dbus.ObjectPath, error)
+	GetCharacteristicsContext(ctx context.Context) ([]dbus.ObjectPath, error)
+
+	SetIsService(v bool) error
+	SetIsServiceContext(ctx context.Context, v bool) error
+	GetIsService() (bool, error)
+	GetIsServiceContext(ctx context.Context) (bool, error)
+
+}
+
+var _ GattService1API = (*GattService1)(nil)
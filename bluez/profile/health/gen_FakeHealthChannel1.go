@@ -0,0 +1,253 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package health
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeHealthChannel1 is an in-memory HealthChannel1API to test the code depending on
+// HealthChannel1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeHealthChannel1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// AcquireFunc handle the calls to Acquire
+	AcquireFunc func(ctx context.Context) (dbus.UnixFD, error)
+	// ReleaseFunc handle the calls to Release
+	ReleaseFunc func(ctx context.Context) error
+}
+
+var _ HealthChannel1API = (*FakeHealthChannel1)(nil)
+
+
+// NewFakeHealthChannel1 create a FakeHealthChannel1 with the initial properties, nil for none
+func NewFakeHealthChannel1(objectPath dbus.ObjectPath, properties *HealthChannel1Properties) *FakeHealthChannel1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeHealthChannel1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(HealthChannel1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeHealthChannel1 object path
+func (a *FakeHealthChannel1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return HealthChannel1 interface
+func (a *FakeHealthChannel1) Interface() string {
+	return HealthChannel1Interface
+}
+
+// Close stop the watchers
+func (a *FakeHealthChannel1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeHealthChannel1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeHealthChannel1) GetProperties() (*HealthChannel1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeHealthChannel1) GetPropertiesContext(ctx context.Context) (*HealthChannel1Properties, error) {
+	return new(HealthChannel1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeHealthChannel1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeHealthChannel1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeHealthChannel1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeHealthChannel1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeHealthChannel1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeHealthChannel1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeHealthChannel1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+// SetType set Type value
+func (a *FakeHealthChannel1) SetType(v string) error {
+	return a.SetTypeContext(context.Background(), v)
+}
+
+// SetTypeContext set Type value
+func (a *FakeHealthChannel1) SetTypeContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Type", v)
+}
+
+
+
+// GetType get Type value
+func (a *FakeHealthChannel1) GetType() (string, error) {
+	return a.GetTypeContext(context.Background())
+}
+
+// GetTypeContext get Type value
+func (a *FakeHealthChannel1) GetTypeContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Type")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Type: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetDevice set Device value
+func (a *FakeHealthChannel1) SetDevice(v dbus.ObjectPath) error {
+	return a.SetDeviceContext(context.Background(), v)
+}
+
+// SetDeviceContext set Device value
+func (a *FakeHealthChannel1) SetDeviceContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Device", v)
+}
+
+
+
+// GetDevice get Device value
+func (a *FakeHealthChannel1) GetDevice() (dbus.ObjectPath, error) {
+	return a.GetDeviceContext(context.Background())
+}
+
+// GetDeviceContext get Device value
+func (a *FakeHealthChannel1) GetDeviceContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Device")
+	if err != nil {
+		return dbus.ObjectPath(""), err
+	}
+	value, ok := v.Value().(dbus.ObjectPath)
+	if !ok {
+		return dbus.ObjectPath(""), fmt.Errorf("Device: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetApplication set Application value
+func (a *FakeHealthChannel1) SetApplication(v dbus.ObjectPath) error {
+	return a.SetApplicationContext(context.Background(), v)
+}
+
+// SetApplicationContext set Application value
+func (a *FakeHealthChannel1) SetApplicationContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Application", v)
+}
+
+
+
+// GetApplication get Application value
+func (a *FakeHealthChannel1) GetApplication() (dbus.ObjectPath, error) {
+	return a.GetApplicationContext(context.Background())
+}
+
+// GetApplicationContext get Application value
+func (a *FakeHealthChannel1) GetApplicationContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Application")
+	if err != nil {
+		return dbus.ObjectPath(""), err
+	}
+	value, ok := v.Value().(dbus.ObjectPath)
+	if !ok {
+		return dbus.ObjectPath(""), fmt.Errorf("Application: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+// Acquire call AcquireFunc
+func (a *FakeHealthChannel1) Acquire() (dbus.UnixFD, error) {
+	return a.AcquireContext(context.Background())
+}
+
+// AcquireContext call AcquireFunc
+func (a *FakeHealthChannel1) AcquireContext(ctx context.Context) (dbus.UnixFD, error) {
+	if a.AcquireFunc != nil {
+		return a.AcquireFunc(ctx)
+	}
+	
+	var val0 dbus.UnixFD
+	return val0, nil	
+}
+
+// Release call ReleaseFunc
+func (a *FakeHealthChannel1) Release() error {
+	return a.ReleaseContext(context.Background())
+}
+
+// ReleaseContext call ReleaseFunc
+func (a *FakeHealthChannel1) ReleaseContext(ctx context.Context) error {
+	if a.ReleaseFunc != nil {
+		return a.ReleaseFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
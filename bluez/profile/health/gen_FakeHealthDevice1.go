@@ -0,0 +1,206 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package health
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeHealthDevice1 is an in-memory HealthDevice1API to test the code depending on
+// HealthDevice1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeHealthDevice1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// EchoFunc handle the calls to Echo
+	EchoFunc func(ctx context.Context) (bool, error)
+	// CreateChannelFunc handle the calls to CreateChannel
+	CreateChannelFunc func(ctx context.Context, application dbus.ObjectPath, configuration string) (dbus.ObjectPath, error)
+	// DestroyChannelFunc handle the calls to DestroyChannel
+	DestroyChannelFunc func(ctx context.Context, channel dbus.ObjectPath) error
+}
+
+var _ HealthDevice1API = (*FakeHealthDevice1)(nil)
+
+
+// NewFakeHealthDevice1 create a FakeHealthDevice1 with the initial properties, nil for none
+func NewFakeHealthDevice1(objectPath dbus.ObjectPath, properties *HealthDevice1Properties) *FakeHealthDevice1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeHealthDevice1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(HealthDevice1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeHealthDevice1 object path
+func (a *FakeHealthDevice1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return HealthDevice1 interface
+func (a *FakeHealthDevice1) Interface() string {
+	return HealthDevice1Interface
+}
+
+// Close stop the watchers
+func (a *FakeHealthDevice1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeHealthDevice1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeHealthDevice1) GetProperties() (*HealthDevice1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeHealthDevice1) GetPropertiesContext(ctx context.Context) (*HealthDevice1Properties, error) {
+	return new(HealthDevice1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeHealthDevice1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeHealthDevice1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeHealthDevice1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeHealthDevice1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeHealthDevice1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeHealthDevice1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeHealthDevice1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+// SetMainChannel set MainChannel value
+func (a *FakeHealthDevice1) SetMainChannel(v dbus.ObjectPath) error {
+	return a.SetMainChannelContext(context.Background(), v)
+}
+
+// SetMainChannelContext set MainChannel value
+func (a *FakeHealthDevice1) SetMainChannelContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "MainChannel", v)
+}
+
+
+
+// GetMainChannel get MainChannel value
+func (a *FakeHealthDevice1) GetMainChannel() (dbus.ObjectPath, error) {
+	return a.GetMainChannelContext(context.Background())
+}
+
+// GetMainChannelContext get MainChannel value
+func (a *FakeHealthDevice1) GetMainChannelContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "MainChannel")
+	if err != nil {
+		return dbus.ObjectPath(""), err
+	}
+	value, ok := v.Value().(dbus.ObjectPath)
+	if !ok {
+		return dbus.ObjectPath(""), fmt.Errorf("MainChannel: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+// Echo call EchoFunc
+func (a *FakeHealthDevice1) Echo() (bool, error) {
+	return a.EchoContext(context.Background())
+}
+
+// EchoContext call EchoFunc
+func (a *FakeHealthDevice1) EchoContext(ctx context.Context) (bool, error) {
+	if a.EchoFunc != nil {
+		return a.EchoFunc(ctx)
+	}
+	
+	var val0 bool
+	return val0, nil	
+}
+
+// CreateChannel call CreateChannelFunc
+func (a *FakeHealthDevice1) CreateChannel(application dbus.ObjectPath, configuration string) (dbus.ObjectPath, error) {
+	return a.CreateChannelContext(context.Background(), application, configuration)
+}
+
+// CreateChannelContext call CreateChannelFunc
+func (a *FakeHealthDevice1) CreateChannelContext(ctx context.Context, application dbus.ObjectPath, configuration string) (dbus.ObjectPath, error) {
+	if a.CreateChannelFunc != nil {
+		return a.CreateChannelFunc(ctx, application, configuration)
+	}
+	
+	var val0 dbus.ObjectPath
+	return val0, nil	
+}
+
+// DestroyChannel call DestroyChannelFunc
+func (a *FakeHealthDevice1) DestroyChannel(channel dbus.ObjectPath) error {
+	return a.DestroyChannelContext(context.Background(), channel)
+}
+
+// DestroyChannelContext call DestroyChannelFunc
+func (a *FakeHealthDevice1) DestroyChannelContext(ctx context.Context, channel dbus.ObjectPath) error {
+	if a.DestroyChannelFunc != nil {
+		return a.DestroyChannelFunc(ctx, channel)
+	}
+	
+	return nil
+	
+}
+
@@ -0,0 +1,156 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package health
+
+
+
+import (
+   "context"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeHealthManager1 is an in-memory HealthManager1API to test the code depending on
+// HealthManager1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeHealthManager1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// CreateApplicationFunc handle the calls to CreateApplication
+	CreateApplicationFunc func(ctx context.Context, config map[string]interface{}) (dbus.ObjectPath, error)
+	// DestroyApplicationFunc handle the calls to DestroyApplication
+	DestroyApplicationFunc func(ctx context.Context, application dbus.ObjectPath) error
+}
+
+var _ HealthManager1API = (*FakeHealthManager1)(nil)
+
+
+// NewFakeHealthManager1 create a FakeHealthManager1 with the initial properties, nil for none
+func NewFakeHealthManager1(objectPath dbus.ObjectPath, properties *HealthManager1Properties) *FakeHealthManager1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeHealthManager1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(HealthManager1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeHealthManager1 object path
+func (a *FakeHealthManager1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return HealthManager1 interface
+func (a *FakeHealthManager1) Interface() string {
+	return HealthManager1Interface
+}
+
+// Close stop the watchers
+func (a *FakeHealthManager1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeHealthManager1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeHealthManager1) GetProperties() (*HealthManager1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeHealthManager1) GetPropertiesContext(ctx context.Context) (*HealthManager1Properties, error) {
+	return new(HealthManager1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeHealthManager1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeHealthManager1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeHealthManager1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeHealthManager1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeHealthManager1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeHealthManager1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeHealthManager1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+
+// CreateApplication call CreateApplicationFunc
+func (a *FakeHealthManager1) CreateApplication(config map[string]interface{}) (dbus.ObjectPath, error) {
+	return a.CreateApplicationContext(context.Background(), config)
+}
+
+// CreateApplicationContext call CreateApplicationFunc
+func (a *FakeHealthManager1) CreateApplicationContext(ctx context.Context, config map[string]interface{}) (dbus.ObjectPath, error) {
+	if a.CreateApplicationFunc != nil {
+		return a.CreateApplicationFunc(ctx, config)
+	}
+	
+	var val0 dbus.ObjectPath
+	return val0, nil	
+}
+
+// DestroyApplication call DestroyApplicationFunc
+func (a *FakeHealthManager1) DestroyApplication(application dbus.ObjectPath) error {
+	return a.DestroyApplicationContext(context.Background(), application)
+}
+
+// DestroyApplicationContext call DestroyApplicationFunc
+func (a *FakeHealthManager1) DestroyApplicationContext(ctx context.Context, application dbus.ObjectPath) error {
+	if a.DestroyApplicationFunc != nil {
+		return a.DestroyApplicationFunc(ctx, application)
+	}
+	
+	return nil
+	
+}
+
@@ -5,6 +5,7 @@ package health
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var HealthChannel1Interface = "org.bluez.HealthChannel1"
 // Args:
 // - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX/chanZZZ
 func NewHealthChannel1(objectPath dbus.ObjectPath) (*HealthChannel1, error) {
+	return NewHealthChannel1WithConn(nil, objectPath)
+}
+
+// NewHealthChannel1WithConn create a new instance of HealthChannel1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX/chanZZZ
+func NewHealthChannel1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*HealthChannel1, error) {
 	a := new(HealthChannel1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewHealthChannel1(objectPath dbus.ObjectPath) (*HealthChannel1, error) {
 			Iface: HealthChannel1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -47,8 +57,7 @@ HealthChannel1 HealthChannel hierarchy
 */
 type HealthChannel1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*HealthChannel1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -58,13 +67,6 @@ type HealthChannel1 struct {
 type HealthChannel1Properties struct {
 	lock sync.RWMutex `dbus:"ignore"`
 
-	/*
-	Application Identifies the HealthApplication to which this channel
-			is related to (which indirectly defines its role and
-			data type).
-	*/
-	Application dbus.ObjectPath
-
 	/*
 	Type The quality of service of the data channel. ("reliable"
 			or "streaming")
@@ -77,6 +79,13 @@ type HealthChannel1Properties struct {
 	*/
 	Device dbus.ObjectPath
 
+	/*
+	Application Identifies the HealthApplication to which this channel
+			is related to (which indirectly defines its role and
+			data type).
+	*/
+	Application dbus.ObjectPath
+
 }
 
 //Lock access to properties
@@ -92,54 +101,84 @@ func (p *HealthChannel1Properties) Unlock() {
 
 
 
-// SetApplication set Application value
-func (a *HealthChannel1) SetApplication(v dbus.ObjectPath) error {
-	return a.SetProperty("Application", v)
+// SetType set Type value
+func (a *HealthChannel1) SetType(v string) error {
+	return a.SetTypeContext(context.Background(), v)
 }
 
+// SetTypeContext set Type value, failing with a bluez.ContextError if ctx is done
+func (a *HealthChannel1) SetTypeContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Type", v)
+}
 
 
-// GetApplication get Application value
-func (a *HealthChannel1) GetApplication() (dbus.ObjectPath, error) {
-	v, err := a.GetProperty("Application")
+
+// GetType get Type value
+func (a *HealthChannel1) GetType() (string, error) {
+	return a.GetTypeContext(context.Background())
+}
+
+// GetTypeContext get Type value, failing with a bluez.ContextError if ctx is done
+func (a *HealthChannel1) GetTypeContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Type")
 	if err != nil {
-		return dbus.ObjectPath(""), err
+		return "", err
 	}
-	return v.Value().(dbus.ObjectPath), nil
+	return v.Value().(string), nil
 }
 
 
 
 
-// SetType set Type value
-func (a *HealthChannel1) SetType(v string) error {
-	return a.SetProperty("Type", v)
+// SetDevice set Device value
+func (a *HealthChannel1) SetDevice(v dbus.ObjectPath) error {
+	return a.SetDeviceContext(context.Background(), v)
 }
 
+// SetDeviceContext set Device value, failing with a bluez.ContextError if ctx is done
+func (a *HealthChannel1) SetDeviceContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Device", v)
+}
 
 
-// GetType get Type value
-func (a *HealthChannel1) GetType() (string, error) {
-	v, err := a.GetProperty("Type")
+
+// GetDevice get Device value
+func (a *HealthChannel1) GetDevice() (dbus.ObjectPath, error) {
+	return a.GetDeviceContext(context.Background())
+}
+
+// GetDeviceContext get Device value, failing with a bluez.ContextError if ctx is done
+func (a *HealthChannel1) GetDeviceContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Device")
 	if err != nil {
-		return "", err
+		return dbus.ObjectPath(""), err
 	}
-	return v.Value().(string), nil
+	return v.Value().(dbus.ObjectPath), nil
 }
 
 
 
 
-// SetDevice set Device value
-func (a *HealthChannel1) SetDevice(v dbus.ObjectPath) error {
-	return a.SetProperty("Device", v)
+// SetApplication set Application value
+func (a *HealthChannel1) SetApplication(v dbus.ObjectPath) error {
+	return a.SetApplicationContext(context.Background(), v)
 }
 
+// SetApplicationContext set Application value, failing with a bluez.ContextError if ctx is done
+func (a *HealthChannel1) SetApplicationContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Application", v)
+}
 
 
-// GetDevice get Device value
-func (a *HealthChannel1) GetDevice() (dbus.ObjectPath, error) {
-	v, err := a.GetProperty("Device")
+
+// GetApplication get Application value
+func (a *HealthChannel1) GetApplication() (dbus.ObjectPath, error) {
+	return a.GetApplicationContext(context.Background())
+}
+
+// GetApplicationContext get Application value, failing with a bluez.ContextError if ctx is done
+func (a *HealthChannel1) GetApplicationContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Application")
 	if err != nil {
 		return dbus.ObjectPath(""), err
 	}
@@ -171,35 +210,24 @@ func (a *HealthChannel1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *HealthChannel1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *HealthChannel1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -229,20 +257,31 @@ func (a *HealthChannel1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *HealthChannel1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *HealthChannel1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *HealthChannel1) GetProperties() (*HealthChannel1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *HealthChannel1) GetPropertiesContext(ctx context.Context) (*HealthChannel1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -252,29 +291,40 @@ func (a *HealthChannel1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *HealthChannel1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *HealthChannel1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *HealthChannel1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *HealthChannel1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *HealthChannel1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *HealthChannel1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -284,6 +334,7 @@ func (a *HealthChannel1) WatchProperties() (chan *bluez.PropertyChanged, error)
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *HealthChannel1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -300,9 +351,14 @@ Acquire 			Returns the file descriptor for this data channel. If
 
 */
 func (a *HealthChannel1) Acquire() (dbus.UnixFD, error) {
+	return a.AcquireContext(context.Background())
+}
+
+// AcquireContext call Acquire, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *HealthChannel1) AcquireContext(ctx context.Context) (dbus.UnixFD, error) {
 	
 	var val0 dbus.UnixFD
-	err := a.client.Call("Acquire", 0, ).Store(&val0)
+	err := a.client.CallWithContext(ctx, "Acquire", 0, ).Store(&val0)
 	return val0, err	
 }
 
@@ -314,8 +370,13 @@ Release 			Releases the fd. Application should also need to
 
 */
 func (a *HealthChannel1) Release() error {
+	return a.ReleaseContext(context.Background())
+}
+
+// ReleaseContext call Release, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *HealthChannel1) ReleaseContext(ctx context.Context) error {
 	
-	return a.client.Call("Release", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Release", 0, ).Store()
 	
 }
 
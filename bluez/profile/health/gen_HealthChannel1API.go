@@ -0,0 +1,53 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package health
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// HealthChannel1API is the interface of HealthChannel1, implemented by
+// FakeHealthChannel1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for HealthChannel1.
+type HealthChannel1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*HealthChannel1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*HealthChannel1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	SetType(v string) error
+	SetTypeContext(ctx context.Context, v string) error
+	GetType() (string, error)
+	GetTypeContext(ctx context.Context) (string, error)
+
+	SetDevice(v dbus.ObjectPath) error
+	SetDeviceContext(ctx context.Context, v dbus.ObjectPath) error
+	GetDevice() (dbus.ObjectPath, error)
+	GetDeviceContext(ctx context.Context) (dbus.ObjectPath, error)
+
+	SetApplication(v dbus.ObjectPath) error
+	SetApplicationContext(ctx context.Context, v dbus.ObjectPath) error
+	GetApplication() (dbus.ObjectPath, error)
+	GetApplicationContext(ctx context.Context) (dbus.ObjectPath, error)
+
+	Acquire() (dbus.UnixFD, error)
+	AcquireContext(ctx context.Context) (dbus.UnixFD, error)
+	Release() error
+	ReleaseContext(ctx context.Context) error
+}
+
+var _ HealthChannel1API = (*HealthChannel1)(nil)
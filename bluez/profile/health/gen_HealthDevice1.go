@@ -5,6 +5,7 @@ package health
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var HealthDevice1Interface = "org.bluez.HealthDevice1"
 // Args:
 // - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX
 func NewHealthDevice1(objectPath dbus.ObjectPath) (*HealthDevice1, error) {
+	return NewHealthDevice1WithConn(nil, objectPath)
+}
+
+// NewHealthDevice1WithConn create a new instance of HealthDevice1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX
+func NewHealthDevice1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*HealthDevice1, error) {
 	a := new(HealthDevice1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewHealthDevice1(objectPath dbus.ObjectPath) (*HealthDevice1, error) {
 			Iface: HealthDevice1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -47,8 +57,7 @@ HealthDevice1 HealthDevice hierarchy
 */
 type HealthDevice1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*HealthDevice1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -83,14 +92,24 @@ func (p *HealthDevice1Properties) Unlock() {
 
 // SetMainChannel set MainChannel value
 func (a *HealthDevice1) SetMainChannel(v dbus.ObjectPath) error {
-	return a.SetProperty("MainChannel", v)
+	return a.SetMainChannelContext(context.Background(), v)
+}
+
+// SetMainChannelContext set MainChannel value, failing with a bluez.ContextError if ctx is done
+func (a *HealthDevice1) SetMainChannelContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "MainChannel", v)
 }
 
 
 
 // GetMainChannel get MainChannel value
 func (a *HealthDevice1) GetMainChannel() (dbus.ObjectPath, error) {
-	v, err := a.GetProperty("MainChannel")
+	return a.GetMainChannelContext(context.Background())
+}
+
+// GetMainChannelContext get MainChannel value, failing with a bluez.ContextError if ctx is done
+func (a *HealthDevice1) GetMainChannelContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "MainChannel")
 	if err != nil {
 		return dbus.ObjectPath(""), err
 	}
@@ -122,35 +141,24 @@ func (a *HealthDevice1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *HealthDevice1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *HealthDevice1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -180,20 +188,31 @@ func (a *HealthDevice1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *HealthDevice1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *HealthDevice1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *HealthDevice1) GetProperties() (*HealthDevice1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *HealthDevice1) GetPropertiesContext(ctx context.Context) (*HealthDevice1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -203,29 +222,40 @@ func (a *HealthDevice1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *HealthDevice1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *HealthDevice1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *HealthDevice1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *HealthDevice1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *HealthDevice1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *HealthDevice1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -235,6 +265,7 @@ func (a *HealthDevice1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *HealthDevice1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -251,9 +282,14 @@ Echo 			Sends an echo petition to the remote service. Returns
 
 */
 func (a *HealthDevice1) Echo() (bool, error) {
+	return a.EchoContext(context.Background())
+}
+
+// EchoContext call Echo, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *HealthDevice1) EchoContext(ctx context.Context) (bool, error) {
 	
 	var val0 bool
-	err := a.client.Call("Echo", 0, ).Store(&val0)
+	err := a.client.CallWithContext(ctx, "Echo", 0, ).Store(&val0)
 	return val0, err	
 }
 
@@ -268,9 +304,14 @@ CreateChannel 			Creates a new data channel.  The configuration should
 
 */
 func (a *HealthDevice1) CreateChannel(application dbus.ObjectPath, configuration string) (dbus.ObjectPath, error) {
+	return a.CreateChannelContext(context.Background(), application, configuration)
+}
+
+// CreateChannelContext call CreateChannel, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *HealthDevice1) CreateChannelContext(ctx context.Context, application dbus.ObjectPath, configuration string) (dbus.ObjectPath, error) {
 	
 	var val0 dbus.ObjectPath
-	err := a.client.Call("CreateChannel", 0, application, configuration).Store(&val0)
+	err := a.client.CallWithContext(ctx, "CreateChannel", 0, application, configuration).Store(&val0)
 	return val0, err	
 }
 
@@ -285,8 +326,13 @@ DestroyChannel 			Destroys the data channel object. Only the creator of
 
 */
 func (a *HealthDevice1) DestroyChannel(channel dbus.ObjectPath) error {
+	return a.DestroyChannelContext(context.Background(), channel)
+}
+
+// DestroyChannelContext call DestroyChannel, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *HealthDevice1) DestroyChannelContext(ctx context.Context, channel dbus.ObjectPath) error {
 	
-	return a.client.Call("DestroyChannel", 0, channel).Store()
+	return a.client.CallWithContext(ctx, "DestroyChannel", 0, channel).Store()
 	
 }
 
@@ -0,0 +1,45 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package health
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// HealthDevice1API is the interface of HealthDevice1, implemented by
+// FakeHealthDevice1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for HealthDevice1.
+type HealthDevice1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*HealthDevice1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*HealthDevice1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	SetMainChannel(v dbus.ObjectPath) error
+	SetMainChannelContext(ctx context.Context, v dbus.ObjectPath) error
+	GetMainChannel() (dbus.ObjectPath, error)
+	GetMainChannelContext(ctx context.Context) (dbus.ObjectPath, error)
+
+	Echo() (bool, error)
+	EchoContext(ctx context.Context) (bool, error)
+	CreateChannel(application dbus.ObjectPath, configuration string) (dbus.ObjectPath, error)
+	CreateChannelContext(ctx context.Context, application dbus.ObjectPath, configuration string) (dbus.ObjectPath, error)
+	DestroyChannel(channel dbus.ObjectPath) error
+	DestroyChannelContext(ctx context.Context, channel dbus.ObjectPath) error
+}
+
+var _ HealthDevice1API = (*HealthDevice1)(nil)
@@ -5,6 +5,7 @@ package health
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var HealthManager1Interface = "org.bluez.HealthManager1"
 // Args:
 
 func NewHealthManager1() (*HealthManager1, error) {
+	return NewHealthManager1WithConn(nil)
+}
+
+// NewHealthManager1WithConn create a new instance of HealthManager1 on a connection, nil for the shared system bus connection
+//
+// Args:
+
+func NewHealthManager1WithConn(conn *bluez.Conn) (*HealthManager1, error) {
 	a := new(HealthManager1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewHealthManager1() (*HealthManager1, error) {
 			Iface: HealthManager1Interface,
 			Path:  dbus.ObjectPath("/org/bluez/"),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -47,8 +57,7 @@ HealthManager1 HealthManager hierarchy
 */
 type HealthManager1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*HealthManager1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -95,35 +104,24 @@ func (a *HealthManager1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *HealthManager1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *HealthManager1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
-
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -153,20 +151,31 @@ func (a *HealthManager1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *HealthManager1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *HealthManager1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *HealthManager1) GetProperties() (*HealthManager1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *HealthManager1) GetPropertiesContext(ctx context.Context) (*HealthManager1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -176,29 +185,40 @@ func (a *HealthManager1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *HealthManager1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *HealthManager1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *HealthManager1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *HealthManager1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *HealthManager1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *HealthManager1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -208,6 +228,7 @@ func (a *HealthManager1) WatchProperties() (chan *bluez.PropertyChanged, error)
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *HealthManager1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -234,9 +255,14 @@ CreateApplication 			Returns the path of the new registered application.
 
 */
 func (a *HealthManager1) CreateApplication(config map[string]interface{}) (dbus.ObjectPath, error) {
+	return a.CreateApplicationContext(context.Background(), config)
+}
+
+// CreateApplicationContext call CreateApplication, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *HealthManager1) CreateApplicationContext(ctx context.Context, config map[string]interface{}) (dbus.ObjectPath, error) {
 	
 	var val0 dbus.ObjectPath
-	err := a.client.Call("CreateApplication", 0, config).Store(&val0)
+	err := a.client.CallWithContext(ctx, "CreateApplication", 0, config).Store(&val0)
 	return val0, err	
 }
 
@@ -251,8 +277,13 @@ DestroyApplication 			Closes the HDP application identified by the object
 
 */
 func (a *HealthManager1) DestroyApplication(application dbus.ObjectPath) error {
+	return a.DestroyApplicationContext(context.Background(), application)
+}
+
+// DestroyApplicationContext call DestroyApplication, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *HealthManager1) DestroyApplicationContext(ctx context.Context, application dbus.ObjectPath) error {
 	
-	return a.client.Call("DestroyApplication", 0, application).Store()
+	return a.client.CallWithContext(ctx, "DestroyApplication", 0, application).Store()
 	
 }
 
@@ -0,0 +1,38 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package health
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// HealthManager1API is the interface of HealthManager1, implemented by
+// FakeHealthManager1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for HealthManager1.
+type HealthManager1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*HealthManager1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*HealthManager1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	CreateApplication(config map[string]interface{}) (dbus.ObjectPath, error)
+	CreateApplicationContext(ctx context.Context, config map[string]interface{}) (dbus.ObjectPath, error)
+	DestroyApplication(application dbus.ObjectPath) error
+	DestroyApplicationContext(ctx context.Context, application dbus.ObjectPath) error
+}
+
+var _ HealthManager1API = (*HealthManager1)(nil)
@@ -0,0 +1,158 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package input
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeInput1 is an in-memory Input1API to test the code depending on
+// Input1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeInput1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+}
+
+var _ Input1API = (*FakeInput1)(nil)
+
+
+// NewFakeInput1 create a FakeInput1 with the initial properties, nil for none
+func NewFakeInput1(objectPath dbus.ObjectPath, properties *Input1Properties) *FakeInput1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeInput1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(Input1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeInput1 object path
+func (a *FakeInput1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return Input1 interface
+func (a *FakeInput1) Interface() string {
+	return Input1Interface
+}
+
+// Close stop the watchers
+func (a *FakeInput1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeInput1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeInput1) GetProperties() (*Input1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeInput1) GetPropertiesContext(ctx context.Context) (*Input1Properties, error) {
+	return new(Input1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeInput1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeInput1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeInput1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeInput1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeInput1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeInput1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeInput1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+// SetReconnectMode set ReconnectMode value
+func (a *FakeInput1) SetReconnectMode(v string) error {
+	return a.SetReconnectModeContext(context.Background(), v)
+}
+
+// SetReconnectModeContext set ReconnectMode value
+func (a *FakeInput1) SetReconnectModeContext(ctx context.Context, v string) error {
+	if !Input1ReconnectMode(v).Valid() {
+		return &bluez.InvalidValueError{Interface: Input1Interface, Member: "ReconnectMode", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "ReconnectMode", v)
+}
+
+
+
+// GetReconnectMode get ReconnectMode value
+func (a *FakeInput1) GetReconnectMode() (string, error) {
+	return a.GetReconnectModeContext(context.Background())
+}
+
+// GetReconnectModeContext get ReconnectMode value
+func (a *FakeInput1) GetReconnectModeContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "ReconnectMode")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("ReconnectMode: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
@@ -0,0 +1,39 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package input
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// Input1API is the interface of Input1, implemented by
+// FakeInput1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for Input1.
+type Input1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*Input1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*Input1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	SetReconnectMode(v string) error
+	SetReconnectModeContext(ctx context.Context, v string) error
+	GetReconnectMode() (string, error)
+	GetReconnectModeContext(ctx context.Context) (string, error)
+
+}
+
+var _ Input1API = (*Input1)(nil)
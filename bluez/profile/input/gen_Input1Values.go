@@ -0,0 +1,33 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package input
+
+
+// Input1ReconnectMode is a value documented for Input1.ReconnectMode
+type Input1ReconnectMode string
+
+const (
+	Input1ReconnectModeNone Input1ReconnectMode = "none"
+	Input1ReconnectModeHost Input1ReconnectMode = "host"
+	Input1ReconnectModeDevice Input1ReconnectMode = "device"
+	Input1ReconnectModeAny Input1ReconnectMode = "any"
+)
+
+// Input1ReconnectModeValues list the documented values of Input1ReconnectMode
+var Input1ReconnectModeValues = []Input1ReconnectMode{
+	Input1ReconnectModeNone,
+	Input1ReconnectModeHost,
+	Input1ReconnectModeDevice,
+	Input1ReconnectModeAny,
+}
+
+// Valid report if v is one of the documented values
+func (v Input1ReconnectMode) Valid() bool {
+	for _, value := range Input1ReconnectModeValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
@@ -0,0 +1,224 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+
+import (
+   "context"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeMedia1 is an in-memory Media1API to test the code depending on
+// Media1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeMedia1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// RegisterEndpointFunc handle the calls to RegisterEndpoint
+	RegisterEndpointFunc func(ctx context.Context, endpoint dbus.ObjectPath, properties map[string]interface{}) error
+	// UnregisterEndpointFunc handle the calls to UnregisterEndpoint
+	UnregisterEndpointFunc func(ctx context.Context, endpoint dbus.ObjectPath) error
+	// RegisterPlayerFunc handle the calls to RegisterPlayer
+	RegisterPlayerFunc func(ctx context.Context, player dbus.ObjectPath, properties map[string]interface{}) error
+	// UnregisterPlayerFunc handle the calls to UnregisterPlayer
+	UnregisterPlayerFunc func(ctx context.Context, player dbus.ObjectPath) error
+	// RegisterApplicationFunc handle the calls to RegisterApplication
+	RegisterApplicationFunc func(ctx context.Context, root dbus.ObjectPath, options map[string]interface{}) error
+	// UnregisterApplicationFunc handle the calls to UnregisterApplication
+	UnregisterApplicationFunc func(ctx context.Context, application dbus.ObjectPath) error
+}
+
+var _ Media1API = (*FakeMedia1)(nil)
+
+
+// NewFakeMedia1 create a FakeMedia1 with the initial properties, nil for none
+func NewFakeMedia1(objectPath dbus.ObjectPath, properties *Media1Properties) *FakeMedia1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeMedia1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(Media1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeMedia1 object path
+func (a *FakeMedia1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return Media1 interface
+func (a *FakeMedia1) Interface() string {
+	return Media1Interface
+}
+
+// Close stop the watchers
+func (a *FakeMedia1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeMedia1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeMedia1) GetProperties() (*Media1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeMedia1) GetPropertiesContext(ctx context.Context) (*Media1Properties, error) {
+	return new(Media1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeMedia1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeMedia1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeMedia1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeMedia1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeMedia1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeMedia1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeMedia1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+
+// RegisterEndpoint call RegisterEndpointFunc
+func (a *FakeMedia1) RegisterEndpoint(endpoint dbus.ObjectPath, properties map[string]interface{}) error {
+	return a.RegisterEndpointContext(context.Background(), endpoint, properties)
+}
+
+// RegisterEndpointContext call RegisterEndpointFunc
+func (a *FakeMedia1) RegisterEndpointContext(ctx context.Context, endpoint dbus.ObjectPath, properties map[string]interface{}) error {
+	if a.RegisterEndpointFunc != nil {
+		return a.RegisterEndpointFunc(ctx, endpoint, properties)
+	}
+	
+	return nil
+	
+}
+
+// UnregisterEndpoint call UnregisterEndpointFunc
+func (a *FakeMedia1) UnregisterEndpoint(endpoint dbus.ObjectPath) error {
+	return a.UnregisterEndpointContext(context.Background(), endpoint)
+}
+
+// UnregisterEndpointContext call UnregisterEndpointFunc
+func (a *FakeMedia1) UnregisterEndpointContext(ctx context.Context, endpoint dbus.ObjectPath) error {
+	if a.UnregisterEndpointFunc != nil {
+		return a.UnregisterEndpointFunc(ctx, endpoint)
+	}
+	
+	return nil
+	
+}
+
+// RegisterPlayer call RegisterPlayerFunc
+func (a *FakeMedia1) RegisterPlayer(player dbus.ObjectPath, properties map[string]interface{}) error {
+	return a.RegisterPlayerContext(context.Background(), player, properties)
+}
+
+// RegisterPlayerContext call RegisterPlayerFunc
+func (a *FakeMedia1) RegisterPlayerContext(ctx context.Context, player dbus.ObjectPath, properties map[string]interface{}) error {
+	if a.RegisterPlayerFunc != nil {
+		return a.RegisterPlayerFunc(ctx, player, properties)
+	}
+	
+	return nil
+	
+}
+
+// UnregisterPlayer call UnregisterPlayerFunc
+func (a *FakeMedia1) UnregisterPlayer(player dbus.ObjectPath) error {
+	return a.UnregisterPlayerContext(context.Background(), player)
+}
+
+// UnregisterPlayerContext call UnregisterPlayerFunc
+func (a *FakeMedia1) UnregisterPlayerContext(ctx context.Context, player dbus.ObjectPath) error {
+	if a.UnregisterPlayerFunc != nil {
+		return a.UnregisterPlayerFunc(ctx, player)
+	}
+	
+	return nil
+	
+}
+
+// RegisterApplication call RegisterApplicationFunc
+func (a *FakeMedia1) RegisterApplication(root dbus.ObjectPath, options map[string]interface{}) error {
+	return a.RegisterApplicationContext(context.Background(), root, options)
+}
+
+// RegisterApplicationContext call RegisterApplicationFunc
+func (a *FakeMedia1) RegisterApplicationContext(ctx context.Context, root dbus.ObjectPath, options map[string]interface{}) error {
+	if a.RegisterApplicationFunc != nil {
+		return a.RegisterApplicationFunc(ctx, root, options)
+	}
+	
+	return nil
+	
+}
+
+// UnregisterApplication call UnregisterApplicationFunc
+func (a *FakeMedia1) UnregisterApplication(application dbus.ObjectPath) error {
+	return a.UnregisterApplicationContext(context.Background(), application)
+}
+
+// UnregisterApplicationContext call UnregisterApplicationFunc
+func (a *FakeMedia1) UnregisterApplicationContext(ctx context.Context, application dbus.ObjectPath) error {
+	if a.UnregisterApplicationFunc != nil {
+		return a.UnregisterApplicationFunc(ctx, application)
+	}
+	
+	return nil
+	
+}
+
@@ -0,0 +1,340 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeMediaControl1 is an in-memory MediaControl1API to test the code depending on
+// MediaControl1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeMediaControl1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// PlayFunc handle the calls to Play
+	PlayFunc func(ctx context.Context) error
+	// PauseFunc handle the calls to Pause
+	PauseFunc func(ctx context.Context) error
+	// StopFunc handle the calls to Stop
+	StopFunc func(ctx context.Context) error
+	// NextFunc handle the calls to Next
+	NextFunc func(ctx context.Context) error
+	// PreviousFunc handle the calls to Previous
+	PreviousFunc func(ctx context.Context) error
+	// VolumeUpFunc handle the calls to VolumeUp
+	VolumeUpFunc func(ctx context.Context) error
+	// VolumeDownFunc handle the calls to VolumeDown
+	VolumeDownFunc func(ctx context.Context) error
+	// FastForwardFunc handle the calls to FastForward
+	FastForwardFunc func(ctx context.Context) error
+	// RewindFunc handle the calls to Rewind
+	RewindFunc func(ctx context.Context) error
+}
+
+var _ MediaControl1API = (*FakeMediaControl1)(nil)
+
+
+// NewFakeMediaControl1 create a FakeMediaControl1 with the initial properties, nil for none
+func NewFakeMediaControl1(objectPath dbus.ObjectPath, properties *MediaControl1Properties) *FakeMediaControl1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeMediaControl1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(MediaControl1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeMediaControl1 object path
+func (a *FakeMediaControl1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return MediaControl1 interface
+func (a *FakeMediaControl1) Interface() string {
+	return MediaControl1Interface
+}
+
+// Close stop the watchers
+func (a *FakeMediaControl1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeMediaControl1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeMediaControl1) GetProperties() (*MediaControl1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeMediaControl1) GetPropertiesContext(ctx context.Context) (*MediaControl1Properties, error) {
+	return new(MediaControl1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeMediaControl1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeMediaControl1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeMediaControl1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeMediaControl1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeMediaControl1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeMediaControl1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeMediaControl1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+// SetConnected set Connected value
+func (a *FakeMediaControl1) SetConnected(v bool) error {
+	return a.SetConnectedContext(context.Background(), v)
+}
+
+// SetConnectedContext set Connected value
+func (a *FakeMediaControl1) SetConnectedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Connected", v)
+}
+
+
+
+// GetConnected get Connected value
+func (a *FakeMediaControl1) GetConnected() (bool, error) {
+	return a.GetConnectedContext(context.Background())
+}
+
+// GetConnectedContext get Connected value
+func (a *FakeMediaControl1) GetConnectedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Connected")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Connected: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetPlayer set Player value
+func (a *FakeMediaControl1) SetPlayer(v dbus.ObjectPath) error {
+	return a.SetPlayerContext(context.Background(), v)
+}
+
+// SetPlayerContext set Player value
+func (a *FakeMediaControl1) SetPlayerContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Player", v)
+}
+
+
+
+// GetPlayer get Player value
+func (a *FakeMediaControl1) GetPlayer() (dbus.ObjectPath, error) {
+	return a.GetPlayerContext(context.Background())
+}
+
+// GetPlayerContext get Player value
+func (a *FakeMediaControl1) GetPlayerContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Player")
+	if err != nil {
+		return dbus.ObjectPath(""), err
+	}
+	value, ok := v.Value().(dbus.ObjectPath)
+	if !ok {
+		return dbus.ObjectPath(""), fmt.Errorf("Player: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+// Play call PlayFunc
+func (a *FakeMediaControl1) Play() error {
+	return a.PlayContext(context.Background())
+}
+
+// PlayContext call PlayFunc
+func (a *FakeMediaControl1) PlayContext(ctx context.Context) error {
+	if a.PlayFunc != nil {
+		return a.PlayFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// Pause call PauseFunc
+func (a *FakeMediaControl1) Pause() error {
+	return a.PauseContext(context.Background())
+}
+
+// PauseContext call PauseFunc
+func (a *FakeMediaControl1) PauseContext(ctx context.Context) error {
+	if a.PauseFunc != nil {
+		return a.PauseFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// Stop call StopFunc
+func (a *FakeMediaControl1) Stop() error {
+	return a.StopContext(context.Background())
+}
+
+// StopContext call StopFunc
+func (a *FakeMediaControl1) StopContext(ctx context.Context) error {
+	if a.StopFunc != nil {
+		return a.StopFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// Next call NextFunc
+func (a *FakeMediaControl1) Next() error {
+	return a.NextContext(context.Background())
+}
+
+// NextContext call NextFunc
+func (a *FakeMediaControl1) NextContext(ctx context.Context) error {
+	if a.NextFunc != nil {
+		return a.NextFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// Previous call PreviousFunc
+func (a *FakeMediaControl1) Previous() error {
+	return a.PreviousContext(context.Background())
+}
+
+// PreviousContext call PreviousFunc
+func (a *FakeMediaControl1) PreviousContext(ctx context.Context) error {
+	if a.PreviousFunc != nil {
+		return a.PreviousFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// VolumeUp call VolumeUpFunc
+func (a *FakeMediaControl1) VolumeUp() error {
+	return a.VolumeUpContext(context.Background())
+}
+
+// VolumeUpContext call VolumeUpFunc
+func (a *FakeMediaControl1) VolumeUpContext(ctx context.Context) error {
+	if a.VolumeUpFunc != nil {
+		return a.VolumeUpFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// VolumeDown call VolumeDownFunc
+func (a *FakeMediaControl1) VolumeDown() error {
+	return a.VolumeDownContext(context.Background())
+}
+
+// VolumeDownContext call VolumeDownFunc
+func (a *FakeMediaControl1) VolumeDownContext(ctx context.Context) error {
+	if a.VolumeDownFunc != nil {
+		return a.VolumeDownFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// FastForward call FastForwardFunc
+func (a *FakeMediaControl1) FastForward() error {
+	return a.FastForwardContext(context.Background())
+}
+
+// FastForwardContext call FastForwardFunc
+func (a *FakeMediaControl1) FastForwardContext(ctx context.Context) error {
+	if a.FastForwardFunc != nil {
+		return a.FastForwardFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// Rewind call RewindFunc
+func (a *FakeMediaControl1) Rewind() error {
+	return a.RewindContext(context.Background())
+}
+
+// RewindContext call RewindFunc
+func (a *FakeMediaControl1) RewindContext(ctx context.Context) error {
+	if a.RewindFunc != nil {
+		return a.RewindFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
@@ -0,0 +1,319 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeMediaEndpoint1 is an in-memory MediaEndpoint1API to test the code depending on
+// MediaEndpoint1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeMediaEndpoint1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// SetConfigurationFunc handle the calls to SetConfiguration
+	SetConfigurationFunc func(ctx context.Context, transport dbus.ObjectPath, properties map[string]interface{}) error
+	// SelectConfigurationFunc handle the calls to SelectConfiguration
+	SelectConfigurationFunc func(ctx context.Context, capabilities []byte) ([]byte, error)
+	// ClearConfigurationFunc handle the calls to ClearConfiguration
+	ClearConfigurationFunc func(ctx context.Context, transport dbus.ObjectPath) error
+	// ReleaseFunc handle the calls to Release
+	ReleaseFunc func(ctx context.Context) error
+}
+
+var _ MediaEndpoint1API = (*FakeMediaEndpoint1)(nil)
+
+
+// NewFakeMediaEndpoint1 create a FakeMediaEndpoint1 with the initial properties, nil for none
+func NewFakeMediaEndpoint1(objectPath dbus.ObjectPath, properties *MediaEndpoint1Properties) *FakeMediaEndpoint1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeMediaEndpoint1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(MediaEndpoint1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeMediaEndpoint1 object path
+func (a *FakeMediaEndpoint1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return MediaEndpoint1 interface
+func (a *FakeMediaEndpoint1) Interface() string {
+	return MediaEndpoint1Interface
+}
+
+// Close stop the watchers
+func (a *FakeMediaEndpoint1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeMediaEndpoint1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeMediaEndpoint1) GetProperties() (*MediaEndpoint1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeMediaEndpoint1) GetPropertiesContext(ctx context.Context) (*MediaEndpoint1Properties, error) {
+	return new(MediaEndpoint1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeMediaEndpoint1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeMediaEndpoint1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeMediaEndpoint1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeMediaEndpoint1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeMediaEndpoint1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeMediaEndpoint1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeMediaEndpoint1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+// SetUUID set UUID value
+func (a *FakeMediaEndpoint1) SetUUID(v string) error {
+	return a.SetUUIDContext(context.Background(), v)
+}
+
+// SetUUIDContext set UUID value
+func (a *FakeMediaEndpoint1) SetUUIDContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "UUID", v)
+}
+
+
+
+// GetUUID get UUID value
+func (a *FakeMediaEndpoint1) GetUUID() (string, error) {
+	return a.GetUUIDContext(context.Background())
+}
+
+// GetUUIDContext get UUID value
+func (a *FakeMediaEndpoint1) GetUUIDContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "UUID")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("UUID: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetCodec set Codec value
+func (a *FakeMediaEndpoint1) SetCodec(v byte) error {
+	return a.SetCodecContext(context.Background(), v)
+}
+
+// SetCodecContext set Codec value
+func (a *FakeMediaEndpoint1) SetCodecContext(ctx context.Context, v byte) error {
+	return a.SetPropertyContext(ctx, "Codec", v)
+}
+
+
+
+// GetCodec get Codec value
+func (a *FakeMediaEndpoint1) GetCodec() (byte, error) {
+	return a.GetCodecContext(context.Background())
+}
+
+// GetCodecContext get Codec value
+func (a *FakeMediaEndpoint1) GetCodecContext(ctx context.Context) (byte, error) {
+	v, err := a.GetPropertyContext(ctx, "Codec")
+	if err != nil {
+		return byte(0), err
+	}
+	value, ok := v.Value().(byte)
+	if !ok {
+		return byte(0), fmt.Errorf("Codec: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetCapabilities set Capabilities value
+func (a *FakeMediaEndpoint1) SetCapabilities(v []byte) error {
+	return a.SetCapabilitiesContext(context.Background(), v)
+}
+
+// SetCapabilitiesContext set Capabilities value
+func (a *FakeMediaEndpoint1) SetCapabilitiesContext(ctx context.Context, v []byte) error {
+	return a.SetPropertyContext(ctx, "Capabilities", v)
+}
+
+
+
+// GetCapabilities get Capabilities value
+func (a *FakeMediaEndpoint1) GetCapabilities() ([]byte, error) {
+	return a.GetCapabilitiesContext(context.Background())
+}
+
+// GetCapabilitiesContext get Capabilities value
+func (a *FakeMediaEndpoint1) GetCapabilitiesContext(ctx context.Context) ([]byte, error) {
+	v, err := a.GetPropertyContext(ctx, "Capabilities")
+	if err != nil {
+		return []byte{}, err
+	}
+	value, ok := v.Value().([]byte)
+	if !ok {
+		return []byte{}, fmt.Errorf("Capabilities: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetDevice set Device value
+func (a *FakeMediaEndpoint1) SetDevice(v dbus.ObjectPath) error {
+	return a.SetDeviceContext(context.Background(), v)
+}
+
+// SetDeviceContext set Device value
+func (a *FakeMediaEndpoint1) SetDeviceContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Device", v)
+}
+
+
+
+// GetDevice get Device value
+func (a *FakeMediaEndpoint1) GetDevice() (dbus.ObjectPath, error) {
+	return a.GetDeviceContext(context.Background())
+}
+
+// GetDeviceContext get Device value
+func (a *FakeMediaEndpoint1) GetDeviceContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Device")
+	if err != nil {
+		return dbus.ObjectPath(""), err
+	}
+	value, ok := v.Value().(dbus.ObjectPath)
+	if !ok {
+		return dbus.ObjectPath(""), fmt.Errorf("Device: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+// SetConfiguration call SetConfigurationFunc
+func (a *FakeMediaEndpoint1) SetConfiguration(transport dbus.ObjectPath, properties map[string]interface{}) error {
+	return a.SetConfigurationContext(context.Background(), transport, properties)
+}
+
+// SetConfigurationContext call SetConfigurationFunc
+func (a *FakeMediaEndpoint1) SetConfigurationContext(ctx context.Context, transport dbus.ObjectPath, properties map[string]interface{}) error {
+	if a.SetConfigurationFunc != nil {
+		return a.SetConfigurationFunc(ctx, transport, properties)
+	}
+	
+	return nil
+	
+}
+
+// SelectConfiguration call SelectConfigurationFunc
+func (a *FakeMediaEndpoint1) SelectConfiguration(capabilities []byte) ([]byte, error) {
+	return a.SelectConfigurationContext(context.Background(), capabilities)
+}
+
+// SelectConfigurationContext call SelectConfigurationFunc
+func (a *FakeMediaEndpoint1) SelectConfigurationContext(ctx context.Context, capabilities []byte) ([]byte, error) {
+	if a.SelectConfigurationFunc != nil {
+		return a.SelectConfigurationFunc(ctx, capabilities)
+	}
+	
+	 val0 := []byte{}
+	return val0, nil	
+}
+
+// ClearConfiguration call ClearConfigurationFunc
+func (a *FakeMediaEndpoint1) ClearConfiguration(transport dbus.ObjectPath) error {
+	return a.ClearConfigurationContext(context.Background(), transport)
+}
+
+// ClearConfigurationContext call ClearConfigurationFunc
+func (a *FakeMediaEndpoint1) ClearConfigurationContext(ctx context.Context, transport dbus.ObjectPath) error {
+	if a.ClearConfigurationFunc != nil {
+		return a.ClearConfigurationFunc(ctx, transport)
+	}
+	
+	return nil
+	
+}
+
+// Release call ReleaseFunc
+func (a *FakeMediaEndpoint1) Release() error {
+	return a.ReleaseContext(context.Background())
+}
+
+// ReleaseContext call ReleaseFunc
+func (a *FakeMediaEndpoint1) ReleaseContext(ctx context.Context) error {
+	if a.ReleaseFunc != nil {
+		return a.ReleaseFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
@@ -0,0 +1,339 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeMediaFolder1 is an in-memory MediaFolder1API to test the code depending on
+// MediaFolder1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeMediaFolder1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// SearchFunc handle the calls to Search
+	SearchFunc func(ctx context.Context, value string, filter map[string]interface{}) (dbus.ObjectPath, error)
+	// ListItemsFunc handle the calls to ListItems
+	ListItemsFunc func(ctx context.Context, filter map[string]interface{}) ([]Item, error)
+	// ChangeFolderFunc handle the calls to ChangeFolder
+	ChangeFolderFunc func(ctx context.Context, folder dbus.ObjectPath) error
+}
+
+var _ MediaFolder1API = (*FakeMediaFolder1)(nil)
+
+
+// NewFakeMediaFolder1 create a FakeMediaFolder1 with the initial properties, nil for none
+func NewFakeMediaFolder1(objectPath dbus.ObjectPath, properties *MediaFolder1Properties) *FakeMediaFolder1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeMediaFolder1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(MediaFolder1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeMediaFolder1 object path
+func (a *FakeMediaFolder1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return MediaFolder1 interface
+func (a *FakeMediaFolder1) Interface() string {
+	return MediaFolder1Interface
+}
+
+// Close stop the watchers
+func (a *FakeMediaFolder1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeMediaFolder1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeMediaFolder1) GetProperties() (*MediaFolder1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeMediaFolder1) GetPropertiesContext(ctx context.Context) (*MediaFolder1Properties, error) {
+	return new(MediaFolder1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeMediaFolder1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeMediaFolder1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeMediaFolder1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeMediaFolder1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeMediaFolder1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeMediaFolder1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeMediaFolder1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+// SetNumberOfItems set NumberOfItems value
+func (a *FakeMediaFolder1) SetNumberOfItems(v uint32) error {
+	return a.SetNumberOfItemsContext(context.Background(), v)
+}
+
+// SetNumberOfItemsContext set NumberOfItems value
+func (a *FakeMediaFolder1) SetNumberOfItemsContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "NumberOfItems", v)
+}
+
+
+
+// GetNumberOfItems get NumberOfItems value
+func (a *FakeMediaFolder1) GetNumberOfItems() (uint32, error) {
+	return a.GetNumberOfItemsContext(context.Background())
+}
+
+// GetNumberOfItemsContext get NumberOfItems value
+func (a *FakeMediaFolder1) GetNumberOfItemsContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "NumberOfItems")
+	if err != nil {
+		return uint32(0), err
+	}
+	value, ok := v.Value().(uint32)
+	if !ok {
+		return uint32(0), fmt.Errorf("NumberOfItems: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetName set Name value
+func (a *FakeMediaFolder1) SetName(v string) error {
+	return a.SetNameContext(context.Background(), v)
+}
+
+// SetNameContext set Name value
+func (a *FakeMediaFolder1) SetNameContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Name", v)
+}
+
+
+
+// GetName get Name value
+func (a *FakeMediaFolder1) GetName() (string, error) {
+	return a.GetNameContext(context.Background())
+}
+
+// GetNameContext get Name value
+func (a *FakeMediaFolder1) GetNameContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Name")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Name: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetStart set Start value
+func (a *FakeMediaFolder1) SetStart(v uint32) error {
+	return a.SetStartContext(context.Background(), v)
+}
+
+// SetStartContext set Start value
+func (a *FakeMediaFolder1) SetStartContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "Start", v)
+}
+
+
+
+// GetStart get Start value
+func (a *FakeMediaFolder1) GetStart() (uint32, error) {
+	return a.GetStartContext(context.Background())
+}
+
+// GetStartContext get Start value
+func (a *FakeMediaFolder1) GetStartContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "Start")
+	if err != nil {
+		return uint32(0), err
+	}
+	value, ok := v.Value().(uint32)
+	if !ok {
+		return uint32(0), fmt.Errorf("Start: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetEnd set End value
+func (a *FakeMediaFolder1) SetEnd(v uint32) error {
+	return a.SetEndContext(context.Background(), v)
+}
+
+// SetEndContext set End value
+func (a *FakeMediaFolder1) SetEndContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "End", v)
+}
+
+
+
+// GetEnd get End value
+func (a *FakeMediaFolder1) GetEnd() (uint32, error) {
+	return a.GetEndContext(context.Background())
+}
+
+// GetEndContext get End value
+func (a *FakeMediaFolder1) GetEndContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "End")
+	if err != nil {
+		return uint32(0), err
+	}
+	value, ok := v.Value().(uint32)
+	if !ok {
+		return uint32(0), fmt.Errorf("End: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetAttributes set Attributes value
+func (a *FakeMediaFolder1) SetAttributes(v []string) error {
+	return a.SetAttributesContext(context.Background(), v)
+}
+
+// SetAttributesContext set Attributes value
+func (a *FakeMediaFolder1) SetAttributesContext(ctx context.Context, v []string) error {
+	for _, value := range v {
+		if !MediaFolder1Attributes(value).Valid() {
+			return &bluez.InvalidValueError{Interface: MediaFolder1Interface, Member: "Attributes", Path: a.Path(), Value: value}
+		}
+	}
+	return a.SetPropertyContext(ctx, "Attributes", v)
+}
+
+
+
+// GetAttributes get Attributes value
+func (a *FakeMediaFolder1) GetAttributes() ([]string, error) {
+	return a.GetAttributesContext(context.Background())
+}
+
+// GetAttributesContext get Attributes value
+func (a *FakeMediaFolder1) GetAttributesContext(ctx context.Context) ([]string, error) {
+	v, err := a.GetPropertyContext(ctx, "Attributes")
+	if err != nil {
+		return []string{}, err
+	}
+	value, ok := v.Value().([]string)
+	if !ok {
+		return []string{}, fmt.Errorf("Attributes: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+// Search call SearchFunc
+func (a *FakeMediaFolder1) Search(value string, filter map[string]interface{}) (dbus.ObjectPath, error) {
+	return a.SearchContext(context.Background(), value, filter)
+}
+
+// SearchContext call SearchFunc
+func (a *FakeMediaFolder1) SearchContext(ctx context.Context, value string, filter map[string]interface{}) (dbus.ObjectPath, error) {
+	if a.SearchFunc != nil {
+		return a.SearchFunc(ctx, value, filter)
+	}
+	
+	var val0 dbus.ObjectPath
+	return val0, nil	
+}
+
+// ListItems call ListItemsFunc
+func (a *FakeMediaFolder1) ListItems(filter map[string]interface{}) ([]Item, error) {
+	return a.ListItemsContext(context.Background(), filter)
+}
+
+// ListItemsContext call ListItemsFunc
+func (a *FakeMediaFolder1) ListItemsContext(ctx context.Context, filter map[string]interface{}) ([]Item, error) {
+	if a.ListItemsFunc != nil {
+		return a.ListItemsFunc(ctx, filter)
+	}
+	
+	 val0 := []Item{}
+	return val0, nil	
+}
+
+// ChangeFolder call ChangeFolderFunc
+func (a *FakeMediaFolder1) ChangeFolder(folder dbus.ObjectPath) error {
+	return a.ChangeFolderContext(context.Background(), folder)
+}
+
+// ChangeFolderContext call ChangeFolderFunc
+func (a *FakeMediaFolder1) ChangeFolderContext(ctx context.Context, folder dbus.ObjectPath) error {
+	if a.ChangeFolderFunc != nil {
+		return a.ChangeFolderFunc(ctx, folder)
+	}
+	
+	return nil
+	
+}
+
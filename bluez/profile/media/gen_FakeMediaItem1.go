@@ -0,0 +1,579 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeMediaItem1 is an in-memory MediaItem1API to test the code depending on
+// MediaItem1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeMediaItem1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// PlayFunc handle the calls to Play
+	PlayFunc func(ctx context.Context) error
+	// AddtoNowPlayingFunc handle the calls to AddtoNowPlaying
+	AddtoNowPlayingFunc func(ctx context.Context) error
+}
+
+var _ MediaItem1API = (*FakeMediaItem1)(nil)
+
+
+// NewFakeMediaItem1 create a FakeMediaItem1 with the initial properties, nil for none
+func NewFakeMediaItem1(objectPath dbus.ObjectPath, properties *MediaItem1Properties) *FakeMediaItem1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeMediaItem1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(MediaItem1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeMediaItem1 object path
+func (a *FakeMediaItem1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return MediaItem1 interface
+func (a *FakeMediaItem1) Interface() string {
+	return MediaItem1Interface
+}
+
+// Close stop the watchers
+func (a *FakeMediaItem1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeMediaItem1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeMediaItem1) GetProperties() (*MediaItem1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeMediaItem1) GetPropertiesContext(ctx context.Context) (*MediaItem1Properties, error) {
+	return new(MediaItem1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeMediaItem1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeMediaItem1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeMediaItem1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeMediaItem1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeMediaItem1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeMediaItem1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeMediaItem1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+// SetPlayer set Player value
+func (a *FakeMediaItem1) SetPlayer(v dbus.ObjectPath) error {
+	return a.SetPlayerContext(context.Background(), v)
+}
+
+// SetPlayerContext set Player value
+func (a *FakeMediaItem1) SetPlayerContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Player", v)
+}
+
+
+
+// GetPlayer get Player value
+func (a *FakeMediaItem1) GetPlayer() (dbus.ObjectPath, error) {
+	return a.GetPlayerContext(context.Background())
+}
+
+// GetPlayerContext get Player value
+func (a *FakeMediaItem1) GetPlayerContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Player")
+	if err != nil {
+		return dbus.ObjectPath(""), err
+	}
+	value, ok := v.Value().(dbus.ObjectPath)
+	if !ok {
+		return dbus.ObjectPath(""), fmt.Errorf("Player: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetName set Name value
+func (a *FakeMediaItem1) SetName(v string) error {
+	return a.SetNameContext(context.Background(), v)
+}
+
+// SetNameContext set Name value
+func (a *FakeMediaItem1) SetNameContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Name", v)
+}
+
+
+
+// GetName get Name value
+func (a *FakeMediaItem1) GetName() (string, error) {
+	return a.GetNameContext(context.Background())
+}
+
+// GetNameContext get Name value
+func (a *FakeMediaItem1) GetNameContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Name")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Name: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetType set Type value
+func (a *FakeMediaItem1) SetType(v string) error {
+	return a.SetTypeContext(context.Background(), v)
+}
+
+// SetTypeContext set Type value
+func (a *FakeMediaItem1) SetTypeContext(ctx context.Context, v string) error {
+	if !MediaItem1Type(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaItem1Interface, Member: "Type", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "Type", v)
+}
+
+
+
+// GetType get Type value
+func (a *FakeMediaItem1) GetType() (string, error) {
+	return a.GetTypeContext(context.Background())
+}
+
+// GetTypeContext get Type value
+func (a *FakeMediaItem1) GetTypeContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Type")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Type: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetFolderType set FolderType value
+func (a *FakeMediaItem1) SetFolderType(v string) error {
+	return a.SetFolderTypeContext(context.Background(), v)
+}
+
+// SetFolderTypeContext set FolderType value
+func (a *FakeMediaItem1) SetFolderTypeContext(ctx context.Context, v string) error {
+	if !MediaItem1FolderType(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaItem1Interface, Member: "FolderType", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "FolderType", v)
+}
+
+
+
+// GetFolderType get FolderType value
+func (a *FakeMediaItem1) GetFolderType() (string, error) {
+	return a.GetFolderTypeContext(context.Background())
+}
+
+// GetFolderTypeContext get FolderType value
+func (a *FakeMediaItem1) GetFolderTypeContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "FolderType")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("FolderType: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetPlayable set Playable value
+func (a *FakeMediaItem1) SetPlayable(v bool) error {
+	return a.SetPlayableContext(context.Background(), v)
+}
+
+// SetPlayableContext set Playable value
+func (a *FakeMediaItem1) SetPlayableContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Playable", v)
+}
+
+
+
+// GetPlayable get Playable value
+func (a *FakeMediaItem1) GetPlayable() (bool, error) {
+	return a.GetPlayableContext(context.Background())
+}
+
+// GetPlayableContext get Playable value
+func (a *FakeMediaItem1) GetPlayableContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Playable")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Playable: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetMetadata set Metadata value
+func (a *FakeMediaItem1) SetMetadata(v map[string]interface{}) error {
+	return a.SetMetadataContext(context.Background(), v)
+}
+
+// SetMetadataContext set Metadata value
+func (a *FakeMediaItem1) SetMetadataContext(ctx context.Context, v map[string]interface{}) error {
+	return a.SetPropertyContext(ctx, "Metadata", v)
+}
+
+
+
+// GetMetadata get Metadata value
+func (a *FakeMediaItem1) GetMetadata() (map[string]interface{}, error) {
+	return a.GetMetadataContext(context.Background())
+}
+
+// GetMetadataContext get Metadata value
+func (a *FakeMediaItem1) GetMetadataContext(ctx context.Context) (map[string]interface{}, error) {
+	v, err := a.GetPropertyContext(ctx, "Metadata")
+	if err != nil {
+		return map[string]interface{}{}, err
+	}
+	value, ok := v.Value().(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{}, fmt.Errorf("Metadata: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetTitle set Title value
+func (a *FakeMediaItem1) SetTitle(v string) error {
+	return a.SetTitleContext(context.Background(), v)
+}
+
+// SetTitleContext set Title value
+func (a *FakeMediaItem1) SetTitleContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Title", v)
+}
+
+
+
+// GetTitle get Title value
+func (a *FakeMediaItem1) GetTitle() (string, error) {
+	return a.GetTitleContext(context.Background())
+}
+
+// GetTitleContext get Title value
+func (a *FakeMediaItem1) GetTitleContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Title")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Title: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetArtist set Artist value
+func (a *FakeMediaItem1) SetArtist(v string) error {
+	return a.SetArtistContext(context.Background(), v)
+}
+
+// SetArtistContext set Artist value
+func (a *FakeMediaItem1) SetArtistContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Artist", v)
+}
+
+
+
+// GetArtist get Artist value
+func (a *FakeMediaItem1) GetArtist() (string, error) {
+	return a.GetArtistContext(context.Background())
+}
+
+// GetArtistContext get Artist value
+func (a *FakeMediaItem1) GetArtistContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Artist")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Artist: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetAlbum set Album value
+func (a *FakeMediaItem1) SetAlbum(v string) error {
+	return a.SetAlbumContext(context.Background(), v)
+}
+
+// SetAlbumContext set Album value
+func (a *FakeMediaItem1) SetAlbumContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Album", v)
+}
+
+
+
+// GetAlbum get Album value
+func (a *FakeMediaItem1) GetAlbum() (string, error) {
+	return a.GetAlbumContext(context.Background())
+}
+
+// GetAlbumContext get Album value
+func (a *FakeMediaItem1) GetAlbumContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Album")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Album: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetGenre set Genre value
+func (a *FakeMediaItem1) SetGenre(v string) error {
+	return a.SetGenreContext(context.Background(), v)
+}
+
+// SetGenreContext set Genre value
+func (a *FakeMediaItem1) SetGenreContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Genre", v)
+}
+
+
+
+// GetGenre get Genre value
+func (a *FakeMediaItem1) GetGenre() (string, error) {
+	return a.GetGenreContext(context.Background())
+}
+
+// GetGenreContext get Genre value
+func (a *FakeMediaItem1) GetGenreContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Genre")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Genre: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetNumberOfTracks set NumberOfTracks value
+func (a *FakeMediaItem1) SetNumberOfTracks(v uint32) error {
+	return a.SetNumberOfTracksContext(context.Background(), v)
+}
+
+// SetNumberOfTracksContext set NumberOfTracks value
+func (a *FakeMediaItem1) SetNumberOfTracksContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "NumberOfTracks", v)
+}
+
+
+
+// GetNumberOfTracks get NumberOfTracks value
+func (a *FakeMediaItem1) GetNumberOfTracks() (uint32, error) {
+	return a.GetNumberOfTracksContext(context.Background())
+}
+
+// GetNumberOfTracksContext get NumberOfTracks value
+func (a *FakeMediaItem1) GetNumberOfTracksContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "NumberOfTracks")
+	if err != nil {
+		return uint32(0), err
+	}
+	value, ok := v.Value().(uint32)
+	if !ok {
+		return uint32(0), fmt.Errorf("NumberOfTracks: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetNumber set Number value
+func (a *FakeMediaItem1) SetNumber(v uint32) error {
+	return a.SetNumberContext(context.Background(), v)
+}
+
+// SetNumberContext set Number value
+func (a *FakeMediaItem1) SetNumberContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "Number", v)
+}
+
+
+
+// GetNumber get Number value
+func (a *FakeMediaItem1) GetNumber() (uint32, error) {
+	return a.GetNumberContext(context.Background())
+}
+
+// GetNumberContext get Number value
+func (a *FakeMediaItem1) GetNumberContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "Number")
+	if err != nil {
+		return uint32(0), err
+	}
+	value, ok := v.Value().(uint32)
+	if !ok {
+		return uint32(0), fmt.Errorf("Number: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetDuration set Duration value
+func (a *FakeMediaItem1) SetDuration(v uint32) error {
+	return a.SetDurationContext(context.Background(), v)
+}
+
+// SetDurationContext set Duration value
+func (a *FakeMediaItem1) SetDurationContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "Duration", v)
+}
+
+
+
+// GetDuration get Duration value
+func (a *FakeMediaItem1) GetDuration() (uint32, error) {
+	return a.GetDurationContext(context.Background())
+}
+
+// GetDurationContext get Duration value
+func (a *FakeMediaItem1) GetDurationContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "Duration")
+	if err != nil {
+		return uint32(0), err
+	}
+	value, ok := v.Value().(uint32)
+	if !ok {
+		return uint32(0), fmt.Errorf("Duration: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+// Play call PlayFunc
+func (a *FakeMediaItem1) Play() error {
+	return a.PlayContext(context.Background())
+}
+
+// PlayContext call PlayFunc
+func (a *FakeMediaItem1) PlayContext(ctx context.Context) error {
+	if a.PlayFunc != nil {
+		return a.PlayFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// AddtoNowPlaying call AddtoNowPlayingFunc
+func (a *FakeMediaItem1) AddtoNowPlaying() error {
+	return a.AddtoNowPlayingContext(context.Background())
+}
+
+// AddtoNowPlayingContext call AddtoNowPlayingFunc
+func (a *FakeMediaItem1) AddtoNowPlayingContext(ctx context.Context) error {
+	if a.AddtoNowPlayingFunc != nil {
+		return a.AddtoNowPlayingFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
@@ -0,0 +1,935 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeMediaPlayer1 is an in-memory MediaPlayer1API to test the code depending on
+// MediaPlayer1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeMediaPlayer1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// PlayFunc handle the calls to Play
+	PlayFunc func(ctx context.Context) error
+	// PauseFunc handle the calls to Pause
+	PauseFunc func(ctx context.Context) error
+	// StopFunc handle the calls to Stop
+	StopFunc func(ctx context.Context) error
+	// NextFunc handle the calls to Next
+	NextFunc func(ctx context.Context) error
+	// PreviousFunc handle the calls to Previous
+	PreviousFunc func(ctx context.Context) error
+	// FastForwardFunc handle the calls to FastForward
+	FastForwardFunc func(ctx context.Context) error
+	// RewindFunc handle the calls to Rewind
+	RewindFunc func(ctx context.Context) error
+}
+
+var _ MediaPlayer1API = (*FakeMediaPlayer1)(nil)
+
+
+// NewFakeMediaPlayer1 create a FakeMediaPlayer1 with the initial properties, nil for none
+func NewFakeMediaPlayer1(objectPath dbus.ObjectPath, properties *MediaPlayer1Properties) *FakeMediaPlayer1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeMediaPlayer1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(MediaPlayer1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeMediaPlayer1 object path
+func (a *FakeMediaPlayer1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return MediaPlayer1 interface
+func (a *FakeMediaPlayer1) Interface() string {
+	return MediaPlayer1Interface
+}
+
+// Close stop the watchers
+func (a *FakeMediaPlayer1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeMediaPlayer1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeMediaPlayer1) GetProperties() (*MediaPlayer1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeMediaPlayer1) GetPropertiesContext(ctx context.Context) (*MediaPlayer1Properties, error) {
+	return new(MediaPlayer1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeMediaPlayer1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeMediaPlayer1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeMediaPlayer1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeMediaPlayer1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeMediaPlayer1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeMediaPlayer1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeMediaPlayer1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+// SetEqualizer set Equalizer value
+func (a *FakeMediaPlayer1) SetEqualizer(v string) error {
+	return a.SetEqualizerContext(context.Background(), v)
+}
+
+// SetEqualizerContext set Equalizer value
+func (a *FakeMediaPlayer1) SetEqualizerContext(ctx context.Context, v string) error {
+	if !MediaPlayer1Equalizer(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaPlayer1Interface, Member: "Equalizer", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "Equalizer", v)
+}
+
+
+
+// GetEqualizer get Equalizer value
+func (a *FakeMediaPlayer1) GetEqualizer() (string, error) {
+	return a.GetEqualizerContext(context.Background())
+}
+
+// GetEqualizerContext get Equalizer value
+func (a *FakeMediaPlayer1) GetEqualizerContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Equalizer")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Equalizer: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetRepeat set Repeat value
+func (a *FakeMediaPlayer1) SetRepeat(v string) error {
+	return a.SetRepeatContext(context.Background(), v)
+}
+
+// SetRepeatContext set Repeat value
+func (a *FakeMediaPlayer1) SetRepeatContext(ctx context.Context, v string) error {
+	if !MediaPlayer1Repeat(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaPlayer1Interface, Member: "Repeat", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "Repeat", v)
+}
+
+
+
+// GetRepeat get Repeat value
+func (a *FakeMediaPlayer1) GetRepeat() (string, error) {
+	return a.GetRepeatContext(context.Background())
+}
+
+// GetRepeatContext get Repeat value
+func (a *FakeMediaPlayer1) GetRepeatContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Repeat")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Repeat: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetShuffle set Shuffle value
+func (a *FakeMediaPlayer1) SetShuffle(v string) error {
+	return a.SetShuffleContext(context.Background(), v)
+}
+
+// SetShuffleContext set Shuffle value
+func (a *FakeMediaPlayer1) SetShuffleContext(ctx context.Context, v string) error {
+	if !MediaPlayer1Shuffle(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaPlayer1Interface, Member: "Shuffle", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "Shuffle", v)
+}
+
+
+
+// GetShuffle get Shuffle value
+func (a *FakeMediaPlayer1) GetShuffle() (string, error) {
+	return a.GetShuffleContext(context.Background())
+}
+
+// GetShuffleContext get Shuffle value
+func (a *FakeMediaPlayer1) GetShuffleContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Shuffle")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Shuffle: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetScan set Scan value
+func (a *FakeMediaPlayer1) SetScan(v string) error {
+	return a.SetScanContext(context.Background(), v)
+}
+
+// SetScanContext set Scan value
+func (a *FakeMediaPlayer1) SetScanContext(ctx context.Context, v string) error {
+	if !MediaPlayer1Scan(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaPlayer1Interface, Member: "Scan", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "Scan", v)
+}
+
+
+
+// GetScan get Scan value
+func (a *FakeMediaPlayer1) GetScan() (string, error) {
+	return a.GetScanContext(context.Background())
+}
+
+// GetScanContext get Scan value
+func (a *FakeMediaPlayer1) GetScanContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Scan")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Scan: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetStatus set Status value
+func (a *FakeMediaPlayer1) SetStatus(v string) error {
+	return a.SetStatusContext(context.Background(), v)
+}
+
+// SetStatusContext set Status value
+func (a *FakeMediaPlayer1) SetStatusContext(ctx context.Context, v string) error {
+	if !MediaPlayer1Status(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaPlayer1Interface, Member: "Status", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "Status", v)
+}
+
+
+
+// GetStatus get Status value
+func (a *FakeMediaPlayer1) GetStatus() (string, error) {
+	return a.GetStatusContext(context.Background())
+}
+
+// GetStatusContext get Status value
+func (a *FakeMediaPlayer1) GetStatusContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Status")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Status: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetPosition set Position value
+func (a *FakeMediaPlayer1) SetPosition(v uint32) error {
+	return a.SetPositionContext(context.Background(), v)
+}
+
+// SetPositionContext set Position value
+func (a *FakeMediaPlayer1) SetPositionContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "Position", v)
+}
+
+
+
+// GetPosition get Position value
+func (a *FakeMediaPlayer1) GetPosition() (uint32, error) {
+	return a.GetPositionContext(context.Background())
+}
+
+// GetPositionContext get Position value
+func (a *FakeMediaPlayer1) GetPositionContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "Position")
+	if err != nil {
+		return uint32(0), err
+	}
+	value, ok := v.Value().(uint32)
+	if !ok {
+		return uint32(0), fmt.Errorf("Position: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetTrack set Track value
+func (a *FakeMediaPlayer1) SetTrack(v map[string]interface{}) error {
+	return a.SetTrackContext(context.Background(), v)
+}
+
+// SetTrackContext set Track value
+func (a *FakeMediaPlayer1) SetTrackContext(ctx context.Context, v map[string]interface{}) error {
+	return a.SetPropertyContext(ctx, "Track", v)
+}
+
+
+
+// GetTrack get Track value
+func (a *FakeMediaPlayer1) GetTrack() (map[string]interface{}, error) {
+	return a.GetTrackContext(context.Background())
+}
+
+// GetTrackContext get Track value
+func (a *FakeMediaPlayer1) GetTrackContext(ctx context.Context) (map[string]interface{}, error) {
+	v, err := a.GetPropertyContext(ctx, "Track")
+	if err != nil {
+		return map[string]interface{}{}, err
+	}
+	value, ok := v.Value().(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{}, fmt.Errorf("Track: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetTitle set Title value
+func (a *FakeMediaPlayer1) SetTitle(v string) error {
+	return a.SetTitleContext(context.Background(), v)
+}
+
+// SetTitleContext set Title value
+func (a *FakeMediaPlayer1) SetTitleContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Title", v)
+}
+
+
+
+// GetTitle get Title value
+func (a *FakeMediaPlayer1) GetTitle() (string, error) {
+	return a.GetTitleContext(context.Background())
+}
+
+// GetTitleContext get Title value
+func (a *FakeMediaPlayer1) GetTitleContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Title")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Title: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetArtist set Artist value
+func (a *FakeMediaPlayer1) SetArtist(v string) error {
+	return a.SetArtistContext(context.Background(), v)
+}
+
+// SetArtistContext set Artist value
+func (a *FakeMediaPlayer1) SetArtistContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Artist", v)
+}
+
+
+
+// GetArtist get Artist value
+func (a *FakeMediaPlayer1) GetArtist() (string, error) {
+	return a.GetArtistContext(context.Background())
+}
+
+// GetArtistContext get Artist value
+func (a *FakeMediaPlayer1) GetArtistContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Artist")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Artist: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetAlbum set Album value
+func (a *FakeMediaPlayer1) SetAlbum(v string) error {
+	return a.SetAlbumContext(context.Background(), v)
+}
+
+// SetAlbumContext set Album value
+func (a *FakeMediaPlayer1) SetAlbumContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Album", v)
+}
+
+
+
+// GetAlbum get Album value
+func (a *FakeMediaPlayer1) GetAlbum() (string, error) {
+	return a.GetAlbumContext(context.Background())
+}
+
+// GetAlbumContext get Album value
+func (a *FakeMediaPlayer1) GetAlbumContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Album")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Album: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetGenre set Genre value
+func (a *FakeMediaPlayer1) SetGenre(v string) error {
+	return a.SetGenreContext(context.Background(), v)
+}
+
+// SetGenreContext set Genre value
+func (a *FakeMediaPlayer1) SetGenreContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Genre", v)
+}
+
+
+
+// GetGenre get Genre value
+func (a *FakeMediaPlayer1) GetGenre() (string, error) {
+	return a.GetGenreContext(context.Background())
+}
+
+// GetGenreContext get Genre value
+func (a *FakeMediaPlayer1) GetGenreContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Genre")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Genre: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetNumberOfTracks set NumberOfTracks value
+func (a *FakeMediaPlayer1) SetNumberOfTracks(v uint32) error {
+	return a.SetNumberOfTracksContext(context.Background(), v)
+}
+
+// SetNumberOfTracksContext set NumberOfTracks value
+func (a *FakeMediaPlayer1) SetNumberOfTracksContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "NumberOfTracks", v)
+}
+
+
+
+// GetNumberOfTracks get NumberOfTracks value
+func (a *FakeMediaPlayer1) GetNumberOfTracks() (uint32, error) {
+	return a.GetNumberOfTracksContext(context.Background())
+}
+
+// GetNumberOfTracksContext get NumberOfTracks value
+func (a *FakeMediaPlayer1) GetNumberOfTracksContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "NumberOfTracks")
+	if err != nil {
+		return uint32(0), err
+	}
+	value, ok := v.Value().(uint32)
+	if !ok {
+		return uint32(0), fmt.Errorf("NumberOfTracks: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetTrackNumber set TrackNumber value
+func (a *FakeMediaPlayer1) SetTrackNumber(v uint32) error {
+	return a.SetTrackNumberContext(context.Background(), v)
+}
+
+// SetTrackNumberContext set TrackNumber value
+func (a *FakeMediaPlayer1) SetTrackNumberContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "TrackNumber", v)
+}
+
+
+
+// GetTrackNumber get TrackNumber value
+func (a *FakeMediaPlayer1) GetTrackNumber() (uint32, error) {
+	return a.GetTrackNumberContext(context.Background())
+}
+
+// GetTrackNumberContext get TrackNumber value
+func (a *FakeMediaPlayer1) GetTrackNumberContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "TrackNumber")
+	if err != nil {
+		return uint32(0), err
+	}
+	value, ok := v.Value().(uint32)
+	if !ok {
+		return uint32(0), fmt.Errorf("TrackNumber: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetDuration set Duration value
+func (a *FakeMediaPlayer1) SetDuration(v uint32) error {
+	return a.SetDurationContext(context.Background(), v)
+}
+
+// SetDurationContext set Duration value
+func (a *FakeMediaPlayer1) SetDurationContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "Duration", v)
+}
+
+
+
+// GetDuration get Duration value
+func (a *FakeMediaPlayer1) GetDuration() (uint32, error) {
+	return a.GetDurationContext(context.Background())
+}
+
+// GetDurationContext get Duration value
+func (a *FakeMediaPlayer1) GetDurationContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "Duration")
+	if err != nil {
+		return uint32(0), err
+	}
+	value, ok := v.Value().(uint32)
+	if !ok {
+		return uint32(0), fmt.Errorf("Duration: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetDevice set Device value
+func (a *FakeMediaPlayer1) SetDevice(v dbus.ObjectPath) error {
+	return a.SetDeviceContext(context.Background(), v)
+}
+
+// SetDeviceContext set Device value
+func (a *FakeMediaPlayer1) SetDeviceContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Device", v)
+}
+
+
+
+// GetDevice get Device value
+func (a *FakeMediaPlayer1) GetDevice() (dbus.ObjectPath, error) {
+	return a.GetDeviceContext(context.Background())
+}
+
+// GetDeviceContext get Device value
+func (a *FakeMediaPlayer1) GetDeviceContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Device")
+	if err != nil {
+		return dbus.ObjectPath(""), err
+	}
+	value, ok := v.Value().(dbus.ObjectPath)
+	if !ok {
+		return dbus.ObjectPath(""), fmt.Errorf("Device: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetName set Name value
+func (a *FakeMediaPlayer1) SetName(v string) error {
+	return a.SetNameContext(context.Background(), v)
+}
+
+// SetNameContext set Name value
+func (a *FakeMediaPlayer1) SetNameContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Name", v)
+}
+
+
+
+// GetName get Name value
+func (a *FakeMediaPlayer1) GetName() (string, error) {
+	return a.GetNameContext(context.Background())
+}
+
+// GetNameContext get Name value
+func (a *FakeMediaPlayer1) GetNameContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Name")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Name: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetType set Type value
+func (a *FakeMediaPlayer1) SetType(v string) error {
+	return a.SetTypeContext(context.Background(), v)
+}
+
+// SetTypeContext set Type value
+func (a *FakeMediaPlayer1) SetTypeContext(ctx context.Context, v string) error {
+	if !MediaPlayer1Type(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaPlayer1Interface, Member: "Type", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "Type", v)
+}
+
+
+
+// GetType get Type value
+func (a *FakeMediaPlayer1) GetType() (string, error) {
+	return a.GetTypeContext(context.Background())
+}
+
+// GetTypeContext get Type value
+func (a *FakeMediaPlayer1) GetTypeContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Type")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Type: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetSubtype set Subtype value
+func (a *FakeMediaPlayer1) SetSubtype(v string) error {
+	return a.SetSubtypeContext(context.Background(), v)
+}
+
+// SetSubtypeContext set Subtype value
+func (a *FakeMediaPlayer1) SetSubtypeContext(ctx context.Context, v string) error {
+	if !MediaPlayer1Subtype(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaPlayer1Interface, Member: "Subtype", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "Subtype", v)
+}
+
+
+
+// GetSubtype get Subtype value
+func (a *FakeMediaPlayer1) GetSubtype() (string, error) {
+	return a.GetSubtypeContext(context.Background())
+}
+
+// GetSubtypeContext get Subtype value
+func (a *FakeMediaPlayer1) GetSubtypeContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Subtype")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Subtype: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetBrowsable set Browsable value
+func (a *FakeMediaPlayer1) SetBrowsable(v bool) error {
+	return a.SetBrowsableContext(context.Background(), v)
+}
+
+// SetBrowsableContext set Browsable value
+func (a *FakeMediaPlayer1) SetBrowsableContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Browsable", v)
+}
+
+
+
+// GetBrowsable get Browsable value
+func (a *FakeMediaPlayer1) GetBrowsable() (bool, error) {
+	return a.GetBrowsableContext(context.Background())
+}
+
+// GetBrowsableContext get Browsable value
+func (a *FakeMediaPlayer1) GetBrowsableContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Browsable")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Browsable: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetSearchable set Searchable value
+func (a *FakeMediaPlayer1) SetSearchable(v bool) error {
+	return a.SetSearchableContext(context.Background(), v)
+}
+
+// SetSearchableContext set Searchable value
+func (a *FakeMediaPlayer1) SetSearchableContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Searchable", v)
+}
+
+
+
+// GetSearchable get Searchable value
+func (a *FakeMediaPlayer1) GetSearchable() (bool, error) {
+	return a.GetSearchableContext(context.Background())
+}
+
+// GetSearchableContext get Searchable value
+func (a *FakeMediaPlayer1) GetSearchableContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Searchable")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Searchable: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetPlaylist set Playlist value
+func (a *FakeMediaPlayer1) SetPlaylist(v dbus.ObjectPath) error {
+	return a.SetPlaylistContext(context.Background(), v)
+}
+
+// SetPlaylistContext set Playlist value
+func (a *FakeMediaPlayer1) SetPlaylistContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Playlist", v)
+}
+
+
+
+// GetPlaylist get Playlist value
+func (a *FakeMediaPlayer1) GetPlaylist() (dbus.ObjectPath, error) {
+	return a.GetPlaylistContext(context.Background())
+}
+
+// GetPlaylistContext get Playlist value
+func (a *FakeMediaPlayer1) GetPlaylistContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Playlist")
+	if err != nil {
+		return dbus.ObjectPath(""), err
+	}
+	value, ok := v.Value().(dbus.ObjectPath)
+	if !ok {
+		return dbus.ObjectPath(""), fmt.Errorf("Playlist: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+// Play call PlayFunc
+func (a *FakeMediaPlayer1) Play() error {
+	return a.PlayContext(context.Background())
+}
+
+// PlayContext call PlayFunc
+func (a *FakeMediaPlayer1) PlayContext(ctx context.Context) error {
+	if a.PlayFunc != nil {
+		return a.PlayFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// Pause call PauseFunc
+func (a *FakeMediaPlayer1) Pause() error {
+	return a.PauseContext(context.Background())
+}
+
+// PauseContext call PauseFunc
+func (a *FakeMediaPlayer1) PauseContext(ctx context.Context) error {
+	if a.PauseFunc != nil {
+		return a.PauseFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// Stop call StopFunc
+func (a *FakeMediaPlayer1) Stop() error {
+	return a.StopContext(context.Background())
+}
+
+// StopContext call StopFunc
+func (a *FakeMediaPlayer1) StopContext(ctx context.Context) error {
+	if a.StopFunc != nil {
+		return a.StopFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// Next call NextFunc
+func (a *FakeMediaPlayer1) Next() error {
+	return a.NextContext(context.Background())
+}
+
+// NextContext call NextFunc
+func (a *FakeMediaPlayer1) NextContext(ctx context.Context) error {
+	if a.NextFunc != nil {
+		return a.NextFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// Previous call PreviousFunc
+func (a *FakeMediaPlayer1) Previous() error {
+	return a.PreviousContext(context.Background())
+}
+
+// PreviousContext call PreviousFunc
+func (a *FakeMediaPlayer1) PreviousContext(ctx context.Context) error {
+	if a.PreviousFunc != nil {
+		return a.PreviousFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// FastForward call FastForwardFunc
+func (a *FakeMediaPlayer1) FastForward() error {
+	return a.FastForwardContext(context.Background())
+}
+
+// FastForwardContext call FastForwardFunc
+func (a *FakeMediaPlayer1) FastForwardContext(ctx context.Context) error {
+	if a.FastForwardFunc != nil {
+		return a.FastForwardFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// Rewind call RewindFunc
+func (a *FakeMediaPlayer1) Rewind() error {
+	return a.RewindContext(context.Background())
+}
+
+// RewindContext call RewindFunc
+func (a *FakeMediaPlayer1) RewindContext(ctx context.Context) error {
+	if a.RewindFunc != nil {
+		return a.RewindFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
@@ -0,0 +1,437 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeMediaTransport1 is an in-memory MediaTransport1API to test the code depending on
+// MediaTransport1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeMediaTransport1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// AcquireFunc handle the calls to Acquire
+	AcquireFunc func(ctx context.Context) (dbus.UnixFD, uint16, uint16, error)
+	// TryAcquireFunc handle the calls to TryAcquire
+	TryAcquireFunc func(ctx context.Context) (dbus.UnixFD, uint16, uint16, error)
+	// ReleaseFunc handle the calls to Release
+	ReleaseFunc func(ctx context.Context) error
+}
+
+var _ MediaTransport1API = (*FakeMediaTransport1)(nil)
+
+
+// NewFakeMediaTransport1 create a FakeMediaTransport1 with the initial properties, nil for none
+func NewFakeMediaTransport1(objectPath dbus.ObjectPath, properties *MediaTransport1Properties) *FakeMediaTransport1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeMediaTransport1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(MediaTransport1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeMediaTransport1 object path
+func (a *FakeMediaTransport1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return MediaTransport1 interface
+func (a *FakeMediaTransport1) Interface() string {
+	return MediaTransport1Interface
+}
+
+// Close stop the watchers
+func (a *FakeMediaTransport1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeMediaTransport1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeMediaTransport1) GetProperties() (*MediaTransport1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeMediaTransport1) GetPropertiesContext(ctx context.Context) (*MediaTransport1Properties, error) {
+	return new(MediaTransport1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeMediaTransport1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeMediaTransport1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeMediaTransport1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeMediaTransport1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeMediaTransport1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeMediaTransport1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeMediaTransport1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+// SetDevice set Device value
+func (a *FakeMediaTransport1) SetDevice(v dbus.ObjectPath) error {
+	return a.SetDeviceContext(context.Background(), v)
+}
+
+// SetDeviceContext set Device value
+func (a *FakeMediaTransport1) SetDeviceContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Device", v)
+}
+
+
+
+// GetDevice get Device value
+func (a *FakeMediaTransport1) GetDevice() (dbus.ObjectPath, error) {
+	return a.GetDeviceContext(context.Background())
+}
+
+// GetDeviceContext get Device value
+func (a *FakeMediaTransport1) GetDeviceContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Device")
+	if err != nil {
+		return dbus.ObjectPath(""), err
+	}
+	value, ok := v.Value().(dbus.ObjectPath)
+	if !ok {
+		return dbus.ObjectPath(""), fmt.Errorf("Device: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetUUID set UUID value
+func (a *FakeMediaTransport1) SetUUID(v string) error {
+	return a.SetUUIDContext(context.Background(), v)
+}
+
+// SetUUIDContext set UUID value
+func (a *FakeMediaTransport1) SetUUIDContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "UUID", v)
+}
+
+
+
+// GetUUID get UUID value
+func (a *FakeMediaTransport1) GetUUID() (string, error) {
+	return a.GetUUIDContext(context.Background())
+}
+
+// GetUUIDContext get UUID value
+func (a *FakeMediaTransport1) GetUUIDContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "UUID")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("UUID: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetCodec set Codec value
+func (a *FakeMediaTransport1) SetCodec(v byte) error {
+	return a.SetCodecContext(context.Background(), v)
+}
+
+// SetCodecContext set Codec value
+func (a *FakeMediaTransport1) SetCodecContext(ctx context.Context, v byte) error {
+	return a.SetPropertyContext(ctx, "Codec", v)
+}
+
+
+
+// GetCodec get Codec value
+func (a *FakeMediaTransport1) GetCodec() (byte, error) {
+	return a.GetCodecContext(context.Background())
+}
+
+// GetCodecContext get Codec value
+func (a *FakeMediaTransport1) GetCodecContext(ctx context.Context) (byte, error) {
+	v, err := a.GetPropertyContext(ctx, "Codec")
+	if err != nil {
+		return byte(0), err
+	}
+	value, ok := v.Value().(byte)
+	if !ok {
+		return byte(0), fmt.Errorf("Codec: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetConfiguration set Configuration value
+func (a *FakeMediaTransport1) SetConfiguration(v []byte) error {
+	return a.SetConfigurationContext(context.Background(), v)
+}
+
+// SetConfigurationContext set Configuration value
+func (a *FakeMediaTransport1) SetConfigurationContext(ctx context.Context, v []byte) error {
+	return a.SetPropertyContext(ctx, "Configuration", v)
+}
+
+
+
+// GetConfiguration get Configuration value
+func (a *FakeMediaTransport1) GetConfiguration() ([]byte, error) {
+	return a.GetConfigurationContext(context.Background())
+}
+
+// GetConfigurationContext get Configuration value
+func (a *FakeMediaTransport1) GetConfigurationContext(ctx context.Context) ([]byte, error) {
+	v, err := a.GetPropertyContext(ctx, "Configuration")
+	if err != nil {
+		return []byte{}, err
+	}
+	value, ok := v.Value().([]byte)
+	if !ok {
+		return []byte{}, fmt.Errorf("Configuration: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetState set State value
+func (a *FakeMediaTransport1) SetState(v string) error {
+	return a.SetStateContext(context.Background(), v)
+}
+
+// SetStateContext set State value
+func (a *FakeMediaTransport1) SetStateContext(ctx context.Context, v string) error {
+	if !MediaTransport1State(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaTransport1Interface, Member: "State", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "State", v)
+}
+
+
+
+// GetState get State value
+func (a *FakeMediaTransport1) GetState() (string, error) {
+	return a.GetStateContext(context.Background())
+}
+
+// GetStateContext get State value
+func (a *FakeMediaTransport1) GetStateContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "State")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("State: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetDelay set Delay value
+func (a *FakeMediaTransport1) SetDelay(v uint16) error {
+	return a.SetDelayContext(context.Background(), v)
+}
+
+// SetDelayContext set Delay value
+func (a *FakeMediaTransport1) SetDelayContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "Delay", v)
+}
+
+
+
+// GetDelay get Delay value
+func (a *FakeMediaTransport1) GetDelay() (uint16, error) {
+	return a.GetDelayContext(context.Background())
+}
+
+// GetDelayContext get Delay value
+func (a *FakeMediaTransport1) GetDelayContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Delay")
+	if err != nil {
+		return uint16(0), err
+	}
+	value, ok := v.Value().(uint16)
+	if !ok {
+		return uint16(0), fmt.Errorf("Delay: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetVolume set Volume value
+func (a *FakeMediaTransport1) SetVolume(v uint16) error {
+	return a.SetVolumeContext(context.Background(), v)
+}
+
+// SetVolumeContext set Volume value
+func (a *FakeMediaTransport1) SetVolumeContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "Volume", v)
+}
+
+
+
+// GetVolume get Volume value
+func (a *FakeMediaTransport1) GetVolume() (uint16, error) {
+	return a.GetVolumeContext(context.Background())
+}
+
+// GetVolumeContext get Volume value
+func (a *FakeMediaTransport1) GetVolumeContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Volume")
+	if err != nil {
+		return uint16(0), err
+	}
+	value, ok := v.Value().(uint16)
+	if !ok {
+		return uint16(0), fmt.Errorf("Volume: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetEndpoint set Endpoint value
+func (a *FakeMediaTransport1) SetEndpoint(v dbus.ObjectPath) error {
+	return a.SetEndpointContext(context.Background(), v)
+}
+
+// SetEndpointContext set Endpoint value
+func (a *FakeMediaTransport1) SetEndpointContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Endpoint", v)
+}
+
+
+
+// GetEndpoint get Endpoint value
+func (a *FakeMediaTransport1) GetEndpoint() (dbus.ObjectPath, error) {
+	return a.GetEndpointContext(context.Background())
+}
+
+// GetEndpointContext get Endpoint value
+func (a *FakeMediaTransport1) GetEndpointContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Endpoint")
+	if err != nil {
+		return dbus.ObjectPath(""), err
+	}
+	value, ok := v.Value().(dbus.ObjectPath)
+	if !ok {
+		return dbus.ObjectPath(""), fmt.Errorf("Endpoint: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+// Acquire call AcquireFunc
+func (a *FakeMediaTransport1) Acquire() (dbus.UnixFD, uint16, uint16, error) {
+	return a.AcquireContext(context.Background())
+}
+
+// AcquireContext call AcquireFunc
+func (a *FakeMediaTransport1) AcquireContext(ctx context.Context) (dbus.UnixFD, uint16, uint16, error) {
+	if a.AcquireFunc != nil {
+		return a.AcquireFunc(ctx)
+	}
+	
+	var val0 dbus.UnixFD
+  var val1 uint16
+  var val2 uint16
+	return val0, val1, val2, nil	
+}
+
+// TryAcquire call TryAcquireFunc
+func (a *FakeMediaTransport1) TryAcquire() (dbus.UnixFD, uint16, uint16, error) {
+	return a.TryAcquireContext(context.Background())
+}
+
+// TryAcquireContext call TryAcquireFunc
+func (a *FakeMediaTransport1) TryAcquireContext(ctx context.Context) (dbus.UnixFD, uint16, uint16, error) {
+	if a.TryAcquireFunc != nil {
+		return a.TryAcquireFunc(ctx)
+	}
+	
+	var val0 dbus.UnixFD
+  var val1 uint16
+  var val2 uint16
+	return val0, val1, val2, nil	
+}
+
+// Release call ReleaseFunc
+func (a *FakeMediaTransport1) Release() error {
+	return a.ReleaseContext(context.Background())
+}
+
+// ReleaseContext call ReleaseFunc
+func (a *FakeMediaTransport1) ReleaseContext(ctx context.Context) error {
+	if a.ReleaseFunc != nil {
+		return a.ReleaseFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
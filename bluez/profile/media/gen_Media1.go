@@ -5,6 +5,7 @@ package media
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var Media1Interface = "org.bluez.Media1"
 // Args:
 // - objectPath: [variable prefix]/{hci0,hci1,...}
 func NewMedia1(objectPath dbus.ObjectPath) (*Media1, error) {
+	return NewMedia1WithConn(nil, objectPath)
+}
+
+// NewMedia1WithConn create a new instance of Media1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [variable prefix]/{hci0,hci1,...}
+func NewMedia1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*Media1, error) {
 	a := new(Media1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewMedia1(objectPath dbus.ObjectPath) (*Media1, error) {
 			Iface: Media1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -47,8 +57,7 @@ Media1 Media hierarchy
 */
 type Media1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*Media1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -95,35 +104,24 @@ func (a *Media1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *Media1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *Media1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -153,20 +151,31 @@ func (a *Media1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Media1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Media1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *Media1) GetProperties() (*Media1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *Media1) GetPropertiesContext(ctx context.Context) (*Media1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -176,29 +185,40 @@ func (a *Media1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *Media1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *Media1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *Media1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *Media1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *Media1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *Media1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -208,6 +228,7 @@ func (a *Media1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *Media1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -239,8 +260,13 @@ RegisterEndpoint 			Register a local end point to sender, the sender can
 
 */
 func (a *Media1) RegisterEndpoint(endpoint dbus.ObjectPath, properties map[string]interface{}) error {
+	return a.RegisterEndpointContext(context.Background(), endpoint, properties)
+}
+
+// RegisterEndpointContext call RegisterEndpoint, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Media1) RegisterEndpointContext(ctx context.Context, endpoint dbus.ObjectPath, properties map[string]interface{}) error {
 	
-	return a.client.Call("RegisterEndpoint", 0, endpoint, properties).Store()
+	return a.client.CallWithContext(ctx, "RegisterEndpoint", 0, endpoint, properties).Store()
 	
 }
 
@@ -249,8 +275,13 @@ UnregisterEndpoint 			Unregister sender end point.
 
 */
 func (a *Media1) UnregisterEndpoint(endpoint dbus.ObjectPath) error {
+	return a.UnregisterEndpointContext(context.Background(), endpoint)
+}
+
+// UnregisterEndpointContext call UnregisterEndpoint, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Media1) UnregisterEndpointContext(ctx context.Context, endpoint dbus.ObjectPath) error {
 	
-	return a.client.Call("UnregisterEndpoint", 0, endpoint).Store()
+	return a.client.CallWithContext(ctx, "UnregisterEndpoint", 0, endpoint).Store()
 	
 }
 
@@ -268,8 +299,13 @@ RegisterPlayer 			Register a media player object to sender, the sender
 
 */
 func (a *Media1) RegisterPlayer(player dbus.ObjectPath, properties map[string]interface{}) error {
+	return a.RegisterPlayerContext(context.Background(), player, properties)
+}
+
+// RegisterPlayerContext call RegisterPlayer, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Media1) RegisterPlayerContext(ctx context.Context, player dbus.ObjectPath, properties map[string]interface{}) error {
 	
-	return a.client.Call("RegisterPlayer", 0, player, properties).Store()
+	return a.client.CallWithContext(ctx, "RegisterPlayer", 0, player, properties).Store()
 	
 }
 
@@ -278,8 +314,13 @@ UnregisterPlayer 			Unregister sender media player.
 
 */
 func (a *Media1) UnregisterPlayer(player dbus.ObjectPath) error {
+	return a.UnregisterPlayerContext(context.Background(), player)
+}
+
+// UnregisterPlayerContext call UnregisterPlayer, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Media1) UnregisterPlayerContext(ctx context.Context, player dbus.ObjectPath) error {
 	
-	return a.client.Call("UnregisterPlayer", 0, player).Store()
+	return a.client.CallWithContext(ctx, "UnregisterPlayer", 0, player).Store()
 	
 }
 
@@ -292,10 +333,17 @@ RegisterApplication 			Register endpoints an player objects within root
 			Possible errors: org.bluez.Error.InvalidArguments
 					 org.bluez.Error.AlreadyExists
 
+
+Since BlueZ 5.53
 */
 func (a *Media1) RegisterApplication(root dbus.ObjectPath, options map[string]interface{}) error {
+	return a.RegisterApplicationContext(context.Background(), root, options)
+}
+
+// RegisterApplicationContext call RegisterApplication, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Media1) RegisterApplicationContext(ctx context.Context, root dbus.ObjectPath, options map[string]interface{}) error {
 	
-	return a.client.Call("RegisterApplication", 0, root, options).Store()
+	return a.client.CallWithContext(ctx, "RegisterApplication", 0, root, options).Store()
 	
 }
 
@@ -307,10 +355,17 @@ UnregisterApplication 			This unregisters the services that has been
 			Possible errors: org.bluez.Error.InvalidArguments
 					 org.bluez.Error.DoesNotExist
 
+
+Since BlueZ 5.53
 */
 func (a *Media1) UnregisterApplication(application dbus.ObjectPath) error {
+	return a.UnregisterApplicationContext(context.Background(), application)
+}
+
+// UnregisterApplicationContext call UnregisterApplication, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Media1) UnregisterApplicationContext(ctx context.Context, application dbus.ObjectPath) error {
 	
-	return a.client.Call("UnregisterApplication", 0, application).Store()
+	return a.client.CallWithContext(ctx, "UnregisterApplication", 0, application).Store()
 	
 }
 
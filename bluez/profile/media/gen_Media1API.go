@@ -0,0 +1,46 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// Media1API is the interface of Media1, implemented by
+// FakeMedia1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for Media1.
+type Media1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*Media1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*Media1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	RegisterEndpoint(endpoint dbus.ObjectPath, properties map[string]interface{}) error
+	RegisterEndpointContext(ctx context.Context, endpoint dbus.ObjectPath, properties map[string]interface{}) error
+	UnregisterEndpoint(endpoint dbus.ObjectPath) error
+	UnregisterEndpointContext(ctx context.Context, endpoint dbus.ObjectPath) error
+	RegisterPlayer(player dbus.ObjectPath, properties map[string]interface{}) error
+	RegisterPlayerContext(ctx context.Context, player dbus.ObjectPath, properties map[string]interface{}) error
+	UnregisterPlayer(player dbus.ObjectPath) error
+	UnregisterPlayerContext(ctx context.Context, player dbus.ObjectPath) error
+	RegisterApplication(root dbus.ObjectPath, options map[string]interface{}) error
+	RegisterApplicationContext(ctx context.Context, root dbus.ObjectPath, options map[string]interface{}) error
+	UnregisterApplication(application dbus.ObjectPath) error
+	UnregisterApplicationContext(ctx context.Context, application dbus.ObjectPath) error
+}
+
+var _ Media1API = (*Media1)(nil)
@@ -5,6 +5,7 @@ package media
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -21,6 +22,14 @@ var MediaControl1Interface = "org.bluez.MediaControl1"
 // Args:
 // - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX
 func NewMediaControl1(objectPath dbus.ObjectPath) (*MediaControl1, error) {
+	return NewMediaControl1WithConn(nil, objectPath)
+}
+
+// NewMediaControl1WithConn create a new instance of MediaControl1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX
+func NewMediaControl1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*MediaControl1, error) {
 	a := new(MediaControl1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -28,6 +37,7 @@ func NewMediaControl1(objectPath dbus.ObjectPath) (*MediaControl1, error) {
 			Iface: MediaControl1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -44,6 +54,12 @@ func NewMediaControl1(objectPath dbus.ObjectPath) (*MediaControl1, error) {
 // NewMediaControl1FromAdapterID create a new instance of MediaControl1
 // adapterID: ID of an adapter eg. hci0
 func NewMediaControl1FromAdapterID(adapterID string) (*MediaControl1, error) {
+	return NewMediaControl1FromAdapterIDWithConn(nil, adapterID)
+}
+
+// NewMediaControl1FromAdapterIDWithConn create a new instance of MediaControl1 on a connection, nil for the shared system bus connection
+// adapterID: ID of an adapter eg. hci0
+func NewMediaControl1FromAdapterIDWithConn(conn *bluez.Conn, adapterID string) (*MediaControl1, error) {
 	a := new(MediaControl1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -51,6 +67,7 @@ func NewMediaControl1FromAdapterID(adapterID string) (*MediaControl1, error) {
 			Iface: MediaControl1Interface,
 			Path:  dbus.ObjectPath(fmt.Sprintf("/org/bluez/%s", adapterID)),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -71,8 +88,7 @@ MediaControl1 Media Control hierarchy
 */
 type MediaControl1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*MediaControl1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -109,14 +125,24 @@ func (p *MediaControl1Properties) Unlock() {
 
 // SetConnected set Connected value
 func (a *MediaControl1) SetConnected(v bool) error {
-	return a.SetProperty("Connected", v)
+	return a.SetConnectedContext(context.Background(), v)
+}
+
+// SetConnectedContext set Connected value, failing with a bluez.ContextError if ctx is done
+func (a *MediaControl1) SetConnectedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Connected", v)
 }
 
 
 
 // GetConnected get Connected value
 func (a *MediaControl1) GetConnected() (bool, error) {
-	v, err := a.GetProperty("Connected")
+	return a.GetConnectedContext(context.Background())
+}
+
+// GetConnectedContext get Connected value, failing with a bluez.ContextError if ctx is done
+func (a *MediaControl1) GetConnectedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Connected")
 	if err != nil {
 		return false, err
 	}
@@ -128,14 +154,24 @@ func (a *MediaControl1) GetConnected() (bool, error) {
 
 // SetPlayer set Player value
 func (a *MediaControl1) SetPlayer(v dbus.ObjectPath) error {
-	return a.SetProperty("Player", v)
+	return a.SetPlayerContext(context.Background(), v)
+}
+
+// SetPlayerContext set Player value, failing with a bluez.ContextError if ctx is done
+func (a *MediaControl1) SetPlayerContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Player", v)
 }
 
 
 
 // GetPlayer get Player value
 func (a *MediaControl1) GetPlayer() (dbus.ObjectPath, error) {
-	v, err := a.GetProperty("Player")
+	return a.GetPlayerContext(context.Background())
+}
+
+// GetPlayerContext get Player value, failing with a bluez.ContextError if ctx is done
+func (a *MediaControl1) GetPlayerContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Player")
 	if err != nil {
 		return dbus.ObjectPath(""), err
 	}
@@ -167,35 +203,24 @@ func (a *MediaControl1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *MediaControl1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *MediaControl1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -225,20 +250,31 @@ func (a *MediaControl1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *MediaControl1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *MediaControl1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *MediaControl1) GetProperties() (*MediaControl1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *MediaControl1) GetPropertiesContext(ctx context.Context) (*MediaControl1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -248,29 +284,40 @@ func (a *MediaControl1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *MediaControl1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *MediaControl1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *MediaControl1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *MediaControl1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *MediaControl1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *MediaControl1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -280,6 +327,7 @@ func (a *MediaControl1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *MediaControl1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -292,8 +340,13 @@ Play 			Resume playback.
 
 */
 func (a *MediaControl1) Play() error {
+	return a.PlayContext(context.Background())
+}
+
+// PlayContext call Play, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaControl1) PlayContext(ctx context.Context) error {
 	
-	return a.client.Call("Play", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Play", 0, ).Store()
 	
 }
 
@@ -302,8 +355,13 @@ Pause 			Pause playback.
 
 */
 func (a *MediaControl1) Pause() error {
+	return a.PauseContext(context.Background())
+}
+
+// PauseContext call Pause, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaControl1) PauseContext(ctx context.Context) error {
 	
-	return a.client.Call("Pause", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Pause", 0, ).Store()
 	
 }
 
@@ -312,8 +370,13 @@ Stop 			Stop playback.
 
 */
 func (a *MediaControl1) Stop() error {
+	return a.StopContext(context.Background())
+}
+
+// StopContext call Stop, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaControl1) StopContext(ctx context.Context) error {
 	
-	return a.client.Call("Stop", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Stop", 0, ).Store()
 	
 }
 
@@ -322,8 +385,13 @@ Next 			Next item.
 
 */
 func (a *MediaControl1) Next() error {
+	return a.NextContext(context.Background())
+}
+
+// NextContext call Next, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaControl1) NextContext(ctx context.Context) error {
 	
-	return a.client.Call("Next", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Next", 0, ).Store()
 	
 }
 
@@ -332,8 +400,13 @@ Previous 			Previous item.
 
 */
 func (a *MediaControl1) Previous() error {
+	return a.PreviousContext(context.Background())
+}
+
+// PreviousContext call Previous, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaControl1) PreviousContext(ctx context.Context) error {
 	
-	return a.client.Call("Previous", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Previous", 0, ).Store()
 	
 }
 
@@ -342,8 +415,13 @@ VolumeUp 			Adjust remote volume one step up
 
 */
 func (a *MediaControl1) VolumeUp() error {
+	return a.VolumeUpContext(context.Background())
+}
+
+// VolumeUpContext call VolumeUp, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaControl1) VolumeUpContext(ctx context.Context) error {
 	
-	return a.client.Call("VolumeUp", 0, ).Store()
+	return a.client.CallWithContext(ctx, "VolumeUp", 0, ).Store()
 	
 }
 
@@ -352,8 +430,13 @@ VolumeDown 			Adjust remote volume one step down
 
 */
 func (a *MediaControl1) VolumeDown() error {
+	return a.VolumeDownContext(context.Background())
+}
+
+// VolumeDownContext call VolumeDown, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaControl1) VolumeDownContext(ctx context.Context) error {
 	
-	return a.client.Call("VolumeDown", 0, ).Store()
+	return a.client.CallWithContext(ctx, "VolumeDown", 0, ).Store()
 	
 }
 
@@ -363,8 +446,13 @@ FastForward 			Fast forward playback, this action is only stopped
 
 */
 func (a *MediaControl1) FastForward() error {
+	return a.FastForwardContext(context.Background())
+}
+
+// FastForwardContext call FastForward, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaControl1) FastForwardContext(ctx context.Context) error {
 	
-	return a.client.Call("FastForward", 0, ).Store()
+	return a.client.CallWithContext(ctx, "FastForward", 0, ).Store()
 	
 }
 
@@ -374,8 +462,13 @@ Rewind 			Rewind playback, this action is only stopped
 
 */
 func (a *MediaControl1) Rewind() error {
+	return a.RewindContext(context.Background())
+}
+
+// RewindContext call Rewind, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaControl1) RewindContext(ctx context.Context) error {
 	
-	return a.client.Call("Rewind", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Rewind", 0, ).Store()
 	
 }
 
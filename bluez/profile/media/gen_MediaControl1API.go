@@ -0,0 +1,62 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// MediaControl1API is the interface of MediaControl1, implemented by
+// FakeMediaControl1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for MediaControl1.
+type MediaControl1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*MediaControl1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*MediaControl1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	SetConnected(v bool) error
+	SetConnectedContext(ctx context.Context, v bool) error
+	GetConnected() (bool, error)
+	GetConnectedContext(ctx context.Context) (bool, error)
+
+	SetPlayer(v dbus.ObjectPath) error
+	SetPlayerContext(ctx context.Context, v dbus.ObjectPath) error
+	GetPlayer() (dbus.ObjectPath, error)
+	GetPlayerContext(ctx context.Context) (dbus.ObjectPath, error)
+
+	Play() error
+	PlayContext(ctx context.Context) error
+	Pause() error
+	PauseContext(ctx context.Context) error
+	Stop() error
+	StopContext(ctx context.Context) error
+	Next() error
+	NextContext(ctx context.Context) error
+	Previous() error
+	PreviousContext(ctx context.Context) error
+	VolumeUp() error
+	VolumeUpContext(ctx context.Context) error
+	VolumeDown() error
+	VolumeDownContext(ctx context.Context) error
+	FastForward() error
+	FastForwardContext(ctx context.Context) error
+	Rewind() error
+	RewindContext(ctx context.Context) error
+}
+
+var _ MediaControl1API = (*MediaControl1)(nil)
@@ -5,6 +5,7 @@ package media
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var MediaEndpoint1Interface = "org.bluez.MediaEndpoint1"
 // Args:
 // - servicePath: unique name
 func NewMediaEndpoint1Server(servicePath string, objectPath dbus.ObjectPath) (*MediaEndpoint1, error) {
+	return NewMediaEndpoint1ServerWithConn(nil, servicePath, objectPath)
+}
+
+// NewMediaEndpoint1ServerWithConn create a new instance of MediaEndpoint1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - servicePath: unique name
+func NewMediaEndpoint1ServerWithConn(conn *bluez.Conn, servicePath string, objectPath dbus.ObjectPath) (*MediaEndpoint1, error) {
 	a := new(MediaEndpoint1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewMediaEndpoint1Server(servicePath string, objectPath dbus.ObjectPath) (*M
 			Iface: MediaEndpoint1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -45,6 +55,14 @@ func NewMediaEndpoint1Server(servicePath string, objectPath dbus.ObjectPath) (*M
 // Args:
 
 func NewMediaEndpoint1Client(objectPath dbus.ObjectPath) (*MediaEndpoint1, error) {
+	return NewMediaEndpoint1ClientWithConn(nil, objectPath)
+}
+
+// NewMediaEndpoint1ClientWithConn create a new instance of MediaEndpoint1 on a connection, nil for the shared system bus connection
+//
+// Args:
+
+func NewMediaEndpoint1ClientWithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*MediaEndpoint1, error) {
 	a := new(MediaEndpoint1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -52,6 +70,7 @@ func NewMediaEndpoint1Client(objectPath dbus.ObjectPath) (*MediaEndpoint1, error
 			Iface: MediaEndpoint1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -72,8 +91,7 @@ MediaEndpoint1 MediaEndpoint1 hierarchy
 */
 type MediaEndpoint1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*MediaEndpoint1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -85,6 +103,8 @@ type MediaEndpoint1Properties struct {
 
 	/*
 	UUID UUID of the profile which the endpoint is for.
+
+	Since BlueZ 5.53
 	*/
 	UUID string
 
@@ -92,17 +112,23 @@ type MediaEndpoint1Properties struct {
 	Codec Assigned number of codec that the endpoint implements.
 			The values should match the profile specification which
 			is indicated by the UUID.
+
+	Since BlueZ 5.53
 	*/
 	Codec byte
 
 	/*
 	Capabilities Capabilities blob, it is used as it is so the size and
 			byte order must match.
+
+	Since BlueZ 5.53
 	*/
 	Capabilities []byte
 
 	/*
 	Device Device object which the endpoint is belongs to.
+
+	Since BlueZ 5.53
 	*/
 	Device dbus.ObjectPath
 
@@ -123,14 +149,24 @@ func (p *MediaEndpoint1Properties) Unlock() {
 
 // SetUUID set UUID value
 func (a *MediaEndpoint1) SetUUID(v string) error {
-	return a.SetProperty("UUID", v)
+	return a.SetUUIDContext(context.Background(), v)
+}
+
+// SetUUIDContext set UUID value, failing with a bluez.ContextError if ctx is done
+func (a *MediaEndpoint1) SetUUIDContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "UUID", v)
 }
 
 
 
 // GetUUID get UUID value
 func (a *MediaEndpoint1) GetUUID() (string, error) {
-	v, err := a.GetProperty("UUID")
+	return a.GetUUIDContext(context.Background())
+}
+
+// GetUUIDContext get UUID value, failing with a bluez.ContextError if ctx is done
+func (a *MediaEndpoint1) GetUUIDContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "UUID")
 	if err != nil {
 		return "", err
 	}
@@ -142,14 +178,24 @@ func (a *MediaEndpoint1) GetUUID() (string, error) {
 
 // SetCodec set Codec value
 func (a *MediaEndpoint1) SetCodec(v byte) error {
-	return a.SetProperty("Codec", v)
+	return a.SetCodecContext(context.Background(), v)
+}
+
+// SetCodecContext set Codec value, failing with a bluez.ContextError if ctx is done
+func (a *MediaEndpoint1) SetCodecContext(ctx context.Context, v byte) error {
+	return a.SetPropertyContext(ctx, "Codec", v)
 }
 
 
 
 // GetCodec get Codec value
 func (a *MediaEndpoint1) GetCodec() (byte, error) {
-	v, err := a.GetProperty("Codec")
+	return a.GetCodecContext(context.Background())
+}
+
+// GetCodecContext get Codec value, failing with a bluez.ContextError if ctx is done
+func (a *MediaEndpoint1) GetCodecContext(ctx context.Context) (byte, error) {
+	v, err := a.GetPropertyContext(ctx, "Codec")
 	if err != nil {
 		return byte(0), err
 	}
@@ -161,14 +207,24 @@ func (a *MediaEndpoint1) GetCodec() (byte, error) {
 
 // SetCapabilities set Capabilities value
 func (a *MediaEndpoint1) SetCapabilities(v []byte) error {
-	return a.SetProperty("Capabilities", v)
+	return a.SetCapabilitiesContext(context.Background(), v)
+}
+
+// SetCapabilitiesContext set Capabilities value, failing with a bluez.ContextError if ctx is done
+func (a *MediaEndpoint1) SetCapabilitiesContext(ctx context.Context, v []byte) error {
+	return a.SetPropertyContext(ctx, "Capabilities", v)
 }
 
 
 
 // GetCapabilities get Capabilities value
 func (a *MediaEndpoint1) GetCapabilities() ([]byte, error) {
-	v, err := a.GetProperty("Capabilities")
+	return a.GetCapabilitiesContext(context.Background())
+}
+
+// GetCapabilitiesContext get Capabilities value, failing with a bluez.ContextError if ctx is done
+func (a *MediaEndpoint1) GetCapabilitiesContext(ctx context.Context) ([]byte, error) {
+	v, err := a.GetPropertyContext(ctx, "Capabilities")
 	if err != nil {
 		return []byte{}, err
 	}
@@ -180,14 +236,24 @@ func (a *MediaEndpoint1) GetCapabilities() ([]byte, error) {
 
 // SetDevice set Device value
 func (a *MediaEndpoint1) SetDevice(v dbus.ObjectPath) error {
-	return a.SetProperty("Device", v)
+	return a.SetDeviceContext(context.Background(), v)
+}
+
+// SetDeviceContext set Device value, failing with a bluez.ContextError if ctx is done
+func (a *MediaEndpoint1) SetDeviceContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Device", v)
 }
 
 
 
 // GetDevice get Device value
 func (a *MediaEndpoint1) GetDevice() (dbus.ObjectPath, error) {
-	v, err := a.GetProperty("Device")
+	return a.GetDeviceContext(context.Background())
+}
+
+// GetDeviceContext get Device value, failing with a bluez.ContextError if ctx is done
+func (a *MediaEndpoint1) GetDeviceContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Device")
 	if err != nil {
 		return dbus.ObjectPath(""), err
 	}
@@ -219,35 +285,24 @@ func (a *MediaEndpoint1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *MediaEndpoint1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *MediaEndpoint1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -277,20 +332,31 @@ func (a *MediaEndpoint1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *MediaEndpoint1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *MediaEndpoint1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *MediaEndpoint1) GetProperties() (*MediaEndpoint1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *MediaEndpoint1) GetPropertiesContext(ctx context.Context) (*MediaEndpoint1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -300,29 +366,40 @@ func (a *MediaEndpoint1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *MediaEndpoint1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *MediaEndpoint1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *MediaEndpoint1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *MediaEndpoint1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *MediaEndpoint1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *MediaEndpoint1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -332,6 +409,7 @@ func (a *MediaEndpoint1) WatchProperties() (chan *bluez.PropertyChanged, error)
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *MediaEndpoint1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -348,8 +426,13 @@ SetConfiguration 			Set configuration for the transport.
 
 */
 func (a *MediaEndpoint1) SetConfiguration(transport dbus.ObjectPath, properties map[string]interface{}) error {
+	return a.SetConfigurationContext(context.Background(), transport, properties)
+}
+
+// SetConfigurationContext call SetConfiguration, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaEndpoint1) SetConfigurationContext(ctx context.Context, transport dbus.ObjectPath, properties map[string]interface{}) error {
 	
-	return a.client.Call("SetConfiguration", 0, transport, properties).Store()
+	return a.client.CallWithContext(ctx, "SetConfiguration", 0, transport, properties).Store()
 	
 }
 
@@ -364,9 +447,14 @@ SelectConfiguration 			Select preferable configuration from the supported
 
 */
 func (a *MediaEndpoint1) SelectConfiguration(capabilities []byte) ([]byte, error) {
+	return a.SelectConfigurationContext(context.Background(), capabilities)
+}
+
+// SelectConfigurationContext call SelectConfiguration, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaEndpoint1) SelectConfigurationContext(ctx context.Context, capabilities []byte) ([]byte, error) {
 	
 	 val0 := []byte{}
-	err := a.client.Call("SelectConfiguration", 0, capabilities).Store(&val0)
+	err := a.client.CallWithContext(ctx, "SelectConfiguration", 0, capabilities).Store(&val0)
 	return val0, err	
 }
 
@@ -375,8 +463,13 @@ ClearConfiguration 			Clear transport configuration.
 
 */
 func (a *MediaEndpoint1) ClearConfiguration(transport dbus.ObjectPath) error {
+	return a.ClearConfigurationContext(context.Background(), transport)
+}
+
+// ClearConfigurationContext call ClearConfiguration, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaEndpoint1) ClearConfigurationContext(ctx context.Context, transport dbus.ObjectPath) error {
 	
-	return a.client.Call("ClearConfiguration", 0, transport).Store()
+	return a.client.CallWithContext(ctx, "ClearConfiguration", 0, transport).Store()
 	
 }
 
@@ -389,8 +482,13 @@ Release 			This method gets called when the service daemon
 
 */
 func (a *MediaEndpoint1) Release() error {
+	return a.ReleaseContext(context.Background())
+}
+
+// ReleaseContext call Release, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaEndpoint1) ReleaseContext(ctx context.Context) error {
 	
-	return a.client.Call("Release", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Release", 0, ).Store()
 	
 }
 
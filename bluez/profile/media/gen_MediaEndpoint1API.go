@@ -0,0 +1,62 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// MediaEndpoint1API is the interface of MediaEndpoint1, implemented by
+// FakeMediaEndpoint1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for MediaEndpoint1.
+type MediaEndpoint1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*MediaEndpoint1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*MediaEndpoint1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	SetUUID(v string) error
+	SetUUIDContext(ctx context.Context, v string) error
+	GetUUID() (string, error)
+	GetUUIDContext(ctx context.Context) (string, error)
+
+	SetCodec(v byte) error
+	SetCodecContext(ctx context.Context, v byte) error
+	GetCodec() (byte, error)
+	GetCodecContext(ctx context.Context) (byte, error)
+
+	SetCapabilities(v []byte) error
+	SetCapabilitiesContext(ctx context.Context, v []byte) error
+	GetCapabilities() ([]byte, error)
+	GetCapabilitiesContext(ctx context.Context) ([]byte, error)
+
+	SetDevice(v dbus.ObjectPath) error
+	SetDeviceContext(ctx context.Context, v dbus.ObjectPath) error
+	GetDevice() (dbus.ObjectPath, error)
+	GetDeviceContext(ctx context.Context) (dbus.ObjectPath, error)
+
+	SetConfiguration(transport dbus.ObjectPath, properties map[string]interface{}) error
+	SetConfigurationContext(ctx context.Context, transport dbus.ObjectPath, properties map[string]interface{}) error
+	SelectConfiguration(capabilities []byte) ([]byte, error)
+	SelectConfigurationContext(ctx context.Context, capabilities []byte) ([]byte, error)
+	ClearConfiguration(transport dbus.ObjectPath) error
+	ClearConfigurationContext(ctx context.Context, transport dbus.ObjectPath) error
+	Release() error
+	ReleaseContext(ctx context.Context) error
+}
+
+var _ MediaEndpoint1API = (*MediaEndpoint1)(nil)
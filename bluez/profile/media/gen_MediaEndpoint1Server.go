@@ -0,0 +1,193 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+
+import (
+   "github.com/godbus/dbus/v5"
+   "github.com/godbus/dbus/v5/introspect"
+   "github.com/godbus/dbus/v5/prop"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/bluez/profile"
+)
+
+// MediaEndpoint1Handler is implemented by the application to serve
+// org.bluez.MediaEndpoint1, see MediaEndpoint1 for the documentation of the
+// methods. Embed UnimplementedMediaEndpoint1Handler to implement only some of them.
+type MediaEndpoint1Handler interface {
+	SetConfiguration(transport dbus.ObjectPath, properties map[string]interface{}) *dbus.Error
+	SelectConfiguration(capabilities []byte) ([]byte, *dbus.Error)
+	ClearConfiguration(transport dbus.ObjectPath) *dbus.Error
+	Release() *dbus.Error
+}
+
+// UnimplementedMediaEndpoint1Handler reply org.bluez.Error.NotImplemented to
+// every method of MediaEndpoint1Handler
+type UnimplementedMediaEndpoint1Handler struct{}
+
+// SetConfiguration reply org.bluez.Error.NotImplemented
+func (UnimplementedMediaEndpoint1Handler) SetConfiguration(transport dbus.ObjectPath, properties map[string]interface{}) *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+// SelectConfiguration reply org.bluez.Error.NotImplemented
+func (UnimplementedMediaEndpoint1Handler) SelectConfiguration(capabilities []byte) ([]byte, *dbus.Error) {
+	
+	 val0 := []byte{}
+	return val0, &profile.ErrNotImplemented	
+}
+
+// ClearConfiguration reply org.bluez.Error.NotImplemented
+func (UnimplementedMediaEndpoint1Handler) ClearConfiguration(transport dbus.ObjectPath) *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+// Release reply org.bluez.Error.NotImplemented
+func (UnimplementedMediaEndpoint1Handler) Release() *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+var _ MediaEndpoint1Handler = UnimplementedMediaEndpoint1Handler{}
+
+// MediaEndpoint1Server export a MediaEndpoint1Handler on a connection,
+// forwarding the calls of bluez to it
+type MediaEndpoint1Server struct {
+	conn       *dbus.Conn
+	path       dbus.ObjectPath
+	handler    MediaEndpoint1Handler
+	properties *prop.Properties
+}
+
+// ExportMediaEndpoint1 export handler as org.bluez.MediaEndpoint1 at path, with
+// its introspection data and the Properties interface serving properties, nil
+// for their zero values. The Introspectable interface of path only describes
+// org.bluez.MediaEndpoint1, use Introspection to describe objects exporting more interfaces.
+func ExportMediaEndpoint1(conn *dbus.Conn, path dbus.ObjectPath, handler MediaEndpoint1Handler, properties *MediaEndpoint1Properties) (*MediaEndpoint1Server, error) {
+
+	s := &MediaEndpoint1Server{
+		conn:    conn,
+		path:    path,
+		handler: handler,
+	}
+
+	err := conn.Export(s, path, MediaEndpoint1Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	if properties == nil {
+		properties = new(MediaEndpoint1Properties)
+	}
+	propsConfig := make(map[string]*prop.Prop)
+	for name, info := range props.ParseProperties(properties) {
+		if info.Skip {
+			continue
+		}
+		p := info.Prop
+		propsConfig[name] = &p
+	}
+	s.properties = prop.New(conn, path, map[string]map[string]*prop.Prop{
+		MediaEndpoint1Interface: propsConfig,
+	})
+
+	node := &introspect.Node{
+		Interfaces: []introspect.Interface{
+			introspect.IntrospectData,
+			prop.IntrospectData,
+			s.Introspection(),
+		},
+	}
+	err = conn.Export(introspect.NewIntrospectable(node), path, bluez.Introspectable)
+	if err != nil {
+		s.Unexport()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Path return the object path of the server
+func (s *MediaEndpoint1Server) Path() dbus.ObjectPath {
+	return s.path
+}
+
+// Interface return the exported interface
+func (s *MediaEndpoint1Server) Interface() string {
+	return MediaEndpoint1Interface
+}
+
+// Handler return the handler serving the calls
+func (s *MediaEndpoint1Server) Handler() MediaEndpoint1Handler {
+	return s.handler
+}
+
+// Introspection return the introspection data of org.bluez.MediaEndpoint1
+func (s *MediaEndpoint1Server) Introspection() introspect.Interface {
+	return introspect.Interface{
+		Name:       MediaEndpoint1Interface,
+		Methods:    introspect.Methods(s),
+		Properties: s.properties.Introspection(MediaEndpoint1Interface),
+	}
+}
+
+// GetProperty return the value of a served property
+func (s *MediaEndpoint1Server) GetProperty(name string) (dbus.Variant, error) {
+	v, err := s.properties.Get(MediaEndpoint1Interface, name)
+	if err != nil {
+		return dbus.Variant{}, err
+	}
+	return v, nil
+}
+
+// SetProperty update a served property, PropertiesChanged is emitted as set
+// by its dbus tag
+func (s *MediaEndpoint1Server) SetProperty(name string, value interface{}) error {
+	_, err := s.properties.Get(MediaEndpoint1Interface, name)
+	if err != nil {
+		return err
+	}
+	s.properties.SetMust(MediaEndpoint1Interface, name, value)
+	return nil
+}
+
+// Unexport remove the object from the connection
+func (s *MediaEndpoint1Server) Unexport() error {
+	err := s.conn.Export(nil, s.path, MediaEndpoint1Interface)
+	if err != nil {
+		return err
+	}
+	err = s.conn.Export(nil, s.path, bluez.PropertiesInterface)
+	if err != nil {
+		return err
+	}
+	return s.conn.Export(nil, s.path, bluez.Introspectable)
+}
+
+// SetConfiguration forward the call to the handler
+func (s *MediaEndpoint1Server) SetConfiguration(transport dbus.ObjectPath, properties map[string]interface{}) *dbus.Error {
+	return s.handler.SetConfiguration(transport, properties)
+}
+
+// SelectConfiguration forward the call to the handler
+func (s *MediaEndpoint1Server) SelectConfiguration(capabilities []byte) ([]byte, *dbus.Error) {
+	return s.handler.SelectConfiguration(capabilities)
+}
+
+// ClearConfiguration forward the call to the handler
+func (s *MediaEndpoint1Server) ClearConfiguration(transport dbus.ObjectPath) *dbus.Error {
+	return s.handler.ClearConfiguration(transport)
+}
+
+// Release forward the call to the handler
+func (s *MediaEndpoint1Server) Release() *dbus.Error {
+	return s.handler.Release()
+}
+
@@ -5,6 +5,7 @@ package media
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -21,6 +22,15 @@ var MediaFolder1Interface = "org.bluez.MediaFolder1"
 // - servicePath: unique name
 // - objectPath: freely definable
 func NewMediaFolder1(servicePath string, objectPath dbus.ObjectPath) (*MediaFolder1, error) {
+	return NewMediaFolder1WithConn(nil, servicePath, objectPath)
+}
+
+// NewMediaFolder1WithConn create a new instance of MediaFolder1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - servicePath: unique name
+// - objectPath: freely definable
+func NewMediaFolder1WithConn(conn *bluez.Conn, servicePath string, objectPath dbus.ObjectPath) (*MediaFolder1, error) {
 	a := new(MediaFolder1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -28,6 +38,7 @@ func NewMediaFolder1(servicePath string, objectPath dbus.ObjectPath) (*MediaFold
 			Iface: MediaFolder1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -46,6 +57,14 @@ func NewMediaFolder1(servicePath string, objectPath dbus.ObjectPath) (*MediaFold
 // Args:
 // - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX/playerX
 func NewMediaFolder1Controller(objectPath dbus.ObjectPath) (*MediaFolder1, error) {
+	return NewMediaFolder1ControllerWithConn(nil, objectPath)
+}
+
+// NewMediaFolder1ControllerWithConn create a new instance of MediaFolder1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX/playerX
+func NewMediaFolder1ControllerWithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*MediaFolder1, error) {
 	a := new(MediaFolder1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -53,6 +72,7 @@ func NewMediaFolder1Controller(objectPath dbus.ObjectPath) (*MediaFolder1, error
 			Iface: MediaFolder1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -73,8 +93,7 @@ MediaFolder1 MediaFolder1 hierarchy
 */
 type MediaFolder1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*MediaFolder1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -148,14 +167,24 @@ func (p *MediaFolder1Properties) Unlock() {
 
 // SetNumberOfItems set NumberOfItems value
 func (a *MediaFolder1) SetNumberOfItems(v uint32) error {
-	return a.SetProperty("NumberOfItems", v)
+	return a.SetNumberOfItemsContext(context.Background(), v)
+}
+
+// SetNumberOfItemsContext set NumberOfItems value, failing with a bluez.ContextError if ctx is done
+func (a *MediaFolder1) SetNumberOfItemsContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "NumberOfItems", v)
 }
 
 
 
 // GetNumberOfItems get NumberOfItems value
 func (a *MediaFolder1) GetNumberOfItems() (uint32, error) {
-	v, err := a.GetProperty("NumberOfItems")
+	return a.GetNumberOfItemsContext(context.Background())
+}
+
+// GetNumberOfItemsContext get NumberOfItems value, failing with a bluez.ContextError if ctx is done
+func (a *MediaFolder1) GetNumberOfItemsContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "NumberOfItems")
 	if err != nil {
 		return uint32(0), err
 	}
@@ -167,14 +196,24 @@ func (a *MediaFolder1) GetNumberOfItems() (uint32, error) {
 
 // SetName set Name value
 func (a *MediaFolder1) SetName(v string) error {
-	return a.SetProperty("Name", v)
+	return a.SetNameContext(context.Background(), v)
+}
+
+// SetNameContext set Name value, failing with a bluez.ContextError if ctx is done
+func (a *MediaFolder1) SetNameContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Name", v)
 }
 
 
 
 // GetName get Name value
 func (a *MediaFolder1) GetName() (string, error) {
-	v, err := a.GetProperty("Name")
+	return a.GetNameContext(context.Background())
+}
+
+// GetNameContext get Name value, failing with a bluez.ContextError if ctx is done
+func (a *MediaFolder1) GetNameContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Name")
 	if err != nil {
 		return "", err
 	}
@@ -186,14 +225,24 @@ func (a *MediaFolder1) GetName() (string, error) {
 
 // SetStart set Start value
 func (a *MediaFolder1) SetStart(v uint32) error {
-	return a.SetProperty("Start", v)
+	return a.SetStartContext(context.Background(), v)
+}
+
+// SetStartContext set Start value, failing with a bluez.ContextError if ctx is done
+func (a *MediaFolder1) SetStartContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "Start", v)
 }
 
 
 
 // GetStart get Start value
 func (a *MediaFolder1) GetStart() (uint32, error) {
-	v, err := a.GetProperty("Start")
+	return a.GetStartContext(context.Background())
+}
+
+// GetStartContext get Start value, failing with a bluez.ContextError if ctx is done
+func (a *MediaFolder1) GetStartContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "Start")
 	if err != nil {
 		return uint32(0), err
 	}
@@ -205,14 +254,24 @@ func (a *MediaFolder1) GetStart() (uint32, error) {
 
 // SetEnd set End value
 func (a *MediaFolder1) SetEnd(v uint32) error {
-	return a.SetProperty("End", v)
+	return a.SetEndContext(context.Background(), v)
+}
+
+// SetEndContext set End value, failing with a bluez.ContextError if ctx is done
+func (a *MediaFolder1) SetEndContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "End", v)
 }
 
 
 
 // GetEnd get End value
 func (a *MediaFolder1) GetEnd() (uint32, error) {
-	v, err := a.GetProperty("End")
+	return a.GetEndContext(context.Background())
+}
+
+// GetEndContext get End value, failing with a bluez.ContextError if ctx is done
+func (a *MediaFolder1) GetEndContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "End")
 	if err != nil {
 		return uint32(0), err
 	}
@@ -224,14 +283,29 @@ func (a *MediaFolder1) GetEnd() (uint32, error) {
 
 // SetAttributes set Attributes value
 func (a *MediaFolder1) SetAttributes(v []string) error {
-	return a.SetProperty("Attributes", v)
+	return a.SetAttributesContext(context.Background(), v)
+}
+
+// SetAttributesContext set Attributes value, failing with a bluez.ContextError if ctx is done
+func (a *MediaFolder1) SetAttributesContext(ctx context.Context, v []string) error {
+	for _, value := range v {
+		if !MediaFolder1Attributes(value).Valid() {
+			return &bluez.InvalidValueError{Interface: MediaFolder1Interface, Member: "Attributes", Path: a.Path(), Value: value}
+		}
+	}
+	return a.SetPropertyContext(ctx, "Attributes", v)
 }
 
 
 
 // GetAttributes get Attributes value
 func (a *MediaFolder1) GetAttributes() ([]string, error) {
-	v, err := a.GetProperty("Attributes")
+	return a.GetAttributesContext(context.Background())
+}
+
+// GetAttributesContext get Attributes value, failing with a bluez.ContextError if ctx is done
+func (a *MediaFolder1) GetAttributesContext(ctx context.Context) ([]string, error) {
+	v, err := a.GetPropertyContext(ctx, "Attributes")
 	if err != nil {
 		return []string{}, err
 	}
@@ -263,35 +337,24 @@ func (a *MediaFolder1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *MediaFolder1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *MediaFolder1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
-
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -321,20 +384,31 @@ func (a *MediaFolder1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *MediaFolder1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *MediaFolder1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *MediaFolder1) GetProperties() (*MediaFolder1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *MediaFolder1) GetPropertiesContext(ctx context.Context) (*MediaFolder1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -344,29 +418,40 @@ func (a *MediaFolder1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *MediaFolder1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *MediaFolder1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *MediaFolder1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *MediaFolder1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *MediaFolder1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *MediaFolder1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -376,6 +461,7 @@ func (a *MediaFolder1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *MediaFolder1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -392,9 +478,14 @@ Search 			Return a folder object containing the search result.
 
 */
 func (a *MediaFolder1) Search(value string, filter map[string]interface{}) (dbus.ObjectPath, error) {
+	return a.SearchContext(context.Background(), value, filter)
+}
+
+// SearchContext call Search, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaFolder1) SearchContext(ctx context.Context, value string, filter map[string]interface{}) (dbus.ObjectPath, error) {
 	
 	var val0 dbus.ObjectPath
-	err := a.client.Call("Search", 0, value, filter).Store(&val0)
+	err := a.client.CallWithContext(ctx, "Search", 0, value, filter).Store(&val0)
 	return val0, err	
 }
 
@@ -406,9 +497,14 @@ ListItems 			Return a list of items found
 
 */
 func (a *MediaFolder1) ListItems(filter map[string]interface{}) ([]Item, error) {
+	return a.ListItemsContext(context.Background(), filter)
+}
+
+// ListItemsContext call ListItems, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaFolder1) ListItemsContext(ctx context.Context, filter map[string]interface{}) ([]Item, error) {
 	
 	 val0 := []Item{}
-	err := a.client.Call("ListItems", 0, filter).Store(&val0)
+	err := a.client.CallWithContext(ctx, "ListItems", 0, filter).Store(&val0)
 	return val0, err	
 }
 
@@ -424,8 +520,13 @@ ChangeFolder 			Change current folder.
 
 */
 func (a *MediaFolder1) ChangeFolder(folder dbus.ObjectPath) error {
+	return a.ChangeFolderContext(context.Background(), folder)
+}
+
+// ChangeFolderContext call ChangeFolder, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaFolder1) ChangeFolderContext(ctx context.Context, folder dbus.ObjectPath) error {
 	
-	return a.client.Call("ChangeFolder", 0, folder).Store()
+	return a.client.CallWithContext(ctx, "ChangeFolder", 0, folder).Store()
 	
 }
 
@@ -0,0 +1,65 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// MediaFolder1API is the interface of MediaFolder1, implemented by
+// FakeMediaFolder1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for MediaFolder1.
+type MediaFolder1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*MediaFolder1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*MediaFolder1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	SetNumberOfItems(v uint32) error
+	SetNumberOfItemsContext(ctx context.Context, v uint32) error
+	GetNumberOfItems() (uint32, error)
+	GetNumberOfItemsContext(ctx context.Context) (uint32, error)
+
+	SetName(v string) error
+	SetNameContext(ctx context.Context, v string) error
+	GetName() (string, error)
+	GetNameContext(ctx context.Context) (string, error)
+
+	SetStart(v uint32) error
+	SetStartContext(ctx context.Context, v uint32) error
+	GetStart() (uint32, error)
+	GetStartContext(ctx context.Context) (uint32, error)
+
+	SetEnd(v uint32) error
+	SetEndContext(ctx context.Context, v uint32) error
+	GetEnd() (uint32, error)
+	GetEndContext(ctx context.Context) (uint32, error)
+
+	SetAttributes(v []string) error
+	SetAttributesContext(ctx context.Context, v []string) error
+	GetAttributes() ([]string, error)
+	GetAttributesContext(ctx context.Context) ([]string, error)
+
+	Search(value string, filter map[string]interface{}) (dbus.ObjectPath, error)
+	SearchContext(ctx context.Context, value string, filter map[string]interface{}) (dbus.ObjectPath, error)
+	ListItems(filter map[string]interface{}) ([]Item, error)
+	ListItemsContext(ctx context.Context, filter map[string]interface{}) ([]Item, error)
+	ChangeFolder(folder dbus.ObjectPath) error
+	ChangeFolderContext(ctx context.Context, folder dbus.ObjectPath) error
+}
+
+var _ MediaFolder1API = (*MediaFolder1)(nil)
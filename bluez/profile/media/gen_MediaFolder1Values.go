@@ -0,0 +1,39 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+// MediaFolder1Attributes is a value documented for MediaFolder1.Attributes
+type MediaFolder1Attributes string
+
+const (
+	MediaFolder1AttributesTitle MediaFolder1Attributes = "title"
+	MediaFolder1AttributesArtist MediaFolder1Attributes = "artist"
+	MediaFolder1AttributesAlbum MediaFolder1Attributes = "album"
+	MediaFolder1AttributesGenre MediaFolder1Attributes = "genre"
+	MediaFolder1AttributesNumberOfTracks MediaFolder1Attributes = "number-of-tracks"
+	MediaFolder1AttributesNumber MediaFolder1Attributes = "number"
+	MediaFolder1AttributesDuration MediaFolder1Attributes = "duration"
+)
+
+// MediaFolder1AttributesValues list the documented values of MediaFolder1Attributes
+var MediaFolder1AttributesValues = []MediaFolder1Attributes{
+	MediaFolder1AttributesTitle,
+	MediaFolder1AttributesArtist,
+	MediaFolder1AttributesAlbum,
+	MediaFolder1AttributesGenre,
+	MediaFolder1AttributesNumberOfTracks,
+	MediaFolder1AttributesNumber,
+	MediaFolder1AttributesDuration,
+}
+
+// Valid report if v is one of the documented values
+func (v MediaFolder1Attributes) Valid() bool {
+	for _, value := range MediaFolder1AttributesValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
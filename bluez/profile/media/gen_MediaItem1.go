@@ -5,6 +5,7 @@ package media
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -21,6 +22,15 @@ var MediaItem1Interface = "org.bluez.MediaItem1"
 // - servicePath: unique name
 // - objectPath: freely definable
 func NewMediaItem1(servicePath string, objectPath dbus.ObjectPath) (*MediaItem1, error) {
+	return NewMediaItem1WithConn(nil, servicePath, objectPath)
+}
+
+// NewMediaItem1WithConn create a new instance of MediaItem1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - servicePath: unique name
+// - objectPath: freely definable
+func NewMediaItem1WithConn(conn *bluez.Conn, servicePath string, objectPath dbus.ObjectPath) (*MediaItem1, error) {
 	a := new(MediaItem1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -28,6 +38,7 @@ func NewMediaItem1(servicePath string, objectPath dbus.ObjectPath) (*MediaItem1,
 			Iface: MediaItem1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -46,6 +57,14 @@ func NewMediaItem1(servicePath string, objectPath dbus.ObjectPath) (*MediaItem1,
 // Args:
 // - objectPath: [variable	prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX/playerX/itemX
 func NewMediaItem1Controller(objectPath dbus.ObjectPath) (*MediaItem1, error) {
+	return NewMediaItem1ControllerWithConn(nil, objectPath)
+}
+
+// NewMediaItem1ControllerWithConn create a new instance of MediaItem1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [variable	prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX/playerX/itemX
+func NewMediaItem1ControllerWithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*MediaItem1, error) {
 	a := new(MediaItem1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -53,6 +72,7 @@ func NewMediaItem1Controller(objectPath dbus.ObjectPath) (*MediaItem1, error) {
 			Iface: MediaItem1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -73,8 +93,7 @@ MediaItem1 MediaItem1 hierarchy
 */
 type MediaItem1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*MediaItem1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -101,13 +120,6 @@ type MediaItem1Properties struct {
 	*/
 	Type string
 
-	/*
-	Playable Indicates if the item can be played
-
-			Available if property Type is "folder"
-	*/
-	Playable bool
-
 	/*
 	FolderType Folder type.
 
@@ -117,6 +129,13 @@ type MediaItem1Properties struct {
 	*/
 	FolderType string
 
+	/*
+	Playable Indicates if the item can be played
+
+			Available if property Type is "folder"
+	*/
+	Playable bool
+
 	/*
 	Metadata Item metadata.
 
@@ -197,14 +216,24 @@ func (p *MediaItem1Properties) Unlock() {
 
 // SetPlayer set Player value
 func (a *MediaItem1) SetPlayer(v dbus.ObjectPath) error {
-	return a.SetProperty("Player", v)
+	return a.SetPlayerContext(context.Background(), v)
+}
+
+// SetPlayerContext set Player value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) SetPlayerContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Player", v)
 }
 
 
 
 // GetPlayer get Player value
 func (a *MediaItem1) GetPlayer() (dbus.ObjectPath, error) {
-	v, err := a.GetProperty("Player")
+	return a.GetPlayerContext(context.Background())
+}
+
+// GetPlayerContext get Player value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) GetPlayerContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Player")
 	if err != nil {
 		return dbus.ObjectPath(""), err
 	}
@@ -216,14 +245,24 @@ func (a *MediaItem1) GetPlayer() (dbus.ObjectPath, error) {
 
 // SetName set Name value
 func (a *MediaItem1) SetName(v string) error {
-	return a.SetProperty("Name", v)
+	return a.SetNameContext(context.Background(), v)
+}
+
+// SetNameContext set Name value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) SetNameContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Name", v)
 }
 
 
 
 // GetName get Name value
 func (a *MediaItem1) GetName() (string, error) {
-	v, err := a.GetProperty("Name")
+	return a.GetNameContext(context.Background())
+}
+
+// GetNameContext get Name value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) GetNameContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Name")
 	if err != nil {
 		return "", err
 	}
@@ -235,14 +274,27 @@ func (a *MediaItem1) GetName() (string, error) {
 
 // SetType set Type value
 func (a *MediaItem1) SetType(v string) error {
-	return a.SetProperty("Type", v)
+	return a.SetTypeContext(context.Background(), v)
+}
+
+// SetTypeContext set Type value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) SetTypeContext(ctx context.Context, v string) error {
+	if !MediaItem1Type(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaItem1Interface, Member: "Type", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "Type", v)
 }
 
 
 
 // GetType get Type value
 func (a *MediaItem1) GetType() (string, error) {
-	v, err := a.GetProperty("Type")
+	return a.GetTypeContext(context.Background())
+}
+
+// GetTypeContext get Type value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) GetTypeContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Type")
 	if err != nil {
 		return "", err
 	}
@@ -252,39 +304,62 @@ func (a *MediaItem1) GetType() (string, error) {
 
 
 
-// SetPlayable set Playable value
-func (a *MediaItem1) SetPlayable(v bool) error {
-	return a.SetProperty("Playable", v)
+// SetFolderType set FolderType value
+func (a *MediaItem1) SetFolderType(v string) error {
+	return a.SetFolderTypeContext(context.Background(), v)
 }
 
+// SetFolderTypeContext set FolderType value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) SetFolderTypeContext(ctx context.Context, v string) error {
+	if !MediaItem1FolderType(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaItem1Interface, Member: "FolderType", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "FolderType", v)
+}
 
 
-// GetPlayable get Playable value
-func (a *MediaItem1) GetPlayable() (bool, error) {
-	v, err := a.GetProperty("Playable")
+
+// GetFolderType get FolderType value
+func (a *MediaItem1) GetFolderType() (string, error) {
+	return a.GetFolderTypeContext(context.Background())
+}
+
+// GetFolderTypeContext get FolderType value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) GetFolderTypeContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "FolderType")
 	if err != nil {
-		return false, err
+		return "", err
 	}
-	return v.Value().(bool), nil
+	return v.Value().(string), nil
 }
 
 
 
 
-// SetFolderType set FolderType value
-func (a *MediaItem1) SetFolderType(v string) error {
-	return a.SetProperty("FolderType", v)
+// SetPlayable set Playable value
+func (a *MediaItem1) SetPlayable(v bool) error {
+	return a.SetPlayableContext(context.Background(), v)
 }
 
+// SetPlayableContext set Playable value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) SetPlayableContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Playable", v)
+}
 
 
-// GetFolderType get FolderType value
-func (a *MediaItem1) GetFolderType() (string, error) {
-	v, err := a.GetProperty("FolderType")
+
+// GetPlayable get Playable value
+func (a *MediaItem1) GetPlayable() (bool, error) {
+	return a.GetPlayableContext(context.Background())
+}
+
+// GetPlayableContext get Playable value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) GetPlayableContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Playable")
 	if err != nil {
-		return "", err
+		return false, err
 	}
-	return v.Value().(string), nil
+	return v.Value().(bool), nil
 }
 
 
@@ -292,14 +367,24 @@ func (a *MediaItem1) GetFolderType() (string, error) {
 
 // SetMetadata set Metadata value
 func (a *MediaItem1) SetMetadata(v map[string]interface{}) error {
-	return a.SetProperty("Metadata", v)
+	return a.SetMetadataContext(context.Background(), v)
+}
+
+// SetMetadataContext set Metadata value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) SetMetadataContext(ctx context.Context, v map[string]interface{}) error {
+	return a.SetPropertyContext(ctx, "Metadata", v)
 }
 
 
 
 // GetMetadata get Metadata value
 func (a *MediaItem1) GetMetadata() (map[string]interface{}, error) {
-	v, err := a.GetProperty("Metadata")
+	return a.GetMetadataContext(context.Background())
+}
+
+// GetMetadataContext get Metadata value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) GetMetadataContext(ctx context.Context) (map[string]interface{}, error) {
+	v, err := a.GetPropertyContext(ctx, "Metadata")
 	if err != nil {
 		return map[string]interface{}{}, err
 	}
@@ -311,14 +396,24 @@ func (a *MediaItem1) GetMetadata() (map[string]interface{}, error) {
 
 // SetTitle set Title value
 func (a *MediaItem1) SetTitle(v string) error {
-	return a.SetProperty("Title", v)
+	return a.SetTitleContext(context.Background(), v)
+}
+
+// SetTitleContext set Title value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) SetTitleContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Title", v)
 }
 
 
 
 // GetTitle get Title value
 func (a *MediaItem1) GetTitle() (string, error) {
-	v, err := a.GetProperty("Title")
+	return a.GetTitleContext(context.Background())
+}
+
+// GetTitleContext get Title value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) GetTitleContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Title")
 	if err != nil {
 		return "", err
 	}
@@ -330,14 +425,24 @@ func (a *MediaItem1) GetTitle() (string, error) {
 
 // SetArtist set Artist value
 func (a *MediaItem1) SetArtist(v string) error {
-	return a.SetProperty("Artist", v)
+	return a.SetArtistContext(context.Background(), v)
+}
+
+// SetArtistContext set Artist value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) SetArtistContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Artist", v)
 }
 
 
 
 // GetArtist get Artist value
 func (a *MediaItem1) GetArtist() (string, error) {
-	v, err := a.GetProperty("Artist")
+	return a.GetArtistContext(context.Background())
+}
+
+// GetArtistContext get Artist value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) GetArtistContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Artist")
 	if err != nil {
 		return "", err
 	}
@@ -349,14 +454,24 @@ func (a *MediaItem1) GetArtist() (string, error) {
 
 // SetAlbum set Album value
 func (a *MediaItem1) SetAlbum(v string) error {
-	return a.SetProperty("Album", v)
+	return a.SetAlbumContext(context.Background(), v)
+}
+
+// SetAlbumContext set Album value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) SetAlbumContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Album", v)
 }
 
 
 
 // GetAlbum get Album value
 func (a *MediaItem1) GetAlbum() (string, error) {
-	v, err := a.GetProperty("Album")
+	return a.GetAlbumContext(context.Background())
+}
+
+// GetAlbumContext get Album value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) GetAlbumContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Album")
 	if err != nil {
 		return "", err
 	}
@@ -368,14 +483,24 @@ func (a *MediaItem1) GetAlbum() (string, error) {
 
 // SetGenre set Genre value
 func (a *MediaItem1) SetGenre(v string) error {
-	return a.SetProperty("Genre", v)
+	return a.SetGenreContext(context.Background(), v)
+}
+
+// SetGenreContext set Genre value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) SetGenreContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Genre", v)
 }
 
 
 
 // GetGenre get Genre value
 func (a *MediaItem1) GetGenre() (string, error) {
-	v, err := a.GetProperty("Genre")
+	return a.GetGenreContext(context.Background())
+}
+
+// GetGenreContext get Genre value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) GetGenreContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Genre")
 	if err != nil {
 		return "", err
 	}
@@ -387,14 +512,24 @@ func (a *MediaItem1) GetGenre() (string, error) {
 
 // SetNumberOfTracks set NumberOfTracks value
 func (a *MediaItem1) SetNumberOfTracks(v uint32) error {
-	return a.SetProperty("NumberOfTracks", v)
+	return a.SetNumberOfTracksContext(context.Background(), v)
+}
+
+// SetNumberOfTracksContext set NumberOfTracks value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) SetNumberOfTracksContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "NumberOfTracks", v)
 }
 
 
 
 // GetNumberOfTracks get NumberOfTracks value
 func (a *MediaItem1) GetNumberOfTracks() (uint32, error) {
-	v, err := a.GetProperty("NumberOfTracks")
+	return a.GetNumberOfTracksContext(context.Background())
+}
+
+// GetNumberOfTracksContext get NumberOfTracks value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) GetNumberOfTracksContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "NumberOfTracks")
 	if err != nil {
 		return uint32(0), err
 	}
@@ -406,14 +541,24 @@ func (a *MediaItem1) GetNumberOfTracks() (uint32, error) {
 
 // SetNumber set Number value
 func (a *MediaItem1) SetNumber(v uint32) error {
-	return a.SetProperty("Number", v)
+	return a.SetNumberContext(context.Background(), v)
+}
+
+// SetNumberContext set Number value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) SetNumberContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "Number", v)
 }
 
 
 
 // GetNumber get Number value
 func (a *MediaItem1) GetNumber() (uint32, error) {
-	v, err := a.GetProperty("Number")
+	return a.GetNumberContext(context.Background())
+}
+
+// GetNumberContext get Number value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) GetNumberContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "Number")
 	if err != nil {
 		return uint32(0), err
 	}
@@ -425,14 +570,24 @@ func (a *MediaItem1) GetNumber() (uint32, error) {
 
 // SetDuration set Duration value
 func (a *MediaItem1) SetDuration(v uint32) error {
-	return a.SetProperty("Duration", v)
+	return a.SetDurationContext(context.Background(), v)
+}
+
+// SetDurationContext set Duration value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) SetDurationContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "Duration", v)
 }
 
 
 
 // GetDuration get Duration value
 func (a *MediaItem1) GetDuration() (uint32, error) {
-	v, err := a.GetProperty("Duration")
+	return a.GetDurationContext(context.Background())
+}
+
+// GetDurationContext get Duration value, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) GetDurationContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "Duration")
 	if err != nil {
 		return uint32(0), err
 	}
@@ -464,35 +619,24 @@ func (a *MediaItem1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *MediaItem1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *MediaItem1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -522,20 +666,31 @@ func (a *MediaItem1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *MediaItem1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *MediaItem1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *MediaItem1) GetProperties() (*MediaItem1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) GetPropertiesContext(ctx context.Context) (*MediaItem1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -545,29 +700,40 @@ func (a *MediaItem1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *MediaItem1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *MediaItem1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *MediaItem1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *MediaItem1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *MediaItem1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -577,6 +743,7 @@ func (a *MediaItem1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *MediaItem1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -591,8 +758,13 @@ Play 			Play item
 
 */
 func (a *MediaItem1) Play() error {
+	return a.PlayContext(context.Background())
+}
+
+// PlayContext call Play, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaItem1) PlayContext(ctx context.Context) error {
 	
-	return a.client.Call("Play", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Play", 0, ).Store()
 	
 }
 
@@ -603,8 +775,13 @@ AddtoNowPlaying 			Add item to now playing list
 
 */
 func (a *MediaItem1) AddtoNowPlaying() error {
+	return a.AddtoNowPlayingContext(context.Background())
+}
+
+// AddtoNowPlayingContext call AddtoNowPlaying, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaItem1) AddtoNowPlayingContext(ctx context.Context) error {
 	
-	return a.client.Call("AddtoNowPlaying", 0, ).Store()
+	return a.client.CallWithContext(ctx, "AddtoNowPlaying", 0, ).Store()
 	
 }
 
@@ -0,0 +1,60 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+// MediaItem1Type is a value documented for MediaItem1.Type
+type MediaItem1Type string
+
+const (
+	MediaItem1TypeVideo MediaItem1Type = "video"
+	MediaItem1TypeAudio MediaItem1Type = "audio"
+	MediaItem1TypeFolder MediaItem1Type = "folder"
+)
+
+// MediaItem1TypeValues list the documented values of MediaItem1Type
+var MediaItem1TypeValues = []MediaItem1Type{
+	MediaItem1TypeVideo,
+	MediaItem1TypeAudio,
+	MediaItem1TypeFolder,
+}
+
+// Valid report if v is one of the documented values
+func (v MediaItem1Type) Valid() bool {
+	for _, value := range MediaItem1TypeValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+
+// MediaItem1FolderType is a value documented for MediaItem1.FolderType
+type MediaItem1FolderType string
+
+const (
+	MediaItem1FolderTypeMixed MediaItem1FolderType = "mixed"
+	MediaItem1FolderTypeTitles MediaItem1FolderType = "titles"
+	MediaItem1FolderTypeAlbums MediaItem1FolderType = "albums"
+	MediaItem1FolderTypeArtists MediaItem1FolderType = "artists"
+)
+
+// MediaItem1FolderTypeValues list the documented values of MediaItem1FolderType
+var MediaItem1FolderTypeValues = []MediaItem1FolderType{
+	MediaItem1FolderTypeMixed,
+	MediaItem1FolderTypeTitles,
+	MediaItem1FolderTypeAlbums,
+	MediaItem1FolderTypeArtists,
+}
+
+// Valid report if v is one of the documented values
+func (v MediaItem1FolderType) Valid() bool {
+	for _, value := range MediaItem1FolderTypeValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
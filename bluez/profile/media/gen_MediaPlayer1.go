@@ -5,6 +5,7 @@ package media
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var MediaPlayer1Interface = "org.bluez.MediaPlayer1"
 // Args:
 // - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX/playerX
 func NewMediaPlayer1(objectPath dbus.ObjectPath) (*MediaPlayer1, error) {
+	return NewMediaPlayer1WithConn(nil, objectPath)
+}
+
+// NewMediaPlayer1WithConn create a new instance of MediaPlayer1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX/playerX
+func NewMediaPlayer1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*MediaPlayer1, error) {
 	a := new(MediaPlayer1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewMediaPlayer1(objectPath dbus.ObjectPath) (*MediaPlayer1, error) {
 			Iface: MediaPlayer1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -47,8 +57,7 @@ MediaPlayer1 MediaPlayer1 hierarchy
 */
 type MediaPlayer1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*MediaPlayer1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -59,14 +68,32 @@ type MediaPlayer1Properties struct {
 	lock sync.RWMutex `dbus:"ignore"`
 
 	/*
-	Title Track title name
+	Equalizer Possible values: "off" or "on"
 	*/
-	Title string
+	Equalizer string
 
 	/*
-	Playlist Playlist object path.
+	Repeat Possible values: "off", "singletrack", "alltracks" or
+					"group"
 	*/
-	Playlist dbus.ObjectPath
+	Repeat string
+
+	/*
+	Shuffle Possible values: "off", "alltracks" or "group"
+	*/
+	Shuffle string
+
+	/*
+	Scan Possible values: "off", "alltracks" or "group"
+	*/
+	Scan string
+
+	/*
+	Status Possible status: "playing", "stopped", "paused",
+					"forward-seek", "reverse-seek"
+					or "error"
+	*/
+	Status string
 
 	/*
 	Position Playback position in milliseconds. Changing the
@@ -81,86 +108,51 @@ type MediaPlayer1Properties struct {
 	Position uint32
 
 	/*
-	TrackNumber Track number
-	*/
-	TrackNumber uint32
-
-	/*
-	Device Device object path.
-	*/
-	Device dbus.ObjectPath
-
-	/*
-	Subtype Player subtype
+	Track Track metadata.
 
 			Possible values:
-
-				"Audio Book"
-				"Podcast"
 	*/
-	Subtype string
+	Track map[string]interface{}
 
 	/*
-	Searchable If present indicates the player can be searched using
-			MediaFolder interface.
-
-			Possible values:
-
-				True: Supported and active
-				False: Supported but inactive
-
-			Note: If supported but inactive clients can enable it
-			by using MediaFolder interface but it might interfere
-			in the playback of other players.
+	Title Track title name
 	*/
-	Searchable bool
+	Title string
 
 	/*
-	Browsable If present indicates the player can be browsed using
-			MediaFolder interface.
-
-			Possible values:
-
-				True: Supported and active
-				False: Supported but inactive
-
-			Note: If supported but inactive clients can enable it
-			by using MediaFolder interface but it might interfere
-			in the playback of other players.
+	Artist Track artist name
 	*/
-	Browsable bool
+	Artist string
 
 	/*
-	Scan Possible values: "off", "alltracks" or "group"
+	Album Track album name
 	*/
-	Scan string
+	Album string
 
 	/*
-	Track Track metadata.
-
-			Possible values:
+	Genre Track genre name
 	*/
-	Track map[string]interface{}
+	Genre string
 
 	/*
-	Artist Track artist name
+	NumberOfTracks Number of tracks in total
 	*/
-	Artist string
+	NumberOfTracks uint32
 
 	/*
-	Album Track album name
+	TrackNumber Track number
 	*/
-	Album string
+	TrackNumber uint32
 
 	/*
-	NumberOfTracks Number of tracks in total
+	Duration Track duration in milliseconds
 	*/
-	NumberOfTracks uint32
+	Duration uint32
 
 	/*
-	Duration Track duration in milliseconds
+	Device Device object path.
 	*/
-	Duration uint32
+	Device dbus.ObjectPath
 
 	/*
 	Name Player name
@@ -180,32 +172,49 @@ type MediaPlayer1Properties struct {
 	Type string
 
 	/*
-	Equalizer Possible values: "off" or "on"
-	*/
-	Equalizer string
+	Subtype Player subtype
 
-	/*
-	Repeat Possible values: "off", "singletrack", "alltracks" or
-					"group"
+			Possible values:
+
+				"Audio Book"
+				"Podcast"
 	*/
-	Repeat string
+	Subtype string
 
 	/*
-	Shuffle Possible values: "off", "alltracks" or "group"
+	Browsable If present indicates the player can be browsed using
+			MediaFolder interface.
+
+			Possible values:
+
+				True: Supported and active
+				False: Supported but inactive
+
+			Note: If supported but inactive clients can enable it
+			by using MediaFolder interface but it might interfere
+			in the playback of other players.
 	*/
-	Shuffle string
+	Browsable bool
 
 	/*
-	Status Possible status: "playing", "stopped", "paused",
-					"forward-seek", "reverse-seek"
-					or "error"
+	Searchable If present indicates the player can be searched using
+			MediaFolder interface.
+
+			Possible values:
+
+				True: Supported and active
+				False: Supported but inactive
+
+			Note: If supported but inactive clients can enable it
+			by using MediaFolder interface but it might interfere
+			in the playback of other players.
 	*/
-	Status string
+	Searchable bool
 
 	/*
-	Genre Track genre name
+	Playlist Playlist object path.
 	*/
-	Genre string
+	Playlist dbus.ObjectPath
 
 }
 
@@ -222,16 +231,29 @@ func (p *MediaPlayer1Properties) Unlock() {
 
 
 
-// SetTitle set Title value
-func (a *MediaPlayer1) SetTitle(v string) error {
-	return a.SetProperty("Title", v)
+// SetEqualizer set Equalizer value
+func (a *MediaPlayer1) SetEqualizer(v string) error {
+	return a.SetEqualizerContext(context.Background(), v)
 }
 
+// SetEqualizerContext set Equalizer value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetEqualizerContext(ctx context.Context, v string) error {
+	if !MediaPlayer1Equalizer(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaPlayer1Interface, Member: "Equalizer", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "Equalizer", v)
+}
 
 
-// GetTitle get Title value
-func (a *MediaPlayer1) GetTitle() (string, error) {
-	v, err := a.GetProperty("Title")
+
+// GetEqualizer get Equalizer value
+func (a *MediaPlayer1) GetEqualizer() (string, error) {
+	return a.GetEqualizerContext(context.Background())
+}
+
+// GetEqualizerContext get Equalizer value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetEqualizerContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Equalizer")
 	if err != nil {
 		return "", err
 	}
@@ -241,92 +263,93 @@ func (a *MediaPlayer1) GetTitle() (string, error) {
 
 
 
-// SetPlaylist set Playlist value
-func (a *MediaPlayer1) SetPlaylist(v dbus.ObjectPath) error {
-	return a.SetProperty("Playlist", v)
+// SetRepeat set Repeat value
+func (a *MediaPlayer1) SetRepeat(v string) error {
+	return a.SetRepeatContext(context.Background(), v)
 }
 
-
-
-// GetPlaylist get Playlist value
-func (a *MediaPlayer1) GetPlaylist() (dbus.ObjectPath, error) {
-	v, err := a.GetProperty("Playlist")
-	if err != nil {
-		return dbus.ObjectPath(""), err
+// SetRepeatContext set Repeat value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetRepeatContext(ctx context.Context, v string) error {
+	if !MediaPlayer1Repeat(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaPlayer1Interface, Member: "Repeat", Path: a.Path(), Value: v}
 	}
-	return v.Value().(dbus.ObjectPath), nil
+	return a.SetPropertyContext(ctx, "Repeat", v)
 }
 
 
 
-
-// SetPosition set Position value
-func (a *MediaPlayer1) SetPosition(v uint32) error {
-	return a.SetProperty("Position", v)
+// GetRepeat get Repeat value
+func (a *MediaPlayer1) GetRepeat() (string, error) {
+	return a.GetRepeatContext(context.Background())
 }
 
-
-
-// GetPosition get Position value
-func (a *MediaPlayer1) GetPosition() (uint32, error) {
-	v, err := a.GetProperty("Position")
+// GetRepeatContext get Repeat value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetRepeatContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Repeat")
 	if err != nil {
-		return uint32(0), err
+		return "", err
 	}
-	return v.Value().(uint32), nil
+	return v.Value().(string), nil
 }
 
 
 
 
-// SetTrackNumber set TrackNumber value
-func (a *MediaPlayer1) SetTrackNumber(v uint32) error {
-	return a.SetProperty("TrackNumber", v)
+// SetShuffle set Shuffle value
+func (a *MediaPlayer1) SetShuffle(v string) error {
+	return a.SetShuffleContext(context.Background(), v)
 }
 
-
-
-// GetTrackNumber get TrackNumber value
-func (a *MediaPlayer1) GetTrackNumber() (uint32, error) {
-	v, err := a.GetProperty("TrackNumber")
-	if err != nil {
-		return uint32(0), err
+// SetShuffleContext set Shuffle value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetShuffleContext(ctx context.Context, v string) error {
+	if !MediaPlayer1Shuffle(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaPlayer1Interface, Member: "Shuffle", Path: a.Path(), Value: v}
 	}
-	return v.Value().(uint32), nil
+	return a.SetPropertyContext(ctx, "Shuffle", v)
 }
 
 
 
-
-// SetDevice set Device value
-func (a *MediaPlayer1) SetDevice(v dbus.ObjectPath) error {
-	return a.SetProperty("Device", v)
+// GetShuffle get Shuffle value
+func (a *MediaPlayer1) GetShuffle() (string, error) {
+	return a.GetShuffleContext(context.Background())
 }
 
-
-
-// GetDevice get Device value
-func (a *MediaPlayer1) GetDevice() (dbus.ObjectPath, error) {
-	v, err := a.GetProperty("Device")
+// GetShuffleContext get Shuffle value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetShuffleContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Shuffle")
 	if err != nil {
-		return dbus.ObjectPath(""), err
+		return "", err
 	}
-	return v.Value().(dbus.ObjectPath), nil
+	return v.Value().(string), nil
 }
 
 
 
 
-// SetSubtype set Subtype value
-func (a *MediaPlayer1) SetSubtype(v string) error {
-	return a.SetProperty("Subtype", v)
+// SetScan set Scan value
+func (a *MediaPlayer1) SetScan(v string) error {
+	return a.SetScanContext(context.Background(), v)
 }
 
+// SetScanContext set Scan value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetScanContext(ctx context.Context, v string) error {
+	if !MediaPlayer1Scan(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaPlayer1Interface, Member: "Scan", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "Scan", v)
+}
 
 
-// GetSubtype get Subtype value
-func (a *MediaPlayer1) GetSubtype() (string, error) {
-	v, err := a.GetProperty("Subtype")
+
+// GetScan get Scan value
+func (a *MediaPlayer1) GetScan() (string, error) {
+	return a.GetScanContext(context.Background())
+}
+
+// GetScanContext get Scan value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetScanContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Scan")
 	if err != nil {
 		return "", err
 	}
@@ -336,58 +359,62 @@ func (a *MediaPlayer1) GetSubtype() (string, error) {
 
 
 
-// SetSearchable set Searchable value
-func (a *MediaPlayer1) SetSearchable(v bool) error {
-	return a.SetProperty("Searchable", v)
+// SetStatus set Status value
+func (a *MediaPlayer1) SetStatus(v string) error {
+	return a.SetStatusContext(context.Background(), v)
 }
 
-
-
-// GetSearchable get Searchable value
-func (a *MediaPlayer1) GetSearchable() (bool, error) {
-	v, err := a.GetProperty("Searchable")
-	if err != nil {
-		return false, err
+// SetStatusContext set Status value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetStatusContext(ctx context.Context, v string) error {
+	if !MediaPlayer1Status(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaPlayer1Interface, Member: "Status", Path: a.Path(), Value: v}
 	}
-	return v.Value().(bool), nil
+	return a.SetPropertyContext(ctx, "Status", v)
 }
 
 
 
-
-// SetBrowsable set Browsable value
-func (a *MediaPlayer1) SetBrowsable(v bool) error {
-	return a.SetProperty("Browsable", v)
+// GetStatus get Status value
+func (a *MediaPlayer1) GetStatus() (string, error) {
+	return a.GetStatusContext(context.Background())
 }
 
-
-
-// GetBrowsable get Browsable value
-func (a *MediaPlayer1) GetBrowsable() (bool, error) {
-	v, err := a.GetProperty("Browsable")
+// GetStatusContext get Status value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetStatusContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Status")
 	if err != nil {
-		return false, err
+		return "", err
 	}
-	return v.Value().(bool), nil
+	return v.Value().(string), nil
 }
 
 
 
 
-// SetScan set Scan value
-func (a *MediaPlayer1) SetScan(v string) error {
-	return a.SetProperty("Scan", v)
+// SetPosition set Position value
+func (a *MediaPlayer1) SetPosition(v uint32) error {
+	return a.SetPositionContext(context.Background(), v)
 }
 
+// SetPositionContext set Position value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetPositionContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "Position", v)
+}
 
 
-// GetScan get Scan value
-func (a *MediaPlayer1) GetScan() (string, error) {
-	v, err := a.GetProperty("Scan")
+
+// GetPosition get Position value
+func (a *MediaPlayer1) GetPosition() (uint32, error) {
+	return a.GetPositionContext(context.Background())
+}
+
+// GetPositionContext get Position value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetPositionContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "Position")
 	if err != nil {
-		return "", err
+		return uint32(0), err
 	}
-	return v.Value().(string), nil
+	return v.Value().(uint32), nil
 }
 
 
@@ -395,14 +422,24 @@ func (a *MediaPlayer1) GetScan() (string, error) {
 
 // SetTrack set Track value
 func (a *MediaPlayer1) SetTrack(v map[string]interface{}) error {
-	return a.SetProperty("Track", v)
+	return a.SetTrackContext(context.Background(), v)
+}
+
+// SetTrackContext set Track value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetTrackContext(ctx context.Context, v map[string]interface{}) error {
+	return a.SetPropertyContext(ctx, "Track", v)
 }
 
 
 
 // GetTrack get Track value
 func (a *MediaPlayer1) GetTrack() (map[string]interface{}, error) {
-	v, err := a.GetProperty("Track")
+	return a.GetTrackContext(context.Background())
+}
+
+// GetTrackContext get Track value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetTrackContext(ctx context.Context) (map[string]interface{}, error) {
+	v, err := a.GetPropertyContext(ctx, "Track")
 	if err != nil {
 		return map[string]interface{}{}, err
 	}
@@ -412,16 +449,55 @@ func (a *MediaPlayer1) GetTrack() (map[string]interface{}, error) {
 
 
 
+// SetTitle set Title value
+func (a *MediaPlayer1) SetTitle(v string) error {
+	return a.SetTitleContext(context.Background(), v)
+}
+
+// SetTitleContext set Title value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetTitleContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Title", v)
+}
+
+
+
+// GetTitle get Title value
+func (a *MediaPlayer1) GetTitle() (string, error) {
+	return a.GetTitleContext(context.Background())
+}
+
+// GetTitleContext get Title value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetTitleContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Title")
+	if err != nil {
+		return "", err
+	}
+	return v.Value().(string), nil
+}
+
+
+
+
 // SetArtist set Artist value
 func (a *MediaPlayer1) SetArtist(v string) error {
-	return a.SetProperty("Artist", v)
+	return a.SetArtistContext(context.Background(), v)
+}
+
+// SetArtistContext set Artist value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetArtistContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Artist", v)
 }
 
 
 
 // GetArtist get Artist value
 func (a *MediaPlayer1) GetArtist() (string, error) {
-	v, err := a.GetProperty("Artist")
+	return a.GetArtistContext(context.Background())
+}
+
+// GetArtistContext get Artist value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetArtistContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Artist")
 	if err != nil {
 		return "", err
 	}
@@ -433,14 +509,53 @@ func (a *MediaPlayer1) GetArtist() (string, error) {
 
 // SetAlbum set Album value
 func (a *MediaPlayer1) SetAlbum(v string) error {
-	return a.SetProperty("Album", v)
+	return a.SetAlbumContext(context.Background(), v)
+}
+
+// SetAlbumContext set Album value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetAlbumContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Album", v)
 }
 
 
 
 // GetAlbum get Album value
 func (a *MediaPlayer1) GetAlbum() (string, error) {
-	v, err := a.GetProperty("Album")
+	return a.GetAlbumContext(context.Background())
+}
+
+// GetAlbumContext get Album value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetAlbumContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Album")
+	if err != nil {
+		return "", err
+	}
+	return v.Value().(string), nil
+}
+
+
+
+
+// SetGenre set Genre value
+func (a *MediaPlayer1) SetGenre(v string) error {
+	return a.SetGenreContext(context.Background(), v)
+}
+
+// SetGenreContext set Genre value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetGenreContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Genre", v)
+}
+
+
+
+// GetGenre get Genre value
+func (a *MediaPlayer1) GetGenre() (string, error) {
+	return a.GetGenreContext(context.Background())
+}
+
+// GetGenreContext get Genre value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetGenreContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Genre")
 	if err != nil {
 		return "", err
 	}
@@ -452,14 +567,53 @@ func (a *MediaPlayer1) GetAlbum() (string, error) {
 
 // SetNumberOfTracks set NumberOfTracks value
 func (a *MediaPlayer1) SetNumberOfTracks(v uint32) error {
-	return a.SetProperty("NumberOfTracks", v)
+	return a.SetNumberOfTracksContext(context.Background(), v)
+}
+
+// SetNumberOfTracksContext set NumberOfTracks value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetNumberOfTracksContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "NumberOfTracks", v)
 }
 
 
 
 // GetNumberOfTracks get NumberOfTracks value
 func (a *MediaPlayer1) GetNumberOfTracks() (uint32, error) {
-	v, err := a.GetProperty("NumberOfTracks")
+	return a.GetNumberOfTracksContext(context.Background())
+}
+
+// GetNumberOfTracksContext get NumberOfTracks value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetNumberOfTracksContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "NumberOfTracks")
+	if err != nil {
+		return uint32(0), err
+	}
+	return v.Value().(uint32), nil
+}
+
+
+
+
+// SetTrackNumber set TrackNumber value
+func (a *MediaPlayer1) SetTrackNumber(v uint32) error {
+	return a.SetTrackNumberContext(context.Background(), v)
+}
+
+// SetTrackNumberContext set TrackNumber value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetTrackNumberContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "TrackNumber", v)
+}
+
+
+
+// GetTrackNumber get TrackNumber value
+func (a *MediaPlayer1) GetTrackNumber() (uint32, error) {
+	return a.GetTrackNumberContext(context.Background())
+}
+
+// GetTrackNumberContext get TrackNumber value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetTrackNumberContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "TrackNumber")
 	if err != nil {
 		return uint32(0), err
 	}
@@ -471,14 +625,24 @@ func (a *MediaPlayer1) GetNumberOfTracks() (uint32, error) {
 
 // SetDuration set Duration value
 func (a *MediaPlayer1) SetDuration(v uint32) error {
-	return a.SetProperty("Duration", v)
+	return a.SetDurationContext(context.Background(), v)
+}
+
+// SetDurationContext set Duration value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetDurationContext(ctx context.Context, v uint32) error {
+	return a.SetPropertyContext(ctx, "Duration", v)
 }
 
 
 
 // GetDuration get Duration value
 func (a *MediaPlayer1) GetDuration() (uint32, error) {
-	v, err := a.GetProperty("Duration")
+	return a.GetDurationContext(context.Background())
+}
+
+// GetDurationContext get Duration value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetDurationContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "Duration")
 	if err != nil {
 		return uint32(0), err
 	}
@@ -488,16 +652,55 @@ func (a *MediaPlayer1) GetDuration() (uint32, error) {
 
 
 
+// SetDevice set Device value
+func (a *MediaPlayer1) SetDevice(v dbus.ObjectPath) error {
+	return a.SetDeviceContext(context.Background(), v)
+}
+
+// SetDeviceContext set Device value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetDeviceContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Device", v)
+}
+
+
+
+// GetDevice get Device value
+func (a *MediaPlayer1) GetDevice() (dbus.ObjectPath, error) {
+	return a.GetDeviceContext(context.Background())
+}
+
+// GetDeviceContext get Device value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetDeviceContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Device")
+	if err != nil {
+		return dbus.ObjectPath(""), err
+	}
+	return v.Value().(dbus.ObjectPath), nil
+}
+
+
+
+
 // SetName set Name value
 func (a *MediaPlayer1) SetName(v string) error {
-	return a.SetProperty("Name", v)
+	return a.SetNameContext(context.Background(), v)
+}
+
+// SetNameContext set Name value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetNameContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Name", v)
 }
 
 
 
 // GetName get Name value
 func (a *MediaPlayer1) GetName() (string, error) {
-	v, err := a.GetProperty("Name")
+	return a.GetNameContext(context.Background())
+}
+
+// GetNameContext get Name value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetNameContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Name")
 	if err != nil {
 		return "", err
 	}
@@ -509,14 +712,27 @@ func (a *MediaPlayer1) GetName() (string, error) {
 
 // SetType set Type value
 func (a *MediaPlayer1) SetType(v string) error {
-	return a.SetProperty("Type", v)
+	return a.SetTypeContext(context.Background(), v)
+}
+
+// SetTypeContext set Type value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetTypeContext(ctx context.Context, v string) error {
+	if !MediaPlayer1Type(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaPlayer1Interface, Member: "Type", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "Type", v)
 }
 
 
 
 // GetType get Type value
 func (a *MediaPlayer1) GetType() (string, error) {
-	v, err := a.GetProperty("Type")
+	return a.GetTypeContext(context.Background())
+}
+
+// GetTypeContext get Type value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetTypeContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Type")
 	if err != nil {
 		return "", err
 	}
@@ -526,35 +742,29 @@ func (a *MediaPlayer1) GetType() (string, error) {
 
 
 
-// SetEqualizer set Equalizer value
-func (a *MediaPlayer1) SetEqualizer(v string) error {
-	return a.SetProperty("Equalizer", v)
+// SetSubtype set Subtype value
+func (a *MediaPlayer1) SetSubtype(v string) error {
+	return a.SetSubtypeContext(context.Background(), v)
 }
 
-
-
-// GetEqualizer get Equalizer value
-func (a *MediaPlayer1) GetEqualizer() (string, error) {
-	v, err := a.GetProperty("Equalizer")
-	if err != nil {
-		return "", err
+// SetSubtypeContext set Subtype value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetSubtypeContext(ctx context.Context, v string) error {
+	if !MediaPlayer1Subtype(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaPlayer1Interface, Member: "Subtype", Path: a.Path(), Value: v}
 	}
-	return v.Value().(string), nil
+	return a.SetPropertyContext(ctx, "Subtype", v)
 }
 
 
 
-
-// SetRepeat set Repeat value
-func (a *MediaPlayer1) SetRepeat(v string) error {
-	return a.SetProperty("Repeat", v)
+// GetSubtype get Subtype value
+func (a *MediaPlayer1) GetSubtype() (string, error) {
+	return a.GetSubtypeContext(context.Background())
 }
 
-
-
-// GetRepeat get Repeat value
-func (a *MediaPlayer1) GetRepeat() (string, error) {
-	v, err := a.GetProperty("Repeat")
+// GetSubtypeContext get Subtype value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetSubtypeContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Subtype")
 	if err != nil {
 		return "", err
 	}
@@ -564,58 +774,88 @@ func (a *MediaPlayer1) GetRepeat() (string, error) {
 
 
 
-// SetShuffle set Shuffle value
-func (a *MediaPlayer1) SetShuffle(v string) error {
-	return a.SetProperty("Shuffle", v)
+// SetBrowsable set Browsable value
+func (a *MediaPlayer1) SetBrowsable(v bool) error {
+	return a.SetBrowsableContext(context.Background(), v)
 }
 
+// SetBrowsableContext set Browsable value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetBrowsableContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Browsable", v)
+}
 
 
-// GetShuffle get Shuffle value
-func (a *MediaPlayer1) GetShuffle() (string, error) {
-	v, err := a.GetProperty("Shuffle")
+
+// GetBrowsable get Browsable value
+func (a *MediaPlayer1) GetBrowsable() (bool, error) {
+	return a.GetBrowsableContext(context.Background())
+}
+
+// GetBrowsableContext get Browsable value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetBrowsableContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Browsable")
 	if err != nil {
-		return "", err
+		return false, err
 	}
-	return v.Value().(string), nil
+	return v.Value().(bool), nil
 }
 
 
 
 
-// SetStatus set Status value
-func (a *MediaPlayer1) SetStatus(v string) error {
-	return a.SetProperty("Status", v)
+// SetSearchable set Searchable value
+func (a *MediaPlayer1) SetSearchable(v bool) error {
+	return a.SetSearchableContext(context.Background(), v)
 }
 
+// SetSearchableContext set Searchable value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetSearchableContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Searchable", v)
+}
 
 
-// GetStatus get Status value
-func (a *MediaPlayer1) GetStatus() (string, error) {
-	v, err := a.GetProperty("Status")
+
+// GetSearchable get Searchable value
+func (a *MediaPlayer1) GetSearchable() (bool, error) {
+	return a.GetSearchableContext(context.Background())
+}
+
+// GetSearchableContext get Searchable value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetSearchableContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Searchable")
 	if err != nil {
-		return "", err
+		return false, err
 	}
-	return v.Value().(string), nil
+	return v.Value().(bool), nil
 }
 
 
 
 
-// SetGenre set Genre value
-func (a *MediaPlayer1) SetGenre(v string) error {
-	return a.SetProperty("Genre", v)
+// SetPlaylist set Playlist value
+func (a *MediaPlayer1) SetPlaylist(v dbus.ObjectPath) error {
+	return a.SetPlaylistContext(context.Background(), v)
 }
 
+// SetPlaylistContext set Playlist value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetPlaylistContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Playlist", v)
+}
 
 
-// GetGenre get Genre value
-func (a *MediaPlayer1) GetGenre() (string, error) {
-	v, err := a.GetProperty("Genre")
+
+// GetPlaylist get Playlist value
+func (a *MediaPlayer1) GetPlaylist() (dbus.ObjectPath, error) {
+	return a.GetPlaylistContext(context.Background())
+}
+
+// GetPlaylistContext get Playlist value, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetPlaylistContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Playlist")
 	if err != nil {
-		return "", err
+		return dbus.ObjectPath(""), err
 	}
-	return v.Value().(string), nil
+	return v.Value().(dbus.ObjectPath), nil
 }
 
 
@@ -643,35 +883,24 @@ func (a *MediaPlayer1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *MediaPlayer1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *MediaPlayer1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -701,20 +930,31 @@ func (a *MediaPlayer1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *MediaPlayer1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *MediaPlayer1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *MediaPlayer1) GetProperties() (*MediaPlayer1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetPropertiesContext(ctx context.Context) (*MediaPlayer1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -724,29 +964,40 @@ func (a *MediaPlayer1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *MediaPlayer1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *MediaPlayer1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *MediaPlayer1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *MediaPlayer1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *MediaPlayer1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -756,6 +1007,7 @@ func (a *MediaPlayer1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *MediaPlayer1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -770,8 +1022,13 @@ Play 			Resume playback.
 
 */
 func (a *MediaPlayer1) Play() error {
+	return a.PlayContext(context.Background())
+}
+
+// PlayContext call Play, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaPlayer1) PlayContext(ctx context.Context) error {
 	
-	return a.client.Call("Play", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Play", 0, ).Store()
 	
 }
 
@@ -782,8 +1039,13 @@ Pause 			Pause playback.
 
 */
 func (a *MediaPlayer1) Pause() error {
+	return a.PauseContext(context.Background())
+}
+
+// PauseContext call Pause, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaPlayer1) PauseContext(ctx context.Context) error {
 	
-	return a.client.Call("Pause", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Pause", 0, ).Store()
 	
 }
 
@@ -794,8 +1056,13 @@ Stop 			Stop playback.
 
 */
 func (a *MediaPlayer1) Stop() error {
+	return a.StopContext(context.Background())
+}
+
+// StopContext call Stop, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaPlayer1) StopContext(ctx context.Context) error {
 	
-	return a.client.Call("Stop", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Stop", 0, ).Store()
 	
 }
 
@@ -806,8 +1073,13 @@ Next 			Next item.
 
 */
 func (a *MediaPlayer1) Next() error {
+	return a.NextContext(context.Background())
+}
+
+// NextContext call Next, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaPlayer1) NextContext(ctx context.Context) error {
 	
-	return a.client.Call("Next", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Next", 0, ).Store()
 	
 }
 
@@ -818,8 +1090,13 @@ Previous 			Previous item.
 
 */
 func (a *MediaPlayer1) Previous() error {
+	return a.PreviousContext(context.Background())
+}
+
+// PreviousContext call Previous, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaPlayer1) PreviousContext(ctx context.Context) error {
 	
-	return a.client.Call("Previous", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Previous", 0, ).Store()
 	
 }
 
@@ -831,8 +1108,13 @@ FastForward 			Fast forward playback, this action is only stopped
 
 */
 func (a *MediaPlayer1) FastForward() error {
+	return a.FastForwardContext(context.Background())
+}
+
+// FastForwardContext call FastForward, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaPlayer1) FastForwardContext(ctx context.Context) error {
 	
-	return a.client.Call("FastForward", 0, ).Store()
+	return a.client.CallWithContext(ctx, "FastForward", 0, ).Store()
 	
 }
 
@@ -844,8 +1126,13 @@ Rewind 			Rewind playback, this action is only stopped
 
 */
 func (a *MediaPlayer1) Rewind() error {
+	return a.RewindContext(context.Background())
+}
+
+// RewindContext call Rewind, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaPlayer1) RewindContext(ctx context.Context) error {
 	
-	return a.client.Call("Rewind", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Rewind", 0, ).Store()
 	
 }
 
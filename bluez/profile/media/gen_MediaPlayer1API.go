@@ -0,0 +1,153 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// MediaPlayer1API is the interface of MediaPlayer1, implemented by
+// FakeMediaPlayer1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for MediaPlayer1.
+type MediaPlayer1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*MediaPlayer1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*MediaPlayer1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	SetEqualizer(v string) error
+	SetEqualizerContext(ctx context.Context, v string) error
+	GetEqualizer() (string, error)
+	GetEqualizerContext(ctx context.Context) (string, error)
+
+	SetRepeat(v string) error
+	SetRepeatContext(ctx context.Context, v string) error
+	GetRepeat() (string, error)
+	GetRepeatContext(ctx context.Context) (string, error)
+
+	SetShuffle(v string) error
+	SetShuffleContext(ctx context.Context, v string) error
+	GetShuffle() (string, error)
+	GetShuffleContext(ctx context.Context) (string, error)
+
+	SetScan(v string) error
+	SetScanContext(ctx context.Context, v string) error
+	GetScan() (string, error)
+	GetScanContext(ctx context.Context) (string, error)
+
+	SetStatus(v string) error
+	SetStatusContext(ctx context.Context, v string) error
+	GetStatus() (string, error)
+	GetStatusContext(ctx context.Context) (string, error)
+
+	SetPosition(v uint32) error
+	SetPositionContext(ctx context.Context, v uint32) error
+	GetPosition() (uint32, error)
+	GetPositionContext(ctx context.Context) (uint32, error)
+
+	SetTrack(v map[string]interface{}) error
+	SetTrackContext(ctx context.Context, v map[string]interface{}) error
+	GetTrack() (map[string]interface{}, error)
+	GetTrackContext(ctx context.Context) (map[string]interface{}, error)
+
+	SetTitle(v string) error
+	SetTitleContext(ctx context.Context, v string) error
+	GetTitle() (string, error)
+	GetTitleContext(ctx context.Context) (string, error)
+
+	SetArtist(v string) error
+	SetArtistContext(ctx context.Context, v string) error
+	GetArtist() (string, error)
+	GetArtistContext(ctx context.Context) (string, error)
+
+	SetAlbum(v string) error
+	SetAlbumContext(ctx context.Context, v string) error
+	GetAlbum() (string, error)
+	GetAlbumContext(ctx context.Context) (string, error)
+
+	SetGenre(v string) error
+	SetGenreContext(ctx context.Context, v string) error
+	GetGenre() (string, error)
+	GetGenreContext(ctx context.Context) (string, error)
+
+	SetNumberOfTracks(v uint32) error
+	SetNumberOfTracksContext(ctx context.Context, v uint32) error
+	GetNumberOfTracks() (uint32, error)
+	GetNumberOfTracksContext(ctx context.Context) (uint32, error)
+
+	SetTrackNumber(v uint32) error
+	SetTrackNumberContext(ctx context.Context, v uint32) error
+	GetTrackNumber() (uint32, error)
+	GetTrackNumberContext(ctx context.Context) (uint32, error)
+
+	SetDuration(v uint32) error
+	SetDurationContext(ctx context.Context, v uint32) error
+	GetDuration() (uint32, error)
+	GetDurationContext(ctx context.Context) (uint32, error)
+
+	SetDevice(v dbus.ObjectPath) error
+	SetDeviceContext(ctx context.Context, v dbus.ObjectPath) error
+	GetDevice() (dbus.ObjectPath, error)
+	GetDeviceContext(ctx context.Context) (dbus.ObjectPath, error)
+
+	SetName(v string) error
+	SetNameContext(ctx context.Context, v string) error
+	GetName() (string, error)
+	GetNameContext(ctx context.Context) (string, error)
+
+	SetType(v string) error
+	SetTypeContext(ctx context.Context, v string) error
+	GetType() (string, error)
+	GetTypeContext(ctx context.Context) (string, error)
+
+	SetSubtype(v string) error
+	SetSubtypeContext(ctx context.Context, v string) error
+	GetSubtype() (string, error)
+	GetSubtypeContext(ctx context.Context) (string, error)
+
+	SetBrowsable(v bool) error
+	SetBrowsableContext(ctx context.Context, v bool) error
+	GetBrowsable() (bool, error)
+	GetBrowsableContext(ctx context.Context) (bool, error)
+
+	SetSearchable(v bool) error
+	SetSearchableContext(ctx context.Context, v bool) error
+	GetSearchable() (bool, error)
+	GetSearchableContext(ctx context.Context) (bool, error)
+
+	SetPlaylist(v dbus.ObjectPath) error
+	SetPlaylistContext(ctx context.Context, v dbus.ObjectPath) error
+	GetPlaylist() (dbus.ObjectPath, error)
+	GetPlaylistContext(ctx context.Context) (dbus.ObjectPath, error)
+
+	Play() error
+	PlayContext(ctx context.Context) error
+	Pause() error
+	PauseContext(ctx context.Context) error
+	Stop() error
+	StopContext(ctx context.Context) error
+	Next() error
+	NextContext(ctx context.Context) error
+	Previous() error
+	PreviousContext(ctx context.Context) error
+	FastForward() error
+	FastForwardContext(ctx context.Context) error
+	Rewind() error
+	RewindContext(ctx context.Context) error
+}
+
+var _ MediaPlayer1API = (*MediaPlayer1)(nil)
@@ -0,0 +1,232 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+
+import (
+   "github.com/godbus/dbus/v5"
+   "github.com/godbus/dbus/v5/introspect"
+   "github.com/godbus/dbus/v5/prop"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/bluez/profile"
+)
+
+// MediaPlayer1Handler is implemented by the application to serve
+// org.bluez.MediaPlayer1, see MediaPlayer1 for the documentation of the
+// methods. Embed UnimplementedMediaPlayer1Handler to implement only some of them.
+type MediaPlayer1Handler interface {
+	Play() *dbus.Error
+	Pause() *dbus.Error
+	Stop() *dbus.Error
+	Next() *dbus.Error
+	Previous() *dbus.Error
+	FastForward() *dbus.Error
+	Rewind() *dbus.Error
+}
+
+// UnimplementedMediaPlayer1Handler reply org.bluez.Error.NotImplemented to
+// every method of MediaPlayer1Handler
+type UnimplementedMediaPlayer1Handler struct{}
+
+// Play reply org.bluez.Error.NotImplemented
+func (UnimplementedMediaPlayer1Handler) Play() *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+// Pause reply org.bluez.Error.NotImplemented
+func (UnimplementedMediaPlayer1Handler) Pause() *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+// Stop reply org.bluez.Error.NotImplemented
+func (UnimplementedMediaPlayer1Handler) Stop() *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+// Next reply org.bluez.Error.NotImplemented
+func (UnimplementedMediaPlayer1Handler) Next() *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+// Previous reply org.bluez.Error.NotImplemented
+func (UnimplementedMediaPlayer1Handler) Previous() *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+// FastForward reply org.bluez.Error.NotImplemented
+func (UnimplementedMediaPlayer1Handler) FastForward() *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+// Rewind reply org.bluez.Error.NotImplemented
+func (UnimplementedMediaPlayer1Handler) Rewind() *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+var _ MediaPlayer1Handler = UnimplementedMediaPlayer1Handler{}
+
+// MediaPlayer1Server export a MediaPlayer1Handler on a connection,
+// forwarding the calls of bluez to it
+type MediaPlayer1Server struct {
+	conn       *dbus.Conn
+	path       dbus.ObjectPath
+	handler    MediaPlayer1Handler
+	properties *prop.Properties
+}
+
+// ExportMediaPlayer1 export handler as org.bluez.MediaPlayer1 at path, with
+// its introspection data and the Properties interface serving properties, nil
+// for their zero values. The Introspectable interface of path only describes
+// org.bluez.MediaPlayer1, use Introspection to describe objects exporting more interfaces.
+func ExportMediaPlayer1(conn *dbus.Conn, path dbus.ObjectPath, handler MediaPlayer1Handler, properties *MediaPlayer1Properties) (*MediaPlayer1Server, error) {
+
+	s := &MediaPlayer1Server{
+		conn:    conn,
+		path:    path,
+		handler: handler,
+	}
+
+	err := conn.Export(s, path, MediaPlayer1Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	if properties == nil {
+		properties = new(MediaPlayer1Properties)
+	}
+	propsConfig := make(map[string]*prop.Prop)
+	for name, info := range props.ParseProperties(properties) {
+		if info.Skip {
+			continue
+		}
+		p := info.Prop
+		propsConfig[name] = &p
+	}
+	s.properties = prop.New(conn, path, map[string]map[string]*prop.Prop{
+		MediaPlayer1Interface: propsConfig,
+	})
+
+	node := &introspect.Node{
+		Interfaces: []introspect.Interface{
+			introspect.IntrospectData,
+			prop.IntrospectData,
+			s.Introspection(),
+		},
+	}
+	err = conn.Export(introspect.NewIntrospectable(node), path, bluez.Introspectable)
+	if err != nil {
+		s.Unexport()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Path return the object path of the server
+func (s *MediaPlayer1Server) Path() dbus.ObjectPath {
+	return s.path
+}
+
+// Interface return the exported interface
+func (s *MediaPlayer1Server) Interface() string {
+	return MediaPlayer1Interface
+}
+
+// Handler return the handler serving the calls
+func (s *MediaPlayer1Server) Handler() MediaPlayer1Handler {
+	return s.handler
+}
+
+// Introspection return the introspection data of org.bluez.MediaPlayer1
+func (s *MediaPlayer1Server) Introspection() introspect.Interface {
+	return introspect.Interface{
+		Name:       MediaPlayer1Interface,
+		Methods:    introspect.Methods(s),
+		Properties: s.properties.Introspection(MediaPlayer1Interface),
+	}
+}
+
+// GetProperty return the value of a served property
+func (s *MediaPlayer1Server) GetProperty(name string) (dbus.Variant, error) {
+	v, err := s.properties.Get(MediaPlayer1Interface, name)
+	if err != nil {
+		return dbus.Variant{}, err
+	}
+	return v, nil
+}
+
+// SetProperty update a served property, PropertiesChanged is emitted as set
+// by its dbus tag
+func (s *MediaPlayer1Server) SetProperty(name string, value interface{}) error {
+	_, err := s.properties.Get(MediaPlayer1Interface, name)
+	if err != nil {
+		return err
+	}
+	s.properties.SetMust(MediaPlayer1Interface, name, value)
+	return nil
+}
+
+// Unexport remove the object from the connection
+func (s *MediaPlayer1Server) Unexport() error {
+	err := s.conn.Export(nil, s.path, MediaPlayer1Interface)
+	if err != nil {
+		return err
+	}
+	err = s.conn.Export(nil, s.path, bluez.PropertiesInterface)
+	if err != nil {
+		return err
+	}
+	return s.conn.Export(nil, s.path, bluez.Introspectable)
+}
+
+// Play forward the call to the handler
+func (s *MediaPlayer1Server) Play() *dbus.Error {
+	return s.handler.Play()
+}
+
+// Pause forward the call to the handler
+func (s *MediaPlayer1Server) Pause() *dbus.Error {
+	return s.handler.Pause()
+}
+
+// Stop forward the call to the handler
+func (s *MediaPlayer1Server) Stop() *dbus.Error {
+	return s.handler.Stop()
+}
+
+// Next forward the call to the handler
+func (s *MediaPlayer1Server) Next() *dbus.Error {
+	return s.handler.Next()
+}
+
+// Previous forward the call to the handler
+func (s *MediaPlayer1Server) Previous() *dbus.Error {
+	return s.handler.Previous()
+}
+
+// FastForward forward the call to the handler
+func (s *MediaPlayer1Server) FastForward() *dbus.Error {
+	return s.handler.FastForward()
+}
+
+// Rewind forward the call to the handler
+func (s *MediaPlayer1Server) Rewind() *dbus.Error {
+	return s.handler.Rewind()
+}
+
@@ -0,0 +1,199 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+// MediaPlayer1Equalizer is a value documented for MediaPlayer1.Equalizer
+type MediaPlayer1Equalizer string
+
+const (
+	MediaPlayer1EqualizerOff MediaPlayer1Equalizer = "off"
+	MediaPlayer1EqualizerOn MediaPlayer1Equalizer = "on"
+)
+
+// MediaPlayer1EqualizerValues list the documented values of MediaPlayer1Equalizer
+var MediaPlayer1EqualizerValues = []MediaPlayer1Equalizer{
+	MediaPlayer1EqualizerOff,
+	MediaPlayer1EqualizerOn,
+}
+
+// Valid report if v is one of the documented values
+func (v MediaPlayer1Equalizer) Valid() bool {
+	for _, value := range MediaPlayer1EqualizerValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+
+// MediaPlayer1Repeat is a value documented for MediaPlayer1.Repeat
+type MediaPlayer1Repeat string
+
+const (
+	MediaPlayer1RepeatOff MediaPlayer1Repeat = "off"
+	MediaPlayer1RepeatSingletrack MediaPlayer1Repeat = "singletrack"
+	MediaPlayer1RepeatAlltracks MediaPlayer1Repeat = "alltracks"
+	MediaPlayer1RepeatGroup MediaPlayer1Repeat = "group"
+)
+
+// MediaPlayer1RepeatValues list the documented values of MediaPlayer1Repeat
+var MediaPlayer1RepeatValues = []MediaPlayer1Repeat{
+	MediaPlayer1RepeatOff,
+	MediaPlayer1RepeatSingletrack,
+	MediaPlayer1RepeatAlltracks,
+	MediaPlayer1RepeatGroup,
+}
+
+// Valid report if v is one of the documented values
+func (v MediaPlayer1Repeat) Valid() bool {
+	for _, value := range MediaPlayer1RepeatValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+
+// MediaPlayer1Shuffle is a value documented for MediaPlayer1.Shuffle
+type MediaPlayer1Shuffle string
+
+const (
+	MediaPlayer1ShuffleOff MediaPlayer1Shuffle = "off"
+	MediaPlayer1ShuffleAlltracks MediaPlayer1Shuffle = "alltracks"
+	MediaPlayer1ShuffleGroup MediaPlayer1Shuffle = "group"
+)
+
+// MediaPlayer1ShuffleValues list the documented values of MediaPlayer1Shuffle
+var MediaPlayer1ShuffleValues = []MediaPlayer1Shuffle{
+	MediaPlayer1ShuffleOff,
+	MediaPlayer1ShuffleAlltracks,
+	MediaPlayer1ShuffleGroup,
+}
+
+// Valid report if v is one of the documented values
+func (v MediaPlayer1Shuffle) Valid() bool {
+	for _, value := range MediaPlayer1ShuffleValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+
+// MediaPlayer1Scan is a value documented for MediaPlayer1.Scan
+type MediaPlayer1Scan string
+
+const (
+	MediaPlayer1ScanOff MediaPlayer1Scan = "off"
+	MediaPlayer1ScanAlltracks MediaPlayer1Scan = "alltracks"
+	MediaPlayer1ScanGroup MediaPlayer1Scan = "group"
+)
+
+// MediaPlayer1ScanValues list the documented values of MediaPlayer1Scan
+var MediaPlayer1ScanValues = []MediaPlayer1Scan{
+	MediaPlayer1ScanOff,
+	MediaPlayer1ScanAlltracks,
+	MediaPlayer1ScanGroup,
+}
+
+// Valid report if v is one of the documented values
+func (v MediaPlayer1Scan) Valid() bool {
+	for _, value := range MediaPlayer1ScanValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+
+// MediaPlayer1Status is a value documented for MediaPlayer1.Status
+type MediaPlayer1Status string
+
+const (
+	MediaPlayer1StatusPlaying MediaPlayer1Status = "playing"
+	MediaPlayer1StatusStopped MediaPlayer1Status = "stopped"
+	MediaPlayer1StatusPaused MediaPlayer1Status = "paused"
+	MediaPlayer1StatusForwardSeek MediaPlayer1Status = "forward-seek"
+	MediaPlayer1StatusReverseSeek MediaPlayer1Status = "reverse-seek"
+	MediaPlayer1StatusError MediaPlayer1Status = "error"
+)
+
+// MediaPlayer1StatusValues list the documented values of MediaPlayer1Status
+var MediaPlayer1StatusValues = []MediaPlayer1Status{
+	MediaPlayer1StatusPlaying,
+	MediaPlayer1StatusStopped,
+	MediaPlayer1StatusPaused,
+	MediaPlayer1StatusForwardSeek,
+	MediaPlayer1StatusReverseSeek,
+	MediaPlayer1StatusError,
+}
+
+// Valid report if v is one of the documented values
+func (v MediaPlayer1Status) Valid() bool {
+	for _, value := range MediaPlayer1StatusValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+
+// MediaPlayer1Type is a value documented for MediaPlayer1.Type
+type MediaPlayer1Type string
+
+const (
+	MediaPlayer1TypeAudio MediaPlayer1Type = "Audio"
+	MediaPlayer1TypeVideo MediaPlayer1Type = "Video"
+	MediaPlayer1TypeAudioBroadcasting MediaPlayer1Type = "Audio Broadcasting"
+	MediaPlayer1TypeVideoBroadcasting MediaPlayer1Type = "Video Broadcasting"
+)
+
+// MediaPlayer1TypeValues list the documented values of MediaPlayer1Type
+var MediaPlayer1TypeValues = []MediaPlayer1Type{
+	MediaPlayer1TypeAudio,
+	MediaPlayer1TypeVideo,
+	MediaPlayer1TypeAudioBroadcasting,
+	MediaPlayer1TypeVideoBroadcasting,
+}
+
+// Valid report if v is one of the documented values
+func (v MediaPlayer1Type) Valid() bool {
+	for _, value := range MediaPlayer1TypeValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+
+// MediaPlayer1Subtype is a value documented for MediaPlayer1.Subtype
+type MediaPlayer1Subtype string
+
+const (
+	MediaPlayer1SubtypeAudioBook MediaPlayer1Subtype = "Audio Book"
+	MediaPlayer1SubtypePodcast MediaPlayer1Subtype = "Podcast"
+)
+
+// MediaPlayer1SubtypeValues list the documented values of MediaPlayer1Subtype
+var MediaPlayer1SubtypeValues = []MediaPlayer1Subtype{
+	MediaPlayer1SubtypeAudioBook,
+	MediaPlayer1SubtypePodcast,
+}
+
+// Valid report if v is one of the documented values
+func (v MediaPlayer1Subtype) Valid() bool {
+	for _, value := range MediaPlayer1SubtypeValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
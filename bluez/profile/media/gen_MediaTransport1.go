@@ -5,6 +5,7 @@ package media
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var MediaTransport1Interface = "org.bluez.MediaTransport1"
 // Args:
 // - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX/fdX
 func NewMediaTransport1(objectPath dbus.ObjectPath) (*MediaTransport1, error) {
+	return NewMediaTransport1WithConn(nil, objectPath)
+}
+
+// NewMediaTransport1WithConn create a new instance of MediaTransport1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX/fdX
+func NewMediaTransport1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*MediaTransport1, error) {
 	a := new(MediaTransport1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewMediaTransport1(objectPath dbus.ObjectPath) (*MediaTransport1, error) {
 			Iface: MediaTransport1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -47,8 +57,7 @@ MediaTransport1 MediaTransport1 hierarchy
 */
 type MediaTransport1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*MediaTransport1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -58,6 +67,29 @@ type MediaTransport1 struct {
 type MediaTransport1Properties struct {
 	lock sync.RWMutex `dbus:"ignore"`
 
+	/*
+	Device Device object which the transport is connected to.
+	*/
+	Device dbus.ObjectPath
+
+	/*
+	UUID UUID of the profile which the transport is for.
+	*/
+	UUID string
+
+	/*
+	Codec Assigned number of codec that the transport support.
+			The values should match the profile specification which
+			is indicated by the UUID.
+	*/
+	Codec byte
+
+	/*
+	Configuration Configuration blob, it is used as it is so the size and
+			byte order must match.
+	*/
+	Configuration []byte
+
 	/*
 	State Indicates the state of the transport. Possible
 			values are:
@@ -86,31 +118,10 @@ type MediaTransport1Properties struct {
 	/*
 	Endpoint Endpoint object which the transport is associated
 			with.
-	*/
-	Endpoint dbus.ObjectPath
 
-	/*
-	Device Device object which the transport is connected to.
-	*/
-	Device dbus.ObjectPath
-
-	/*
-	UUID UUID of the profile which the transport is for.
-	*/
-	UUID string
-
-	/*
-	Codec Assigned number of codec that the transport support.
-			The values should match the profile specification which
-			is indicated by the UUID.
-	*/
-	Codec byte
-
-	/*
-	Configuration Configuration blob, it is used as it is so the size and
-			byte order must match.
+	Since BlueZ 5.53
 	*/
-	Configuration []byte
+	Endpoint dbus.ObjectPath
 
 }
 
@@ -127,153 +138,236 @@ func (p *MediaTransport1Properties) Unlock() {
 
 
 
-// SetState set State value
-func (a *MediaTransport1) SetState(v string) error {
-	return a.SetProperty("State", v)
+// SetDevice set Device value
+func (a *MediaTransport1) SetDevice(v dbus.ObjectPath) error {
+	return a.SetDeviceContext(context.Background(), v)
 }
 
+// SetDeviceContext set Device value, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) SetDeviceContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Device", v)
+}
 
 
-// GetState get State value
-func (a *MediaTransport1) GetState() (string, error) {
-	v, err := a.GetProperty("State")
+
+// GetDevice get Device value
+func (a *MediaTransport1) GetDevice() (dbus.ObjectPath, error) {
+	return a.GetDeviceContext(context.Background())
+}
+
+// GetDeviceContext get Device value, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) GetDeviceContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Device")
 	if err != nil {
-		return "", err
+		return dbus.ObjectPath(""), err
 	}
-	return v.Value().(string), nil
+	return v.Value().(dbus.ObjectPath), nil
 }
 
 
 
 
-// SetDelay set Delay value
-func (a *MediaTransport1) SetDelay(v uint16) error {
-	return a.SetProperty("Delay", v)
+// SetUUID set UUID value
+func (a *MediaTransport1) SetUUID(v string) error {
+	return a.SetUUIDContext(context.Background(), v)
 }
 
+// SetUUIDContext set UUID value, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) SetUUIDContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "UUID", v)
+}
 
 
-// GetDelay get Delay value
-func (a *MediaTransport1) GetDelay() (uint16, error) {
-	v, err := a.GetProperty("Delay")
+
+// GetUUID get UUID value
+func (a *MediaTransport1) GetUUID() (string, error) {
+	return a.GetUUIDContext(context.Background())
+}
+
+// GetUUIDContext get UUID value, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) GetUUIDContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "UUID")
 	if err != nil {
-		return uint16(0), err
+		return "", err
 	}
-	return v.Value().(uint16), nil
+	return v.Value().(string), nil
 }
 
 
 
 
-// SetVolume set Volume value
-func (a *MediaTransport1) SetVolume(v uint16) error {
-	return a.SetProperty("Volume", v)
+// SetCodec set Codec value
+func (a *MediaTransport1) SetCodec(v byte) error {
+	return a.SetCodecContext(context.Background(), v)
 }
 
+// SetCodecContext set Codec value, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) SetCodecContext(ctx context.Context, v byte) error {
+	return a.SetPropertyContext(ctx, "Codec", v)
+}
 
 
-// GetVolume get Volume value
-func (a *MediaTransport1) GetVolume() (uint16, error) {
-	v, err := a.GetProperty("Volume")
+
+// GetCodec get Codec value
+func (a *MediaTransport1) GetCodec() (byte, error) {
+	return a.GetCodecContext(context.Background())
+}
+
+// GetCodecContext get Codec value, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) GetCodecContext(ctx context.Context) (byte, error) {
+	v, err := a.GetPropertyContext(ctx, "Codec")
 	if err != nil {
-		return uint16(0), err
+		return byte(0), err
 	}
-	return v.Value().(uint16), nil
+	return v.Value().(byte), nil
 }
 
 
 
 
-// SetEndpoint set Endpoint value
-func (a *MediaTransport1) SetEndpoint(v dbus.ObjectPath) error {
-	return a.SetProperty("Endpoint", v)
+// SetConfiguration set Configuration value
+func (a *MediaTransport1) SetConfiguration(v []byte) error {
+	return a.SetConfigurationContext(context.Background(), v)
 }
 
+// SetConfigurationContext set Configuration value, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) SetConfigurationContext(ctx context.Context, v []byte) error {
+	return a.SetPropertyContext(ctx, "Configuration", v)
+}
 
 
-// GetEndpoint get Endpoint value
-func (a *MediaTransport1) GetEndpoint() (dbus.ObjectPath, error) {
-	v, err := a.GetProperty("Endpoint")
+
+// GetConfiguration get Configuration value
+func (a *MediaTransport1) GetConfiguration() ([]byte, error) {
+	return a.GetConfigurationContext(context.Background())
+}
+
+// GetConfigurationContext get Configuration value, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) GetConfigurationContext(ctx context.Context) ([]byte, error) {
+	v, err := a.GetPropertyContext(ctx, "Configuration")
 	if err != nil {
-		return dbus.ObjectPath(""), err
+		return []byte{}, err
 	}
-	return v.Value().(dbus.ObjectPath), nil
+	return v.Value().([]byte), nil
 }
 
 
 
 
-// SetDevice set Device value
-func (a *MediaTransport1) SetDevice(v dbus.ObjectPath) error {
-	return a.SetProperty("Device", v)
+// SetState set State value
+func (a *MediaTransport1) SetState(v string) error {
+	return a.SetStateContext(context.Background(), v)
+}
+
+// SetStateContext set State value, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) SetStateContext(ctx context.Context, v string) error {
+	if !MediaTransport1State(v).Valid() {
+		return &bluez.InvalidValueError{Interface: MediaTransport1Interface, Member: "State", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "State", v)
 }
 
 
 
-// GetDevice get Device value
-func (a *MediaTransport1) GetDevice() (dbus.ObjectPath, error) {
-	v, err := a.GetProperty("Device")
+// GetState get State value
+func (a *MediaTransport1) GetState() (string, error) {
+	return a.GetStateContext(context.Background())
+}
+
+// GetStateContext get State value, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) GetStateContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "State")
 	if err != nil {
-		return dbus.ObjectPath(""), err
+		return "", err
 	}
-	return v.Value().(dbus.ObjectPath), nil
+	return v.Value().(string), nil
 }
 
 
 
 
-// SetUUID set UUID value
-func (a *MediaTransport1) SetUUID(v string) error {
-	return a.SetProperty("UUID", v)
+// SetDelay set Delay value
+func (a *MediaTransport1) SetDelay(v uint16) error {
+	return a.SetDelayContext(context.Background(), v)
 }
 
+// SetDelayContext set Delay value, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) SetDelayContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "Delay", v)
+}
 
 
-// GetUUID get UUID value
-func (a *MediaTransport1) GetUUID() (string, error) {
-	v, err := a.GetProperty("UUID")
+
+// GetDelay get Delay value
+func (a *MediaTransport1) GetDelay() (uint16, error) {
+	return a.GetDelayContext(context.Background())
+}
+
+// GetDelayContext get Delay value, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) GetDelayContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Delay")
 	if err != nil {
-		return "", err
+		return uint16(0), err
 	}
-	return v.Value().(string), nil
+	return v.Value().(uint16), nil
 }
 
 
 
 
-// SetCodec set Codec value
-func (a *MediaTransport1) SetCodec(v byte) error {
-	return a.SetProperty("Codec", v)
+// SetVolume set Volume value
+func (a *MediaTransport1) SetVolume(v uint16) error {
+	return a.SetVolumeContext(context.Background(), v)
 }
 
+// SetVolumeContext set Volume value, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) SetVolumeContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "Volume", v)
+}
 
 
-// GetCodec get Codec value
-func (a *MediaTransport1) GetCodec() (byte, error) {
-	v, err := a.GetProperty("Codec")
+
+// GetVolume get Volume value
+func (a *MediaTransport1) GetVolume() (uint16, error) {
+	return a.GetVolumeContext(context.Background())
+}
+
+// GetVolumeContext get Volume value, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) GetVolumeContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Volume")
 	if err != nil {
-		return byte(0), err
+		return uint16(0), err
 	}
-	return v.Value().(byte), nil
+	return v.Value().(uint16), nil
 }
 
 
 
 
-// SetConfiguration set Configuration value
-func (a *MediaTransport1) SetConfiguration(v []byte) error {
-	return a.SetProperty("Configuration", v)
+// SetEndpoint set Endpoint value
+func (a *MediaTransport1) SetEndpoint(v dbus.ObjectPath) error {
+	return a.SetEndpointContext(context.Background(), v)
 }
 
+// SetEndpointContext set Endpoint value, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) SetEndpointContext(ctx context.Context, v dbus.ObjectPath) error {
+	return a.SetPropertyContext(ctx, "Endpoint", v)
+}
 
 
-// GetConfiguration get Configuration value
-func (a *MediaTransport1) GetConfiguration() ([]byte, error) {
-	v, err := a.GetProperty("Configuration")
+
+// GetEndpoint get Endpoint value
+func (a *MediaTransport1) GetEndpoint() (dbus.ObjectPath, error) {
+	return a.GetEndpointContext(context.Background())
+}
+
+// GetEndpointContext get Endpoint value, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) GetEndpointContext(ctx context.Context) (dbus.ObjectPath, error) {
+	v, err := a.GetPropertyContext(ctx, "Endpoint")
 	if err != nil {
-		return []byte{}, err
+		return dbus.ObjectPath(""), err
 	}
-	return v.Value().([]byte), nil
+	return v.Value().(dbus.ObjectPath), nil
 }
 
 
@@ -301,35 +395,24 @@ func (a *MediaTransport1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *MediaTransport1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *MediaTransport1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
-
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -359,20 +442,31 @@ func (a *MediaTransport1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *MediaTransport1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *MediaTransport1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *MediaTransport1) GetProperties() (*MediaTransport1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) GetPropertiesContext(ctx context.Context) (*MediaTransport1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -382,29 +476,40 @@ func (a *MediaTransport1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *MediaTransport1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *MediaTransport1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *MediaTransport1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *MediaTransport1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *MediaTransport1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -414,6 +519,7 @@ func (a *MediaTransport1) WatchProperties() (chan *bluez.PropertyChanged, error)
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *MediaTransport1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -429,11 +535,16 @@ Acquire 			Acquire transport file descriptor and the MTU for read
 
 */
 func (a *MediaTransport1) Acquire() (dbus.UnixFD, uint16, uint16, error) {
+	return a.AcquireContext(context.Background())
+}
+
+// AcquireContext call Acquire, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaTransport1) AcquireContext(ctx context.Context) (dbus.UnixFD, uint16, uint16, error) {
 	
 	var val0 dbus.UnixFD
   var val1 uint16
   var val2 uint16
-	err := a.client.Call("Acquire", 0, ).Store(&val0, &val1, &val2)
+	err := a.client.CallWithContext(ctx, "Acquire", 0, ).Store(&val0, &val1, &val2)
 	return val0, val1, val2, err	
 }
 
@@ -449,11 +560,16 @@ TryAcquire 			Acquire transport file descriptor only if the transport
 
 */
 func (a *MediaTransport1) TryAcquire() (dbus.UnixFD, uint16, uint16, error) {
+	return a.TryAcquireContext(context.Background())
+}
+
+// TryAcquireContext call TryAcquire, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaTransport1) TryAcquireContext(ctx context.Context) (dbus.UnixFD, uint16, uint16, error) {
 	
 	var val0 dbus.UnixFD
   var val1 uint16
   var val2 uint16
-	err := a.client.Call("TryAcquire", 0, ).Store(&val0, &val1, &val2)
+	err := a.client.CallWithContext(ctx, "TryAcquire", 0, ).Store(&val0, &val1, &val2)
 	return val0, val1, val2, err	
 }
 
@@ -462,8 +578,13 @@ Release 			Releases file descriptor.
 
 */
 func (a *MediaTransport1) Release() error {
+	return a.ReleaseContext(context.Background())
+}
+
+// ReleaseContext call Release, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MediaTransport1) ReleaseContext(ctx context.Context) error {
 	
-	return a.client.Call("Release", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Release", 0, ).Store()
 	
 }
 
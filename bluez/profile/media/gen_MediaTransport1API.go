@@ -0,0 +1,80 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// MediaTransport1API is the interface of MediaTransport1, implemented by
+// FakeMediaTransport1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for MediaTransport1.
+type MediaTransport1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*MediaTransport1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*MediaTransport1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	SetDevice(v dbus.ObjectPath) error
+	SetDeviceContext(ctx context.Context, v dbus.ObjectPath) error
+	GetDevice() (dbus.ObjectPath, error)
+	GetDeviceContext(ctx context.Context) (dbus.ObjectPath, error)
+
+	SetUUID(v string) error
+	SetUUIDContext(ctx context.Context, v string) error
+	GetUUID() (string, error)
+	GetUUIDContext(ctx context.Context) (string, error)
+
+	SetCodec(v byte) error
+	SetCodecContext(ctx context.Context, v byte) error
+	GetCodec() (byte, error)
+	GetCodecContext(ctx context.Context) (byte, error)
+
+	SetConfiguration(v []byte) error
+	SetConfigurationContext(ctx context.Context, v []byte) error
+	GetConfiguration() ([]byte, error)
+	GetConfigurationContext(ctx context.Context) ([]byte, error)
+
+	SetState(v string) error
+	SetStateContext(ctx context.Context, v string) error
+	GetState() (string, error)
+	GetStateContext(ctx context.Context) (string, error)
+
+	SetDelay(v uint16) error
+	SetDelayContext(ctx context.Context, v uint16) error
+	GetDelay() (uint16, error)
+	GetDelayContext(ctx context.Context) (uint16, error)
+
+	SetVolume(v uint16) error
+	SetVolumeContext(ctx context.Context, v uint16) error
+	GetVolume() (uint16, error)
+	GetVolumeContext(ctx context.Context) (uint16, error)
+
+	SetEndpoint(v dbus.ObjectPath) error
+	SetEndpointContext(ctx context.Context, v dbus.ObjectPath) error
+	GetEndpoint() (dbus.ObjectPath, error)
+	GetEndpointContext(ctx context.Context) (dbus.ObjectPath, error)
+
+	Acquire() (dbus.UnixFD, uint16, uint16, error)
+	AcquireContext(ctx context.Context) (dbus.UnixFD, uint16, uint16, error)
+	TryAcquire() (dbus.UnixFD, uint16, uint16, error)
+	TryAcquireContext(ctx context.Context) (dbus.UnixFD, uint16, uint16, error)
+	Release() error
+	ReleaseContext(ctx context.Context) error
+}
+
+var _ MediaTransport1API = (*MediaTransport1)(nil)
@@ -0,0 +1,31 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package media
+
+
+// MediaTransport1State is a value documented for MediaTransport1.State
+type MediaTransport1State string
+
+const (
+	MediaTransport1StateIdle MediaTransport1State = "idle"
+	MediaTransport1StatePending MediaTransport1State = "pending"
+	MediaTransport1StateActive MediaTransport1State = "active"
+)
+
+// MediaTransport1StateValues list the documented values of MediaTransport1State
+var MediaTransport1StateValues = []MediaTransport1State{
+	MediaTransport1StateIdle,
+	MediaTransport1StatePending,
+	MediaTransport1StateActive,
+}
+
+// Valid report if v is one of the documented values
+func (v MediaTransport1State) Valid() bool {
+	for _, value := range MediaTransport1StateValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
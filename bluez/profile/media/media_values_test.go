@@ -0,0 +1,43 @@
+package media
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMediaPlayer1Values(t *testing.T) {
+
+	assert.True(t, MediaPlayer1RepeatAlltracks.Valid())
+	assert.False(t, MediaPlayer1Repeat("sometimes").Valid())
+	assert.Equal(t, MediaPlayer1Type("Audio Broadcasting"), MediaPlayer1TypeAudioBroadcasting)
+
+	path := dbus.ObjectPath("/org/bluez/hci0/dev_00_11_22_33_44_55/player0")
+	player := NewFakeMediaPlayer1(path, &MediaPlayer1Properties{
+		Repeat: string(MediaPlayer1RepeatOff),
+	})
+	defer player.Close()
+
+	err := player.SetRepeat(string(MediaPlayer1RepeatGroup))
+	assert.NoError(t, err)
+	repeat, err := player.GetRepeat()
+	assert.NoError(t, err)
+	assert.Equal(t, string(MediaPlayer1RepeatGroup), repeat)
+
+	// invalid values are rejected before calling bluez
+	err = player.SetRepeat("sometimes")
+	assert.True(t, errors.Is(err, bluez.ErrInvalidArguments))
+	var invalid *bluez.InvalidValueError
+	if assert.True(t, errors.As(err, &invalid)) {
+		assert.Equal(t, MediaPlayer1Interface, invalid.Interface)
+		assert.Equal(t, "Repeat", invalid.Member)
+		assert.Equal(t, path, invalid.Path)
+		assert.Equal(t, "sometimes", invalid.Value)
+	}
+	repeat, err = player.GetRepeat()
+	assert.NoError(t, err)
+	assert.Equal(t, string(MediaPlayer1RepeatGroup), repeat)
+}
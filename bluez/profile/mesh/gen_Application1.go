@@ -5,6 +5,7 @@ package mesh
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -21,6 +22,15 @@ var Application1Interface = "org.bluez.mesh.Application1"
 // - servicePath: unique name
 // - objectPath: <app_root>
 func NewApplication1(servicePath string, objectPath dbus.ObjectPath) (*Application1, error) {
+	return NewApplication1WithConn(nil, servicePath, objectPath)
+}
+
+// NewApplication1WithConn create a new instance of Application1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - servicePath: unique name
+// - objectPath: <app_root>
+func NewApplication1WithConn(conn *bluez.Conn, servicePath string, objectPath dbus.ObjectPath) (*Application1, error) {
 	a := new(Application1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -28,6 +38,7 @@ func NewApplication1(servicePath string, objectPath dbus.ObjectPath) (*Applicati
 			Iface: Application1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -45,11 +56,12 @@ func NewApplication1(servicePath string, objectPath dbus.ObjectPath) (*Applicati
 /*
 Application1 Mesh Application Hierarchy
 
+
+Since BlueZ 5.53
 */
 type Application1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*Application1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -59,6 +71,12 @@ type Application1 struct {
 type Application1Properties struct {
 	lock sync.RWMutex `dbus:"ignore"`
 
+	/*
+	CompanyID A 16-bit Bluetooth-assigned Company Identifier of the vendor as
+		defined by Bluetooth SIG
+	*/
+	CompanyID uint16
+
 	/*
 	ProductID A 16-bit vendor-assigned product identifier
 	*/
@@ -74,12 +92,6 @@ type Application1Properties struct {
 	*/
 	CRPL uint16
 
-	/*
-	CompanyID A 16-bit Bluetooth-assigned Company Identifier of the vendor as
-		defined by Bluetooth SIG
-	*/
-	CompanyID uint16
-
 }
 
 //Lock access to properties
@@ -97,9 +109,14 @@ func (p *Application1Properties) Unlock() {
 
 
 
-// GetProductID get ProductID value
-func (a *Application1) GetProductID() (uint16, error) {
-	v, err := a.GetProperty("ProductID")
+// GetCompanyID get CompanyID value
+func (a *Application1) GetCompanyID() (uint16, error) {
+	return a.GetCompanyIDContext(context.Background())
+}
+
+// GetCompanyIDContext get CompanyID value, failing with a bluez.ContextError if ctx is done
+func (a *Application1) GetCompanyIDContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "CompanyID")
 	if err != nil {
 		return uint16(0), err
 	}
@@ -111,9 +128,14 @@ func (a *Application1) GetProductID() (uint16, error) {
 
 
 
-// GetVersionID get VersionID value
-func (a *Application1) GetVersionID() (uint16, error) {
-	v, err := a.GetProperty("VersionID")
+// GetProductID get ProductID value
+func (a *Application1) GetProductID() (uint16, error) {
+	return a.GetProductIDContext(context.Background())
+}
+
+// GetProductIDContext get ProductID value, failing with a bluez.ContextError if ctx is done
+func (a *Application1) GetProductIDContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "ProductID")
 	if err != nil {
 		return uint16(0), err
 	}
@@ -125,9 +147,14 @@ func (a *Application1) GetVersionID() (uint16, error) {
 
 
 
-// GetCRPL get CRPL value
-func (a *Application1) GetCRPL() (uint16, error) {
-	v, err := a.GetProperty("CRPL")
+// GetVersionID get VersionID value
+func (a *Application1) GetVersionID() (uint16, error) {
+	return a.GetVersionIDContext(context.Background())
+}
+
+// GetVersionIDContext get VersionID value, failing with a bluez.ContextError if ctx is done
+func (a *Application1) GetVersionIDContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "VersionID")
 	if err != nil {
 		return uint16(0), err
 	}
@@ -139,9 +166,14 @@ func (a *Application1) GetCRPL() (uint16, error) {
 
 
 
-// GetCompanyID get CompanyID value
-func (a *Application1) GetCompanyID() (uint16, error) {
-	v, err := a.GetProperty("CompanyID")
+// GetCRPL get CRPL value
+func (a *Application1) GetCRPL() (uint16, error) {
+	return a.GetCRPLContext(context.Background())
+}
+
+// GetCRPLContext get CRPL value, failing with a bluez.ContextError if ctx is done
+func (a *Application1) GetCRPLContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "CRPL")
 	if err != nil {
 		return uint16(0), err
 	}
@@ -173,35 +205,24 @@ func (a *Application1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *Application1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *Application1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -231,20 +252,31 @@ func (a *Application1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Application1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Application1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *Application1) GetProperties() (*Application1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *Application1) GetPropertiesContext(ctx context.Context) (*Application1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -254,29 +286,40 @@ func (a *Application1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *Application1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *Application1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *Application1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *Application1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *Application1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *Application1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -286,6 +329,7 @@ func (a *Application1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *Application1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -305,8 +349,13 @@ JoinComplete 		This method is called when the node provisioning initiated
 
 */
 func (a *Application1) JoinComplete(token uint64) error {
+	return a.JoinCompleteContext(context.Background(), token)
+}
+
+// JoinCompleteContext call JoinComplete, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Application1) JoinCompleteContext(ctx context.Context, token uint64) error {
 	
-	return a.client.Call("JoinComplete", 0, token).Store()
+	return a.client.CallWithContext(ctx, "JoinComplete", 0, token).Store()
 	
 }
 
@@ -320,8 +369,13 @@ JoinFailed 		This method is called when the node provisioning initiated by
 
 */
 func (a *Application1) JoinFailed(reason string) error {
+	return a.JoinFailedContext(context.Background(), reason)
+}
+
+// JoinFailedContext call JoinFailed, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Application1) JoinFailedContext(ctx context.Context, reason string) error {
 	
-	return a.client.Call("JoinFailed", 0, reason).Store()
+	return a.client.CallWithContext(ctx, "JoinFailed", 0, reason).Store()
 	
 }
 
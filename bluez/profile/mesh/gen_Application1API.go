@@ -0,0 +1,50 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// Application1API is the interface of Application1, implemented by
+// FakeApplication1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for Application1.
+type Application1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*Application1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*Application1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	GetCompanyID() (uint16, error)
+	GetCompanyIDContext(ctx context.Context) (uint16, error)
+
+	GetProductID() (uint16, error)
+	GetProductIDContext(ctx context.Context) (uint16, error)
+
+	GetVersionID() (uint16, error)
+	GetVersionIDContext(ctx context.Context) (uint16, error)
+
+	GetCRPL() (uint16, error)
+	GetCRPLContext(ctx context.Context) (uint16, error)
+
+	JoinComplete(token uint64) error
+	JoinCompleteContext(ctx context.Context, token uint64) error
+	JoinFailed(reason string) error
+	JoinFailedContext(ctx context.Context, reason string) error
+}
+
+var _ Application1API = (*Application1)(nil)
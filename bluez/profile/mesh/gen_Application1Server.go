@@ -0,0 +1,167 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+
+import (
+   "github.com/godbus/dbus/v5"
+   "github.com/godbus/dbus/v5/introspect"
+   "github.com/godbus/dbus/v5/prop"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/bluez/profile"
+)
+
+// Application1Handler is implemented by the application to serve
+// org.bluez.mesh.Application1, see Application1 for the documentation of the
+// methods. Embed UnimplementedApplication1Handler to implement only some of them.
+type Application1Handler interface {
+	JoinComplete(token uint64) *dbus.Error
+	JoinFailed(reason string) *dbus.Error
+}
+
+// UnimplementedApplication1Handler reply org.bluez.Error.NotImplemented to
+// every method of Application1Handler
+type UnimplementedApplication1Handler struct{}
+
+// JoinComplete reply org.bluez.Error.NotImplemented
+func (UnimplementedApplication1Handler) JoinComplete(token uint64) *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+// JoinFailed reply org.bluez.Error.NotImplemented
+func (UnimplementedApplication1Handler) JoinFailed(reason string) *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+var _ Application1Handler = UnimplementedApplication1Handler{}
+
+// Application1Server export a Application1Handler on a connection,
+// forwarding the calls of bluez to it
+type Application1Server struct {
+	conn       *dbus.Conn
+	path       dbus.ObjectPath
+	handler    Application1Handler
+	properties *prop.Properties
+}
+
+// ExportApplication1 export handler as org.bluez.mesh.Application1 at path, with
+// its introspection data and the Properties interface serving properties, nil
+// for their zero values. The Introspectable interface of path only describes
+// org.bluez.mesh.Application1, use Introspection to describe objects exporting more interfaces.
+func ExportApplication1(conn *dbus.Conn, path dbus.ObjectPath, handler Application1Handler, properties *Application1Properties) (*Application1Server, error) {
+
+	s := &Application1Server{
+		conn:    conn,
+		path:    path,
+		handler: handler,
+	}
+
+	err := conn.Export(s, path, Application1Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	if properties == nil {
+		properties = new(Application1Properties)
+	}
+	propsConfig := make(map[string]*prop.Prop)
+	for name, info := range props.ParseProperties(properties) {
+		if info.Skip {
+			continue
+		}
+		p := info.Prop
+		propsConfig[name] = &p
+	}
+	s.properties = prop.New(conn, path, map[string]map[string]*prop.Prop{
+		Application1Interface: propsConfig,
+	})
+
+	node := &introspect.Node{
+		Interfaces: []introspect.Interface{
+			introspect.IntrospectData,
+			prop.IntrospectData,
+			s.Introspection(),
+		},
+	}
+	err = conn.Export(introspect.NewIntrospectable(node), path, bluez.Introspectable)
+	if err != nil {
+		s.Unexport()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Path return the object path of the server
+func (s *Application1Server) Path() dbus.ObjectPath {
+	return s.path
+}
+
+// Interface return the exported interface
+func (s *Application1Server) Interface() string {
+	return Application1Interface
+}
+
+// Handler return the handler serving the calls
+func (s *Application1Server) Handler() Application1Handler {
+	return s.handler
+}
+
+// Introspection return the introspection data of org.bluez.mesh.Application1
+func (s *Application1Server) Introspection() introspect.Interface {
+	return introspect.Interface{
+		Name:       Application1Interface,
+		Methods:    introspect.Methods(s),
+		Properties: s.properties.Introspection(Application1Interface),
+	}
+}
+
+// GetProperty return the value of a served property
+func (s *Application1Server) GetProperty(name string) (dbus.Variant, error) {
+	v, err := s.properties.Get(Application1Interface, name)
+	if err != nil {
+		return dbus.Variant{}, err
+	}
+	return v, nil
+}
+
+// SetProperty update a served property, PropertiesChanged is emitted as set
+// by its dbus tag
+func (s *Application1Server) SetProperty(name string, value interface{}) error {
+	_, err := s.properties.Get(Application1Interface, name)
+	if err != nil {
+		return err
+	}
+	s.properties.SetMust(Application1Interface, name, value)
+	return nil
+}
+
+// Unexport remove the object from the connection
+func (s *Application1Server) Unexport() error {
+	err := s.conn.Export(nil, s.path, Application1Interface)
+	if err != nil {
+		return err
+	}
+	err = s.conn.Export(nil, s.path, bluez.PropertiesInterface)
+	if err != nil {
+		return err
+	}
+	return s.conn.Export(nil, s.path, bluez.Introspectable)
+}
+
+// JoinComplete forward the call to the handler
+func (s *Application1Server) JoinComplete(token uint64) *dbus.Error {
+	return s.handler.JoinComplete(token)
+}
+
+// JoinFailed forward the call to the handler
+func (s *Application1Server) JoinFailed(reason string) *dbus.Error {
+	return s.handler.JoinFailed(reason)
+}
+
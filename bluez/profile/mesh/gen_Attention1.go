@@ -5,6 +5,7 @@ package mesh
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -21,6 +22,15 @@ var Attention1Interface = "org.bluez.mesh.Attention1"
 // - servicePath: unique name
 // - objectPath: freely definable
 func NewAttention1(servicePath string, objectPath dbus.ObjectPath) (*Attention1, error) {
+	return NewAttention1WithConn(nil, servicePath, objectPath)
+}
+
+// NewAttention1WithConn create a new instance of Attention1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - servicePath: unique name
+// - objectPath: freely definable
+func NewAttention1WithConn(conn *bluez.Conn, servicePath string, objectPath dbus.ObjectPath) (*Attention1, error) {
 	a := new(Attention1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -28,6 +38,7 @@ func NewAttention1(servicePath string, objectPath dbus.ObjectPath) (*Attention1,
 			Iface: Attention1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -45,11 +56,12 @@ func NewAttention1(servicePath string, objectPath dbus.ObjectPath) (*Attention1,
 /*
 Attention1 Mesh Attention Hierarchy
 
+
+Since BlueZ 5.53
 */
 type Attention1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*Attention1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -96,35 +108,24 @@ func (a *Attention1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *Attention1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *Attention1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -154,20 +155,31 @@ func (a *Attention1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Attention1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Attention1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *Attention1) GetProperties() (*Attention1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *Attention1) GetPropertiesContext(ctx context.Context) (*Attention1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -177,29 +189,40 @@ func (a *Attention1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *Attention1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *Attention1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *Attention1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *Attention1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *Attention1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *Attention1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -209,6 +232,7 @@ func (a *Attention1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *Attention1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -226,8 +250,13 @@ SetTimer 		The element_index parameter is the element's index within the
 
 */
 func (a *Attention1) SetTimer(element_index uint8, time uint16) error {
+	return a.SetTimerContext(context.Background(), element_index, time)
+}
+
+// SetTimerContext call SetTimer, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Attention1) SetTimerContext(ctx context.Context, element_index uint8, time uint16) error {
 	
-	return a.client.Call("SetTimer", 0, element_index, time).Store()
+	return a.client.CallWithContext(ctx, "SetTimer", 0, element_index, time).Store()
 	
 }
 
@@ -241,9 +270,14 @@ GetTimer 		The element parameter is the unicast address within the node
 
 */
 func (a *Attention1) GetTimer(element uint16) (uint16, error) {
+	return a.GetTimerContext(context.Background(), element)
+}
+
+// GetTimerContext call GetTimer, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Attention1) GetTimerContext(ctx context.Context, element uint16) (uint16, error) {
 	
 	var val0 uint16
-	err := a.client.Call("GetTimer", 0, element).Store(&val0)
+	err := a.client.CallWithContext(ctx, "GetTimer", 0, element).Store(&val0)
 	return val0, err	
 }
 
@@ -0,0 +1,38 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// Attention1API is the interface of Attention1, implemented by
+// FakeAttention1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for Attention1.
+type Attention1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*Attention1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*Attention1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	SetTimer(element_index uint8, time uint16) error
+	SetTimerContext(ctx context.Context, element_index uint8, time uint16) error
+	GetTimer(element uint16) (uint16, error)
+	GetTimerContext(ctx context.Context, element uint16) (uint16, error)
+}
+
+var _ Attention1API = (*Attention1)(nil)
@@ -5,6 +5,7 @@ package mesh
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -21,6 +22,15 @@ var Element1Interface = "org.bluez.mesh.Element1"
 // - servicePath: unique name
 // - objectPath: <app_defined_element_path>
 func NewElement1(servicePath string, objectPath dbus.ObjectPath) (*Element1, error) {
+	return NewElement1WithConn(nil, servicePath, objectPath)
+}
+
+// NewElement1WithConn create a new instance of Element1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - servicePath: unique name
+// - objectPath: <app_defined_element_path>
+func NewElement1WithConn(conn *bluez.Conn, servicePath string, objectPath dbus.ObjectPath) (*Element1, error) {
 	a := new(Element1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -28,6 +38,7 @@ func NewElement1(servicePath string, objectPath dbus.ObjectPath) (*Element1, err
 			Iface: Element1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -45,11 +56,12 @@ func NewElement1(servicePath string, objectPath dbus.ObjectPath) (*Element1, err
 /*
 Element1 Mesh Element Hierarchy
 
+
+Since BlueZ 5.53
 */
 type Element1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*Element1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -98,7 +110,12 @@ func (p *Element1Properties) Unlock() {
 
 // GetModels get Models value
 func (a *Element1) GetModels() ([]uint16, error) {
-	v, err := a.GetProperty("Models")
+	return a.GetModelsContext(context.Background())
+}
+
+// GetModelsContext get Models value, failing with a bluez.ContextError if ctx is done
+func (a *Element1) GetModelsContext(ctx context.Context) ([]uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Models")
 	if err != nil {
 		return []uint16{}, err
 	}
@@ -112,7 +129,12 @@ func (a *Element1) GetModels() ([]uint16, error) {
 
 // GetVendorModels get VendorModels value
 func (a *Element1) GetVendorModels() ([]VendorItem, error) {
-	v, err := a.GetProperty("VendorModels")
+	return a.GetVendorModelsContext(context.Background())
+}
+
+// GetVendorModelsContext get VendorModels value, failing with a bluez.ContextError if ctx is done
+func (a *Element1) GetVendorModelsContext(ctx context.Context) ([]VendorItem, error) {
+	v, err := a.GetPropertyContext(ctx, "VendorModels")
 	if err != nil {
 		return []VendorItem{}, err
 	}
@@ -126,7 +148,12 @@ func (a *Element1) GetVendorModels() ([]VendorItem, error) {
 
 // GetLocation get Location value
 func (a *Element1) GetLocation() (uint16, error) {
-	v, err := a.GetProperty("Location")
+	return a.GetLocationContext(context.Background())
+}
+
+// GetLocationContext get Location value, failing with a bluez.ContextError if ctx is done
+func (a *Element1) GetLocationContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Location")
 	if err != nil {
 		return uint16(0), err
 	}
@@ -158,35 +185,24 @@ func (a *Element1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *Element1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *Element1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -216,20 +232,31 @@ func (a *Element1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Element1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Element1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *Element1) GetProperties() (*Element1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *Element1) GetPropertiesContext(ctx context.Context) (*Element1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -239,29 +266,40 @@ func (a *Element1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *Element1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *Element1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *Element1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *Element1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *Element1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *Element1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -271,6 +309,7 @@ func (a *Element1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *Element1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -298,8 +337,13 @@ MessageReceived 		This method is called by bluetooth-meshd daemon when a message
 
 */
 func (a *Element1) MessageReceived(source uint16, key_index uint16, destination dbus.Variant, data []byte) error {
+	return a.MessageReceivedContext(context.Background(), source, key_index, destination, data)
+}
+
+// MessageReceivedContext call MessageReceived, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Element1) MessageReceivedContext(ctx context.Context, source uint16, key_index uint16, destination dbus.Variant, data []byte) error {
 	
-	return a.client.Call("MessageReceived", 0, source, key_index, destination, data).Store()
+	return a.client.CallWithContext(ctx, "MessageReceived", 0, source, key_index, destination, data).Store()
 	
 }
 
@@ -320,8 +364,13 @@ DevKeyMessageReceived 		This method is called by meshd daemon when a message arr
 
 */
 func (a *Element1) DevKeyMessageReceived(source uint16, remote bool, net_index uint16, data []byte) error {
+	return a.DevKeyMessageReceivedContext(context.Background(), source, remote, net_index, data)
+}
+
+// DevKeyMessageReceivedContext call DevKeyMessageReceived, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Element1) DevKeyMessageReceivedContext(ctx context.Context, source uint16, remote bool, net_index uint16, data []byte) error {
 	
-	return a.client.Call("DevKeyMessageReceived", 0, source, remote, net_index, data).Store()
+	return a.client.CallWithContext(ctx, "DevKeyMessageReceived", 0, source, remote, net_index, data).Store()
 	
 }
 
@@ -347,8 +396,13 @@ UpdateModelConfiguration 		This method is called by bluetooth-meshd daemon when
 
 */
 func (a *Element1) UpdateModelConfiguration(model_id uint16, config map[string]interface{}) error {
+	return a.UpdateModelConfigurationContext(context.Background(), model_id, config)
+}
+
+// UpdateModelConfigurationContext call UpdateModelConfiguration, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Element1) UpdateModelConfigurationContext(ctx context.Context, model_id uint16, config map[string]interface{}) error {
 	
-	return a.client.Call("UpdateModelConfiguration", 0, model_id, config).Store()
+	return a.client.CallWithContext(ctx, "UpdateModelConfiguration", 0, model_id, config).Store()
 	
 }
 
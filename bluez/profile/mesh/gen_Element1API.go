@@ -0,0 +1,49 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// Element1API is the interface of Element1, implemented by
+// FakeElement1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for Element1.
+type Element1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*Element1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*Element1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	GetModels() ([]uint16, error)
+	GetModelsContext(ctx context.Context) ([]uint16, error)
+
+	GetVendorModels() ([]VendorItem, error)
+	GetVendorModelsContext(ctx context.Context) ([]VendorItem, error)
+
+	GetLocation() (uint16, error)
+	GetLocationContext(ctx context.Context) (uint16, error)
+
+	MessageReceived(source uint16, key_index uint16, destination dbus.Variant, data []byte) error
+	MessageReceivedContext(ctx context.Context, source uint16, key_index uint16, destination dbus.Variant, data []byte) error
+	DevKeyMessageReceived(source uint16, remote bool, net_index uint16, data []byte) error
+	DevKeyMessageReceivedContext(ctx context.Context, source uint16, remote bool, net_index uint16, data []byte) error
+	UpdateModelConfiguration(model_id uint16, config map[string]interface{}) error
+	UpdateModelConfigurationContext(ctx context.Context, model_id uint16, config map[string]interface{}) error
+}
+
+var _ Element1API = (*Element1)(nil)
@@ -0,0 +1,180 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+
+import (
+   "github.com/godbus/dbus/v5"
+   "github.com/godbus/dbus/v5/introspect"
+   "github.com/godbus/dbus/v5/prop"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/bluez/profile"
+)
+
+// Element1Handler is implemented by the application to serve
+// org.bluez.mesh.Element1, see Element1 for the documentation of the
+// methods. Embed UnimplementedElement1Handler to implement only some of them.
+type Element1Handler interface {
+	MessageReceived(source uint16, key_index uint16, destination dbus.Variant, data []byte) *dbus.Error
+	DevKeyMessageReceived(source uint16, remote bool, net_index uint16, data []byte) *dbus.Error
+	UpdateModelConfiguration(model_id uint16, config map[string]interface{}) *dbus.Error
+}
+
+// UnimplementedElement1Handler reply org.bluez.Error.NotImplemented to
+// every method of Element1Handler
+type UnimplementedElement1Handler struct{}
+
+// MessageReceived reply org.bluez.Error.NotImplemented
+func (UnimplementedElement1Handler) MessageReceived(source uint16, key_index uint16, destination dbus.Variant, data []byte) *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+// DevKeyMessageReceived reply org.bluez.Error.NotImplemented
+func (UnimplementedElement1Handler) DevKeyMessageReceived(source uint16, remote bool, net_index uint16, data []byte) *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+// UpdateModelConfiguration reply org.bluez.Error.NotImplemented
+func (UnimplementedElement1Handler) UpdateModelConfiguration(model_id uint16, config map[string]interface{}) *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+var _ Element1Handler = UnimplementedElement1Handler{}
+
+// Element1Server export a Element1Handler on a connection,
+// forwarding the calls of bluez to it
+type Element1Server struct {
+	conn       *dbus.Conn
+	path       dbus.ObjectPath
+	handler    Element1Handler
+	properties *prop.Properties
+}
+
+// ExportElement1 export handler as org.bluez.mesh.Element1 at path, with
+// its introspection data and the Properties interface serving properties, nil
+// for their zero values. The Introspectable interface of path only describes
+// org.bluez.mesh.Element1, use Introspection to describe objects exporting more interfaces.
+func ExportElement1(conn *dbus.Conn, path dbus.ObjectPath, handler Element1Handler, properties *Element1Properties) (*Element1Server, error) {
+
+	s := &Element1Server{
+		conn:    conn,
+		path:    path,
+		handler: handler,
+	}
+
+	err := conn.Export(s, path, Element1Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	if properties == nil {
+		properties = new(Element1Properties)
+	}
+	propsConfig := make(map[string]*prop.Prop)
+	for name, info := range props.ParseProperties(properties) {
+		if info.Skip {
+			continue
+		}
+		p := info.Prop
+		propsConfig[name] = &p
+	}
+	s.properties = prop.New(conn, path, map[string]map[string]*prop.Prop{
+		Element1Interface: propsConfig,
+	})
+
+	node := &introspect.Node{
+		Interfaces: []introspect.Interface{
+			introspect.IntrospectData,
+			prop.IntrospectData,
+			s.Introspection(),
+		},
+	}
+	err = conn.Export(introspect.NewIntrospectable(node), path, bluez.Introspectable)
+	if err != nil {
+		s.Unexport()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Path return the object path of the server
+func (s *Element1Server) Path() dbus.ObjectPath {
+	return s.path
+}
+
+// Interface return the exported interface
+func (s *Element1Server) Interface() string {
+	return Element1Interface
+}
+
+// Handler return the handler serving the calls
+func (s *Element1Server) Handler() Element1Handler {
+	return s.handler
+}
+
+// Introspection return the introspection data of org.bluez.mesh.Element1
+func (s *Element1Server) Introspection() introspect.Interface {
+	return introspect.Interface{
+		Name:       Element1Interface,
+		Methods:    introspect.Methods(s),
+		Properties: s.properties.Introspection(Element1Interface),
+	}
+}
+
+// GetProperty return the value of a served property
+func (s *Element1Server) GetProperty(name string) (dbus.Variant, error) {
+	v, err := s.properties.Get(Element1Interface, name)
+	if err != nil {
+		return dbus.Variant{}, err
+	}
+	return v, nil
+}
+
+// SetProperty update a served property, PropertiesChanged is emitted as set
+// by its dbus tag
+func (s *Element1Server) SetProperty(name string, value interface{}) error {
+	_, err := s.properties.Get(Element1Interface, name)
+	if err != nil {
+		return err
+	}
+	s.properties.SetMust(Element1Interface, name, value)
+	return nil
+}
+
+// Unexport remove the object from the connection
+func (s *Element1Server) Unexport() error {
+	err := s.conn.Export(nil, s.path, Element1Interface)
+	if err != nil {
+		return err
+	}
+	err = s.conn.Export(nil, s.path, bluez.PropertiesInterface)
+	if err != nil {
+		return err
+	}
+	return s.conn.Export(nil, s.path, bluez.Introspectable)
+}
+
+// MessageReceived forward the call to the handler
+func (s *Element1Server) MessageReceived(source uint16, key_index uint16, destination dbus.Variant, data []byte) *dbus.Error {
+	return s.handler.MessageReceived(source, key_index, destination, data)
+}
+
+// DevKeyMessageReceived forward the call to the handler
+func (s *Element1Server) DevKeyMessageReceived(source uint16, remote bool, net_index uint16, data []byte) *dbus.Error {
+	return s.handler.DevKeyMessageReceived(source, remote, net_index, data)
+}
+
+// UpdateModelConfiguration forward the call to the handler
+func (s *Element1Server) UpdateModelConfiguration(model_id uint16, config map[string]interface{}) *dbus.Error {
+	return s.handler.UpdateModelConfiguration(model_id, config)
+}
+
@@ -0,0 +1,245 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeApplication1 is an in-memory Application1API to test the code depending on
+// Application1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeApplication1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// JoinCompleteFunc handle the calls to JoinComplete
+	JoinCompleteFunc func(ctx context.Context, token uint64) error
+	// JoinFailedFunc handle the calls to JoinFailed
+	JoinFailedFunc func(ctx context.Context, reason string) error
+}
+
+var _ Application1API = (*FakeApplication1)(nil)
+
+
+// NewFakeApplication1 create a FakeApplication1 with the initial properties, nil for none
+func NewFakeApplication1(objectPath dbus.ObjectPath, properties *Application1Properties) *FakeApplication1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeApplication1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(Application1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeApplication1 object path
+func (a *FakeApplication1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return Application1 interface
+func (a *FakeApplication1) Interface() string {
+	return Application1Interface
+}
+
+// Close stop the watchers
+func (a *FakeApplication1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeApplication1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeApplication1) GetProperties() (*Application1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeApplication1) GetPropertiesContext(ctx context.Context) (*Application1Properties, error) {
+	return new(Application1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeApplication1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeApplication1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeApplication1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeApplication1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeApplication1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeApplication1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeApplication1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+
+
+// GetCompanyID get CompanyID value
+func (a *FakeApplication1) GetCompanyID() (uint16, error) {
+	return a.GetCompanyIDContext(context.Background())
+}
+
+// GetCompanyIDContext get CompanyID value
+func (a *FakeApplication1) GetCompanyIDContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "CompanyID")
+	if err != nil {
+		return uint16(0), err
+	}
+	value, ok := v.Value().(uint16)
+	if !ok {
+		return uint16(0), fmt.Errorf("CompanyID: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+
+// GetProductID get ProductID value
+func (a *FakeApplication1) GetProductID() (uint16, error) {
+	return a.GetProductIDContext(context.Background())
+}
+
+// GetProductIDContext get ProductID value
+func (a *FakeApplication1) GetProductIDContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "ProductID")
+	if err != nil {
+		return uint16(0), err
+	}
+	value, ok := v.Value().(uint16)
+	if !ok {
+		return uint16(0), fmt.Errorf("ProductID: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+
+// GetVersionID get VersionID value
+func (a *FakeApplication1) GetVersionID() (uint16, error) {
+	return a.GetVersionIDContext(context.Background())
+}
+
+// GetVersionIDContext get VersionID value
+func (a *FakeApplication1) GetVersionIDContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "VersionID")
+	if err != nil {
+		return uint16(0), err
+	}
+	value, ok := v.Value().(uint16)
+	if !ok {
+		return uint16(0), fmt.Errorf("VersionID: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+
+// GetCRPL get CRPL value
+func (a *FakeApplication1) GetCRPL() (uint16, error) {
+	return a.GetCRPLContext(context.Background())
+}
+
+// GetCRPLContext get CRPL value
+func (a *FakeApplication1) GetCRPLContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "CRPL")
+	if err != nil {
+		return uint16(0), err
+	}
+	value, ok := v.Value().(uint16)
+	if !ok {
+		return uint16(0), fmt.Errorf("CRPL: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+// JoinComplete call JoinCompleteFunc
+func (a *FakeApplication1) JoinComplete(token uint64) error {
+	return a.JoinCompleteContext(context.Background(), token)
+}
+
+// JoinCompleteContext call JoinCompleteFunc
+func (a *FakeApplication1) JoinCompleteContext(ctx context.Context, token uint64) error {
+	if a.JoinCompleteFunc != nil {
+		return a.JoinCompleteFunc(ctx, token)
+	}
+	
+	return nil
+	
+}
+
+// JoinFailed call JoinFailedFunc
+func (a *FakeApplication1) JoinFailed(reason string) error {
+	return a.JoinFailedContext(context.Background(), reason)
+}
+
+// JoinFailedContext call JoinFailedFunc
+func (a *FakeApplication1) JoinFailedContext(ctx context.Context, reason string) error {
+	if a.JoinFailedFunc != nil {
+		return a.JoinFailedFunc(ctx, reason)
+	}
+	
+	return nil
+	
+}
+
@@ -0,0 +1,156 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+
+import (
+   "context"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeAttention1 is an in-memory Attention1API to test the code depending on
+// Attention1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeAttention1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// SetTimerFunc handle the calls to SetTimer
+	SetTimerFunc func(ctx context.Context, element_index uint8, time uint16) error
+	// GetTimerFunc handle the calls to GetTimer
+	GetTimerFunc func(ctx context.Context, element uint16) (uint16, error)
+}
+
+var _ Attention1API = (*FakeAttention1)(nil)
+
+
+// NewFakeAttention1 create a FakeAttention1 with the initial properties, nil for none
+func NewFakeAttention1(objectPath dbus.ObjectPath, properties *Attention1Properties) *FakeAttention1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeAttention1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(Attention1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeAttention1 object path
+func (a *FakeAttention1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return Attention1 interface
+func (a *FakeAttention1) Interface() string {
+	return Attention1Interface
+}
+
+// Close stop the watchers
+func (a *FakeAttention1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeAttention1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeAttention1) GetProperties() (*Attention1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeAttention1) GetPropertiesContext(ctx context.Context) (*Attention1Properties, error) {
+	return new(Attention1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeAttention1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeAttention1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeAttention1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeAttention1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeAttention1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeAttention1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeAttention1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+
+// SetTimer call SetTimerFunc
+func (a *FakeAttention1) SetTimer(element_index uint8, time uint16) error {
+	return a.SetTimerContext(context.Background(), element_index, time)
+}
+
+// SetTimerContext call SetTimerFunc
+func (a *FakeAttention1) SetTimerContext(ctx context.Context, element_index uint8, time uint16) error {
+	if a.SetTimerFunc != nil {
+		return a.SetTimerFunc(ctx, element_index, time)
+	}
+	
+	return nil
+	
+}
+
+// GetTimer call GetTimerFunc
+func (a *FakeAttention1) GetTimer(element uint16) (uint16, error) {
+	return a.GetTimerContext(context.Background(), element)
+}
+
+// GetTimerContext call GetTimerFunc
+func (a *FakeAttention1) GetTimerContext(ctx context.Context, element uint16) (uint16, error) {
+	if a.GetTimerFunc != nil {
+		return a.GetTimerFunc(ctx, element)
+	}
+	
+	var val0 uint16
+	return val0, nil	
+}
+
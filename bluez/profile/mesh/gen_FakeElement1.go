@@ -0,0 +1,240 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeElement1 is an in-memory Element1API to test the code depending on
+// Element1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeElement1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// MessageReceivedFunc handle the calls to MessageReceived
+	MessageReceivedFunc func(ctx context.Context, source uint16, key_index uint16, destination dbus.Variant, data []byte) error
+	// DevKeyMessageReceivedFunc handle the calls to DevKeyMessageReceived
+	DevKeyMessageReceivedFunc func(ctx context.Context, source uint16, remote bool, net_index uint16, data []byte) error
+	// UpdateModelConfigurationFunc handle the calls to UpdateModelConfiguration
+	UpdateModelConfigurationFunc func(ctx context.Context, model_id uint16, config map[string]interface{}) error
+}
+
+var _ Element1API = (*FakeElement1)(nil)
+
+
+// NewFakeElement1 create a FakeElement1 with the initial properties, nil for none
+func NewFakeElement1(objectPath dbus.ObjectPath, properties *Element1Properties) *FakeElement1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeElement1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(Element1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeElement1 object path
+func (a *FakeElement1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return Element1 interface
+func (a *FakeElement1) Interface() string {
+	return Element1Interface
+}
+
+// Close stop the watchers
+func (a *FakeElement1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeElement1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeElement1) GetProperties() (*Element1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeElement1) GetPropertiesContext(ctx context.Context) (*Element1Properties, error) {
+	return new(Element1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeElement1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeElement1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeElement1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeElement1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeElement1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeElement1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeElement1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+
+
+// GetModels get Models value
+func (a *FakeElement1) GetModels() ([]uint16, error) {
+	return a.GetModelsContext(context.Background())
+}
+
+// GetModelsContext get Models value
+func (a *FakeElement1) GetModelsContext(ctx context.Context) ([]uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Models")
+	if err != nil {
+		return []uint16{}, err
+	}
+	value, ok := v.Value().([]uint16)
+	if !ok {
+		return []uint16{}, fmt.Errorf("Models: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+
+// GetVendorModels get VendorModels value
+func (a *FakeElement1) GetVendorModels() ([]VendorItem, error) {
+	return a.GetVendorModelsContext(context.Background())
+}
+
+// GetVendorModelsContext get VendorModels value
+func (a *FakeElement1) GetVendorModelsContext(ctx context.Context) ([]VendorItem, error) {
+	v, err := a.GetPropertyContext(ctx, "VendorModels")
+	if err != nil {
+		return []VendorItem{}, err
+	}
+	value, ok := v.Value().([]VendorItem)
+	if !ok {
+		return []VendorItem{}, fmt.Errorf("VendorModels: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+
+// GetLocation get Location value
+func (a *FakeElement1) GetLocation() (uint16, error) {
+	return a.GetLocationContext(context.Background())
+}
+
+// GetLocationContext get Location value
+func (a *FakeElement1) GetLocationContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Location")
+	if err != nil {
+		return uint16(0), err
+	}
+	value, ok := v.Value().(uint16)
+	if !ok {
+		return uint16(0), fmt.Errorf("Location: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+// MessageReceived call MessageReceivedFunc
+func (a *FakeElement1) MessageReceived(source uint16, key_index uint16, destination dbus.Variant, data []byte) error {
+	return a.MessageReceivedContext(context.Background(), source, key_index, destination, data)
+}
+
+// MessageReceivedContext call MessageReceivedFunc
+func (a *FakeElement1) MessageReceivedContext(ctx context.Context, source uint16, key_index uint16, destination dbus.Variant, data []byte) error {
+	if a.MessageReceivedFunc != nil {
+		return a.MessageReceivedFunc(ctx, source, key_index, destination, data)
+	}
+	
+	return nil
+	
+}
+
+// DevKeyMessageReceived call DevKeyMessageReceivedFunc
+func (a *FakeElement1) DevKeyMessageReceived(source uint16, remote bool, net_index uint16, data []byte) error {
+	return a.DevKeyMessageReceivedContext(context.Background(), source, remote, net_index, data)
+}
+
+// DevKeyMessageReceivedContext call DevKeyMessageReceivedFunc
+func (a *FakeElement1) DevKeyMessageReceivedContext(ctx context.Context, source uint16, remote bool, net_index uint16, data []byte) error {
+	if a.DevKeyMessageReceivedFunc != nil {
+		return a.DevKeyMessageReceivedFunc(ctx, source, remote, net_index, data)
+	}
+	
+	return nil
+	
+}
+
+// UpdateModelConfiguration call UpdateModelConfigurationFunc
+func (a *FakeElement1) UpdateModelConfiguration(model_id uint16, config map[string]interface{}) error {
+	return a.UpdateModelConfigurationContext(context.Background(), model_id, config)
+}
+
+// UpdateModelConfigurationContext call UpdateModelConfigurationFunc
+func (a *FakeElement1) UpdateModelConfigurationContext(ctx context.Context, model_id uint16, config map[string]interface{}) error {
+	if a.UpdateModelConfigurationFunc != nil {
+		return a.UpdateModelConfigurationFunc(ctx, model_id, config)
+	}
+	
+	return nil
+	
+}
+
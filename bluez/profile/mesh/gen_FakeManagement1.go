@@ -0,0 +1,360 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+
+import (
+   "context"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeManagement1 is an in-memory Management1API to test the code depending on
+// Management1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeManagement1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// UnprovisionedScanFunc handle the calls to UnprovisionedScan
+	UnprovisionedScanFunc func(ctx context.Context, seconds uint16) error
+	// UnprovisionedScanCancelFunc handle the calls to UnprovisionedScanCancel
+	UnprovisionedScanCancelFunc func(ctx context.Context) error
+	// AddNodeFunc handle the calls to AddNode
+	AddNodeFunc func(ctx context.Context, uuid []byte) error
+	// CreateSubnetFunc handle the calls to CreateSubnet
+	CreateSubnetFunc func(ctx context.Context, net_index uint16) error
+	// ImportSubnetFunc handle the calls to ImportSubnet
+	ImportSubnetFunc func(ctx context.Context, net_index uint16, net_key []byte) error
+	// UpdateSubnetFunc handle the calls to UpdateSubnet
+	UpdateSubnetFunc func(ctx context.Context, net_index uint16) error
+	// DeleteSubnetFunc handle the calls to DeleteSubnet
+	DeleteSubnetFunc func(ctx context.Context, net_index uint16) error
+	// SetKeyPhaseFunc handle the calls to SetKeyPhase
+	SetKeyPhaseFunc func(ctx context.Context, net_index uint16, phase uint8) error
+	// CreateAppKeyFunc handle the calls to CreateAppKey
+	CreateAppKeyFunc func(ctx context.Context, net_index uint16, app_index uint16) error
+	// ImportAppKeyFunc handle the calls to ImportAppKey
+	ImportAppKeyFunc func(ctx context.Context, net_index uint16, app_index uint16, app_key []byte) error
+	// UpdateAppKeyFunc handle the calls to UpdateAppKey
+	UpdateAppKeyFunc func(ctx context.Context, app_index uint16) error
+	// DeleteAppKeyFunc handle the calls to DeleteAppKey
+	DeleteAppKeyFunc func(ctx context.Context, app_index uint16) error
+	// ImportRemoteNodeFunc handle the calls to ImportRemoteNode
+	ImportRemoteNodeFunc func(ctx context.Context, primary uint16, count uint8, device_key []byte) error
+	// DeleteRemoteNodeFunc handle the calls to DeleteRemoteNode
+	DeleteRemoteNodeFunc func(ctx context.Context, primary uint16, count uint8) error
+}
+
+var _ Management1API = (*FakeManagement1)(nil)
+
+
+// NewFakeManagement1 create a FakeManagement1 with the initial properties, nil for none
+func NewFakeManagement1(objectPath dbus.ObjectPath, properties *Management1Properties) *FakeManagement1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeManagement1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(Management1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeManagement1 object path
+func (a *FakeManagement1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return Management1 interface
+func (a *FakeManagement1) Interface() string {
+	return Management1Interface
+}
+
+// Close stop the watchers
+func (a *FakeManagement1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeManagement1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeManagement1) GetProperties() (*Management1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeManagement1) GetPropertiesContext(ctx context.Context) (*Management1Properties, error) {
+	return new(Management1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeManagement1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeManagement1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeManagement1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeManagement1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeManagement1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeManagement1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeManagement1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+
+// UnprovisionedScan call UnprovisionedScanFunc
+func (a *FakeManagement1) UnprovisionedScan(seconds uint16) error {
+	return a.UnprovisionedScanContext(context.Background(), seconds)
+}
+
+// UnprovisionedScanContext call UnprovisionedScanFunc
+func (a *FakeManagement1) UnprovisionedScanContext(ctx context.Context, seconds uint16) error {
+	if a.UnprovisionedScanFunc != nil {
+		return a.UnprovisionedScanFunc(ctx, seconds)
+	}
+	
+	return nil
+	
+}
+
+// UnprovisionedScanCancel call UnprovisionedScanCancelFunc
+func (a *FakeManagement1) UnprovisionedScanCancel() error {
+	return a.UnprovisionedScanCancelContext(context.Background())
+}
+
+// UnprovisionedScanCancelContext call UnprovisionedScanCancelFunc
+func (a *FakeManagement1) UnprovisionedScanCancelContext(ctx context.Context) error {
+	if a.UnprovisionedScanCancelFunc != nil {
+		return a.UnprovisionedScanCancelFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// AddNode call AddNodeFunc
+func (a *FakeManagement1) AddNode(uuid []byte) error {
+	return a.AddNodeContext(context.Background(), uuid)
+}
+
+// AddNodeContext call AddNodeFunc
+func (a *FakeManagement1) AddNodeContext(ctx context.Context, uuid []byte) error {
+	if a.AddNodeFunc != nil {
+		return a.AddNodeFunc(ctx, uuid)
+	}
+	
+	return nil
+	
+}
+
+// CreateSubnet call CreateSubnetFunc
+func (a *FakeManagement1) CreateSubnet(net_index uint16) error {
+	return a.CreateSubnetContext(context.Background(), net_index)
+}
+
+// CreateSubnetContext call CreateSubnetFunc
+func (a *FakeManagement1) CreateSubnetContext(ctx context.Context, net_index uint16) error {
+	if a.CreateSubnetFunc != nil {
+		return a.CreateSubnetFunc(ctx, net_index)
+	}
+	
+	return nil
+	
+}
+
+// ImportSubnet call ImportSubnetFunc
+func (a *FakeManagement1) ImportSubnet(net_index uint16, net_key []byte) error {
+	return a.ImportSubnetContext(context.Background(), net_index, net_key)
+}
+
+// ImportSubnetContext call ImportSubnetFunc
+func (a *FakeManagement1) ImportSubnetContext(ctx context.Context, net_index uint16, net_key []byte) error {
+	if a.ImportSubnetFunc != nil {
+		return a.ImportSubnetFunc(ctx, net_index, net_key)
+	}
+	
+	return nil
+	
+}
+
+// UpdateSubnet call UpdateSubnetFunc
+func (a *FakeManagement1) UpdateSubnet(net_index uint16) error {
+	return a.UpdateSubnetContext(context.Background(), net_index)
+}
+
+// UpdateSubnetContext call UpdateSubnetFunc
+func (a *FakeManagement1) UpdateSubnetContext(ctx context.Context, net_index uint16) error {
+	if a.UpdateSubnetFunc != nil {
+		return a.UpdateSubnetFunc(ctx, net_index)
+	}
+	
+	return nil
+	
+}
+
+// DeleteSubnet call DeleteSubnetFunc
+func (a *FakeManagement1) DeleteSubnet(net_index uint16) error {
+	return a.DeleteSubnetContext(context.Background(), net_index)
+}
+
+// DeleteSubnetContext call DeleteSubnetFunc
+func (a *FakeManagement1) DeleteSubnetContext(ctx context.Context, net_index uint16) error {
+	if a.DeleteSubnetFunc != nil {
+		return a.DeleteSubnetFunc(ctx, net_index)
+	}
+	
+	return nil
+	
+}
+
+// SetKeyPhase call SetKeyPhaseFunc
+func (a *FakeManagement1) SetKeyPhase(net_index uint16, phase uint8) error {
+	return a.SetKeyPhaseContext(context.Background(), net_index, phase)
+}
+
+// SetKeyPhaseContext call SetKeyPhaseFunc
+func (a *FakeManagement1) SetKeyPhaseContext(ctx context.Context, net_index uint16, phase uint8) error {
+	if a.SetKeyPhaseFunc != nil {
+		return a.SetKeyPhaseFunc(ctx, net_index, phase)
+	}
+	
+	return nil
+	
+}
+
+// CreateAppKey call CreateAppKeyFunc
+func (a *FakeManagement1) CreateAppKey(net_index uint16, app_index uint16) error {
+	return a.CreateAppKeyContext(context.Background(), net_index, app_index)
+}
+
+// CreateAppKeyContext call CreateAppKeyFunc
+func (a *FakeManagement1) CreateAppKeyContext(ctx context.Context, net_index uint16, app_index uint16) error {
+	if a.CreateAppKeyFunc != nil {
+		return a.CreateAppKeyFunc(ctx, net_index, app_index)
+	}
+	
+	return nil
+	
+}
+
+// ImportAppKey call ImportAppKeyFunc
+func (a *FakeManagement1) ImportAppKey(net_index uint16, app_index uint16, app_key []byte) error {
+	return a.ImportAppKeyContext(context.Background(), net_index, app_index, app_key)
+}
+
+// ImportAppKeyContext call ImportAppKeyFunc
+func (a *FakeManagement1) ImportAppKeyContext(ctx context.Context, net_index uint16, app_index uint16, app_key []byte) error {
+	if a.ImportAppKeyFunc != nil {
+		return a.ImportAppKeyFunc(ctx, net_index, app_index, app_key)
+	}
+	
+	return nil
+	
+}
+
+// UpdateAppKey call UpdateAppKeyFunc
+func (a *FakeManagement1) UpdateAppKey(app_index uint16) error {
+	return a.UpdateAppKeyContext(context.Background(), app_index)
+}
+
+// UpdateAppKeyContext call UpdateAppKeyFunc
+func (a *FakeManagement1) UpdateAppKeyContext(ctx context.Context, app_index uint16) error {
+	if a.UpdateAppKeyFunc != nil {
+		return a.UpdateAppKeyFunc(ctx, app_index)
+	}
+	
+	return nil
+	
+}
+
+// DeleteAppKey call DeleteAppKeyFunc
+func (a *FakeManagement1) DeleteAppKey(app_index uint16) error {
+	return a.DeleteAppKeyContext(context.Background(), app_index)
+}
+
+// DeleteAppKeyContext call DeleteAppKeyFunc
+func (a *FakeManagement1) DeleteAppKeyContext(ctx context.Context, app_index uint16) error {
+	if a.DeleteAppKeyFunc != nil {
+		return a.DeleteAppKeyFunc(ctx, app_index)
+	}
+	
+	return nil
+	
+}
+
+// ImportRemoteNode call ImportRemoteNodeFunc
+func (a *FakeManagement1) ImportRemoteNode(primary uint16, count uint8, device_key []byte) error {
+	return a.ImportRemoteNodeContext(context.Background(), primary, count, device_key)
+}
+
+// ImportRemoteNodeContext call ImportRemoteNodeFunc
+func (a *FakeManagement1) ImportRemoteNodeContext(ctx context.Context, primary uint16, count uint8, device_key []byte) error {
+	if a.ImportRemoteNodeFunc != nil {
+		return a.ImportRemoteNodeFunc(ctx, primary, count, device_key)
+	}
+	
+	return nil
+	
+}
+
+// DeleteRemoteNode call DeleteRemoteNodeFunc
+func (a *FakeManagement1) DeleteRemoteNode(primary uint16, count uint8) error {
+	return a.DeleteRemoteNodeContext(context.Background(), primary, count)
+}
+
+// DeleteRemoteNodeContext call DeleteRemoteNodeFunc
+func (a *FakeManagement1) DeleteRemoteNodeContext(ctx context.Context, primary uint16, count uint8) error {
+	if a.DeleteRemoteNodeFunc != nil {
+		return a.DeleteRemoteNodeFunc(ctx, primary, count)
+	}
+	
+	return nil
+	
+}
+
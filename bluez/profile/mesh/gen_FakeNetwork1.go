@@ -0,0 +1,225 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+
+import (
+   "context"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeNetwork1 is an in-memory Network1API to test the code depending on
+// Network1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeNetwork1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// JoinFunc handle the calls to Join
+	JoinFunc func(ctx context.Context, app_root dbus.ObjectPath, uuid []byte) error
+	// CancelFunc handle the calls to Cancel
+	CancelFunc func(ctx context.Context) error
+	// AttachFunc handle the calls to Attach
+	AttachFunc func(ctx context.Context, app_root dbus.ObjectPath, token uint64) (dbus.ObjectPath, []ConfigurationItem, error)
+	// LeaveFunc handle the calls to Leave
+	LeaveFunc func(ctx context.Context, token uint64) error
+	// CreateNetworkFunc handle the calls to CreateNetwork
+	CreateNetworkFunc func(ctx context.Context, app_root dbus.ObjectPath, uuid []byte) (uint64, error)
+	// ImportFunc handle the calls to Import
+	ImportFunc func(ctx context.Context, app_root dbus.ObjectPath, uuid []byte, dev_key []byte, net_key []byte, net_index uint16, flags map[string]interface{}, iv_index uint32, unicast uint16) (uint64, error)
+}
+
+var _ Network1API = (*FakeNetwork1)(nil)
+
+
+// NewFakeNetwork1 create a FakeNetwork1 with the initial properties, nil for none
+func NewFakeNetwork1(objectPath dbus.ObjectPath, properties *Network1Properties) *FakeNetwork1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeNetwork1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(Network1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeNetwork1 object path
+func (a *FakeNetwork1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return Network1 interface
+func (a *FakeNetwork1) Interface() string {
+	return Network1Interface
+}
+
+// Close stop the watchers
+func (a *FakeNetwork1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeNetwork1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeNetwork1) GetProperties() (*Network1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeNetwork1) GetPropertiesContext(ctx context.Context) (*Network1Properties, error) {
+	return new(Network1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeNetwork1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeNetwork1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeNetwork1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeNetwork1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeNetwork1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeNetwork1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeNetwork1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+
+// Join call JoinFunc
+func (a *FakeNetwork1) Join(app_root dbus.ObjectPath, uuid []byte) error {
+	return a.JoinContext(context.Background(), app_root, uuid)
+}
+
+// JoinContext call JoinFunc
+func (a *FakeNetwork1) JoinContext(ctx context.Context, app_root dbus.ObjectPath, uuid []byte) error {
+	if a.JoinFunc != nil {
+		return a.JoinFunc(ctx, app_root, uuid)
+	}
+	
+	return nil
+	
+}
+
+// Cancel call CancelFunc
+func (a *FakeNetwork1) Cancel() error {
+	return a.CancelContext(context.Background())
+}
+
+// CancelContext call CancelFunc
+func (a *FakeNetwork1) CancelContext(ctx context.Context) error {
+	if a.CancelFunc != nil {
+		return a.CancelFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// Attach call AttachFunc
+func (a *FakeNetwork1) Attach(app_root dbus.ObjectPath, token uint64) (dbus.ObjectPath, []ConfigurationItem, error) {
+	return a.AttachContext(context.Background(), app_root, token)
+}
+
+// AttachContext call AttachFunc
+func (a *FakeNetwork1) AttachContext(ctx context.Context, app_root dbus.ObjectPath, token uint64) (dbus.ObjectPath, []ConfigurationItem, error) {
+	if a.AttachFunc != nil {
+		return a.AttachFunc(ctx, app_root, token)
+	}
+	
+	var val0 dbus.ObjectPath
+   val1 := []ConfigurationItem{}
+	return val0, val1, nil	
+}
+
+// Leave call LeaveFunc
+func (a *FakeNetwork1) Leave(token uint64) error {
+	return a.LeaveContext(context.Background(), token)
+}
+
+// LeaveContext call LeaveFunc
+func (a *FakeNetwork1) LeaveContext(ctx context.Context, token uint64) error {
+	if a.LeaveFunc != nil {
+		return a.LeaveFunc(ctx, token)
+	}
+	
+	return nil
+	
+}
+
+// CreateNetwork call CreateNetworkFunc
+func (a *FakeNetwork1) CreateNetwork(app_root dbus.ObjectPath, uuid []byte) (uint64, error) {
+	return a.CreateNetworkContext(context.Background(), app_root, uuid)
+}
+
+// CreateNetworkContext call CreateNetworkFunc
+func (a *FakeNetwork1) CreateNetworkContext(ctx context.Context, app_root dbus.ObjectPath, uuid []byte) (uint64, error) {
+	if a.CreateNetworkFunc != nil {
+		return a.CreateNetworkFunc(ctx, app_root, uuid)
+	}
+	
+	var val0 uint64
+	return val0, nil	
+}
+
+// Import call ImportFunc
+func (a *FakeNetwork1) Import(app_root dbus.ObjectPath, uuid []byte, dev_key []byte, net_key []byte, net_index uint16, flags map[string]interface{}, iv_index uint32, unicast uint16) (uint64, error) {
+	return a.ImportContext(context.Background(), app_root, uuid, dev_key, net_key, net_index, flags, iv_index, unicast)
+}
+
+// ImportContext call ImportFunc
+func (a *FakeNetwork1) ImportContext(ctx context.Context, app_root dbus.ObjectPath, uuid []byte, dev_key []byte, net_key []byte, net_index uint16, flags map[string]interface{}, iv_index uint32, unicast uint16) (uint64, error) {
+	if a.ImportFunc != nil {
+		return a.ImportFunc(ctx, app_root, uuid, dev_key, net_key, net_index, flags, iv_index, unicast)
+	}
+	
+	var val0 uint64
+	return val0, nil	
+}
+
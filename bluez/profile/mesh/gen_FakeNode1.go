@@ -0,0 +1,485 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeNode1 is an in-memory Node1API to test the code depending on
+// Node1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeNode1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// SendFunc handle the calls to Send
+	SendFunc func(ctx context.Context, element_path dbus.ObjectPath, destination uint16, key_index uint16, data []byte) error
+	// DevKeySendFunc handle the calls to DevKeySend
+	DevKeySendFunc func(ctx context.Context, element_path dbus.ObjectPath, destination uint16, remote bool, net_index uint16, data []byte) error
+	// AddNetKeyFunc handle the calls to AddNetKey
+	AddNetKeyFunc func(ctx context.Context, element_path dbus.ObjectPath, destination uint16, subnet_index uint16, net_index uint16, update bool) error
+	// AddAppKeyFunc handle the calls to AddAppKey
+	AddAppKeyFunc func(ctx context.Context, element_path dbus.ObjectPath, destination uint16, app_index uint16, net_index uint16, update bool) error
+	// PublishFunc handle the calls to Publish
+	PublishFunc func(ctx context.Context, element_path dbus.ObjectPath, model uint16, data []byte) error
+	// VendorPublishFunc handle the calls to VendorPublish
+	VendorPublishFunc func(ctx context.Context, element_path dbus.ObjectPath, vendor uint16, model_id uint16, data []byte) error
+}
+
+var _ Node1API = (*FakeNode1)(nil)
+
+
+// NewFakeNode1 create a FakeNode1 with the initial properties, nil for none
+func NewFakeNode1(objectPath dbus.ObjectPath, properties *Node1Properties) *FakeNode1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeNode1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(Node1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeNode1 object path
+func (a *FakeNode1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return Node1 interface
+func (a *FakeNode1) Interface() string {
+	return Node1Interface
+}
+
+// Close stop the watchers
+func (a *FakeNode1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeNode1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeNode1) GetProperties() (*Node1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeNode1) GetPropertiesContext(ctx context.Context) (*Node1Properties, error) {
+	return new(Node1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeNode1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeNode1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeNode1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeNode1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeNode1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeNode1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeNode1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+
+
+// GetFeatures get Features value
+func (a *FakeNode1) GetFeatures() (map[string]interface{}, error) {
+	return a.GetFeaturesContext(context.Background())
+}
+
+// GetFeaturesContext get Features value
+func (a *FakeNode1) GetFeaturesContext(ctx context.Context) (map[string]interface{}, error) {
+	v, err := a.GetPropertyContext(ctx, "Features")
+	if err != nil {
+		return map[string]interface{}{}, err
+	}
+	value, ok := v.Value().(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{}, fmt.Errorf("Features: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetFriend set Friend value
+func (a *FakeNode1) SetFriend(v bool) error {
+	return a.SetFriendContext(context.Background(), v)
+}
+
+// SetFriendContext set Friend value
+func (a *FakeNode1) SetFriendContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Friend", v)
+}
+
+
+
+// GetFriend get Friend value
+func (a *FakeNode1) GetFriend() (bool, error) {
+	return a.GetFriendContext(context.Background())
+}
+
+// GetFriendContext get Friend value
+func (a *FakeNode1) GetFriendContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Friend")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Friend: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetLowPower set LowPower value
+func (a *FakeNode1) SetLowPower(v bool) error {
+	return a.SetLowPowerContext(context.Background(), v)
+}
+
+// SetLowPowerContext set LowPower value
+func (a *FakeNode1) SetLowPowerContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "LowPower", v)
+}
+
+
+
+// GetLowPower get LowPower value
+func (a *FakeNode1) GetLowPower() (bool, error) {
+	return a.GetLowPowerContext(context.Background())
+}
+
+// GetLowPowerContext get LowPower value
+func (a *FakeNode1) GetLowPowerContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "LowPower")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("LowPower: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetProxy set Proxy value
+func (a *FakeNode1) SetProxy(v bool) error {
+	return a.SetProxyContext(context.Background(), v)
+}
+
+// SetProxyContext set Proxy value
+func (a *FakeNode1) SetProxyContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Proxy", v)
+}
+
+
+
+// GetProxy get Proxy value
+func (a *FakeNode1) GetProxy() (bool, error) {
+	return a.GetProxyContext(context.Background())
+}
+
+// GetProxyContext get Proxy value
+func (a *FakeNode1) GetProxyContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Proxy")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Proxy: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetRelay set Relay value
+func (a *FakeNode1) SetRelay(v bool) error {
+	return a.SetRelayContext(context.Background(), v)
+}
+
+// SetRelayContext set Relay value
+func (a *FakeNode1) SetRelayContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Relay", v)
+}
+
+
+
+// GetRelay get Relay value
+func (a *FakeNode1) GetRelay() (bool, error) {
+	return a.GetRelayContext(context.Background())
+}
+
+// GetRelayContext get Relay value
+func (a *FakeNode1) GetRelayContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Relay")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Relay: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+
+// GetBeacon get Beacon value
+func (a *FakeNode1) GetBeacon() (bool, error) {
+	return a.GetBeaconContext(context.Background())
+}
+
+// GetBeaconContext get Beacon value
+func (a *FakeNode1) GetBeaconContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Beacon")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Beacon: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+
+// GetIvIndex get IvIndex value
+func (a *FakeNode1) GetIvIndex() (uint32, error) {
+	return a.GetIvIndexContext(context.Background())
+}
+
+// GetIvIndexContext get IvIndex value
+func (a *FakeNode1) GetIvIndexContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "IvIndex")
+	if err != nil {
+		return uint32(0), err
+	}
+	value, ok := v.Value().(uint32)
+	if !ok {
+		return uint32(0), fmt.Errorf("IvIndex: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+
+// GetSecondsSinceLastHeard get SecondsSinceLastHeard value
+func (a *FakeNode1) GetSecondsSinceLastHeard() (uint32, error) {
+	return a.GetSecondsSinceLastHeardContext(context.Background())
+}
+
+// GetSecondsSinceLastHeardContext get SecondsSinceLastHeard value
+func (a *FakeNode1) GetSecondsSinceLastHeardContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "SecondsSinceLastHeard")
+	if err != nil {
+		return uint32(0), err
+	}
+	value, ok := v.Value().(uint32)
+	if !ok {
+		return uint32(0), fmt.Errorf("SecondsSinceLastHeard: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+
+// GetAddresses get Addresses value
+func (a *FakeNode1) GetAddresses() ([]uint16, error) {
+	return a.GetAddressesContext(context.Background())
+}
+
+// GetAddressesContext get Addresses value
+func (a *FakeNode1) GetAddressesContext(ctx context.Context) ([]uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Addresses")
+	if err != nil {
+		return []uint16{}, err
+	}
+	value, ok := v.Value().([]uint16)
+	if !ok {
+		return []uint16{}, fmt.Errorf("Addresses: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+
+// GetSequenceNumber get SequenceNumber value
+func (a *FakeNode1) GetSequenceNumber() (uint32, error) {
+	return a.GetSequenceNumberContext(context.Background())
+}
+
+// GetSequenceNumberContext get SequenceNumber value
+func (a *FakeNode1) GetSequenceNumberContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "SequenceNumber")
+	if err != nil {
+		return uint32(0), err
+	}
+	value, ok := v.Value().(uint32)
+	if !ok {
+		return uint32(0), fmt.Errorf("SequenceNumber: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+// Send call SendFunc
+func (a *FakeNode1) Send(element_path dbus.ObjectPath, destination uint16, key_index uint16, data []byte) error {
+	return a.SendContext(context.Background(), element_path, destination, key_index, data)
+}
+
+// SendContext call SendFunc
+func (a *FakeNode1) SendContext(ctx context.Context, element_path dbus.ObjectPath, destination uint16, key_index uint16, data []byte) error {
+	if a.SendFunc != nil {
+		return a.SendFunc(ctx, element_path, destination, key_index, data)
+	}
+	
+	return nil
+	
+}
+
+// DevKeySend call DevKeySendFunc
+func (a *FakeNode1) DevKeySend(element_path dbus.ObjectPath, destination uint16, remote bool, net_index uint16, data []byte) error {
+	return a.DevKeySendContext(context.Background(), element_path, destination, remote, net_index, data)
+}
+
+// DevKeySendContext call DevKeySendFunc
+func (a *FakeNode1) DevKeySendContext(ctx context.Context, element_path dbus.ObjectPath, destination uint16, remote bool, net_index uint16, data []byte) error {
+	if a.DevKeySendFunc != nil {
+		return a.DevKeySendFunc(ctx, element_path, destination, remote, net_index, data)
+	}
+	
+	return nil
+	
+}
+
+// AddNetKey call AddNetKeyFunc
+func (a *FakeNode1) AddNetKey(element_path dbus.ObjectPath, destination uint16, subnet_index uint16, net_index uint16, update bool) error {
+	return a.AddNetKeyContext(context.Background(), element_path, destination, subnet_index, net_index, update)
+}
+
+// AddNetKeyContext call AddNetKeyFunc
+func (a *FakeNode1) AddNetKeyContext(ctx context.Context, element_path dbus.ObjectPath, destination uint16, subnet_index uint16, net_index uint16, update bool) error {
+	if a.AddNetKeyFunc != nil {
+		return a.AddNetKeyFunc(ctx, element_path, destination, subnet_index, net_index, update)
+	}
+	
+	return nil
+	
+}
+
+// AddAppKey call AddAppKeyFunc
+func (a *FakeNode1) AddAppKey(element_path dbus.ObjectPath, destination uint16, app_index uint16, net_index uint16, update bool) error {
+	return a.AddAppKeyContext(context.Background(), element_path, destination, app_index, net_index, update)
+}
+
+// AddAppKeyContext call AddAppKeyFunc
+func (a *FakeNode1) AddAppKeyContext(ctx context.Context, element_path dbus.ObjectPath, destination uint16, app_index uint16, net_index uint16, update bool) error {
+	if a.AddAppKeyFunc != nil {
+		return a.AddAppKeyFunc(ctx, element_path, destination, app_index, net_index, update)
+	}
+	
+	return nil
+	
+}
+
+// Publish call PublishFunc
+func (a *FakeNode1) Publish(element_path dbus.ObjectPath, model uint16, data []byte) error {
+	return a.PublishContext(context.Background(), element_path, model, data)
+}
+
+// PublishContext call PublishFunc
+func (a *FakeNode1) PublishContext(ctx context.Context, element_path dbus.ObjectPath, model uint16, data []byte) error {
+	if a.PublishFunc != nil {
+		return a.PublishFunc(ctx, element_path, model, data)
+	}
+	
+	return nil
+	
+}
+
+// VendorPublish call VendorPublishFunc
+func (a *FakeNode1) VendorPublish(element_path dbus.ObjectPath, vendor uint16, model_id uint16, data []byte) error {
+	return a.VendorPublishContext(context.Background(), element_path, vendor, model_id, data)
+}
+
+// VendorPublishContext call VendorPublishFunc
+func (a *FakeNode1) VendorPublishContext(ctx context.Context, element_path dbus.ObjectPath, vendor uint16, model_id uint16, data []byte) error {
+	if a.VendorPublishFunc != nil {
+		return a.VendorPublishFunc(ctx, element_path, vendor, model_id, data)
+	}
+	
+	return nil
+	
+}
+
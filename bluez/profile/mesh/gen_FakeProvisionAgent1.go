@@ -0,0 +1,308 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeProvisionAgent1 is an in-memory ProvisionAgent1API to test the code depending on
+// ProvisionAgent1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeProvisionAgent1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// PrivateKeyFunc handle the calls to PrivateKey
+	PrivateKeyFunc func(ctx context.Context) ([]byte, error)
+	// PublicKeyFunc handle the calls to PublicKey
+	PublicKeyFunc func(ctx context.Context) ([]byte, error)
+	// DisplayStringFunc handle the calls to DisplayString
+	DisplayStringFunc func(ctx context.Context, value string) error
+	// DisplayNumericFunc handle the calls to DisplayNumeric
+	DisplayNumericFunc func(ctx context.Context, type1 string, number uint32) error
+	// PromptNumericFunc handle the calls to PromptNumeric
+	PromptNumericFunc func(ctx context.Context, type1 string) (uint32, error)
+	// PromptStaticFunc handle the calls to PromptStatic
+	PromptStaticFunc func(ctx context.Context, type1 string) ([]byte, error)
+	// CancelFunc handle the calls to Cancel
+	CancelFunc func(ctx context.Context) error
+}
+
+var _ ProvisionAgent1API = (*FakeProvisionAgent1)(nil)
+
+
+// NewFakeProvisionAgent1 create a FakeProvisionAgent1 with the initial properties, nil for none
+func NewFakeProvisionAgent1(objectPath dbus.ObjectPath, properties *ProvisionAgent1Properties) *FakeProvisionAgent1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeProvisionAgent1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(ProvisionAgent1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeProvisionAgent1 object path
+func (a *FakeProvisionAgent1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return ProvisionAgent1 interface
+func (a *FakeProvisionAgent1) Interface() string {
+	return ProvisionAgent1Interface
+}
+
+// Close stop the watchers
+func (a *FakeProvisionAgent1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeProvisionAgent1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeProvisionAgent1) GetProperties() (*ProvisionAgent1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeProvisionAgent1) GetPropertiesContext(ctx context.Context) (*ProvisionAgent1Properties, error) {
+	return new(ProvisionAgent1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeProvisionAgent1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeProvisionAgent1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeProvisionAgent1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeProvisionAgent1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeProvisionAgent1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeProvisionAgent1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeProvisionAgent1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+
+
+// GetCapabilities get Capabilities value
+func (a *FakeProvisionAgent1) GetCapabilities() ([]string, error) {
+	return a.GetCapabilitiesContext(context.Background())
+}
+
+// GetCapabilitiesContext get Capabilities value
+func (a *FakeProvisionAgent1) GetCapabilitiesContext(ctx context.Context) ([]string, error) {
+	v, err := a.GetPropertyContext(ctx, "Capabilities")
+	if err != nil {
+		return []string{}, err
+	}
+	value, ok := v.Value().([]string)
+	if !ok {
+		return []string{}, fmt.Errorf("Capabilities: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+
+// GetOutOfBandInfo get OutOfBandInfo value
+func (a *FakeProvisionAgent1) GetOutOfBandInfo() ([]string, error) {
+	return a.GetOutOfBandInfoContext(context.Background())
+}
+
+// GetOutOfBandInfoContext get OutOfBandInfo value
+func (a *FakeProvisionAgent1) GetOutOfBandInfoContext(ctx context.Context) ([]string, error) {
+	v, err := a.GetPropertyContext(ctx, "OutOfBandInfo")
+	if err != nil {
+		return []string{}, err
+	}
+	value, ok := v.Value().([]string)
+	if !ok {
+		return []string{}, fmt.Errorf("OutOfBandInfo: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+
+// GetURI get URI value
+func (a *FakeProvisionAgent1) GetURI() (string, error) {
+	return a.GetURIContext(context.Background())
+}
+
+// GetURIContext get URI value
+func (a *FakeProvisionAgent1) GetURIContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "URI")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("URI: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+// PrivateKey call PrivateKeyFunc
+func (a *FakeProvisionAgent1) PrivateKey() ([]byte, error) {
+	return a.PrivateKeyContext(context.Background())
+}
+
+// PrivateKeyContext call PrivateKeyFunc
+func (a *FakeProvisionAgent1) PrivateKeyContext(ctx context.Context) ([]byte, error) {
+	if a.PrivateKeyFunc != nil {
+		return a.PrivateKeyFunc(ctx)
+	}
+	
+	 val0 := []byte{}
+	return val0, nil	
+}
+
+// PublicKey call PublicKeyFunc
+func (a *FakeProvisionAgent1) PublicKey() ([]byte, error) {
+	return a.PublicKeyContext(context.Background())
+}
+
+// PublicKeyContext call PublicKeyFunc
+func (a *FakeProvisionAgent1) PublicKeyContext(ctx context.Context) ([]byte, error) {
+	if a.PublicKeyFunc != nil {
+		return a.PublicKeyFunc(ctx)
+	}
+	
+	 val0 := []byte{}
+	return val0, nil	
+}
+
+// DisplayString call DisplayStringFunc
+func (a *FakeProvisionAgent1) DisplayString(value string) error {
+	return a.DisplayStringContext(context.Background(), value)
+}
+
+// DisplayStringContext call DisplayStringFunc
+func (a *FakeProvisionAgent1) DisplayStringContext(ctx context.Context, value string) error {
+	if a.DisplayStringFunc != nil {
+		return a.DisplayStringFunc(ctx, value)
+	}
+	
+	return nil
+	
+}
+
+// DisplayNumeric call DisplayNumericFunc
+func (a *FakeProvisionAgent1) DisplayNumeric(type1 string, number uint32) error {
+	return a.DisplayNumericContext(context.Background(), type1, number)
+}
+
+// DisplayNumericContext call DisplayNumericFunc
+func (a *FakeProvisionAgent1) DisplayNumericContext(ctx context.Context, type1 string, number uint32) error {
+	if a.DisplayNumericFunc != nil {
+		return a.DisplayNumericFunc(ctx, type1, number)
+	}
+	
+	return nil
+	
+}
+
+// PromptNumeric call PromptNumericFunc
+func (a *FakeProvisionAgent1) PromptNumeric(type1 string) (uint32, error) {
+	return a.PromptNumericContext(context.Background(), type1)
+}
+
+// PromptNumericContext call PromptNumericFunc
+func (a *FakeProvisionAgent1) PromptNumericContext(ctx context.Context, type1 string) (uint32, error) {
+	if a.PromptNumericFunc != nil {
+		return a.PromptNumericFunc(ctx, type1)
+	}
+	
+	var val0 uint32
+	return val0, nil	
+}
+
+// PromptStatic call PromptStaticFunc
+func (a *FakeProvisionAgent1) PromptStatic(type1 string) ([]byte, error) {
+	return a.PromptStaticContext(context.Background(), type1)
+}
+
+// PromptStaticContext call PromptStaticFunc
+func (a *FakeProvisionAgent1) PromptStaticContext(ctx context.Context, type1 string) ([]byte, error) {
+	if a.PromptStaticFunc != nil {
+		return a.PromptStaticFunc(ctx, type1)
+	}
+	
+	 val0 := []byte{}
+	return val0, nil	
+}
+
+// Cancel call CancelFunc
+func (a *FakeProvisionAgent1) Cancel() error {
+	return a.CancelContext(context.Background())
+}
+
+// CancelContext call CancelFunc
+func (a *FakeProvisionAgent1) CancelContext(ctx context.Context) error {
+	if a.CancelFunc != nil {
+		return a.CancelFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
@@ -0,0 +1,190 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+
+import (
+   "context"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeProvisioner1 is an in-memory Provisioner1API to test the code depending on
+// Provisioner1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeProvisioner1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// ScanResultFunc handle the calls to ScanResult
+	ScanResultFunc func(ctx context.Context, rssi int16, data []byte) error
+	// RequestProvDataFunc handle the calls to RequestProvData
+	RequestProvDataFunc func(ctx context.Context, count uint8) (uint16, error)
+	// AddNodeCompleteFunc handle the calls to AddNodeComplete
+	AddNodeCompleteFunc func(ctx context.Context, uuid []byte, unicast uint16, count uint8) error
+	// AddNodeFailedFunc handle the calls to AddNodeFailed
+	AddNodeFailedFunc func(ctx context.Context, uuid []byte, reason string) error
+}
+
+var _ Provisioner1API = (*FakeProvisioner1)(nil)
+
+
+// NewFakeProvisioner1 create a FakeProvisioner1 with the initial properties, nil for none
+func NewFakeProvisioner1(objectPath dbus.ObjectPath, properties *Provisioner1Properties) *FakeProvisioner1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeProvisioner1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(Provisioner1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeProvisioner1 object path
+func (a *FakeProvisioner1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return Provisioner1 interface
+func (a *FakeProvisioner1) Interface() string {
+	return Provisioner1Interface
+}
+
+// Close stop the watchers
+func (a *FakeProvisioner1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeProvisioner1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeProvisioner1) GetProperties() (*Provisioner1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeProvisioner1) GetPropertiesContext(ctx context.Context) (*Provisioner1Properties, error) {
+	return new(Provisioner1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeProvisioner1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeProvisioner1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeProvisioner1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeProvisioner1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeProvisioner1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeProvisioner1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeProvisioner1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+
+// ScanResult call ScanResultFunc
+func (a *FakeProvisioner1) ScanResult(rssi int16, data []byte) error {
+	return a.ScanResultContext(context.Background(), rssi, data)
+}
+
+// ScanResultContext call ScanResultFunc
+func (a *FakeProvisioner1) ScanResultContext(ctx context.Context, rssi int16, data []byte) error {
+	if a.ScanResultFunc != nil {
+		return a.ScanResultFunc(ctx, rssi, data)
+	}
+	
+	return nil
+	
+}
+
+// RequestProvData call RequestProvDataFunc
+func (a *FakeProvisioner1) RequestProvData(count uint8) (uint16, error) {
+	return a.RequestProvDataContext(context.Background(), count)
+}
+
+// RequestProvDataContext call RequestProvDataFunc
+func (a *FakeProvisioner1) RequestProvDataContext(ctx context.Context, count uint8) (uint16, error) {
+	if a.RequestProvDataFunc != nil {
+		return a.RequestProvDataFunc(ctx, count)
+	}
+	
+	var val0 uint16
+	return val0, nil	
+}
+
+// AddNodeComplete call AddNodeCompleteFunc
+func (a *FakeProvisioner1) AddNodeComplete(uuid []byte, unicast uint16, count uint8) error {
+	return a.AddNodeCompleteContext(context.Background(), uuid, unicast, count)
+}
+
+// AddNodeCompleteContext call AddNodeCompleteFunc
+func (a *FakeProvisioner1) AddNodeCompleteContext(ctx context.Context, uuid []byte, unicast uint16, count uint8) error {
+	if a.AddNodeCompleteFunc != nil {
+		return a.AddNodeCompleteFunc(ctx, uuid, unicast, count)
+	}
+	
+	return nil
+	
+}
+
+// AddNodeFailed call AddNodeFailedFunc
+func (a *FakeProvisioner1) AddNodeFailed(uuid []byte, reason string) error {
+	return a.AddNodeFailedContext(context.Background(), uuid, reason)
+}
+
+// AddNodeFailedContext call AddNodeFailedFunc
+func (a *FakeProvisioner1) AddNodeFailedContext(ctx context.Context, uuid []byte, reason string) error {
+	if a.AddNodeFailedFunc != nil {
+		return a.AddNodeFailedFunc(ctx, uuid, reason)
+	}
+	
+	return nil
+	
+}
+
@@ -5,6 +5,7 @@ package mesh
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var Management1Interface = "org.bluez.mesh.Management1"
 // Args:
 
 func NewManagement1(objectPath dbus.ObjectPath) (*Management1, error) {
+	return NewManagement1WithConn(nil, objectPath)
+}
+
+// NewManagement1WithConn create a new instance of Management1 on a connection, nil for the shared system bus connection
+//
+// Args:
+
+func NewManagement1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*Management1, error) {
 	a := new(Management1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewManagement1(objectPath dbus.ObjectPath) (*Management1, error) {
 			Iface: Management1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -44,11 +54,12 @@ func NewManagement1(objectPath dbus.ObjectPath) (*Management1, error) {
 /*
 Management1 Mesh Provisioning Hierarchy
 
+
+Since BlueZ 5.53
 */
 type Management1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*Management1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -95,35 +106,24 @@ func (a *Management1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *Management1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *Management1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
-
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -153,20 +153,31 @@ func (a *Management1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Management1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Management1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *Management1) GetProperties() (*Management1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *Management1) GetPropertiesContext(ctx context.Context) (*Management1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -176,29 +187,40 @@ func (a *Management1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *Management1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *Management1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *Management1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *Management1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *Management1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *Management1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -208,6 +230,7 @@ func (a *Management1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *Management1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -231,8 +254,13 @@ UnprovisionedScan 		This method is used by the application that supports
 
 */
 func (a *Management1) UnprovisionedScan(seconds uint16) error {
+	return a.UnprovisionedScanContext(context.Background(), seconds)
+}
+
+// UnprovisionedScanContext call UnprovisionedScan, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Management1) UnprovisionedScanContext(ctx context.Context, seconds uint16) error {
 	
-	return a.client.Call("UnprovisionedScan", 0, seconds).Store()
+	return a.client.CallWithContext(ctx, "UnprovisionedScan", 0, seconds).Store()
 	
 }
 
@@ -240,8 +268,13 @@ func (a *Management1) UnprovisionedScan(seconds uint16) error {
 UnprovisionedScanCancel 
 */
 func (a *Management1) UnprovisionedScanCancel() error {
+	return a.UnprovisionedScanCancelContext(context.Background())
+}
+
+// UnprovisionedScanCancelContext call UnprovisionedScanCancel, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Management1) UnprovisionedScanCancelContext(ctx context.Context) error {
 	
-	return a.client.Call("UnprovisionedScanCancel", 0, ).Store()
+	return a.client.CallWithContext(ctx, "UnprovisionedScanCancel", 0, ).Store()
 	
 }
 
@@ -257,8 +290,13 @@ AddNode 		This method is used by the application that supports
 
 */
 func (a *Management1) AddNode(uuid []byte) error {
+	return a.AddNodeContext(context.Background(), uuid)
+}
+
+// AddNodeContext call AddNode, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Management1) AddNodeContext(ctx context.Context, uuid []byte) error {
 	
-	return a.client.Call("AddNode", 0, uuid).Store()
+	return a.client.CallWithContext(ctx, "AddNode", 0, uuid).Store()
 	
 }
 
@@ -275,8 +313,13 @@ CreateSubnet 		This method is used by the application to generate and add a new
 
 */
 func (a *Management1) CreateSubnet(net_index uint16) error {
+	return a.CreateSubnetContext(context.Background(), net_index)
+}
+
+// CreateSubnetContext call CreateSubnet, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Management1) CreateSubnetContext(ctx context.Context, net_index uint16) error {
 	
-	return a.client.Call("CreateSubnet", 0, net_index).Store()
+	return a.client.CallWithContext(ctx, "CreateSubnet", 0, net_index).Store()
 	
 }
 
@@ -295,8 +338,13 @@ ImportSubnet 		This method is used by the application to add a network subnet
 
 */
 func (a *Management1) ImportSubnet(net_index uint16, net_key []byte) error {
+	return a.ImportSubnetContext(context.Background(), net_index, net_key)
+}
+
+// ImportSubnetContext call ImportSubnet, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Management1) ImportSubnetContext(ctx context.Context, net_index uint16, net_key []byte) error {
 	
-	return a.client.Call("ImportSubnet", 0, net_index, net_key).Store()
+	return a.client.CallWithContext(ctx, "ImportSubnet", 0, net_index, net_key).Store()
 	
 }
 
@@ -315,8 +363,13 @@ UpdateSubnet 		This method is used by the application to generate a new network
 
 */
 func (a *Management1) UpdateSubnet(net_index uint16) error {
+	return a.UpdateSubnetContext(context.Background(), net_index)
+}
+
+// UpdateSubnetContext call UpdateSubnet, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Management1) UpdateSubnetContext(ctx context.Context, net_index uint16) error {
 	
-	return a.client.Call("UpdateSubnet", 0, net_index).Store()
+	return a.client.CallWithContext(ctx, "UpdateSubnet", 0, net_index).Store()
 	
 }
 
@@ -331,8 +384,13 @@ DeleteSubnet 		This method is used by the application that to delete a subnet.
 
 */
 func (a *Management1) DeleteSubnet(net_index uint16) error {
+	return a.DeleteSubnetContext(context.Background(), net_index)
+}
+
+// DeleteSubnetContext call DeleteSubnet, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Management1) DeleteSubnetContext(ctx context.Context, net_index uint16) error {
 	
-	return a.client.Call("DeleteSubnet", 0, net_index).Store()
+	return a.client.CallWithContext(ctx, "DeleteSubnet", 0, net_index).Store()
 	
 }
 
@@ -363,8 +421,13 @@ SetKeyPhase 		This method is used to set the master key update phase of the
 
 */
 func (a *Management1) SetKeyPhase(net_index uint16, phase uint8) error {
+	return a.SetKeyPhaseContext(context.Background(), net_index, phase)
+}
+
+// SetKeyPhaseContext call SetKeyPhase, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Management1) SetKeyPhaseContext(ctx context.Context, net_index uint16, phase uint8) error {
 	
-	return a.client.Call("SetKeyPhase", 0, net_index, phase).Store()
+	return a.client.CallWithContext(ctx, "SetKeyPhase", 0, net_index, phase).Store()
 	
 }
 
@@ -384,8 +447,13 @@ CreateAppKey 		This method is used by the application to generate and add a new
 
 */
 func (a *Management1) CreateAppKey(net_index uint16, app_index uint16) error {
+	return a.CreateAppKeyContext(context.Background(), net_index, app_index)
+}
+
+// CreateAppKeyContext call CreateAppKey, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Management1) CreateAppKeyContext(ctx context.Context, net_index uint16, app_index uint16) error {
 	
-	return a.client.Call("CreateAppKey", 0, net_index, app_index).Store()
+	return a.client.CallWithContext(ctx, "CreateAppKey", 0, net_index, app_index).Store()
 	
 }
 
@@ -407,8 +475,13 @@ ImportAppKey 		This method is used by the application to add an application
 
 */
 func (a *Management1) ImportAppKey(net_index uint16, app_index uint16, app_key []byte) error {
+	return a.ImportAppKeyContext(context.Background(), net_index, app_index, app_key)
+}
+
+// ImportAppKeyContext call ImportAppKey, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Management1) ImportAppKeyContext(ctx context.Context, net_index uint16, app_index uint16, app_key []byte) error {
 	
-	return a.client.Call("ImportAppKey", 0, net_index, app_index, app_key).Store()
+	return a.client.CallWithContext(ctx, "ImportAppKey", 0, net_index, app_index, app_key).Store()
 	
 }
 
@@ -427,8 +500,13 @@ UpdateAppKey 		This method is used by the application to generate a new
 
 */
 func (a *Management1) UpdateAppKey(app_index uint16) error {
+	return a.UpdateAppKeyContext(context.Background(), app_index)
+}
+
+// UpdateAppKeyContext call UpdateAppKey, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Management1) UpdateAppKeyContext(ctx context.Context, app_index uint16) error {
 	
-	return a.client.Call("UpdateAppKey", 0, app_index).Store()
+	return a.client.CallWithContext(ctx, "UpdateAppKey", 0, app_index).Store()
 	
 }
 
@@ -443,8 +521,13 @@ DeleteAppKey 		This method is used by the application to delete an application
 
 */
 func (a *Management1) DeleteAppKey(app_index uint16) error {
+	return a.DeleteAppKeyContext(context.Background(), app_index)
+}
+
+// DeleteAppKeyContext call DeleteAppKey, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Management1) DeleteAppKeyContext(ctx context.Context, app_index uint16) error {
 	
-	return a.client.Call("DeleteAppKey", 0, app_index).Store()
+	return a.client.CallWithContext(ctx, "DeleteAppKey", 0, app_index).Store()
 	
 }
 
@@ -466,8 +549,13 @@ ImportRemoteNode 		This method is used by the application to import a remote nod
 
 */
 func (a *Management1) ImportRemoteNode(primary uint16, count uint8, device_key []byte) error {
+	return a.ImportRemoteNodeContext(context.Background(), primary, count, device_key)
+}
+
+// ImportRemoteNodeContext call ImportRemoteNode, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Management1) ImportRemoteNodeContext(ctx context.Context, primary uint16, count uint8, device_key []byte) error {
 	
-	return a.client.Call("ImportRemoteNode", 0, primary, count, device_key).Store()
+	return a.client.CallWithContext(ctx, "ImportRemoteNode", 0, primary, count, device_key).Store()
 	
 }
 
@@ -486,8 +574,13 @@ DeleteRemoteNode 		This method is used by the application to delete a remote nod
 
 */
 func (a *Management1) DeleteRemoteNode(primary uint16, count uint8) error {
+	return a.DeleteRemoteNodeContext(context.Background(), primary, count)
+}
+
+// DeleteRemoteNodeContext call DeleteRemoteNode, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Management1) DeleteRemoteNodeContext(ctx context.Context, primary uint16, count uint8) error {
 	
-	return a.client.Call("DeleteRemoteNode", 0, primary, count).Store()
+	return a.client.CallWithContext(ctx, "DeleteRemoteNode", 0, primary, count).Store()
 	
 }
 
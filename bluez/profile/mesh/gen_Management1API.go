@@ -0,0 +1,62 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// Management1API is the interface of Management1, implemented by
+// FakeManagement1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for Management1.
+type Management1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*Management1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*Management1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	UnprovisionedScan(seconds uint16) error
+	UnprovisionedScanContext(ctx context.Context, seconds uint16) error
+	UnprovisionedScanCancel() error
+	UnprovisionedScanCancelContext(ctx context.Context) error
+	AddNode(uuid []byte) error
+	AddNodeContext(ctx context.Context, uuid []byte) error
+	CreateSubnet(net_index uint16) error
+	CreateSubnetContext(ctx context.Context, net_index uint16) error
+	ImportSubnet(net_index uint16, net_key []byte) error
+	ImportSubnetContext(ctx context.Context, net_index uint16, net_key []byte) error
+	UpdateSubnet(net_index uint16) error
+	UpdateSubnetContext(ctx context.Context, net_index uint16) error
+	DeleteSubnet(net_index uint16) error
+	DeleteSubnetContext(ctx context.Context, net_index uint16) error
+	SetKeyPhase(net_index uint16, phase uint8) error
+	SetKeyPhaseContext(ctx context.Context, net_index uint16, phase uint8) error
+	CreateAppKey(net_index uint16, app_index uint16) error
+	CreateAppKeyContext(ctx context.Context, net_index uint16, app_index uint16) error
+	ImportAppKey(net_index uint16, app_index uint16, app_key []byte) error
+	ImportAppKeyContext(ctx context.Context, net_index uint16, app_index uint16, app_key []byte) error
+	UpdateAppKey(app_index uint16) error
+	UpdateAppKeyContext(ctx context.Context, app_index uint16) error
+	DeleteAppKey(app_index uint16) error
+	DeleteAppKeyContext(ctx context.Context, app_index uint16) error
+	ImportRemoteNode(primary uint16, count uint8, device_key []byte) error
+	ImportRemoteNodeContext(ctx context.Context, primary uint16, count uint8, device_key []byte) error
+	DeleteRemoteNode(primary uint16, count uint8) error
+	DeleteRemoteNodeContext(ctx context.Context, primary uint16, count uint8) error
+}
+
+var _ Management1API = (*Management1)(nil)
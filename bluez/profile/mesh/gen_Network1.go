@@ -5,6 +5,7 @@ package mesh
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var Network1Interface = "org.bluez.mesh.Network1"
 // Args:
 
 func NewNetwork1() (*Network1, error) {
+	return NewNetwork1WithConn(nil)
+}
+
+// NewNetwork1WithConn create a new instance of Network1 on a connection, nil for the shared system bus connection
+//
+// Args:
+
+func NewNetwork1WithConn(conn *bluez.Conn) (*Network1, error) {
 	a := new(Network1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewNetwork1() (*Network1, error) {
 			Iface: Network1Interface,
 			Path:  dbus.ObjectPath("/org/bluez/mesh"),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -44,11 +54,12 @@ func NewNetwork1() (*Network1, error) {
 /*
 Network1 Mesh Network Hierarchy
 
+
+Since BlueZ 5.53
 */
 type Network1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*Network1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -95,35 +106,24 @@ func (a *Network1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *Network1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *Network1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -153,20 +153,31 @@ func (a *Network1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Network1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Network1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *Network1) GetProperties() (*Network1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *Network1) GetPropertiesContext(ctx context.Context) (*Network1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -176,29 +187,40 @@ func (a *Network1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *Network1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *Network1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *Network1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *Network1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *Network1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *Network1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -208,6 +230,7 @@ func (a *Network1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *Network1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -238,8 +261,13 @@ Join 		This is the first method that an application has to call to
 
 */
 func (a *Network1) Join(app_root dbus.ObjectPath, uuid []byte) error {
+	return a.JoinContext(context.Background(), app_root, uuid)
+}
+
+// JoinContext call Join, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Network1) JoinContext(ctx context.Context, app_root dbus.ObjectPath, uuid []byte) error {
 	
-	return a.client.Call("Join", 0, app_root, uuid).Store()
+	return a.client.CallWithContext(ctx, "Join", 0, app_root, uuid).Store()
 	
 }
 
@@ -247,8 +275,13 @@ func (a *Network1) Join(app_root dbus.ObjectPath, uuid []byte) error {
 Cancel 
 */
 func (a *Network1) Cancel() error {
+	return a.CancelContext(context.Background())
+}
+
+// CancelContext call Cancel, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Network1) CancelContext(ctx context.Context) error {
 	
-	return a.client.Call("Cancel", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Cancel", 0, ).Store()
 	
 }
 
@@ -307,10 +340,15 @@ Attach 		This is the first method that an application must call to get
 
 */
 func (a *Network1) Attach(app_root dbus.ObjectPath, token uint64) (dbus.ObjectPath, []ConfigurationItem, error) {
+	return a.AttachContext(context.Background(), app_root, token)
+}
+
+// AttachContext call Attach, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Network1) AttachContext(ctx context.Context, app_root dbus.ObjectPath, token uint64) (dbus.ObjectPath, []ConfigurationItem, error) {
 	
 	var val0 dbus.ObjectPath
    val1 := []ConfigurationItem{}
-	err := a.client.Call("Attach", 0, app_root, token).Store(&val0, &val1)
+	err := a.client.CallWithContext(ctx, "Attach", 0, app_root, token).Store(&val0, &val1)
 	return val0, val1, err	
 }
 
@@ -323,8 +361,13 @@ Leave 		This removes the configuration information about the mesh node
 
 */
 func (a *Network1) Leave(token uint64) error {
+	return a.LeaveContext(context.Background(), token)
+}
+
+// LeaveContext call Leave, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Network1) LeaveContext(ctx context.Context, token uint64) error {
 	
-	return a.client.Call("Leave", 0, token).Store()
+	return a.client.CallWithContext(ctx, "Leave", 0, token).Store()
 	
 }
 
@@ -360,9 +403,14 @@ CreateNetwork 		This is the first method that an application calls to become
 
 */
 func (a *Network1) CreateNetwork(app_root dbus.ObjectPath, uuid []byte) (uint64, error) {
+	return a.CreateNetworkContext(context.Background(), app_root, uuid)
+}
+
+// CreateNetworkContext call CreateNetwork, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Network1) CreateNetworkContext(ctx context.Context, app_root dbus.ObjectPath, uuid []byte) (uint64, error) {
 	
 	var val0 uint64
-	err := a.client.Call("CreateNetwork", 0, app_root, uuid).Store(&val0)
+	err := a.client.CallWithContext(ctx, "CreateNetwork", 0, app_root, uuid).Store(&val0)
 	return val0, err	
 }
 
@@ -407,9 +455,14 @@ Import 		This method creates a local mesh node based on node
 
 */
 func (a *Network1) Import(app_root dbus.ObjectPath, uuid []byte, dev_key []byte, net_key []byte, net_index uint16, flags map[string]interface{}, iv_index uint32, unicast uint16) (uint64, error) {
+	return a.ImportContext(context.Background(), app_root, uuid, dev_key, net_key, net_index, flags, iv_index, unicast)
+}
+
+// ImportContext call Import, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Network1) ImportContext(ctx context.Context, app_root dbus.ObjectPath, uuid []byte, dev_key []byte, net_key []byte, net_index uint16, flags map[string]interface{}, iv_index uint32, unicast uint16) (uint64, error) {
 	
 	var val0 uint64
-	err := a.client.Call("Import", 0, app_root, uuid, dev_key, net_key, net_index, flags, iv_index, unicast).Store(&val0)
+	err := a.client.CallWithContext(ctx, "Import", 0, app_root, uuid, dev_key, net_key, net_index, flags, iv_index, unicast).Store(&val0)
 	return val0, err	
 }
 
@@ -0,0 +1,46 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// Network1API is the interface of Network1, implemented by
+// FakeNetwork1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for Network1.
+type Network1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*Network1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*Network1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	Join(app_root dbus.ObjectPath, uuid []byte) error
+	JoinContext(ctx context.Context, app_root dbus.ObjectPath, uuid []byte) error
+	Cancel() error
+	CancelContext(ctx context.Context) error
+	Attach(app_root dbus.ObjectPath, token uint64) (dbus.ObjectPath, []ConfigurationItem, error)
+	AttachContext(ctx context.Context, app_root dbus.ObjectPath, token uint64) (dbus.ObjectPath, []ConfigurationItem, error)
+	Leave(token uint64) error
+	LeaveContext(ctx context.Context, token uint64) error
+	CreateNetwork(app_root dbus.ObjectPath, uuid []byte) (uint64, error)
+	CreateNetworkContext(ctx context.Context, app_root dbus.ObjectPath, uuid []byte) (uint64, error)
+	Import(app_root dbus.ObjectPath, uuid []byte, dev_key []byte, net_key []byte, net_index uint16, flags map[string]interface{}, iv_index uint32, unicast uint16) (uint64, error)
+	ImportContext(ctx context.Context, app_root dbus.ObjectPath, uuid []byte, dev_key []byte, net_key []byte, net_index uint16, flags map[string]interface{}, iv_index uint32, unicast uint16) (uint64, error)
+}
+
+var _ Network1API = (*Network1)(nil)
@@ -5,6 +5,7 @@ package mesh
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var Node1Interface = "org.bluez.mesh.Node1"
 // Args:
 
 func NewNode1(objectPath dbus.ObjectPath) (*Node1, error) {
+	return NewNode1WithConn(nil, objectPath)
+}
+
+// NewNode1WithConn create a new instance of Node1 on a connection, nil for the shared system bus connection
+//
+// Args:
+
+func NewNode1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*Node1, error) {
 	a := new(Node1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewNode1(objectPath dbus.ObjectPath) (*Node1, error) {
 			Iface: Node1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -44,11 +54,12 @@ func NewNode1(objectPath dbus.ObjectPath) (*Node1, error) {
 /*
 Node1 Mesh Node Hierarchy
 
+
+Since BlueZ 5.53
 */
 type Node1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*Node1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -64,42 +75,17 @@ type Node1Properties struct {
 	*/
 	Features map[string]interface{}
 
-	/*
-	LowPower Indicates support for operating in Low Power node mode
-	*/
-	LowPower bool
-
-	/*
-	SecondsSinceLastHeard This property may be read at any time to determine the number of
-		seconds since mesh network layer traffic was last detected on
-		this node's network.
-	*/
-	SecondsSinceLastHeard uint32
-
-	/*
-	Addresses This property contains unicast addresses of node's elements.
-	*/
-	Addresses []uint16
-
-	/*
-	IvIndex This property may be read at any time to determine the IV_Index
-		that the current network is on. This information is only useful
-		for provisioning.
-	*/
-	IvIndex uint32
-
-	/*
-	SequenceNumber This property may be read at any time to determine the
-		sequence number.
-	*/
-	SequenceNumber uint32
-
 	/*
 	Friend Indicates the ability to establish a friendship with a
 			Low Power node
 	*/
 	Friend bool
 
+	/*
+	LowPower Indicates support for operating in Low Power node mode
+	*/
+	LowPower bool
+
 	/*
 	Proxy Indicates support for GATT proxy
 	*/
@@ -126,6 +112,31 @@ type Node1Properties struct {
 	*/
 	Beacon bool
 
+	/*
+	IvIndex This property may be read at any time to determine the IV_Index
+		that the current network is on. This information is only useful
+		for provisioning.
+	*/
+	IvIndex uint32
+
+	/*
+	SecondsSinceLastHeard This property may be read at any time to determine the number of
+		seconds since mesh network layer traffic was last detected on
+		this node's network.
+	*/
+	SecondsSinceLastHeard uint32
+
+	/*
+	Addresses This property contains unicast addresses of node's elements.
+	*/
+	Addresses []uint16
+
+	/*
+	SequenceNumber This property may be read at any time to determine the
+		sequence number.
+	*/
+	SequenceNumber uint32
+
 }
 
 //Lock access to properties
@@ -145,7 +156,12 @@ func (p *Node1Properties) Unlock() {
 
 // GetFeatures get Features value
 func (a *Node1) GetFeatures() (map[string]interface{}, error) {
-	v, err := a.GetProperty("Features")
+	return a.GetFeaturesContext(context.Background())
+}
+
+// GetFeaturesContext get Features value, failing with a bluez.ContextError if ctx is done
+func (a *Node1) GetFeaturesContext(ctx context.Context) (map[string]interface{}, error) {
+	v, err := a.GetPropertyContext(ctx, "Features")
 	if err != nil {
 		return map[string]interface{}{}, err
 	}
@@ -155,16 +171,26 @@ func (a *Node1) GetFeatures() (map[string]interface{}, error) {
 
 
 
-// SetLowPower set LowPower value
-func (a *Node1) SetLowPower(v bool) error {
-	return a.SetProperty("LowPower", v)
+// SetFriend set Friend value
+func (a *Node1) SetFriend(v bool) error {
+	return a.SetFriendContext(context.Background(), v)
 }
 
+// SetFriendContext set Friend value, failing with a bluez.ContextError if ctx is done
+func (a *Node1) SetFriendContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Friend", v)
+}
 
 
-// GetLowPower get LowPower value
-func (a *Node1) GetLowPower() (bool, error) {
-	v, err := a.GetProperty("LowPower")
+
+// GetFriend get Friend value
+func (a *Node1) GetFriend() (bool, error) {
+	return a.GetFriendContext(context.Background())
+}
+
+// GetFriendContext get Friend value, failing with a bluez.ContextError if ctx is done
+func (a *Node1) GetFriendContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Friend")
 	if err != nil {
 		return false, err
 	}
@@ -174,43 +200,88 @@ func (a *Node1) GetLowPower() (bool, error) {
 
 
 
+// SetLowPower set LowPower value
+func (a *Node1) SetLowPower(v bool) error {
+	return a.SetLowPowerContext(context.Background(), v)
+}
 
+// SetLowPowerContext set LowPower value, failing with a bluez.ContextError if ctx is done
+func (a *Node1) SetLowPowerContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "LowPower", v)
+}
 
-// GetSecondsSinceLastHeard get SecondsSinceLastHeard value
-func (a *Node1) GetSecondsSinceLastHeard() (uint32, error) {
-	v, err := a.GetProperty("SecondsSinceLastHeard")
+
+
+// GetLowPower get LowPower value
+func (a *Node1) GetLowPower() (bool, error) {
+	return a.GetLowPowerContext(context.Background())
+}
+
+// GetLowPowerContext get LowPower value, failing with a bluez.ContextError if ctx is done
+func (a *Node1) GetLowPowerContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "LowPower")
 	if err != nil {
-		return uint32(0), err
+		return false, err
 	}
-	return v.Value().(uint32), nil
+	return v.Value().(bool), nil
 }
 
 
 
 
+// SetProxy set Proxy value
+func (a *Node1) SetProxy(v bool) error {
+	return a.SetProxyContext(context.Background(), v)
+}
 
+// SetProxyContext set Proxy value, failing with a bluez.ContextError if ctx is done
+func (a *Node1) SetProxyContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Proxy", v)
+}
 
-// GetAddresses get Addresses value
-func (a *Node1) GetAddresses() ([]uint16, error) {
-	v, err := a.GetProperty("Addresses")
+
+
+// GetProxy get Proxy value
+func (a *Node1) GetProxy() (bool, error) {
+	return a.GetProxyContext(context.Background())
+}
+
+// GetProxyContext get Proxy value, failing with a bluez.ContextError if ctx is done
+func (a *Node1) GetProxyContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Proxy")
 	if err != nil {
-		return []uint16{}, err
+		return false, err
 	}
-	return v.Value().([]uint16), nil
+	return v.Value().(bool), nil
 }
 
 
 
 
+// SetRelay set Relay value
+func (a *Node1) SetRelay(v bool) error {
+	return a.SetRelayContext(context.Background(), v)
+}
 
+// SetRelayContext set Relay value, failing with a bluez.ContextError if ctx is done
+func (a *Node1) SetRelayContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Relay", v)
+}
 
-// GetIvIndex get IvIndex value
-func (a *Node1) GetIvIndex() (uint32, error) {
-	v, err := a.GetProperty("IvIndex")
+
+
+// GetRelay get Relay value
+func (a *Node1) GetRelay() (bool, error) {
+	return a.GetRelayContext(context.Background())
+}
+
+// GetRelayContext get Relay value, failing with a bluez.ContextError if ctx is done
+func (a *Node1) GetRelayContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Relay")
 	if err != nil {
-		return uint32(0), err
+		return false, err
 	}
-	return v.Value().(uint32), nil
+	return v.Value().(bool), nil
 }
 
 
@@ -218,70 +289,75 @@ func (a *Node1) GetIvIndex() (uint32, error) {
 
 
 
-// GetSequenceNumber get SequenceNumber value
-func (a *Node1) GetSequenceNumber() (uint32, error) {
-	v, err := a.GetProperty("SequenceNumber")
+// GetBeacon get Beacon value
+func (a *Node1) GetBeacon() (bool, error) {
+	return a.GetBeaconContext(context.Background())
+}
+
+// GetBeaconContext get Beacon value, failing with a bluez.ContextError if ctx is done
+func (a *Node1) GetBeaconContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Beacon")
 	if err != nil {
-		return uint32(0), err
+		return false, err
 	}
-	return v.Value().(uint32), nil
+	return v.Value().(bool), nil
 }
 
 
 
 
-// SetFriend set Friend value
-func (a *Node1) SetFriend(v bool) error {
-	return a.SetProperty("Friend", v)
-}
 
 
+// GetIvIndex get IvIndex value
+func (a *Node1) GetIvIndex() (uint32, error) {
+	return a.GetIvIndexContext(context.Background())
+}
 
-// GetFriend get Friend value
-func (a *Node1) GetFriend() (bool, error) {
-	v, err := a.GetProperty("Friend")
+// GetIvIndexContext get IvIndex value, failing with a bluez.ContextError if ctx is done
+func (a *Node1) GetIvIndexContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "IvIndex")
 	if err != nil {
-		return false, err
+		return uint32(0), err
 	}
-	return v.Value().(bool), nil
+	return v.Value().(uint32), nil
 }
 
 
 
 
-// SetProxy set Proxy value
-func (a *Node1) SetProxy(v bool) error {
-	return a.SetProperty("Proxy", v)
-}
 
 
+// GetSecondsSinceLastHeard get SecondsSinceLastHeard value
+func (a *Node1) GetSecondsSinceLastHeard() (uint32, error) {
+	return a.GetSecondsSinceLastHeardContext(context.Background())
+}
 
-// GetProxy get Proxy value
-func (a *Node1) GetProxy() (bool, error) {
-	v, err := a.GetProperty("Proxy")
+// GetSecondsSinceLastHeardContext get SecondsSinceLastHeard value, failing with a bluez.ContextError if ctx is done
+func (a *Node1) GetSecondsSinceLastHeardContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "SecondsSinceLastHeard")
 	if err != nil {
-		return false, err
+		return uint32(0), err
 	}
-	return v.Value().(bool), nil
+	return v.Value().(uint32), nil
 }
 
 
 
 
-// SetRelay set Relay value
-func (a *Node1) SetRelay(v bool) error {
-	return a.SetProperty("Relay", v)
-}
 
 
+// GetAddresses get Addresses value
+func (a *Node1) GetAddresses() ([]uint16, error) {
+	return a.GetAddressesContext(context.Background())
+}
 
-// GetRelay get Relay value
-func (a *Node1) GetRelay() (bool, error) {
-	v, err := a.GetProperty("Relay")
+// GetAddressesContext get Addresses value, failing with a bluez.ContextError if ctx is done
+func (a *Node1) GetAddressesContext(ctx context.Context) ([]uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Addresses")
 	if err != nil {
-		return false, err
+		return []uint16{}, err
 	}
-	return v.Value().(bool), nil
+	return v.Value().([]uint16), nil
 }
 
 
@@ -289,13 +365,18 @@ func (a *Node1) GetRelay() (bool, error) {
 
 
 
-// GetBeacon get Beacon value
-func (a *Node1) GetBeacon() (bool, error) {
-	v, err := a.GetProperty("Beacon")
+// GetSequenceNumber get SequenceNumber value
+func (a *Node1) GetSequenceNumber() (uint32, error) {
+	return a.GetSequenceNumberContext(context.Background())
+}
+
+// GetSequenceNumberContext get SequenceNumber value, failing with a bluez.ContextError if ctx is done
+func (a *Node1) GetSequenceNumberContext(ctx context.Context) (uint32, error) {
+	v, err := a.GetPropertyContext(ctx, "SequenceNumber")
 	if err != nil {
-		return false, err
+		return uint32(0), err
 	}
-	return v.Value().(bool), nil
+	return v.Value().(uint32), nil
 }
 
 
@@ -323,35 +404,24 @@ func (a *Node1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *Node1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *Node1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -381,20 +451,31 @@ func (a *Node1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Node1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Node1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *Node1) GetProperties() (*Node1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *Node1) GetPropertiesContext(ctx context.Context) (*Node1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -404,29 +485,40 @@ func (a *Node1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *Node1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *Node1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *Node1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *Node1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *Node1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *Node1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -436,6 +528,7 @@ func (a *Node1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *Node1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -466,8 +559,13 @@ Send 		This method is used to send a message originated by a local
 
 */
 func (a *Node1) Send(element_path dbus.ObjectPath, destination uint16, key_index uint16, data []byte) error {
+	return a.SendContext(context.Background(), element_path, destination, key_index, data)
+}
+
+// SendContext call Send, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Node1) SendContext(ctx context.Context, element_path dbus.ObjectPath, destination uint16, key_index uint16, data []byte) error {
 	
-	return a.client.Call("Send", 0, element_path, destination, key_index, data).Store()
+	return a.client.CallWithContext(ctx, "Send", 0, element_path, destination, key_index, data).Store()
 	
 }
 
@@ -495,8 +593,13 @@ DevKeySend 		This method is used to send a message originated by a local
 
 */
 func (a *Node1) DevKeySend(element_path dbus.ObjectPath, destination uint16, remote bool, net_index uint16, data []byte) error {
+	return a.DevKeySendContext(context.Background(), element_path, destination, remote, net_index, data)
+}
+
+// DevKeySendContext call DevKeySend, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Node1) DevKeySendContext(ctx context.Context, element_path dbus.ObjectPath, destination uint16, remote bool, net_index uint16, data []byte) error {
 	
-	return a.client.Call("DevKeySend", 0, element_path, destination, remote, net_index, data).Store()
+	return a.client.CallWithContext(ctx, "DevKeySend", 0, element_path, destination, remote, net_index, data).Store()
 	
 }
 
@@ -523,8 +626,13 @@ AddNetKey 		This method is used to send add or update network key originated
 
 */
 func (a *Node1) AddNetKey(element_path dbus.ObjectPath, destination uint16, subnet_index uint16, net_index uint16, update bool) error {
+	return a.AddNetKeyContext(context.Background(), element_path, destination, subnet_index, net_index, update)
+}
+
+// AddNetKeyContext call AddNetKey, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Node1) AddNetKeyContext(ctx context.Context, element_path dbus.ObjectPath, destination uint16, subnet_index uint16, net_index uint16, update bool) error {
 	
-	return a.client.Call("AddNetKey", 0, element_path, destination, subnet_index, net_index, update).Store()
+	return a.client.CallWithContext(ctx, "AddNetKey", 0, element_path, destination, subnet_index, net_index, update).Store()
 	
 }
 
@@ -551,8 +659,13 @@ AddAppKey 		This method is used to send add or update network key originated
 
 */
 func (a *Node1) AddAppKey(element_path dbus.ObjectPath, destination uint16, app_index uint16, net_index uint16, update bool) error {
+	return a.AddAppKeyContext(context.Background(), element_path, destination, app_index, net_index, update)
+}
+
+// AddAppKeyContext call AddAppKey, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Node1) AddAppKeyContext(ctx context.Context, element_path dbus.ObjectPath, destination uint16, app_index uint16, net_index uint16, update bool) error {
 	
-	return a.client.Call("AddAppKey", 0, element_path, destination, app_index, net_index, update).Store()
+	return a.client.CallWithContext(ctx, "AddAppKey", 0, element_path, destination, app_index, net_index, update).Store()
 	
 }
 
@@ -575,8 +688,13 @@ Publish 		This method is used to send a publication originated by a local
 
 */
 func (a *Node1) Publish(element_path dbus.ObjectPath, model uint16, data []byte) error {
+	return a.PublishContext(context.Background(), element_path, model, data)
+}
+
+// PublishContext call Publish, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Node1) PublishContext(ctx context.Context, element_path dbus.ObjectPath, model uint16, data []byte) error {
 	
-	return a.client.Call("Publish", 0, element_path, model, data).Store()
+	return a.client.CallWithContext(ctx, "Publish", 0, element_path, model, data).Store()
 	
 }
 
@@ -600,8 +718,13 @@ VendorPublish 		This method is used to send a publication originated by a local
 
 */
 func (a *Node1) VendorPublish(element_path dbus.ObjectPath, vendor uint16, model_id uint16, data []byte) error {
+	return a.VendorPublishContext(context.Background(), element_path, vendor, model_id, data)
+}
+
+// VendorPublishContext call VendorPublish, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Node1) VendorPublishContext(ctx context.Context, element_path dbus.ObjectPath, vendor uint16, model_id uint16, data []byte) error {
 	
-	return a.client.Call("VendorPublish", 0, element_path, vendor, model_id, data).Store()
+	return a.client.CallWithContext(ctx, "VendorPublish", 0, element_path, vendor, model_id, data).Store()
 	
 }
 
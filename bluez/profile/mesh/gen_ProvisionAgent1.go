@@ -5,6 +5,7 @@ package mesh
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -21,6 +22,15 @@ var ProvisionAgent1Interface = "org.bluez.mesh.ProvisionAgent1"
 // - servicePath: unique name
 // - objectPath: freely definable
 func NewProvisionAgent1(servicePath string, objectPath dbus.ObjectPath) (*ProvisionAgent1, error) {
+	return NewProvisionAgent1WithConn(nil, servicePath, objectPath)
+}
+
+// NewProvisionAgent1WithConn create a new instance of ProvisionAgent1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - servicePath: unique name
+// - objectPath: freely definable
+func NewProvisionAgent1WithConn(conn *bluez.Conn, servicePath string, objectPath dbus.ObjectPath) (*ProvisionAgent1, error) {
 	a := new(ProvisionAgent1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -28,6 +38,7 @@ func NewProvisionAgent1(servicePath string, objectPath dbus.ObjectPath) (*Provis
 			Iface: ProvisionAgent1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -45,11 +56,12 @@ func NewProvisionAgent1(servicePath string, objectPath dbus.ObjectPath) (*Provis
 /*
 ProvisionAgent1 Provisioning Agent Hierarchy
 
+
+Since BlueZ 5.53
 */
 type ProvisionAgent1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*ProvisionAgent1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -118,7 +130,12 @@ func (p *ProvisionAgent1Properties) Unlock() {
 
 // GetCapabilities get Capabilities value
 func (a *ProvisionAgent1) GetCapabilities() ([]string, error) {
-	v, err := a.GetProperty("Capabilities")
+	return a.GetCapabilitiesContext(context.Background())
+}
+
+// GetCapabilitiesContext get Capabilities value, failing with a bluez.ContextError if ctx is done
+func (a *ProvisionAgent1) GetCapabilitiesContext(ctx context.Context) ([]string, error) {
+	v, err := a.GetPropertyContext(ctx, "Capabilities")
 	if err != nil {
 		return []string{}, err
 	}
@@ -132,7 +149,12 @@ func (a *ProvisionAgent1) GetCapabilities() ([]string, error) {
 
 // GetOutOfBandInfo get OutOfBandInfo value
 func (a *ProvisionAgent1) GetOutOfBandInfo() ([]string, error) {
-	v, err := a.GetProperty("OutOfBandInfo")
+	return a.GetOutOfBandInfoContext(context.Background())
+}
+
+// GetOutOfBandInfoContext get OutOfBandInfo value, failing with a bluez.ContextError if ctx is done
+func (a *ProvisionAgent1) GetOutOfBandInfoContext(ctx context.Context) ([]string, error) {
+	v, err := a.GetPropertyContext(ctx, "OutOfBandInfo")
 	if err != nil {
 		return []string{}, err
 	}
@@ -146,7 +168,12 @@ func (a *ProvisionAgent1) GetOutOfBandInfo() ([]string, error) {
 
 // GetURI get URI value
 func (a *ProvisionAgent1) GetURI() (string, error) {
-	v, err := a.GetProperty("URI")
+	return a.GetURIContext(context.Background())
+}
+
+// GetURIContext get URI value, failing with a bluez.ContextError if ctx is done
+func (a *ProvisionAgent1) GetURIContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "URI")
 	if err != nil {
 		return "", err
 	}
@@ -178,35 +205,24 @@ func (a *ProvisionAgent1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *ProvisionAgent1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *ProvisionAgent1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -236,20 +252,31 @@ func (a *ProvisionAgent1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *ProvisionAgent1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *ProvisionAgent1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *ProvisionAgent1) GetProperties() (*ProvisionAgent1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *ProvisionAgent1) GetPropertiesContext(ctx context.Context) (*ProvisionAgent1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -259,29 +286,40 @@ func (a *ProvisionAgent1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *ProvisionAgent1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *ProvisionAgent1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *ProvisionAgent1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *ProvisionAgent1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *ProvisionAgent1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *ProvisionAgent1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -291,6 +329,7 @@ func (a *ProvisionAgent1) WatchProperties() (chan *bluez.PropertyChanged, error)
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *ProvisionAgent1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -312,9 +351,14 @@ PrivateKey 		This method is called during provisioning if the Provisioner
 
 */
 func (a *ProvisionAgent1) PrivateKey() ([]byte, error) {
+	return a.PrivateKeyContext(context.Background())
+}
+
+// PrivateKeyContext call PrivateKey, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *ProvisionAgent1) PrivateKeyContext(ctx context.Context) ([]byte, error) {
 	
 	 val0 := []byte{}
-	err := a.client.Call("PrivateKey", 0, ).Store(&val0)
+	err := a.client.CallWithContext(ctx, "PrivateKey", 0, ).Store(&val0)
 	return val0, err	
 }
 
@@ -331,9 +375,14 @@ PublicKey 		This method is called during provisioning if the local device is
 
 */
 func (a *ProvisionAgent1) PublicKey() ([]byte, error) {
+	return a.PublicKeyContext(context.Background())
+}
+
+// PublicKeyContext call PublicKey, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *ProvisionAgent1) PublicKeyContext(ctx context.Context) ([]byte, error) {
 	
 	 val0 := []byte{}
-	err := a.client.Call("PublicKey", 0, ).Store(&val0)
+	err := a.client.CallWithContext(ctx, "PublicKey", 0, ).Store(&val0)
 	return val0, err	
 }
 
@@ -344,8 +393,13 @@ DisplayString 		This method is called when the Daemon has something important
 
 */
 func (a *ProvisionAgent1) DisplayString(value string) error {
+	return a.DisplayStringContext(context.Background(), value)
+}
+
+// DisplayStringContext call DisplayString, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *ProvisionAgent1) DisplayStringContext(ctx context.Context, value string) error {
 	
-	return a.client.Call("DisplayString", 0, value).Store()
+	return a.client.CallWithContext(ctx, "DisplayString", 0, value).Store()
 	
 }
 
@@ -366,8 +420,13 @@ DisplayNumeric 		This method is called when the Daemon has something important
 
 */
 func (a *ProvisionAgent1) DisplayNumeric(type1 string, number uint32) error {
+	return a.DisplayNumericContext(context.Background(), type1, number)
+}
+
+// DisplayNumericContext call DisplayNumeric, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *ProvisionAgent1) DisplayNumericContext(ctx context.Context, type1 string, number uint32) error {
 	
-	return a.client.Call("DisplayNumeric", 0, type1, number).Store()
+	return a.client.CallWithContext(ctx, "DisplayNumeric", 0, type1, number).Store()
 	
 }
 
@@ -387,9 +446,14 @@ PromptNumeric 		This method is called when the Daemon requests the user to
 
 */
 func (a *ProvisionAgent1) PromptNumeric(type1 string) (uint32, error) {
+	return a.PromptNumericContext(context.Background(), type1)
+}
+
+// PromptNumericContext call PromptNumeric, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *ProvisionAgent1) PromptNumericContext(ctx context.Context, type1 string) (uint32, error) {
 	
 	var val0 uint32
-	err := a.client.Call("PromptNumeric", 0, type1).Store(&val0)
+	err := a.client.CallWithContext(ctx, "PromptNumeric", 0, type1).Store(&val0)
 	return val0, err	
 }
 
@@ -408,9 +472,14 @@ PromptStatic 		This method is called when the Daemon requires a 16 octet byte
 
 */
 func (a *ProvisionAgent1) PromptStatic(type1 string) ([]byte, error) {
+	return a.PromptStaticContext(context.Background(), type1)
+}
+
+// PromptStaticContext call PromptStatic, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *ProvisionAgent1) PromptStaticContext(ctx context.Context, type1 string) ([]byte, error) {
 	
 	 val0 := []byte{}
-	err := a.client.Call("PromptStatic", 0, type1).Store(&val0)
+	err := a.client.CallWithContext(ctx, "PromptStatic", 0, type1).Store(&val0)
 	return val0, err	
 }
 
@@ -421,8 +490,13 @@ Cancel 		This method gets called by the daemon to cancel any existing
 
 */
 func (a *ProvisionAgent1) Cancel() error {
+	return a.CancelContext(context.Background())
+}
+
+// CancelContext call Cancel, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *ProvisionAgent1) CancelContext(ctx context.Context) error {
 	
-	return a.client.Call("Cancel", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Cancel", 0, ).Store()
 	
 }
 
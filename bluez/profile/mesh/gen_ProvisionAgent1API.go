@@ -0,0 +1,57 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// ProvisionAgent1API is the interface of ProvisionAgent1, implemented by
+// FakeProvisionAgent1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for ProvisionAgent1.
+type ProvisionAgent1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*ProvisionAgent1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*ProvisionAgent1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	GetCapabilities() ([]string, error)
+	GetCapabilitiesContext(ctx context.Context) ([]string, error)
+
+	GetOutOfBandInfo() ([]string, error)
+	GetOutOfBandInfoContext(ctx context.Context) ([]string, error)
+
+	GetURI() (string, error)
+	GetURIContext(ctx context.Context) (string, error)
+
+	PrivateKey() ([]byte, error)
+	PrivateKeyContext(ctx context.Context) ([]byte, error)
+	PublicKey() ([]byte, error)
+	PublicKeyContext(ctx context.Context) ([]byte, error)
+	DisplayString(value string) error
+	DisplayStringContext(ctx context.Context, value string) error
+	DisplayNumeric(type1 string, number uint32) error
+	DisplayNumericContext(ctx context.Context, type1 string, number uint32) error
+	PromptNumeric(type1 string) (uint32, error)
+	PromptNumericContext(ctx context.Context, type1 string) (uint32, error)
+	PromptStatic(type1 string) ([]byte, error)
+	PromptStaticContext(ctx context.Context, type1 string) ([]byte, error)
+	Cancel() error
+	CancelContext(ctx context.Context) error
+}
+
+var _ ProvisionAgent1API = (*ProvisionAgent1)(nil)
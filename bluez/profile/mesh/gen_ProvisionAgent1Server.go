@@ -0,0 +1,232 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+
+import (
+   "github.com/godbus/dbus/v5"
+   "github.com/godbus/dbus/v5/introspect"
+   "github.com/godbus/dbus/v5/prop"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/bluez/profile"
+)
+
+// ProvisionAgent1Handler is implemented by the application to serve
+// org.bluez.mesh.ProvisionAgent1, see ProvisionAgent1 for the documentation of the
+// methods. Embed UnimplementedProvisionAgent1Handler to implement only some of them.
+type ProvisionAgent1Handler interface {
+	PrivateKey() ([]byte, *dbus.Error)
+	PublicKey() ([]byte, *dbus.Error)
+	DisplayString(value string) *dbus.Error
+	DisplayNumeric(type1 string, number uint32) *dbus.Error
+	PromptNumeric(type1 string) (uint32, *dbus.Error)
+	PromptStatic(type1 string) ([]byte, *dbus.Error)
+	Cancel() *dbus.Error
+}
+
+// UnimplementedProvisionAgent1Handler reply org.bluez.Error.NotImplemented to
+// every method of ProvisionAgent1Handler
+type UnimplementedProvisionAgent1Handler struct{}
+
+// PrivateKey reply org.bluez.Error.NotImplemented
+func (UnimplementedProvisionAgent1Handler) PrivateKey() ([]byte, *dbus.Error) {
+	
+	 val0 := []byte{}
+	return val0, &profile.ErrNotImplemented	
+}
+
+// PublicKey reply org.bluez.Error.NotImplemented
+func (UnimplementedProvisionAgent1Handler) PublicKey() ([]byte, *dbus.Error) {
+	
+	 val0 := []byte{}
+	return val0, &profile.ErrNotImplemented	
+}
+
+// DisplayString reply org.bluez.Error.NotImplemented
+func (UnimplementedProvisionAgent1Handler) DisplayString(value string) *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+// DisplayNumeric reply org.bluez.Error.NotImplemented
+func (UnimplementedProvisionAgent1Handler) DisplayNumeric(type1 string, number uint32) *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+// PromptNumeric reply org.bluez.Error.NotImplemented
+func (UnimplementedProvisionAgent1Handler) PromptNumeric(type1 string) (uint32, *dbus.Error) {
+	
+	var val0 uint32
+	return val0, &profile.ErrNotImplemented	
+}
+
+// PromptStatic reply org.bluez.Error.NotImplemented
+func (UnimplementedProvisionAgent1Handler) PromptStatic(type1 string) ([]byte, *dbus.Error) {
+	
+	 val0 := []byte{}
+	return val0, &profile.ErrNotImplemented	
+}
+
+// Cancel reply org.bluez.Error.NotImplemented
+func (UnimplementedProvisionAgent1Handler) Cancel() *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+var _ ProvisionAgent1Handler = UnimplementedProvisionAgent1Handler{}
+
+// ProvisionAgent1Server export a ProvisionAgent1Handler on a connection,
+// forwarding the calls of bluez to it
+type ProvisionAgent1Server struct {
+	conn       *dbus.Conn
+	path       dbus.ObjectPath
+	handler    ProvisionAgent1Handler
+	properties *prop.Properties
+}
+
+// ExportProvisionAgent1 export handler as org.bluez.mesh.ProvisionAgent1 at path, with
+// its introspection data and the Properties interface serving properties, nil
+// for their zero values. The Introspectable interface of path only describes
+// org.bluez.mesh.ProvisionAgent1, use Introspection to describe objects exporting more interfaces.
+func ExportProvisionAgent1(conn *dbus.Conn, path dbus.ObjectPath, handler ProvisionAgent1Handler, properties *ProvisionAgent1Properties) (*ProvisionAgent1Server, error) {
+
+	s := &ProvisionAgent1Server{
+		conn:    conn,
+		path:    path,
+		handler: handler,
+	}
+
+	err := conn.Export(s, path, ProvisionAgent1Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	if properties == nil {
+		properties = new(ProvisionAgent1Properties)
+	}
+	propsConfig := make(map[string]*prop.Prop)
+	for name, info := range props.ParseProperties(properties) {
+		if info.Skip {
+			continue
+		}
+		p := info.Prop
+		propsConfig[name] = &p
+	}
+	s.properties = prop.New(conn, path, map[string]map[string]*prop.Prop{
+		ProvisionAgent1Interface: propsConfig,
+	})
+
+	node := &introspect.Node{
+		Interfaces: []introspect.Interface{
+			introspect.IntrospectData,
+			prop.IntrospectData,
+			s.Introspection(),
+		},
+	}
+	err = conn.Export(introspect.NewIntrospectable(node), path, bluez.Introspectable)
+	if err != nil {
+		s.Unexport()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Path return the object path of the server
+func (s *ProvisionAgent1Server) Path() dbus.ObjectPath {
+	return s.path
+}
+
+// Interface return the exported interface
+func (s *ProvisionAgent1Server) Interface() string {
+	return ProvisionAgent1Interface
+}
+
+// Handler return the handler serving the calls
+func (s *ProvisionAgent1Server) Handler() ProvisionAgent1Handler {
+	return s.handler
+}
+
+// Introspection return the introspection data of org.bluez.mesh.ProvisionAgent1
+func (s *ProvisionAgent1Server) Introspection() introspect.Interface {
+	return introspect.Interface{
+		Name:       ProvisionAgent1Interface,
+		Methods:    introspect.Methods(s),
+		Properties: s.properties.Introspection(ProvisionAgent1Interface),
+	}
+}
+
+// GetProperty return the value of a served property
+func (s *ProvisionAgent1Server) GetProperty(name string) (dbus.Variant, error) {
+	v, err := s.properties.Get(ProvisionAgent1Interface, name)
+	if err != nil {
+		return dbus.Variant{}, err
+	}
+	return v, nil
+}
+
+// SetProperty update a served property, PropertiesChanged is emitted as set
+// by its dbus tag
+func (s *ProvisionAgent1Server) SetProperty(name string, value interface{}) error {
+	_, err := s.properties.Get(ProvisionAgent1Interface, name)
+	if err != nil {
+		return err
+	}
+	s.properties.SetMust(ProvisionAgent1Interface, name, value)
+	return nil
+}
+
+// Unexport remove the object from the connection
+func (s *ProvisionAgent1Server) Unexport() error {
+	err := s.conn.Export(nil, s.path, ProvisionAgent1Interface)
+	if err != nil {
+		return err
+	}
+	err = s.conn.Export(nil, s.path, bluez.PropertiesInterface)
+	if err != nil {
+		return err
+	}
+	return s.conn.Export(nil, s.path, bluez.Introspectable)
+}
+
+// PrivateKey forward the call to the handler
+func (s *ProvisionAgent1Server) PrivateKey() ([]byte, *dbus.Error) {
+	return s.handler.PrivateKey()
+}
+
+// PublicKey forward the call to the handler
+func (s *ProvisionAgent1Server) PublicKey() ([]byte, *dbus.Error) {
+	return s.handler.PublicKey()
+}
+
+// DisplayString forward the call to the handler
+func (s *ProvisionAgent1Server) DisplayString(value string) *dbus.Error {
+	return s.handler.DisplayString(value)
+}
+
+// DisplayNumeric forward the call to the handler
+func (s *ProvisionAgent1Server) DisplayNumeric(type1 string, number uint32) *dbus.Error {
+	return s.handler.DisplayNumeric(type1, number)
+}
+
+// PromptNumeric forward the call to the handler
+func (s *ProvisionAgent1Server) PromptNumeric(type1 string) (uint32, *dbus.Error) {
+	return s.handler.PromptNumeric(type1)
+}
+
+// PromptStatic forward the call to the handler
+func (s *ProvisionAgent1Server) PromptStatic(type1 string) ([]byte, *dbus.Error) {
+	return s.handler.PromptStatic(type1)
+}
+
+// Cancel forward the call to the handler
+func (s *ProvisionAgent1Server) Cancel() *dbus.Error {
+	return s.handler.Cancel()
+}
+
@@ -0,0 +1,183 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+// ProvisionAgent1Capabilities is a value documented for ProvisionAgent1.Capabilities
+type ProvisionAgent1Capabilities string
+
+const (
+	ProvisionAgent1CapabilitiesBlink ProvisionAgent1Capabilities = "blink"
+	ProvisionAgent1CapabilitiesBeep ProvisionAgent1Capabilities = "beep"
+	ProvisionAgent1CapabilitiesVibrate ProvisionAgent1Capabilities = "vibrate"
+	ProvisionAgent1CapabilitiesOutNumeric ProvisionAgent1Capabilities = "out-numeric"
+	ProvisionAgent1CapabilitiesOutAlpha ProvisionAgent1Capabilities = "out-alpha"
+	ProvisionAgent1CapabilitiesPush ProvisionAgent1Capabilities = "push"
+	ProvisionAgent1CapabilitiesTwist ProvisionAgent1Capabilities = "twist"
+	ProvisionAgent1CapabilitiesInNumeric ProvisionAgent1Capabilities = "in-numeric"
+	ProvisionAgent1CapabilitiesInAlpha ProvisionAgent1Capabilities = "in-alpha"
+	ProvisionAgent1CapabilitiesStaticOob ProvisionAgent1Capabilities = "static-oob"
+	ProvisionAgent1CapabilitiesPublicOob ProvisionAgent1Capabilities = "public-oob"
+)
+
+// ProvisionAgent1CapabilitiesValues list the documented values of ProvisionAgent1Capabilities
+var ProvisionAgent1CapabilitiesValues = []ProvisionAgent1Capabilities{
+	ProvisionAgent1CapabilitiesBlink,
+	ProvisionAgent1CapabilitiesBeep,
+	ProvisionAgent1CapabilitiesVibrate,
+	ProvisionAgent1CapabilitiesOutNumeric,
+	ProvisionAgent1CapabilitiesOutAlpha,
+	ProvisionAgent1CapabilitiesPush,
+	ProvisionAgent1CapabilitiesTwist,
+	ProvisionAgent1CapabilitiesInNumeric,
+	ProvisionAgent1CapabilitiesInAlpha,
+	ProvisionAgent1CapabilitiesStaticOob,
+	ProvisionAgent1CapabilitiesPublicOob,
+}
+
+// Valid report if v is one of the documented values
+func (v ProvisionAgent1Capabilities) Valid() bool {
+	for _, value := range ProvisionAgent1CapabilitiesValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+
+// ProvisionAgent1OutOfBandInfo is a value documented for ProvisionAgent1.OutOfBandInfo
+type ProvisionAgent1OutOfBandInfo string
+
+const (
+	ProvisionAgent1OutOfBandInfoOther ProvisionAgent1OutOfBandInfo = "other"
+	ProvisionAgent1OutOfBandInfoUri ProvisionAgent1OutOfBandInfo = "uri"
+	ProvisionAgent1OutOfBandInfoMachineCode2d ProvisionAgent1OutOfBandInfo = "machine-code-2d"
+	ProvisionAgent1OutOfBandInfoBarCode ProvisionAgent1OutOfBandInfo = "bar-code"
+	ProvisionAgent1OutOfBandInfoNfc ProvisionAgent1OutOfBandInfo = "nfc"
+	ProvisionAgent1OutOfBandInfoNumber ProvisionAgent1OutOfBandInfo = "number"
+	ProvisionAgent1OutOfBandInfoString ProvisionAgent1OutOfBandInfo = "string"
+	ProvisionAgent1OutOfBandInfoOnBox ProvisionAgent1OutOfBandInfo = "on-box"
+	ProvisionAgent1OutOfBandInfoInBox ProvisionAgent1OutOfBandInfo = "in-box"
+	ProvisionAgent1OutOfBandInfoOnPaper ProvisionAgent1OutOfBandInfo = "on-paper"
+	ProvisionAgent1OutOfBandInfoInManual ProvisionAgent1OutOfBandInfo = "in-manual"
+	ProvisionAgent1OutOfBandInfoOnDevice ProvisionAgent1OutOfBandInfo = "on-device"
+)
+
+// ProvisionAgent1OutOfBandInfoValues list the documented values of ProvisionAgent1OutOfBandInfo
+var ProvisionAgent1OutOfBandInfoValues = []ProvisionAgent1OutOfBandInfo{
+	ProvisionAgent1OutOfBandInfoOther,
+	ProvisionAgent1OutOfBandInfoUri,
+	ProvisionAgent1OutOfBandInfoMachineCode2d,
+	ProvisionAgent1OutOfBandInfoBarCode,
+	ProvisionAgent1OutOfBandInfoNfc,
+	ProvisionAgent1OutOfBandInfoNumber,
+	ProvisionAgent1OutOfBandInfoString,
+	ProvisionAgent1OutOfBandInfoOnBox,
+	ProvisionAgent1OutOfBandInfoInBox,
+	ProvisionAgent1OutOfBandInfoOnPaper,
+	ProvisionAgent1OutOfBandInfoInManual,
+	ProvisionAgent1OutOfBandInfoOnDevice,
+}
+
+// Valid report if v is one of the documented values
+func (v ProvisionAgent1OutOfBandInfo) Valid() bool {
+	for _, value := range ProvisionAgent1OutOfBandInfoValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+
+// ProvisionAgent1DisplayNumericType is a value documented for the type argument of ProvisionAgent1.DisplayNumeric
+type ProvisionAgent1DisplayNumericType string
+
+const (
+	ProvisionAgent1DisplayNumericTypeBlink ProvisionAgent1DisplayNumericType = "blink"
+	ProvisionAgent1DisplayNumericTypeBeep ProvisionAgent1DisplayNumericType = "beep"
+	ProvisionAgent1DisplayNumericTypeVibrate ProvisionAgent1DisplayNumericType = "vibrate"
+	ProvisionAgent1DisplayNumericTypeOutNumeric ProvisionAgent1DisplayNumericType = "out-numeric"
+	ProvisionAgent1DisplayNumericTypePush ProvisionAgent1DisplayNumericType = "push"
+	ProvisionAgent1DisplayNumericTypeTwist ProvisionAgent1DisplayNumericType = "twist"
+)
+
+// ProvisionAgent1DisplayNumericTypeValues list the documented values of ProvisionAgent1DisplayNumericType
+var ProvisionAgent1DisplayNumericTypeValues = []ProvisionAgent1DisplayNumericType{
+	ProvisionAgent1DisplayNumericTypeBlink,
+	ProvisionAgent1DisplayNumericTypeBeep,
+	ProvisionAgent1DisplayNumericTypeVibrate,
+	ProvisionAgent1DisplayNumericTypeOutNumeric,
+	ProvisionAgent1DisplayNumericTypePush,
+	ProvisionAgent1DisplayNumericTypeTwist,
+}
+
+// Valid report if v is one of the documented values
+func (v ProvisionAgent1DisplayNumericType) Valid() bool {
+	for _, value := range ProvisionAgent1DisplayNumericTypeValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+
+// ProvisionAgent1PromptNumericType is a value documented for the type argument of ProvisionAgent1.PromptNumeric
+type ProvisionAgent1PromptNumericType string
+
+const (
+	ProvisionAgent1PromptNumericTypeBlink ProvisionAgent1PromptNumericType = "blink"
+	ProvisionAgent1PromptNumericTypeBeep ProvisionAgent1PromptNumericType = "beep"
+	ProvisionAgent1PromptNumericTypeVibrate ProvisionAgent1PromptNumericType = "vibrate"
+	ProvisionAgent1PromptNumericTypeInNumeric ProvisionAgent1PromptNumericType = "in-numeric"
+	ProvisionAgent1PromptNumericTypePush ProvisionAgent1PromptNumericType = "push"
+	ProvisionAgent1PromptNumericTypeTwist ProvisionAgent1PromptNumericType = "twist"
+)
+
+// ProvisionAgent1PromptNumericTypeValues list the documented values of ProvisionAgent1PromptNumericType
+var ProvisionAgent1PromptNumericTypeValues = []ProvisionAgent1PromptNumericType{
+	ProvisionAgent1PromptNumericTypeBlink,
+	ProvisionAgent1PromptNumericTypeBeep,
+	ProvisionAgent1PromptNumericTypeVibrate,
+	ProvisionAgent1PromptNumericTypeInNumeric,
+	ProvisionAgent1PromptNumericTypePush,
+	ProvisionAgent1PromptNumericTypeTwist,
+}
+
+// Valid report if v is one of the documented values
+func (v ProvisionAgent1PromptNumericType) Valid() bool {
+	for _, value := range ProvisionAgent1PromptNumericTypeValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+
+// ProvisionAgent1PromptStaticType is a value documented for the type argument of ProvisionAgent1.PromptStatic
+type ProvisionAgent1PromptStaticType string
+
+const (
+	ProvisionAgent1PromptStaticTypeStaticOob ProvisionAgent1PromptStaticType = "static-oob"
+	ProvisionAgent1PromptStaticTypeInAlpha ProvisionAgent1PromptStaticType = "in-alpha"
+)
+
+// ProvisionAgent1PromptStaticTypeValues list the documented values of ProvisionAgent1PromptStaticType
+var ProvisionAgent1PromptStaticTypeValues = []ProvisionAgent1PromptStaticType{
+	ProvisionAgent1PromptStaticTypeStaticOob,
+	ProvisionAgent1PromptStaticTypeInAlpha,
+}
+
+// Valid report if v is one of the documented values
+func (v ProvisionAgent1PromptStaticType) Valid() bool {
+	for _, value := range ProvisionAgent1PromptStaticTypeValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
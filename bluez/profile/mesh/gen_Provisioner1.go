@@ -5,6 +5,7 @@ package mesh
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -21,6 +22,15 @@ var Provisioner1Interface = "org.bluez.mesh.Provisioner1"
 // - servicePath: unique name
 // - objectPath: freely definable
 func NewProvisioner1(servicePath string, objectPath dbus.ObjectPath) (*Provisioner1, error) {
+	return NewProvisioner1WithConn(nil, servicePath, objectPath)
+}
+
+// NewProvisioner1WithConn create a new instance of Provisioner1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - servicePath: unique name
+// - objectPath: freely definable
+func NewProvisioner1WithConn(conn *bluez.Conn, servicePath string, objectPath dbus.ObjectPath) (*Provisioner1, error) {
 	a := new(Provisioner1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -28,6 +38,7 @@ func NewProvisioner1(servicePath string, objectPath dbus.ObjectPath) (*Provision
 			Iface: Provisioner1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -45,11 +56,12 @@ func NewProvisioner1(servicePath string, objectPath dbus.ObjectPath) (*Provision
 /*
 Provisioner1 Mesh Provisioner Hierarchy
 
+
+Since BlueZ 5.53
 */
 type Provisioner1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*Provisioner1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -96,35 +108,24 @@ func (a *Provisioner1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *Provisioner1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *Provisioner1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
-
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -154,20 +155,31 @@ func (a *Provisioner1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Provisioner1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Provisioner1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *Provisioner1) GetProperties() (*Provisioner1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *Provisioner1) GetPropertiesContext(ctx context.Context) (*Provisioner1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -177,29 +189,40 @@ func (a *Provisioner1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *Provisioner1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *Provisioner1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *Provisioner1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *Provisioner1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *Provisioner1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *Provisioner1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -209,6 +232,7 @@ func (a *Provisioner1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *Provisioner1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -234,8 +258,13 @@ ScanResult 		The method is called from the bluetooth-meshd daemon when a
 
 */
 func (a *Provisioner1) ScanResult(rssi int16, data []byte) error {
+	return a.ScanResultContext(context.Background(), rssi, data)
+}
+
+// ScanResultContext call ScanResult, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Provisioner1) ScanResultContext(ctx context.Context, rssi int16, data []byte) error {
 	
-	return a.client.Call("ScanResult", 0, rssi, data).Store()
+	return a.client.CallWithContext(ctx, "ScanResult", 0, rssi, data).Store()
 	
 }
 
@@ -253,9 +282,14 @@ RequestProvData 		This method is implemented by a Provisioner capable applicatio
 
 */
 func (a *Provisioner1) RequestProvData(count uint8) (uint16, error) {
+	return a.RequestProvDataContext(context.Background(), count)
+}
+
+// RequestProvDataContext call RequestProvData, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Provisioner1) RequestProvDataContext(ctx context.Context, count uint8) (uint16, error) {
 	
 	var val0 uint16
-	err := a.client.Call("RequestProvData", 0, count).Store(&val0)
+	err := a.client.CallWithContext(ctx, "RequestProvData", 0, count).Store(&val0)
 	return val0, err	
 }
 
@@ -271,8 +305,13 @@ AddNodeComplete 		This method is called when the node provisioning initiated
 
 */
 func (a *Provisioner1) AddNodeComplete(uuid []byte, unicast uint16, count uint8) error {
+	return a.AddNodeCompleteContext(context.Background(), uuid, unicast, count)
+}
+
+// AddNodeCompleteContext call AddNodeComplete, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Provisioner1) AddNodeCompleteContext(ctx context.Context, uuid []byte, unicast uint16, count uint8) error {
 	
-	return a.client.Call("AddNodeComplete", 0, uuid, unicast, count).Store()
+	return a.client.CallWithContext(ctx, "AddNodeComplete", 0, uuid, unicast, count).Store()
 	
 }
 
@@ -289,8 +328,13 @@ AddNodeFailed 		This method is called when the node provisioning initiated by
 
 */
 func (a *Provisioner1) AddNodeFailed(uuid []byte, reason string) error {
+	return a.AddNodeFailedContext(context.Background(), uuid, reason)
+}
+
+// AddNodeFailedContext call AddNodeFailed, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Provisioner1) AddNodeFailedContext(ctx context.Context, uuid []byte, reason string) error {
 	
-	return a.client.Call("AddNodeFailed", 0, uuid, reason).Store()
+	return a.client.CallWithContext(ctx, "AddNodeFailed", 0, uuid, reason).Store()
 	
 }
 
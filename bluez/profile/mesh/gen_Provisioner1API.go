@@ -0,0 +1,42 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// Provisioner1API is the interface of Provisioner1, implemented by
+// FakeProvisioner1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for Provisioner1.
+type Provisioner1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*Provisioner1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*Provisioner1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	ScanResult(rssi int16, data []byte) error
+	ScanResultContext(ctx context.Context, rssi int16, data []byte) error
+	RequestProvData(count uint8) (uint16, error)
+	RequestProvDataContext(ctx context.Context, count uint8) (uint16, error)
+	AddNodeComplete(uuid []byte, unicast uint16, count uint8) error
+	AddNodeCompleteContext(ctx context.Context, uuid []byte, unicast uint16, count uint8) error
+	AddNodeFailed(uuid []byte, reason string) error
+	AddNodeFailedContext(ctx context.Context, uuid []byte, reason string) error
+}
+
+var _ Provisioner1API = (*Provisioner1)(nil)
@@ -0,0 +1,148 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package mesh
+
+
+
+import (
+   "github.com/godbus/dbus/v5"
+   "github.com/godbus/dbus/v5/introspect"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/bluez/profile"
+)
+
+// Provisioner1Handler is implemented by the application to serve
+// org.bluez.mesh.Provisioner1, see Provisioner1 for the documentation of the
+// methods. Embed UnimplementedProvisioner1Handler to implement only some of them.
+type Provisioner1Handler interface {
+	ScanResult(rssi int16, data []byte) *dbus.Error
+	RequestProvData(count uint8) (uint16, *dbus.Error)
+	AddNodeComplete(uuid []byte, unicast uint16, count uint8) *dbus.Error
+	AddNodeFailed(uuid []byte, reason string) *dbus.Error
+}
+
+// UnimplementedProvisioner1Handler reply org.bluez.Error.NotImplemented to
+// every method of Provisioner1Handler
+type UnimplementedProvisioner1Handler struct{}
+
+// ScanResult reply org.bluez.Error.NotImplemented
+func (UnimplementedProvisioner1Handler) ScanResult(rssi int16, data []byte) *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+// RequestProvData reply org.bluez.Error.NotImplemented
+func (UnimplementedProvisioner1Handler) RequestProvData(count uint8) (uint16, *dbus.Error) {
+	
+	var val0 uint16
+	return val0, &profile.ErrNotImplemented	
+}
+
+// AddNodeComplete reply org.bluez.Error.NotImplemented
+func (UnimplementedProvisioner1Handler) AddNodeComplete(uuid []byte, unicast uint16, count uint8) *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+// AddNodeFailed reply org.bluez.Error.NotImplemented
+func (UnimplementedProvisioner1Handler) AddNodeFailed(uuid []byte, reason string) *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+var _ Provisioner1Handler = UnimplementedProvisioner1Handler{}
+
+// Provisioner1Server export a Provisioner1Handler on a connection,
+// forwarding the calls of bluez to it
+type Provisioner1Server struct {
+	conn       *dbus.Conn
+	path       dbus.ObjectPath
+	handler    Provisioner1Handler
+}
+
+// ExportProvisioner1 export handler as org.bluez.mesh.Provisioner1 at path, with
+// its introspection data. The Introspectable interface of path only describes
+// org.bluez.mesh.Provisioner1, use Introspection to describe objects exporting more interfaces.
+func ExportProvisioner1(conn *dbus.Conn, path dbus.ObjectPath, handler Provisioner1Handler) (*Provisioner1Server, error) {
+
+	s := &Provisioner1Server{
+		conn:    conn,
+		path:    path,
+		handler: handler,
+	}
+
+	err := conn.Export(s, path, Provisioner1Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	node := &introspect.Node{
+		Interfaces: []introspect.Interface{
+			introspect.IntrospectData,
+			s.Introspection(),
+		},
+	}
+	err = conn.Export(introspect.NewIntrospectable(node), path, bluez.Introspectable)
+	if err != nil {
+		s.Unexport()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Path return the object path of the server
+func (s *Provisioner1Server) Path() dbus.ObjectPath {
+	return s.path
+}
+
+// Interface return the exported interface
+func (s *Provisioner1Server) Interface() string {
+	return Provisioner1Interface
+}
+
+// Handler return the handler serving the calls
+func (s *Provisioner1Server) Handler() Provisioner1Handler {
+	return s.handler
+}
+
+// Introspection return the introspection data of org.bluez.mesh.Provisioner1
+func (s *Provisioner1Server) Introspection() introspect.Interface {
+	return introspect.Interface{
+		Name:       Provisioner1Interface,
+		Methods:    introspect.Methods(s),
+	}
+}
+
+// Unexport remove the object from the connection
+func (s *Provisioner1Server) Unexport() error {
+	err := s.conn.Export(nil, s.path, Provisioner1Interface)
+	if err != nil {
+		return err
+	}
+	return s.conn.Export(nil, s.path, bluez.Introspectable)
+}
+
+// ScanResult forward the call to the handler
+func (s *Provisioner1Server) ScanResult(rssi int16, data []byte) *dbus.Error {
+	return s.handler.ScanResult(rssi, data)
+}
+
+// RequestProvData forward the call to the handler
+func (s *Provisioner1Server) RequestProvData(count uint8) (uint16, *dbus.Error) {
+	return s.handler.RequestProvData(count)
+}
+
+// AddNodeComplete forward the call to the handler
+func (s *Provisioner1Server) AddNodeComplete(uuid []byte, unicast uint16, count uint8) *dbus.Error {
+	return s.handler.AddNodeComplete(uuid, unicast, count)
+}
+
+// AddNodeFailed forward the call to the handler
+func (s *Provisioner1Server) AddNodeFailed(uuid []byte, reason string) *dbus.Error {
+	return s.handler.AddNodeFailed(uuid, reason)
+}
+
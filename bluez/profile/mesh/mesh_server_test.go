@@ -0,0 +1,42 @@
+package mesh_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/mock"
+	"github.com/muka/go-bluetooth/bluez/profile/mesh"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportProvisionAgent1(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	path := dbus.ObjectPath("/go_bluetooth/test/prov_agent")
+	s, err := mesh.ExportProvisionAgent1(b.ClientConn(), path, mesh.UnimplementedProvisionAgent1Handler{}, &mesh.ProvisionAgent1Properties{
+		Capabilities: []string{"in-numeric"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Unexport()
+
+	var number uint32
+	err = b.CallApp(path, mesh.ProvisionAgent1Interface+".PromptNumeric", "in-numeric").Store(&number)
+	assert.True(t, errors.Is(bluez.MapError(err, path, "PromptNumeric"), bluez.ErrNotImplemented))
+
+	var capabilities dbus.Variant
+	err = b.CallApp(path, bluez.PropertiesInterface+".Get", mesh.ProvisionAgent1Interface, "Capabilities").Store(&capabilities)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"in-numeric"}, capabilities.Value())
+
+	err = s.SetProperty("URI", "https://example.com")
+	assert.NoError(t, err)
+	uri, err := s.GetProperty("URI")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com", uri.Value())
+	assert.Error(t, s.SetProperty("Unknown", true))
+}
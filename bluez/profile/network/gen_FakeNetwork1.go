@@ -0,0 +1,253 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package network
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeNetwork1 is an in-memory Network1API to test the code depending on
+// Network1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeNetwork1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// ConnectFunc handle the calls to Connect
+	ConnectFunc func(ctx context.Context, uuid string) (string, error)
+	// DisconnectFunc handle the calls to Disconnect
+	DisconnectFunc func(ctx context.Context) error
+}
+
+var _ Network1API = (*FakeNetwork1)(nil)
+
+
+// NewFakeNetwork1 create a FakeNetwork1 with the initial properties, nil for none
+func NewFakeNetwork1(objectPath dbus.ObjectPath, properties *Network1Properties) *FakeNetwork1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeNetwork1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(Network1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeNetwork1 object path
+func (a *FakeNetwork1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return Network1 interface
+func (a *FakeNetwork1) Interface() string {
+	return Network1Interface
+}
+
+// Close stop the watchers
+func (a *FakeNetwork1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeNetwork1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeNetwork1) GetProperties() (*Network1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeNetwork1) GetPropertiesContext(ctx context.Context) (*Network1Properties, error) {
+	return new(Network1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeNetwork1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeNetwork1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeNetwork1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeNetwork1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeNetwork1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeNetwork1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeNetwork1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+// SetConnected set Connected value
+func (a *FakeNetwork1) SetConnected(v bool) error {
+	return a.SetConnectedContext(context.Background(), v)
+}
+
+// SetConnectedContext set Connected value
+func (a *FakeNetwork1) SetConnectedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Connected", v)
+}
+
+
+
+// GetConnected get Connected value
+func (a *FakeNetwork1) GetConnected() (bool, error) {
+	return a.GetConnectedContext(context.Background())
+}
+
+// GetConnectedContext get Connected value
+func (a *FakeNetwork1) GetConnectedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Connected")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Connected: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetInterface set Interface value
+func (a *FakeNetwork1) SetInterface(v string) error {
+	return a.SetInterfaceContext(context.Background(), v)
+}
+
+// SetInterfaceContext set Interface value
+func (a *FakeNetwork1) SetInterfaceContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Interface", v)
+}
+
+
+
+// GetInterface get Interface value
+func (a *FakeNetwork1) GetInterface() (string, error) {
+	return a.GetInterfaceContext(context.Background())
+}
+
+// GetInterfaceContext get Interface value
+func (a *FakeNetwork1) GetInterfaceContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Interface")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Interface: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetUUID set UUID value
+func (a *FakeNetwork1) SetUUID(v string) error {
+	return a.SetUUIDContext(context.Background(), v)
+}
+
+// SetUUIDContext set UUID value
+func (a *FakeNetwork1) SetUUIDContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "UUID", v)
+}
+
+
+
+// GetUUID get UUID value
+func (a *FakeNetwork1) GetUUID() (string, error) {
+	return a.GetUUIDContext(context.Background())
+}
+
+// GetUUIDContext get UUID value
+func (a *FakeNetwork1) GetUUIDContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "UUID")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("UUID: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+// Connect call ConnectFunc
+func (a *FakeNetwork1) Connect(uuid string) (string, error) {
+	return a.ConnectContext(context.Background(), uuid)
+}
+
+// ConnectContext call ConnectFunc
+func (a *FakeNetwork1) ConnectContext(ctx context.Context, uuid string) (string, error) {
+	if a.ConnectFunc != nil {
+		return a.ConnectFunc(ctx, uuid)
+	}
+	
+	var val0 string
+	return val0, nil	
+}
+
+// Disconnect call DisconnectFunc
+func (a *FakeNetwork1) Disconnect() error {
+	return a.DisconnectContext(context.Background())
+}
+
+// DisconnectContext call DisconnectFunc
+func (a *FakeNetwork1) DisconnectContext(ctx context.Context) error {
+	if a.DisconnectFunc != nil {
+		return a.DisconnectFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
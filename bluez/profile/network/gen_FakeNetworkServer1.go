@@ -0,0 +1,156 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package network
+
+
+
+import (
+   "context"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeNetworkServer1 is an in-memory NetworkServer1API to test the code depending on
+// NetworkServer1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeNetworkServer1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// RegisterFunc handle the calls to Register
+	RegisterFunc func(ctx context.Context, uuid string, bridge string) error
+	// UnregisterFunc handle the calls to Unregister
+	UnregisterFunc func(ctx context.Context, uuid string) error
+}
+
+var _ NetworkServer1API = (*FakeNetworkServer1)(nil)
+
+
+// NewFakeNetworkServer1 create a FakeNetworkServer1 with the initial properties, nil for none
+func NewFakeNetworkServer1(objectPath dbus.ObjectPath, properties *NetworkServer1Properties) *FakeNetworkServer1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeNetworkServer1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(NetworkServer1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeNetworkServer1 object path
+func (a *FakeNetworkServer1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return NetworkServer1 interface
+func (a *FakeNetworkServer1) Interface() string {
+	return NetworkServer1Interface
+}
+
+// Close stop the watchers
+func (a *FakeNetworkServer1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeNetworkServer1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeNetworkServer1) GetProperties() (*NetworkServer1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeNetworkServer1) GetPropertiesContext(ctx context.Context) (*NetworkServer1Properties, error) {
+	return new(NetworkServer1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeNetworkServer1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeNetworkServer1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeNetworkServer1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeNetworkServer1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeNetworkServer1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeNetworkServer1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeNetworkServer1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+
+// Register call RegisterFunc
+func (a *FakeNetworkServer1) Register(uuid string, bridge string) error {
+	return a.RegisterContext(context.Background(), uuid, bridge)
+}
+
+// RegisterContext call RegisterFunc
+func (a *FakeNetworkServer1) RegisterContext(ctx context.Context, uuid string, bridge string) error {
+	if a.RegisterFunc != nil {
+		return a.RegisterFunc(ctx, uuid, bridge)
+	}
+	
+	return nil
+	
+}
+
+// Unregister call UnregisterFunc
+func (a *FakeNetworkServer1) Unregister(uuid string) error {
+	return a.UnregisterContext(context.Background(), uuid)
+}
+
+// UnregisterContext call UnregisterFunc
+func (a *FakeNetworkServer1) UnregisterContext(ctx context.Context, uuid string) error {
+	if a.UnregisterFunc != nil {
+		return a.UnregisterFunc(ctx, uuid)
+	}
+	
+	return nil
+	
+}
+
@@ -5,6 +5,7 @@ package network
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var Network1Interface = "org.bluez.Network1"
 // Args:
 // - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX
 func NewNetwork1(objectPath dbus.ObjectPath) (*Network1, error) {
+	return NewNetwork1WithConn(nil, objectPath)
+}
+
+// NewNetwork1WithConn create a new instance of Network1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX
+func NewNetwork1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*Network1, error) {
 	a := new(Network1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewNetwork1(objectPath dbus.ObjectPath) (*Network1, error) {
 			Iface: Network1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -47,8 +57,7 @@ Network1 Network hierarchy
 */
 type Network1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*Network1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -90,14 +99,24 @@ func (p *Network1Properties) Unlock() {
 
 // SetConnected set Connected value
 func (a *Network1) SetConnected(v bool) error {
-	return a.SetProperty("Connected", v)
+	return a.SetConnectedContext(context.Background(), v)
+}
+
+// SetConnectedContext set Connected value, failing with a bluez.ContextError if ctx is done
+func (a *Network1) SetConnectedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Connected", v)
 }
 
 
 
 // GetConnected get Connected value
 func (a *Network1) GetConnected() (bool, error) {
-	v, err := a.GetProperty("Connected")
+	return a.GetConnectedContext(context.Background())
+}
+
+// GetConnectedContext get Connected value, failing with a bluez.ContextError if ctx is done
+func (a *Network1) GetConnectedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Connected")
 	if err != nil {
 		return false, err
 	}
@@ -109,14 +128,24 @@ func (a *Network1) GetConnected() (bool, error) {
 
 // SetInterface set Interface value
 func (a *Network1) SetInterface(v string) error {
-	return a.SetProperty("Interface", v)
+	return a.SetInterfaceContext(context.Background(), v)
+}
+
+// SetInterfaceContext set Interface value, failing with a bluez.ContextError if ctx is done
+func (a *Network1) SetInterfaceContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Interface", v)
 }
 
 
 
 // GetInterface get Interface value
 func (a *Network1) GetInterface() (string, error) {
-	v, err := a.GetProperty("Interface")
+	return a.GetInterfaceContext(context.Background())
+}
+
+// GetInterfaceContext get Interface value, failing with a bluez.ContextError if ctx is done
+func (a *Network1) GetInterfaceContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Interface")
 	if err != nil {
 		return "", err
 	}
@@ -128,14 +157,24 @@ func (a *Network1) GetInterface() (string, error) {
 
 // SetUUID set UUID value
 func (a *Network1) SetUUID(v string) error {
-	return a.SetProperty("UUID", v)
+	return a.SetUUIDContext(context.Background(), v)
+}
+
+// SetUUIDContext set UUID value, failing with a bluez.ContextError if ctx is done
+func (a *Network1) SetUUIDContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "UUID", v)
 }
 
 
 
 // GetUUID get UUID value
 func (a *Network1) GetUUID() (string, error) {
-	v, err := a.GetProperty("UUID")
+	return a.GetUUIDContext(context.Background())
+}
+
+// GetUUIDContext get UUID value, failing with a bluez.ContextError if ctx is done
+func (a *Network1) GetUUIDContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "UUID")
 	if err != nil {
 		return "", err
 	}
@@ -167,35 +206,24 @@ func (a *Network1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *Network1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *Network1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
-
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -225,20 +253,31 @@ func (a *Network1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Network1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Network1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *Network1) GetProperties() (*Network1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *Network1) GetPropertiesContext(ctx context.Context) (*Network1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -248,29 +287,40 @@ func (a *Network1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *Network1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *Network1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *Network1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *Network1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *Network1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *Network1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -280,6 +330,7 @@ func (a *Network1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *Network1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -302,9 +353,14 @@ Connect 			Connect to the network device and return the network
 
 */
 func (a *Network1) Connect(uuid string) (string, error) {
+	return a.ConnectContext(context.Background(), uuid)
+}
+
+// ConnectContext call Connect, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Network1) ConnectContext(ctx context.Context, uuid string) (string, error) {
 	
 	var val0 string
-	err := a.client.Call("Connect", 0, uuid).Store(&val0)
+	err := a.client.CallWithContext(ctx, "Connect", 0, uuid).Store(&val0)
 	return val0, err	
 }
 
@@ -316,8 +372,13 @@ Disconnect 			Disconnect from the network device.
 
 */
 func (a *Network1) Disconnect() error {
+	return a.DisconnectContext(context.Background())
+}
+
+// DisconnectContext call Disconnect, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Network1) DisconnectContext(ctx context.Context) error {
 	
-	return a.client.Call("Disconnect", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Disconnect", 0, ).Store()
 	
 }
 
@@ -0,0 +1,53 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package network
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// Network1API is the interface of Network1, implemented by
+// FakeNetwork1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for Network1.
+type Network1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*Network1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*Network1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	SetConnected(v bool) error
+	SetConnectedContext(ctx context.Context, v bool) error
+	GetConnected() (bool, error)
+	GetConnectedContext(ctx context.Context) (bool, error)
+
+	SetInterface(v string) error
+	SetInterfaceContext(ctx context.Context, v string) error
+	GetInterface() (string, error)
+	GetInterfaceContext(ctx context.Context) (string, error)
+
+	SetUUID(v string) error
+	SetUUIDContext(ctx context.Context, v string) error
+	GetUUID() (string, error)
+	GetUUIDContext(ctx context.Context) (string, error)
+
+	Connect(uuid string) (string, error)
+	ConnectContext(ctx context.Context, uuid string) (string, error)
+	Disconnect() error
+	DisconnectContext(ctx context.Context) error
+}
+
+var _ Network1API = (*Network1)(nil)
@@ -5,6 +5,7 @@ package network
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var NetworkServer1Interface = "org.bluez.NetworkServer1"
 // Args:
 // - objectPath: /org/bluez/{hci0,hci1,...}
 func NewNetworkServer1(objectPath dbus.ObjectPath) (*NetworkServer1, error) {
+	return NewNetworkServer1WithConn(nil, objectPath)
+}
+
+// NewNetworkServer1WithConn create a new instance of NetworkServer1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: /org/bluez/{hci0,hci1,...}
+func NewNetworkServer1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*NetworkServer1, error) {
 	a := new(NetworkServer1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewNetworkServer1(objectPath dbus.ObjectPath) (*NetworkServer1, error) {
 			Iface: NetworkServer1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -47,8 +57,7 @@ NetworkServer1 Network server hierarchy
 */
 type NetworkServer1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*NetworkServer1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -95,35 +104,24 @@ func (a *NetworkServer1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *NetworkServer1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *NetworkServer1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
-
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -153,20 +151,31 @@ func (a *NetworkServer1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *NetworkServer1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *NetworkServer1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *NetworkServer1) GetProperties() (*NetworkServer1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *NetworkServer1) GetPropertiesContext(ctx context.Context) (*NetworkServer1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -176,29 +185,40 @@ func (a *NetworkServer1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *NetworkServer1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *NetworkServer1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *NetworkServer1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *NetworkServer1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *NetworkServer1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *NetworkServer1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -208,6 +228,7 @@ func (a *NetworkServer1) WatchProperties() (chan *bluez.PropertyChanged, error)
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *NetworkServer1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -227,8 +248,13 @@ Register 			Register server for the provided UUID. Every new
 
 */
 func (a *NetworkServer1) Register(uuid string, bridge string) error {
+	return a.RegisterContext(context.Background(), uuid, bridge)
+}
+
+// RegisterContext call Register, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *NetworkServer1) RegisterContext(ctx context.Context, uuid string, bridge string) error {
 	
-	return a.client.Call("Register", 0, uuid, bridge).Store()
+	return a.client.CallWithContext(ctx, "Register", 0, uuid, bridge).Store()
 	
 }
 
@@ -239,8 +265,13 @@ Unregister 			Unregister the server for provided UUID.
 
 */
 func (a *NetworkServer1) Unregister(uuid string) error {
+	return a.UnregisterContext(context.Background(), uuid)
+}
+
+// UnregisterContext call Unregister, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *NetworkServer1) UnregisterContext(ctx context.Context, uuid string) error {
 	
-	return a.client.Call("Unregister", 0, uuid).Store()
+	return a.client.CallWithContext(ctx, "Unregister", 0, uuid).Store()
 	
 }
 
@@ -0,0 +1,38 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package network
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// NetworkServer1API is the interface of NetworkServer1, implemented by
+// FakeNetworkServer1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for NetworkServer1.
+type NetworkServer1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*NetworkServer1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*NetworkServer1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	Register(uuid string, bridge string) error
+	RegisterContext(ctx context.Context, uuid string, bridge string) error
+	Unregister(uuid string) error
+	UnregisterContext(ctx context.Context, uuid string) error
+}
+
+var _ NetworkServer1API = (*NetworkServer1)(nil)
@@ -3,7 +3,6 @@ package obex
 import (
 	"github.com/godbus/dbus/v5"
 	"github.com/muka/go-bluetooth/bluez"
-	log "github.com/sirupsen/logrus"
 )
 
 // TODO: https://github.com/blueman-project/blueman/issues/218#issuecomment-89315974
@@ -55,7 +54,7 @@ func (a *ObexClient1) Close() {
 //
 // TODO: Use ObexSession1 struct instead of generic map for options
 func (a *ObexClient1) CreateSession(destination string, options map[string]interface{}) (string, error) {
-	log.Debugf("CreateSession to %s", destination)
+	a.client.Logger().WithMethod("CreateSession").Debugf("CreateSession to %s", destination)
 	var sessionPath string
 	err := a.client.Call("CreateSession", 0, destination, options).Store(&sessionPath)
 	return sessionPath, err
@@ -3,7 +3,6 @@ package obex
 import (
 	"github.com/godbus/dbus/v5"
 	"github.com/muka/go-bluetooth/bluez"
-	log "github.com/sirupsen/logrus"
 )
 
 // NewObexSession1 create a new ObexSession1 client
@@ -20,7 +19,7 @@ func NewObexSession1(path string) *ObexSession1 {
 	a.Properties = new(ObexSession1Properties)
 	_, err := a.GetProperties()
 	if err != nil {
-		log.Warn(err)
+		a.client.Logger().Warnf("%s", err)
 	}
 	return a
 }
@@ -0,0 +1,260 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package obex
+
+
+
+import (
+   "context"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeFileTransfer is an in-memory FileTransferAPI to test the code depending on
+// FileTransfer without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeFileTransfer struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// ChangeFolderFunc handle the calls to ChangeFolder
+	ChangeFolderFunc func(ctx context.Context, folder string) error
+	// CreateFolderFunc handle the calls to CreateFolder
+	CreateFolderFunc func(ctx context.Context, folder string) error
+	// ListFolderFunc handle the calls to ListFolder
+	ListFolderFunc func(ctx context.Context) ([]map[string]interface{}, error)
+	// GetFileFunc handle the calls to GetFile
+	GetFileFunc func(ctx context.Context, targetfile string, sourcefile string) (dbus.ObjectPath, map[string]interface{}, error)
+	// PutFileFunc handle the calls to PutFile
+	PutFileFunc func(ctx context.Context, sourcefile string, targetfile string) (dbus.ObjectPath, map[string]interface{}, error)
+	// CopyFileFunc handle the calls to CopyFile
+	CopyFileFunc func(ctx context.Context, sourcefile string, targetfile string) error
+	// MoveFileFunc handle the calls to MoveFile
+	MoveFileFunc func(ctx context.Context, sourcefile string, targetfile string) error
+	// DeleteFunc handle the calls to Delete
+	DeleteFunc func(ctx context.Context, file string) error
+}
+
+var _ FileTransferAPI = (*FakeFileTransfer)(nil)
+
+
+// NewFakeFileTransfer create a FakeFileTransfer with the initial properties, nil for none
+func NewFakeFileTransfer(objectPath dbus.ObjectPath, properties *FileTransferProperties) *FakeFileTransfer {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeFileTransfer{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(FileTransferInterface, objectPath, values),
+	}
+}
+
+
+// Path return FakeFileTransfer object path
+func (a *FakeFileTransfer) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return FileTransfer interface
+func (a *FakeFileTransfer) Interface() string {
+	return FileTransferInterface
+}
+
+// Close stop the watchers
+func (a *FakeFileTransfer) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeFileTransfer) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeFileTransfer) GetProperties() (*FileTransferProperties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeFileTransfer) GetPropertiesContext(ctx context.Context) (*FileTransferProperties, error) {
+	return new(FileTransferProperties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeFileTransfer) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeFileTransfer) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeFileTransfer) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeFileTransfer) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeFileTransfer) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeFileTransfer) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeFileTransfer) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+
+// ChangeFolder call ChangeFolderFunc
+func (a *FakeFileTransfer) ChangeFolder(folder string) error {
+	return a.ChangeFolderContext(context.Background(), folder)
+}
+
+// ChangeFolderContext call ChangeFolderFunc
+func (a *FakeFileTransfer) ChangeFolderContext(ctx context.Context, folder string) error {
+	if a.ChangeFolderFunc != nil {
+		return a.ChangeFolderFunc(ctx, folder)
+	}
+	
+	return nil
+	
+}
+
+// CreateFolder call CreateFolderFunc
+func (a *FakeFileTransfer) CreateFolder(folder string) error {
+	return a.CreateFolderContext(context.Background(), folder)
+}
+
+// CreateFolderContext call CreateFolderFunc
+func (a *FakeFileTransfer) CreateFolderContext(ctx context.Context, folder string) error {
+	if a.CreateFolderFunc != nil {
+		return a.CreateFolderFunc(ctx, folder)
+	}
+	
+	return nil
+	
+}
+
+// ListFolder call ListFolderFunc
+func (a *FakeFileTransfer) ListFolder() ([]map[string]interface{}, error) {
+	return a.ListFolderContext(context.Background())
+}
+
+// ListFolderContext call ListFolderFunc
+func (a *FakeFileTransfer) ListFolderContext(ctx context.Context) ([]map[string]interface{}, error) {
+	if a.ListFolderFunc != nil {
+		return a.ListFolderFunc(ctx)
+	}
+	
+	 val0 := []map[string]interface{}{}
+	return val0, nil	
+}
+
+// GetFile call GetFileFunc
+func (a *FakeFileTransfer) GetFile(targetfile string, sourcefile string) (dbus.ObjectPath, map[string]interface{}, error) {
+	return a.GetFileContext(context.Background(), targetfile, sourcefile)
+}
+
+// GetFileContext call GetFileFunc
+func (a *FakeFileTransfer) GetFileContext(ctx context.Context, targetfile string, sourcefile string) (dbus.ObjectPath, map[string]interface{}, error) {
+	if a.GetFileFunc != nil {
+		return a.GetFileFunc(ctx, targetfile, sourcefile)
+	}
+	
+	var val0 dbus.ObjectPath
+  var val1 map[string]interface{}
+	return val0, val1, nil	
+}
+
+// PutFile call PutFileFunc
+func (a *FakeFileTransfer) PutFile(sourcefile string, targetfile string) (dbus.ObjectPath, map[string]interface{}, error) {
+	return a.PutFileContext(context.Background(), sourcefile, targetfile)
+}
+
+// PutFileContext call PutFileFunc
+func (a *FakeFileTransfer) PutFileContext(ctx context.Context, sourcefile string, targetfile string) (dbus.ObjectPath, map[string]interface{}, error) {
+	if a.PutFileFunc != nil {
+		return a.PutFileFunc(ctx, sourcefile, targetfile)
+	}
+	
+	var val0 dbus.ObjectPath
+  var val1 map[string]interface{}
+	return val0, val1, nil	
+}
+
+// CopyFile call CopyFileFunc
+func (a *FakeFileTransfer) CopyFile(sourcefile string, targetfile string) error {
+	return a.CopyFileContext(context.Background(), sourcefile, targetfile)
+}
+
+// CopyFileContext call CopyFileFunc
+func (a *FakeFileTransfer) CopyFileContext(ctx context.Context, sourcefile string, targetfile string) error {
+	if a.CopyFileFunc != nil {
+		return a.CopyFileFunc(ctx, sourcefile, targetfile)
+	}
+	
+	return nil
+	
+}
+
+// MoveFile call MoveFileFunc
+func (a *FakeFileTransfer) MoveFile(sourcefile string, targetfile string) error {
+	return a.MoveFileContext(context.Background(), sourcefile, targetfile)
+}
+
+// MoveFileContext call MoveFileFunc
+func (a *FakeFileTransfer) MoveFileContext(ctx context.Context, sourcefile string, targetfile string) error {
+	if a.MoveFileFunc != nil {
+		return a.MoveFileFunc(ctx, sourcefile, targetfile)
+	}
+	
+	return nil
+	
+}
+
+// Delete call DeleteFunc
+func (a *FakeFileTransfer) Delete(file string) error {
+	return a.DeleteContext(context.Background(), file)
+}
+
+// DeleteContext call DeleteFunc
+func (a *FakeFileTransfer) DeleteContext(ctx context.Context, file string) error {
+	if a.DeleteFunc != nil {
+		return a.DeleteFunc(ctx, file)
+	}
+	
+	return nil
+	
+}
+
@@ -0,0 +1,627 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package obex
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeMessage1 is an in-memory Message1API to test the code depending on
+// Message1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeMessage1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// GetFunc handle the calls to Get
+	GetFunc func(ctx context.Context, targetfile string, attachment bool) (dbus.ObjectPath, map[string]interface{}, error)
+}
+
+var _ Message1API = (*FakeMessage1)(nil)
+
+
+// NewFakeMessage1 create a FakeMessage1 with the initial properties, nil for none
+func NewFakeMessage1(objectPath dbus.ObjectPath, properties *Message1Properties) *FakeMessage1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeMessage1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(Message1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeMessage1 object path
+func (a *FakeMessage1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return Message1 interface
+func (a *FakeMessage1) Interface() string {
+	return Message1Interface
+}
+
+// Close stop the watchers
+func (a *FakeMessage1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeMessage1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeMessage1) GetProperties() (*Message1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeMessage1) GetPropertiesContext(ctx context.Context) (*Message1Properties, error) {
+	return new(Message1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeMessage1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeMessage1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeMessage1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeMessage1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeMessage1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeMessage1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeMessage1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+// SetFolder set Folder value
+func (a *FakeMessage1) SetFolder(v string) error {
+	return a.SetFolderContext(context.Background(), v)
+}
+
+// SetFolderContext set Folder value
+func (a *FakeMessage1) SetFolderContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Folder", v)
+}
+
+
+
+// GetFolder get Folder value
+func (a *FakeMessage1) GetFolder() (string, error) {
+	return a.GetFolderContext(context.Background())
+}
+
+// GetFolderContext get Folder value
+func (a *FakeMessage1) GetFolderContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Folder")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Folder: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetSubject set Subject value
+func (a *FakeMessage1) SetSubject(v string) error {
+	return a.SetSubjectContext(context.Background(), v)
+}
+
+// SetSubjectContext set Subject value
+func (a *FakeMessage1) SetSubjectContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Subject", v)
+}
+
+
+
+// GetSubject get Subject value
+func (a *FakeMessage1) GetSubject() (string, error) {
+	return a.GetSubjectContext(context.Background())
+}
+
+// GetSubjectContext get Subject value
+func (a *FakeMessage1) GetSubjectContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Subject")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Subject: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetTimestamp set Timestamp value
+func (a *FakeMessage1) SetTimestamp(v string) error {
+	return a.SetTimestampContext(context.Background(), v)
+}
+
+// SetTimestampContext set Timestamp value
+func (a *FakeMessage1) SetTimestampContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Timestamp", v)
+}
+
+
+
+// GetTimestamp get Timestamp value
+func (a *FakeMessage1) GetTimestamp() (string, error) {
+	return a.GetTimestampContext(context.Background())
+}
+
+// GetTimestampContext get Timestamp value
+func (a *FakeMessage1) GetTimestampContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Timestamp")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Timestamp: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetSender set Sender value
+func (a *FakeMessage1) SetSender(v string) error {
+	return a.SetSenderContext(context.Background(), v)
+}
+
+// SetSenderContext set Sender value
+func (a *FakeMessage1) SetSenderContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Sender", v)
+}
+
+
+
+// GetSender get Sender value
+func (a *FakeMessage1) GetSender() (string, error) {
+	return a.GetSenderContext(context.Background())
+}
+
+// GetSenderContext get Sender value
+func (a *FakeMessage1) GetSenderContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Sender")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Sender: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetSenderAddress set SenderAddress value
+func (a *FakeMessage1) SetSenderAddress(v string) error {
+	return a.SetSenderAddressContext(context.Background(), v)
+}
+
+// SetSenderAddressContext set SenderAddress value
+func (a *FakeMessage1) SetSenderAddressContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "SenderAddress", v)
+}
+
+
+
+// GetSenderAddress get SenderAddress value
+func (a *FakeMessage1) GetSenderAddress() (string, error) {
+	return a.GetSenderAddressContext(context.Background())
+}
+
+// GetSenderAddressContext get SenderAddress value
+func (a *FakeMessage1) GetSenderAddressContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "SenderAddress")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("SenderAddress: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetReplyTo set ReplyTo value
+func (a *FakeMessage1) SetReplyTo(v string) error {
+	return a.SetReplyToContext(context.Background(), v)
+}
+
+// SetReplyToContext set ReplyTo value
+func (a *FakeMessage1) SetReplyToContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "ReplyTo", v)
+}
+
+
+
+// GetReplyTo get ReplyTo value
+func (a *FakeMessage1) GetReplyTo() (string, error) {
+	return a.GetReplyToContext(context.Background())
+}
+
+// GetReplyToContext get ReplyTo value
+func (a *FakeMessage1) GetReplyToContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "ReplyTo")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("ReplyTo: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetRecipient set Recipient value
+func (a *FakeMessage1) SetRecipient(v string) error {
+	return a.SetRecipientContext(context.Background(), v)
+}
+
+// SetRecipientContext set Recipient value
+func (a *FakeMessage1) SetRecipientContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Recipient", v)
+}
+
+
+
+// GetRecipient get Recipient value
+func (a *FakeMessage1) GetRecipient() (string, error) {
+	return a.GetRecipientContext(context.Background())
+}
+
+// GetRecipientContext get Recipient value
+func (a *FakeMessage1) GetRecipientContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Recipient")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Recipient: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetRecipientAddress set RecipientAddress value
+func (a *FakeMessage1) SetRecipientAddress(v string) error {
+	return a.SetRecipientAddressContext(context.Background(), v)
+}
+
+// SetRecipientAddressContext set RecipientAddress value
+func (a *FakeMessage1) SetRecipientAddressContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "RecipientAddress", v)
+}
+
+
+
+// GetRecipientAddress get RecipientAddress value
+func (a *FakeMessage1) GetRecipientAddress() (string, error) {
+	return a.GetRecipientAddressContext(context.Background())
+}
+
+// GetRecipientAddressContext get RecipientAddress value
+func (a *FakeMessage1) GetRecipientAddressContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "RecipientAddress")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("RecipientAddress: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetType set Type value
+func (a *FakeMessage1) SetType(v string) error {
+	return a.SetTypeContext(context.Background(), v)
+}
+
+// SetTypeContext set Type value
+func (a *FakeMessage1) SetTypeContext(ctx context.Context, v string) error {
+	if !Message1Type(v).Valid() {
+		return &bluez.InvalidValueError{Interface: Message1Interface, Member: "Type", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "Type", v)
+}
+
+
+
+// GetType get Type value
+func (a *FakeMessage1) GetType() (string, error) {
+	return a.GetTypeContext(context.Background())
+}
+
+// GetTypeContext get Type value
+func (a *FakeMessage1) GetTypeContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Type")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Type: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetStatus set Status value
+func (a *FakeMessage1) SetStatus(v string) error {
+	return a.SetStatusContext(context.Background(), v)
+}
+
+// SetStatusContext set Status value
+func (a *FakeMessage1) SetStatusContext(ctx context.Context, v string) error {
+	if !Message1Status(v).Valid() {
+		return &bluez.InvalidValueError{Interface: Message1Interface, Member: "Status", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "Status", v)
+}
+
+
+
+// GetStatus get Status value
+func (a *FakeMessage1) GetStatus() (string, error) {
+	return a.GetStatusContext(context.Background())
+}
+
+// GetStatusContext get Status value
+func (a *FakeMessage1) GetStatusContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Status")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Status: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetPriority set Priority value
+func (a *FakeMessage1) SetPriority(v bool) error {
+	return a.SetPriorityContext(context.Background(), v)
+}
+
+// SetPriorityContext set Priority value
+func (a *FakeMessage1) SetPriorityContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Priority", v)
+}
+
+
+
+// GetPriority get Priority value
+func (a *FakeMessage1) GetPriority() (bool, error) {
+	return a.GetPriorityContext(context.Background())
+}
+
+// GetPriorityContext get Priority value
+func (a *FakeMessage1) GetPriorityContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Priority")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Priority: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetRead set Read value
+func (a *FakeMessage1) SetRead(v bool) error {
+	return a.SetReadContext(context.Background(), v)
+}
+
+// SetReadContext set Read value
+func (a *FakeMessage1) SetReadContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Read", v)
+}
+
+
+
+// GetRead get Read value
+func (a *FakeMessage1) GetRead() (bool, error) {
+	return a.GetReadContext(context.Background())
+}
+
+// GetReadContext get Read value
+func (a *FakeMessage1) GetReadContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Read")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Read: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetDeleted set Deleted value
+func (a *FakeMessage1) SetDeleted(v bool) error {
+	return a.SetDeletedContext(context.Background(), v)
+}
+
+// SetDeletedContext set Deleted value
+func (a *FakeMessage1) SetDeletedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Deleted", v)
+}
+
+
+
+// GetDeleted get Deleted value
+func (a *FakeMessage1) GetDeleted() (bool, error) {
+	return a.GetDeletedContext(context.Background())
+}
+
+// GetDeletedContext get Deleted value
+func (a *FakeMessage1) GetDeletedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Deleted")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Deleted: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetSent set Sent value
+func (a *FakeMessage1) SetSent(v bool) error {
+	return a.SetSentContext(context.Background(), v)
+}
+
+// SetSentContext set Sent value
+func (a *FakeMessage1) SetSentContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Sent", v)
+}
+
+
+
+// GetSent get Sent value
+func (a *FakeMessage1) GetSent() (bool, error) {
+	return a.GetSentContext(context.Background())
+}
+
+// GetSentContext get Sent value
+func (a *FakeMessage1) GetSentContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Sent")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Sent: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetProtected set Protected value
+func (a *FakeMessage1) SetProtected(v bool) error {
+	return a.SetProtectedContext(context.Background(), v)
+}
+
+// SetProtectedContext set Protected value
+func (a *FakeMessage1) SetProtectedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Protected", v)
+}
+
+
+
+// GetProtected get Protected value
+func (a *FakeMessage1) GetProtected() (bool, error) {
+	return a.GetProtectedContext(context.Background())
+}
+
+// GetProtectedContext get Protected value
+func (a *FakeMessage1) GetProtectedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Protected")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Protected: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+// Get call GetFunc
+func (a *FakeMessage1) Get(targetfile string, attachment bool) (dbus.ObjectPath, map[string]interface{}, error) {
+	return a.GetContext(context.Background(), targetfile, attachment)
+}
+
+// GetContext call GetFunc
+func (a *FakeMessage1) GetContext(ctx context.Context, targetfile string, attachment bool) (dbus.ObjectPath, map[string]interface{}, error) {
+	if a.GetFunc != nil {
+		return a.GetFunc(ctx, targetfile, attachment)
+	}
+	
+	var val0 dbus.ObjectPath
+  var val1 map[string]interface{}
+	return val0, val1, nil	
+}
+
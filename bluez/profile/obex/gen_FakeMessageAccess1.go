@@ -0,0 +1,207 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package obex
+
+
+
+import (
+   "context"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeMessageAccess1 is an in-memory MessageAccess1API to test the code depending on
+// MessageAccess1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeMessageAccess1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// SetFolderFunc handle the calls to SetFolder
+	SetFolderFunc func(ctx context.Context, name string) error
+	// ListFoldersFunc handle the calls to ListFolders
+	ListFoldersFunc func(ctx context.Context, filter map[string]interface{}) ([]map[string]interface{}, error)
+	// ListFilterFieldsFunc handle the calls to ListFilterFields
+	ListFilterFieldsFunc func(ctx context.Context) ([]string, error)
+	// ListMessagesFunc handle the calls to ListMessages
+	ListMessagesFunc func(ctx context.Context, folder string, filter map[string]interface{}) ([]Message, error)
+	// UpdateInboxFunc handle the calls to UpdateInbox
+	UpdateInboxFunc func(ctx context.Context) error
+}
+
+var _ MessageAccess1API = (*FakeMessageAccess1)(nil)
+
+
+// NewFakeMessageAccess1 create a FakeMessageAccess1 with the initial properties, nil for none
+func NewFakeMessageAccess1(objectPath dbus.ObjectPath, properties *MessageAccess1Properties) *FakeMessageAccess1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeMessageAccess1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(MessageAccess1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeMessageAccess1 object path
+func (a *FakeMessageAccess1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return MessageAccess1 interface
+func (a *FakeMessageAccess1) Interface() string {
+	return MessageAccess1Interface
+}
+
+// Close stop the watchers
+func (a *FakeMessageAccess1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeMessageAccess1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeMessageAccess1) GetProperties() (*MessageAccess1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeMessageAccess1) GetPropertiesContext(ctx context.Context) (*MessageAccess1Properties, error) {
+	return new(MessageAccess1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeMessageAccess1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeMessageAccess1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeMessageAccess1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeMessageAccess1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeMessageAccess1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeMessageAccess1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeMessageAccess1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+
+// SetFolder call SetFolderFunc
+func (a *FakeMessageAccess1) SetFolder(name string) error {
+	return a.SetFolderContext(context.Background(), name)
+}
+
+// SetFolderContext call SetFolderFunc
+func (a *FakeMessageAccess1) SetFolderContext(ctx context.Context, name string) error {
+	if a.SetFolderFunc != nil {
+		return a.SetFolderFunc(ctx, name)
+	}
+	
+	return nil
+	
+}
+
+// ListFolders call ListFoldersFunc
+func (a *FakeMessageAccess1) ListFolders(filter map[string]interface{}) ([]map[string]interface{}, error) {
+	return a.ListFoldersContext(context.Background(), filter)
+}
+
+// ListFoldersContext call ListFoldersFunc
+func (a *FakeMessageAccess1) ListFoldersContext(ctx context.Context, filter map[string]interface{}) ([]map[string]interface{}, error) {
+	if a.ListFoldersFunc != nil {
+		return a.ListFoldersFunc(ctx, filter)
+	}
+	
+	 val0 := []map[string]interface{}{}
+	return val0, nil	
+}
+
+// ListFilterFields call ListFilterFieldsFunc
+func (a *FakeMessageAccess1) ListFilterFields() ([]string, error) {
+	return a.ListFilterFieldsContext(context.Background())
+}
+
+// ListFilterFieldsContext call ListFilterFieldsFunc
+func (a *FakeMessageAccess1) ListFilterFieldsContext(ctx context.Context) ([]string, error) {
+	if a.ListFilterFieldsFunc != nil {
+		return a.ListFilterFieldsFunc(ctx)
+	}
+	
+	 val0 := []string{}
+	return val0, nil	
+}
+
+// ListMessages call ListMessagesFunc
+func (a *FakeMessageAccess1) ListMessages(folder string, filter map[string]interface{}) ([]Message, error) {
+	return a.ListMessagesContext(context.Background(), folder, filter)
+}
+
+// ListMessagesContext call ListMessagesFunc
+func (a *FakeMessageAccess1) ListMessagesContext(ctx context.Context, folder string, filter map[string]interface{}) ([]Message, error) {
+	if a.ListMessagesFunc != nil {
+		return a.ListMessagesFunc(ctx, folder, filter)
+	}
+	
+	 val0 := []Message{}
+	return val0, nil	
+}
+
+// UpdateInbox call UpdateInboxFunc
+func (a *FakeMessageAccess1) UpdateInbox() error {
+	return a.UpdateInboxContext(context.Background())
+}
+
+// UpdateInboxContext call UpdateInboxFunc
+func (a *FakeMessageAccess1) UpdateInboxContext(ctx context.Context) error {
+	if a.UpdateInboxFunc != nil {
+		return a.UpdateInboxFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
@@ -0,0 +1,421 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package obex
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakePhonebookAccess1 is an in-memory PhonebookAccess1API to test the code depending on
+// PhonebookAccess1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakePhonebookAccess1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// SelectFunc handle the calls to Select
+	SelectFunc func(ctx context.Context, location string, phonebook string) error
+	// PullAllFunc handle the calls to PullAll
+	PullAllFunc func(ctx context.Context, targetfile string, filters map[string]interface{}) (dbus.ObjectPath, map[string]interface{}, error)
+	// ListFunc handle the calls to List
+	ListFunc func(ctx context.Context, filters map[string]interface{}) ([]VCardItem, error)
+	// PullFunc handle the calls to Pull
+	PullFunc func(ctx context.Context, vcard string, targetfile string, filters map[string]interface{}) (dbus.ObjectPath, map[string]interface{}, error)
+	// SearchFunc handle the calls to Search
+	SearchFunc func(ctx context.Context, field string, value string, filters map[string]interface{}) ([]VCardItem, error)
+	// GetSizeFunc handle the calls to GetSize
+	GetSizeFunc func(ctx context.Context) (uint16, error)
+	// UpdateVersionFunc handle the calls to UpdateVersion
+	UpdateVersionFunc func(ctx context.Context) error
+	// ListFilterFieldsFunc handle the calls to ListFilterFields
+	ListFilterFieldsFunc func(ctx context.Context) ([]string, error)
+}
+
+var _ PhonebookAccess1API = (*FakePhonebookAccess1)(nil)
+
+
+// NewFakePhonebookAccess1 create a FakePhonebookAccess1 with the initial properties, nil for none
+func NewFakePhonebookAccess1(objectPath dbus.ObjectPath, properties *PhonebookAccess1Properties) *FakePhonebookAccess1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakePhonebookAccess1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(PhonebookAccess1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakePhonebookAccess1 object path
+func (a *FakePhonebookAccess1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return PhonebookAccess1 interface
+func (a *FakePhonebookAccess1) Interface() string {
+	return PhonebookAccess1Interface
+}
+
+// Close stop the watchers
+func (a *FakePhonebookAccess1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakePhonebookAccess1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakePhonebookAccess1) GetProperties() (*PhonebookAccess1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakePhonebookAccess1) GetPropertiesContext(ctx context.Context) (*PhonebookAccess1Properties, error) {
+	return new(PhonebookAccess1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakePhonebookAccess1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakePhonebookAccess1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakePhonebookAccess1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakePhonebookAccess1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakePhonebookAccess1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakePhonebookAccess1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakePhonebookAccess1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+// SetFolder set Folder value
+func (a *FakePhonebookAccess1) SetFolder(v string) error {
+	return a.SetFolderContext(context.Background(), v)
+}
+
+// SetFolderContext set Folder value
+func (a *FakePhonebookAccess1) SetFolderContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Folder", v)
+}
+
+
+
+// GetFolder get Folder value
+func (a *FakePhonebookAccess1) GetFolder() (string, error) {
+	return a.GetFolderContext(context.Background())
+}
+
+// GetFolderContext get Folder value
+func (a *FakePhonebookAccess1) GetFolderContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Folder")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("Folder: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetDatabaseIdentifier set DatabaseIdentifier value
+func (a *FakePhonebookAccess1) SetDatabaseIdentifier(v string) error {
+	return a.SetDatabaseIdentifierContext(context.Background(), v)
+}
+
+// SetDatabaseIdentifierContext set DatabaseIdentifier value
+func (a *FakePhonebookAccess1) SetDatabaseIdentifierContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "DatabaseIdentifier", v)
+}
+
+
+
+// GetDatabaseIdentifier get DatabaseIdentifier value
+func (a *FakePhonebookAccess1) GetDatabaseIdentifier() (string, error) {
+	return a.GetDatabaseIdentifierContext(context.Background())
+}
+
+// GetDatabaseIdentifierContext get DatabaseIdentifier value
+func (a *FakePhonebookAccess1) GetDatabaseIdentifierContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "DatabaseIdentifier")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("DatabaseIdentifier: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetPrimaryCounter set PrimaryCounter value
+func (a *FakePhonebookAccess1) SetPrimaryCounter(v string) error {
+	return a.SetPrimaryCounterContext(context.Background(), v)
+}
+
+// SetPrimaryCounterContext set PrimaryCounter value
+func (a *FakePhonebookAccess1) SetPrimaryCounterContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "PrimaryCounter", v)
+}
+
+
+
+// GetPrimaryCounter get PrimaryCounter value
+func (a *FakePhonebookAccess1) GetPrimaryCounter() (string, error) {
+	return a.GetPrimaryCounterContext(context.Background())
+}
+
+// GetPrimaryCounterContext get PrimaryCounter value
+func (a *FakePhonebookAccess1) GetPrimaryCounterContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "PrimaryCounter")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("PrimaryCounter: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetSecondaryCounter set SecondaryCounter value
+func (a *FakePhonebookAccess1) SetSecondaryCounter(v string) error {
+	return a.SetSecondaryCounterContext(context.Background(), v)
+}
+
+// SetSecondaryCounterContext set SecondaryCounter value
+func (a *FakePhonebookAccess1) SetSecondaryCounterContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "SecondaryCounter", v)
+}
+
+
+
+// GetSecondaryCounter get SecondaryCounter value
+func (a *FakePhonebookAccess1) GetSecondaryCounter() (string, error) {
+	return a.GetSecondaryCounterContext(context.Background())
+}
+
+// GetSecondaryCounterContext get SecondaryCounter value
+func (a *FakePhonebookAccess1) GetSecondaryCounterContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "SecondaryCounter")
+	if err != nil {
+		return "", err
+	}
+	value, ok := v.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("SecondaryCounter: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetFixedImageSize set FixedImageSize value
+func (a *FakePhonebookAccess1) SetFixedImageSize(v bool) error {
+	return a.SetFixedImageSizeContext(context.Background(), v)
+}
+
+// SetFixedImageSizeContext set FixedImageSize value
+func (a *FakePhonebookAccess1) SetFixedImageSizeContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "FixedImageSize", v)
+}
+
+
+
+// GetFixedImageSize get FixedImageSize value
+func (a *FakePhonebookAccess1) GetFixedImageSize() (bool, error) {
+	return a.GetFixedImageSizeContext(context.Background())
+}
+
+// GetFixedImageSizeContext get FixedImageSize value
+func (a *FakePhonebookAccess1) GetFixedImageSizeContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "FixedImageSize")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("FixedImageSize: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+// Select call SelectFunc
+func (a *FakePhonebookAccess1) Select(location string, phonebook string) error {
+	return a.SelectContext(context.Background(), location, phonebook)
+}
+
+// SelectContext call SelectFunc
+func (a *FakePhonebookAccess1) SelectContext(ctx context.Context, location string, phonebook string) error {
+	if a.SelectFunc != nil {
+		return a.SelectFunc(ctx, location, phonebook)
+	}
+	
+	return nil
+	
+}
+
+// PullAll call PullAllFunc
+func (a *FakePhonebookAccess1) PullAll(targetfile string, filters map[string]interface{}) (dbus.ObjectPath, map[string]interface{}, error) {
+	return a.PullAllContext(context.Background(), targetfile, filters)
+}
+
+// PullAllContext call PullAllFunc
+func (a *FakePhonebookAccess1) PullAllContext(ctx context.Context, targetfile string, filters map[string]interface{}) (dbus.ObjectPath, map[string]interface{}, error) {
+	if a.PullAllFunc != nil {
+		return a.PullAllFunc(ctx, targetfile, filters)
+	}
+	
+	var val0 dbus.ObjectPath
+  var val1 map[string]interface{}
+	return val0, val1, nil	
+}
+
+// List call ListFunc
+func (a *FakePhonebookAccess1) List(filters map[string]interface{}) ([]VCardItem, error) {
+	return a.ListContext(context.Background(), filters)
+}
+
+// ListContext call ListFunc
+func (a *FakePhonebookAccess1) ListContext(ctx context.Context, filters map[string]interface{}) ([]VCardItem, error) {
+	if a.ListFunc != nil {
+		return a.ListFunc(ctx, filters)
+	}
+	
+	 val0 := []VCardItem{}
+	return val0, nil	
+}
+
+// Pull call PullFunc
+func (a *FakePhonebookAccess1) Pull(vcard string, targetfile string, filters map[string]interface{}) (dbus.ObjectPath, map[string]interface{}, error) {
+	return a.PullContext(context.Background(), vcard, targetfile, filters)
+}
+
+// PullContext call PullFunc
+func (a *FakePhonebookAccess1) PullContext(ctx context.Context, vcard string, targetfile string, filters map[string]interface{}) (dbus.ObjectPath, map[string]interface{}, error) {
+	if a.PullFunc != nil {
+		return a.PullFunc(ctx, vcard, targetfile, filters)
+	}
+	
+	var val0 dbus.ObjectPath
+  var val1 map[string]interface{}
+	return val0, val1, nil	
+}
+
+// Search call SearchFunc
+func (a *FakePhonebookAccess1) Search(field string, value string, filters map[string]interface{}) ([]VCardItem, error) {
+	return a.SearchContext(context.Background(), field, value, filters)
+}
+
+// SearchContext call SearchFunc
+func (a *FakePhonebookAccess1) SearchContext(ctx context.Context, field string, value string, filters map[string]interface{}) ([]VCardItem, error) {
+	if a.SearchFunc != nil {
+		return a.SearchFunc(ctx, field, value, filters)
+	}
+	
+	 val0 := []VCardItem{}
+	return val0, nil	
+}
+
+// GetSize call GetSizeFunc
+func (a *FakePhonebookAccess1) GetSize() (uint16, error) {
+	return a.GetSizeContext(context.Background())
+}
+
+// GetSizeContext call GetSizeFunc
+func (a *FakePhonebookAccess1) GetSizeContext(ctx context.Context) (uint16, error) {
+	if a.GetSizeFunc != nil {
+		return a.GetSizeFunc(ctx)
+	}
+	
+	var val0 uint16
+	return val0, nil	
+}
+
+// UpdateVersion call UpdateVersionFunc
+func (a *FakePhonebookAccess1) UpdateVersion() error {
+	return a.UpdateVersionContext(context.Background())
+}
+
+// UpdateVersionContext call UpdateVersionFunc
+func (a *FakePhonebookAccess1) UpdateVersionContext(ctx context.Context) error {
+	if a.UpdateVersionFunc != nil {
+		return a.UpdateVersionFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// ListFilterFields call ListFilterFieldsFunc
+func (a *FakePhonebookAccess1) ListFilterFields() ([]string, error) {
+	return a.ListFilterFieldsContext(context.Background())
+}
+
+// ListFilterFieldsContext call ListFilterFieldsFunc
+func (a *FakePhonebookAccess1) ListFilterFieldsContext(ctx context.Context) ([]string, error) {
+	if a.ListFilterFieldsFunc != nil {
+		return a.ListFilterFieldsFunc(ctx)
+	}
+	
+	 val0 := []string{}
+	return val0, nil	
+}
+
@@ -0,0 +1,175 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package obex
+
+
+
+import (
+   "context"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeSynchronization1 is an in-memory Synchronization1API to test the code depending on
+// Synchronization1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeSynchronization1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// SetLocationFunc handle the calls to SetLocation
+	SetLocationFunc func(ctx context.Context, location string) error
+	// GetPhonebookFunc handle the calls to GetPhonebook
+	GetPhonebookFunc func(ctx context.Context, targetfile string) (dbus.ObjectPath, map[string]interface{}, error)
+	// PutPhonebookFunc handle the calls to PutPhonebook
+	PutPhonebookFunc func(ctx context.Context, sourcefile string) (dbus.ObjectPath, map[string]interface{}, error)
+}
+
+var _ Synchronization1API = (*FakeSynchronization1)(nil)
+
+
+// NewFakeSynchronization1 create a FakeSynchronization1 with the initial properties, nil for none
+func NewFakeSynchronization1(objectPath dbus.ObjectPath, properties *Synchronization1Properties) *FakeSynchronization1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeSynchronization1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(Synchronization1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeSynchronization1 object path
+func (a *FakeSynchronization1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return Synchronization1 interface
+func (a *FakeSynchronization1) Interface() string {
+	return Synchronization1Interface
+}
+
+// Close stop the watchers
+func (a *FakeSynchronization1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeSynchronization1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeSynchronization1) GetProperties() (*Synchronization1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeSynchronization1) GetPropertiesContext(ctx context.Context) (*Synchronization1Properties, error) {
+	return new(Synchronization1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeSynchronization1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeSynchronization1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeSynchronization1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeSynchronization1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeSynchronization1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeSynchronization1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeSynchronization1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+
+// SetLocation call SetLocationFunc
+func (a *FakeSynchronization1) SetLocation(location string) error {
+	return a.SetLocationContext(context.Background(), location)
+}
+
+// SetLocationContext call SetLocationFunc
+func (a *FakeSynchronization1) SetLocationContext(ctx context.Context, location string) error {
+	if a.SetLocationFunc != nil {
+		return a.SetLocationFunc(ctx, location)
+	}
+	
+	return nil
+	
+}
+
+// GetPhonebook call GetPhonebookFunc
+func (a *FakeSynchronization1) GetPhonebook(targetfile string) (dbus.ObjectPath, map[string]interface{}, error) {
+	return a.GetPhonebookContext(context.Background(), targetfile)
+}
+
+// GetPhonebookContext call GetPhonebookFunc
+func (a *FakeSynchronization1) GetPhonebookContext(ctx context.Context, targetfile string) (dbus.ObjectPath, map[string]interface{}, error) {
+	if a.GetPhonebookFunc != nil {
+		return a.GetPhonebookFunc(ctx, targetfile)
+	}
+	
+	var val0 dbus.ObjectPath
+  var val1 map[string]interface{}
+	return val0, val1, nil	
+}
+
+// PutPhonebook call PutPhonebookFunc
+func (a *FakeSynchronization1) PutPhonebook(sourcefile string) (dbus.ObjectPath, map[string]interface{}, error) {
+	return a.PutPhonebookContext(context.Background(), sourcefile)
+}
+
+// PutPhonebookContext call PutPhonebookFunc
+func (a *FakeSynchronization1) PutPhonebookContext(ctx context.Context, sourcefile string) (dbus.ObjectPath, map[string]interface{}, error) {
+	if a.PutPhonebookFunc != nil {
+		return a.PutPhonebookFunc(ctx, sourcefile)
+	}
+	
+	var val0 dbus.ObjectPath
+  var val1 map[string]interface{}
+	return val0, val1, nil	
+}
+
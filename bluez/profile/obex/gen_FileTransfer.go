@@ -5,6 +5,7 @@ package obex
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var FileTransferInterface = "org.bluez.obex.FileTransfer"
 // Args:
 // - objectPath: [Session object path]
 func NewFileTransfer(objectPath dbus.ObjectPath) (*FileTransfer, error) {
+	return NewFileTransferWithConn(nil, objectPath)
+}
+
+// NewFileTransferWithConn create a new instance of FileTransfer on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [Session object path]
+func NewFileTransferWithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*FileTransfer, error) {
 	a := new(FileTransfer)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewFileTransfer(objectPath dbus.ObjectPath) (*FileTransfer, error) {
 			Iface: FileTransferInterface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -47,8 +57,7 @@ FileTransfer File Transfer hierarchy
 */
 type FileTransfer struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*FileTransferProperties
 	watchPropertiesChannel chan *dbus.Signal
@@ -95,35 +104,24 @@ func (a *FileTransfer) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *FileTransfer) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *FileTransfer) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
-
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -153,20 +151,31 @@ func (a *FileTransfer) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *FileTransfer) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *FileTransfer) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *FileTransfer) GetProperties() (*FileTransferProperties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *FileTransfer) GetPropertiesContext(ctx context.Context) (*FileTransferProperties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -176,29 +185,40 @@ func (a *FileTransfer) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *FileTransfer) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *FileTransfer) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *FileTransfer) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *FileTransfer) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *FileTransfer) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *FileTransfer) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -208,6 +228,7 @@ func (a *FileTransfer) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *FileTransfer) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -222,8 +243,13 @@ ChangeFolder 			Change the current folder of the remote device.
 
 */
 func (a *FileTransfer) ChangeFolder(folder string) error {
+	return a.ChangeFolderContext(context.Background(), folder)
+}
+
+// ChangeFolderContext call ChangeFolder, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *FileTransfer) ChangeFolderContext(ctx context.Context, folder string) error {
 	
-	return a.client.Call("ChangeFolder", 0, folder).Store()
+	return a.client.CallWithContext(ctx, "ChangeFolder", 0, folder).Store()
 	
 }
 
@@ -234,8 +260,13 @@ CreateFolder 			Create a new folder in the remote device.
 
 */
 func (a *FileTransfer) CreateFolder(folder string) error {
+	return a.CreateFolderContext(context.Background(), folder)
+}
+
+// CreateFolderContext call CreateFolder, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *FileTransfer) CreateFolderContext(ctx context.Context, folder string) error {
 	
-	return a.client.Call("CreateFolder", 0, folder).Store()
+	return a.client.CallWithContext(ctx, "CreateFolder", 0, folder).Store()
 	
 }
 
@@ -256,9 +287,14 @@ ListFolder 			Returns a dictionary containing information about
 
 */
 func (a *FileTransfer) ListFolder() ([]map[string]interface{}, error) {
+	return a.ListFolderContext(context.Background())
+}
+
+// ListFolderContext call ListFolder, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *FileTransfer) ListFolderContext(ctx context.Context) ([]map[string]interface{}, error) {
 	
 	 val0 := []map[string]interface{}{}
-	err := a.client.Call("ListFolder", 0, ).Store(&val0)
+	err := a.client.CallWithContext(ctx, "ListFolder", 0, ).Store(&val0)
 	return val0, err	
 }
 
@@ -277,10 +313,15 @@ GetFile 			Copy the source file (from remote device) to the
 
 */
 func (a *FileTransfer) GetFile(targetfile string, sourcefile string) (dbus.ObjectPath, map[string]interface{}, error) {
+	return a.GetFileContext(context.Background(), targetfile, sourcefile)
+}
+
+// GetFileContext call GetFile, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *FileTransfer) GetFileContext(ctx context.Context, targetfile string, sourcefile string) (dbus.ObjectPath, map[string]interface{}, error) {
 	
 	var val0 dbus.ObjectPath
   var val1 map[string]interface{}
-	err := a.client.Call("GetFile", 0, targetfile, sourcefile).Store(&val0, &val1)
+	err := a.client.CallWithContext(ctx, "GetFile", 0, targetfile, sourcefile).Store(&val0, &val1)
 	return val0, val1, err	
 }
 
@@ -297,10 +338,15 @@ PutFile 			Copy the source file (from local filesystem) to the
 
 */
 func (a *FileTransfer) PutFile(sourcefile string, targetfile string) (dbus.ObjectPath, map[string]interface{}, error) {
+	return a.PutFileContext(context.Background(), sourcefile, targetfile)
+}
+
+// PutFileContext call PutFile, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *FileTransfer) PutFileContext(ctx context.Context, sourcefile string, targetfile string) (dbus.ObjectPath, map[string]interface{}, error) {
 	
 	var val0 dbus.ObjectPath
   var val1 map[string]interface{}
-	err := a.client.Call("PutFile", 0, sourcefile, targetfile).Store(&val0, &val1)
+	err := a.client.CallWithContext(ctx, "PutFile", 0, sourcefile, targetfile).Store(&val0, &val1)
 	return val0, val1, err	
 }
 
@@ -312,8 +358,13 @@ CopyFile 			Copy a file within the remote device from source file
 
 */
 func (a *FileTransfer) CopyFile(sourcefile string, targetfile string) error {
+	return a.CopyFileContext(context.Background(), sourcefile, targetfile)
+}
+
+// CopyFileContext call CopyFile, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *FileTransfer) CopyFileContext(ctx context.Context, sourcefile string, targetfile string) error {
 	
-	return a.client.Call("CopyFile", 0, sourcefile, targetfile).Store()
+	return a.client.CallWithContext(ctx, "CopyFile", 0, sourcefile, targetfile).Store()
 	
 }
 
@@ -325,8 +376,13 @@ MoveFile 			Move a file within the remote device from source file
 
 */
 func (a *FileTransfer) MoveFile(sourcefile string, targetfile string) error {
+	return a.MoveFileContext(context.Background(), sourcefile, targetfile)
+}
+
+// MoveFileContext call MoveFile, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *FileTransfer) MoveFileContext(ctx context.Context, sourcefile string, targetfile string) error {
 	
-	return a.client.Call("MoveFile", 0, sourcefile, targetfile).Store()
+	return a.client.CallWithContext(ctx, "MoveFile", 0, sourcefile, targetfile).Store()
 	
 }
 
@@ -337,8 +393,13 @@ Delete 			Deletes the specified file/folder.
 
 */
 func (a *FileTransfer) Delete(file string) error {
+	return a.DeleteContext(context.Background(), file)
+}
+
+// DeleteContext call Delete, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *FileTransfer) DeleteContext(ctx context.Context, file string) error {
 	
-	return a.client.Call("Delete", 0, file).Store()
+	return a.client.CallWithContext(ctx, "Delete", 0, file).Store()
 	
 }
 
@@ -0,0 +1,50 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package obex
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// FileTransferAPI is the interface of FileTransfer, implemented by
+// FakeFileTransfer to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for FileTransfer.
+type FileTransferAPI interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*FileTransferProperties, error)
+	GetPropertiesContext(ctx context.Context) (*FileTransferProperties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	ChangeFolder(folder string) error
+	ChangeFolderContext(ctx context.Context, folder string) error
+	CreateFolder(folder string) error
+	CreateFolderContext(ctx context.Context, folder string) error
+	ListFolder() ([]map[string]interface{}, error)
+	ListFolderContext(ctx context.Context) ([]map[string]interface{}, error)
+	GetFile(targetfile string, sourcefile string) (dbus.ObjectPath, map[string]interface{}, error)
+	GetFileContext(ctx context.Context, targetfile string, sourcefile string) (dbus.ObjectPath, map[string]interface{}, error)
+	PutFile(sourcefile string, targetfile string) (dbus.ObjectPath, map[string]interface{}, error)
+	PutFileContext(ctx context.Context, sourcefile string, targetfile string) (dbus.ObjectPath, map[string]interface{}, error)
+	CopyFile(sourcefile string, targetfile string) error
+	CopyFileContext(ctx context.Context, sourcefile string, targetfile string) error
+	MoveFile(sourcefile string, targetfile string) error
+	MoveFileContext(ctx context.Context, sourcefile string, targetfile string) error
+	Delete(file string) error
+	DeleteContext(ctx context.Context, file string) error
+}
+
+var _ FileTransferAPI = (*FileTransfer)(nil)
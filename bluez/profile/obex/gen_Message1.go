@@ -5,6 +5,7 @@ package obex
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var Message1Interface = "org.bluez.obex.Message1"
 // Args:
 // - objectPath: [Session object path]/{message0,...}
 func NewMessage1(objectPath dbus.ObjectPath) (*Message1, error) {
+	return NewMessage1WithConn(nil, objectPath)
+}
+
+// NewMessage1WithConn create a new instance of Message1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [Session object path]/{message0,...}
+func NewMessage1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*Message1, error) {
 	a := new(Message1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewMessage1(objectPath dbus.ObjectPath) (*Message1, error) {
 			Iface: Message1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -47,8 +57,7 @@ Message1 Message hierarchy
 */
 type Message1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*Message1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -59,29 +68,34 @@ type Message1Properties struct {
 	lock sync.RWMutex `dbus:"ignore"`
 
 	/*
-	SenderAddress Message sender address
+	Folder Folder which the message belongs to
 	*/
-	SenderAddress string
+	Folder string
 
 	/*
-	Priority Message priority flag
+	Subject Message subject
 	*/
-	Priority bool
+	Subject string
 
 	/*
-	Read Message read flag
+	Timestamp Message timestamp
 	*/
-	Read bool
+	Timestamp string
 
 	/*
-	Sent Message sent flag
+	Sender Message sender name
 	*/
-	Sent bool
+	Sender string
 
 	/*
-	Sender Message sender name
+	SenderAddress Message sender address
 	*/
-	Sender string
+	SenderAddress string
+
+	/*
+	ReplyTo Message Reply-To address
+	*/
+	ReplyTo string
 
 	/*
 	Recipient Message recipient name
@@ -105,16 +119,6 @@ type Message1Properties struct {
 	*/
 	Type string
 
-	/*
-	Folder Folder which the message belongs to
-	*/
-	Folder string
-
-	/*
-	ReplyTo Message Reply-To address
-	*/
-	ReplyTo string
-
 	/*
 	Status Message reception status
 
@@ -124,20 +128,25 @@ type Message1Properties struct {
 	Status string
 
 	/*
-	Subject Message subject
+	Priority Message priority flag
 	*/
-	Subject string
+	Priority bool
 
 	/*
-	Timestamp Message timestamp
+	Read Message read flag
 	*/
-	Timestamp string
+	Read bool
 
 	/*
 	Deleted Message deleted flag
 	*/
 	Deleted bool
 
+	/*
+	Sent Message sent flag
+	*/
+	Sent bool
+
 	/*
 	Protected Message protected flag
 	*/
@@ -158,16 +167,26 @@ func (p *Message1Properties) Unlock() {
 
 
 
-// SetSenderAddress set SenderAddress value
-func (a *Message1) SetSenderAddress(v string) error {
-	return a.SetProperty("SenderAddress", v)
+// SetFolder set Folder value
+func (a *Message1) SetFolder(v string) error {
+	return a.SetFolderContext(context.Background(), v)
 }
 
+// SetFolderContext set Folder value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) SetFolderContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Folder", v)
+}
 
 
-// GetSenderAddress get SenderAddress value
-func (a *Message1) GetSenderAddress() (string, error) {
-	v, err := a.GetProperty("SenderAddress")
+
+// GetFolder get Folder value
+func (a *Message1) GetFolder() (string, error) {
+	return a.GetFolderContext(context.Background())
+}
+
+// GetFolderContext get Folder value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) GetFolderContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Folder")
 	if err != nil {
 		return "", err
 	}
@@ -177,58 +196,59 @@ func (a *Message1) GetSenderAddress() (string, error) {
 
 
 
-// SetPriority set Priority value
-func (a *Message1) SetPriority(v bool) error {
-	return a.SetProperty("Priority", v)
+// SetSubject set Subject value
+func (a *Message1) SetSubject(v string) error {
+	return a.SetSubjectContext(context.Background(), v)
 }
 
-
-
-// GetPriority get Priority value
-func (a *Message1) GetPriority() (bool, error) {
-	v, err := a.GetProperty("Priority")
-	if err != nil {
-		return false, err
-	}
-	return v.Value().(bool), nil
+// SetSubjectContext set Subject value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) SetSubjectContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Subject", v)
 }
 
 
 
-
-// SetRead set Read value
-func (a *Message1) SetRead(v bool) error {
-	return a.SetProperty("Read", v)
+// GetSubject get Subject value
+func (a *Message1) GetSubject() (string, error) {
+	return a.GetSubjectContext(context.Background())
 }
 
-
-
-// GetRead get Read value
-func (a *Message1) GetRead() (bool, error) {
-	v, err := a.GetProperty("Read")
+// GetSubjectContext get Subject value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) GetSubjectContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Subject")
 	if err != nil {
-		return false, err
+		return "", err
 	}
-	return v.Value().(bool), nil
+	return v.Value().(string), nil
 }
 
 
 
 
-// SetSent set Sent value
-func (a *Message1) SetSent(v bool) error {
-	return a.SetProperty("Sent", v)
+// SetTimestamp set Timestamp value
+func (a *Message1) SetTimestamp(v string) error {
+	return a.SetTimestampContext(context.Background(), v)
 }
 
+// SetTimestampContext set Timestamp value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) SetTimestampContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Timestamp", v)
+}
 
 
-// GetSent get Sent value
-func (a *Message1) GetSent() (bool, error) {
-	v, err := a.GetProperty("Sent")
+
+// GetTimestamp get Timestamp value
+func (a *Message1) GetTimestamp() (string, error) {
+	return a.GetTimestampContext(context.Background())
+}
+
+// GetTimestampContext get Timestamp value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) GetTimestampContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Timestamp")
 	if err != nil {
-		return false, err
+		return "", err
 	}
-	return v.Value().(bool), nil
+	return v.Value().(string), nil
 }
 
 
@@ -236,14 +256,24 @@ func (a *Message1) GetSent() (bool, error) {
 
 // SetSender set Sender value
 func (a *Message1) SetSender(v string) error {
-	return a.SetProperty("Sender", v)
+	return a.SetSenderContext(context.Background(), v)
+}
+
+// SetSenderContext set Sender value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) SetSenderContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Sender", v)
 }
 
 
 
 // GetSender get Sender value
 func (a *Message1) GetSender() (string, error) {
-	v, err := a.GetProperty("Sender")
+	return a.GetSenderContext(context.Background())
+}
+
+// GetSenderContext get Sender value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) GetSenderContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Sender")
 	if err != nil {
 		return "", err
 	}
@@ -253,16 +283,26 @@ func (a *Message1) GetSender() (string, error) {
 
 
 
-// SetRecipient set Recipient value
-func (a *Message1) SetRecipient(v string) error {
-	return a.SetProperty("Recipient", v)
+// SetSenderAddress set SenderAddress value
+func (a *Message1) SetSenderAddress(v string) error {
+	return a.SetSenderAddressContext(context.Background(), v)
+}
+
+// SetSenderAddressContext set SenderAddress value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) SetSenderAddressContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "SenderAddress", v)
 }
 
 
 
-// GetRecipient get Recipient value
-func (a *Message1) GetRecipient() (string, error) {
-	v, err := a.GetProperty("Recipient")
+// GetSenderAddress get SenderAddress value
+func (a *Message1) GetSenderAddress() (string, error) {
+	return a.GetSenderAddressContext(context.Background())
+}
+
+// GetSenderAddressContext get SenderAddress value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) GetSenderAddressContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "SenderAddress")
 	if err != nil {
 		return "", err
 	}
@@ -272,16 +312,26 @@ func (a *Message1) GetRecipient() (string, error) {
 
 
 
-// SetRecipientAddress set RecipientAddress value
-func (a *Message1) SetRecipientAddress(v string) error {
-	return a.SetProperty("RecipientAddress", v)
+// SetReplyTo set ReplyTo value
+func (a *Message1) SetReplyTo(v string) error {
+	return a.SetReplyToContext(context.Background(), v)
 }
 
+// SetReplyToContext set ReplyTo value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) SetReplyToContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "ReplyTo", v)
+}
 
 
-// GetRecipientAddress get RecipientAddress value
-func (a *Message1) GetRecipientAddress() (string, error) {
-	v, err := a.GetProperty("RecipientAddress")
+
+// GetReplyTo get ReplyTo value
+func (a *Message1) GetReplyTo() (string, error) {
+	return a.GetReplyToContext(context.Background())
+}
+
+// GetReplyToContext get ReplyTo value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) GetReplyToContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "ReplyTo")
 	if err != nil {
 		return "", err
 	}
@@ -291,16 +341,26 @@ func (a *Message1) GetRecipientAddress() (string, error) {
 
 
 
-// SetType set Type value
-func (a *Message1) SetType(v string) error {
-	return a.SetProperty("Type", v)
+// SetRecipient set Recipient value
+func (a *Message1) SetRecipient(v string) error {
+	return a.SetRecipientContext(context.Background(), v)
 }
 
+// SetRecipientContext set Recipient value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) SetRecipientContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Recipient", v)
+}
 
 
-// GetType get Type value
-func (a *Message1) GetType() (string, error) {
-	v, err := a.GetProperty("Type")
+
+// GetRecipient get Recipient value
+func (a *Message1) GetRecipient() (string, error) {
+	return a.GetRecipientContext(context.Background())
+}
+
+// GetRecipientContext get Recipient value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) GetRecipientContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Recipient")
 	if err != nil {
 		return "", err
 	}
@@ -310,16 +370,26 @@ func (a *Message1) GetType() (string, error) {
 
 
 
-// SetFolder set Folder value
-func (a *Message1) SetFolder(v string) error {
-	return a.SetProperty("Folder", v)
+// SetRecipientAddress set RecipientAddress value
+func (a *Message1) SetRecipientAddress(v string) error {
+	return a.SetRecipientAddressContext(context.Background(), v)
 }
 
+// SetRecipientAddressContext set RecipientAddress value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) SetRecipientAddressContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "RecipientAddress", v)
+}
 
 
-// GetFolder get Folder value
-func (a *Message1) GetFolder() (string, error) {
-	v, err := a.GetProperty("Folder")
+
+// GetRecipientAddress get RecipientAddress value
+func (a *Message1) GetRecipientAddress() (string, error) {
+	return a.GetRecipientAddressContext(context.Background())
+}
+
+// GetRecipientAddressContext get RecipientAddress value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) GetRecipientAddressContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "RecipientAddress")
 	if err != nil {
 		return "", err
 	}
@@ -329,16 +399,29 @@ func (a *Message1) GetFolder() (string, error) {
 
 
 
-// SetReplyTo set ReplyTo value
-func (a *Message1) SetReplyTo(v string) error {
-	return a.SetProperty("ReplyTo", v)
+// SetType set Type value
+func (a *Message1) SetType(v string) error {
+	return a.SetTypeContext(context.Background(), v)
 }
 
+// SetTypeContext set Type value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) SetTypeContext(ctx context.Context, v string) error {
+	if !Message1Type(v).Valid() {
+		return &bluez.InvalidValueError{Interface: Message1Interface, Member: "Type", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "Type", v)
+}
 
 
-// GetReplyTo get ReplyTo value
-func (a *Message1) GetReplyTo() (string, error) {
-	v, err := a.GetProperty("ReplyTo")
+
+// GetType get Type value
+func (a *Message1) GetType() (string, error) {
+	return a.GetTypeContext(context.Background())
+}
+
+// GetTypeContext get Type value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) GetTypeContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Type")
 	if err != nil {
 		return "", err
 	}
@@ -350,14 +433,27 @@ func (a *Message1) GetReplyTo() (string, error) {
 
 // SetStatus set Status value
 func (a *Message1) SetStatus(v string) error {
-	return a.SetProperty("Status", v)
+	return a.SetStatusContext(context.Background(), v)
+}
+
+// SetStatusContext set Status value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) SetStatusContext(ctx context.Context, v string) error {
+	if !Message1Status(v).Valid() {
+		return &bluez.InvalidValueError{Interface: Message1Interface, Member: "Status", Path: a.Path(), Value: v}
+	}
+	return a.SetPropertyContext(ctx, "Status", v)
 }
 
 
 
 // GetStatus get Status value
 func (a *Message1) GetStatus() (string, error) {
-	v, err := a.GetProperty("Status")
+	return a.GetStatusContext(context.Background())
+}
+
+// GetStatusContext get Status value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) GetStatusContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Status")
 	if err != nil {
 		return "", err
 	}
@@ -367,39 +463,59 @@ func (a *Message1) GetStatus() (string, error) {
 
 
 
-// SetSubject set Subject value
-func (a *Message1) SetSubject(v string) error {
-	return a.SetProperty("Subject", v)
+// SetPriority set Priority value
+func (a *Message1) SetPriority(v bool) error {
+	return a.SetPriorityContext(context.Background(), v)
 }
 
+// SetPriorityContext set Priority value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) SetPriorityContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Priority", v)
+}
 
 
-// GetSubject get Subject value
-func (a *Message1) GetSubject() (string, error) {
-	v, err := a.GetProperty("Subject")
+
+// GetPriority get Priority value
+func (a *Message1) GetPriority() (bool, error) {
+	return a.GetPriorityContext(context.Background())
+}
+
+// GetPriorityContext get Priority value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) GetPriorityContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Priority")
 	if err != nil {
-		return "", err
+		return false, err
 	}
-	return v.Value().(string), nil
+	return v.Value().(bool), nil
 }
 
 
 
 
-// SetTimestamp set Timestamp value
-func (a *Message1) SetTimestamp(v string) error {
-	return a.SetProperty("Timestamp", v)
+// SetRead set Read value
+func (a *Message1) SetRead(v bool) error {
+	return a.SetReadContext(context.Background(), v)
+}
+
+// SetReadContext set Read value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) SetReadContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Read", v)
 }
 
 
 
-// GetTimestamp get Timestamp value
-func (a *Message1) GetTimestamp() (string, error) {
-	v, err := a.GetProperty("Timestamp")
+// GetRead get Read value
+func (a *Message1) GetRead() (bool, error) {
+	return a.GetReadContext(context.Background())
+}
+
+// GetReadContext get Read value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) GetReadContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Read")
 	if err != nil {
-		return "", err
+		return false, err
 	}
-	return v.Value().(string), nil
+	return v.Value().(bool), nil
 }
 
 
@@ -407,14 +523,53 @@ func (a *Message1) GetTimestamp() (string, error) {
 
 // SetDeleted set Deleted value
 func (a *Message1) SetDeleted(v bool) error {
-	return a.SetProperty("Deleted", v)
+	return a.SetDeletedContext(context.Background(), v)
+}
+
+// SetDeletedContext set Deleted value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) SetDeletedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Deleted", v)
 }
 
 
 
 // GetDeleted get Deleted value
 func (a *Message1) GetDeleted() (bool, error) {
-	v, err := a.GetProperty("Deleted")
+	return a.GetDeletedContext(context.Background())
+}
+
+// GetDeletedContext get Deleted value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) GetDeletedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Deleted")
+	if err != nil {
+		return false, err
+	}
+	return v.Value().(bool), nil
+}
+
+
+
+
+// SetSent set Sent value
+func (a *Message1) SetSent(v bool) error {
+	return a.SetSentContext(context.Background(), v)
+}
+
+// SetSentContext set Sent value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) SetSentContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Sent", v)
+}
+
+
+
+// GetSent get Sent value
+func (a *Message1) GetSent() (bool, error) {
+	return a.GetSentContext(context.Background())
+}
+
+// GetSentContext get Sent value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) GetSentContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Sent")
 	if err != nil {
 		return false, err
 	}
@@ -426,14 +581,24 @@ func (a *Message1) GetDeleted() (bool, error) {
 
 // SetProtected set Protected value
 func (a *Message1) SetProtected(v bool) error {
-	return a.SetProperty("Protected", v)
+	return a.SetProtectedContext(context.Background(), v)
+}
+
+// SetProtectedContext set Protected value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) SetProtectedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Protected", v)
 }
 
 
 
 // GetProtected get Protected value
 func (a *Message1) GetProtected() (bool, error) {
-	v, err := a.GetProperty("Protected")
+	return a.GetProtectedContext(context.Background())
+}
+
+// GetProtectedContext get Protected value, failing with a bluez.ContextError if ctx is done
+func (a *Message1) GetProtectedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Protected")
 	if err != nil {
 		return false, err
 	}
@@ -465,35 +630,24 @@ func (a *Message1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *Message1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *Message1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -523,20 +677,31 @@ func (a *Message1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Message1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Message1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *Message1) GetProperties() (*Message1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *Message1) GetPropertiesContext(ctx context.Context) (*Message1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -546,29 +711,40 @@ func (a *Message1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *Message1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *Message1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *Message1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *Message1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *Message1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *Message1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -578,6 +754,7 @@ func (a *Message1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *Message1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -599,10 +776,15 @@ Get 			Download message and store it in the target file.
 
 */
 func (a *Message1) Get(targetfile string, attachment bool) (dbus.ObjectPath, map[string]interface{}, error) {
+	return a.GetContext(context.Background(), targetfile, attachment)
+}
+
+// GetContext call Get, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Message1) GetContext(ctx context.Context, targetfile string, attachment bool) (dbus.ObjectPath, map[string]interface{}, error) {
 	
 	var val0 dbus.ObjectPath
   var val1 map[string]interface{}
-	err := a.client.Call("Get", 0, targetfile, attachment).Store(&val0, &val1)
+	err := a.client.CallWithContext(ctx, "Get", 0, targetfile, attachment).Store(&val0, &val1)
 	return val0, val1, err	
 }
 
@@ -0,0 +1,111 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package obex
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// Message1API is the interface of Message1, implemented by
+// FakeMessage1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for Message1.
+type Message1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*Message1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*Message1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	SetFolder(v string) error
+	SetFolderContext(ctx context.Context, v string) error
+	GetFolder() (string, error)
+	GetFolderContext(ctx context.Context) (string, error)
+
+	SetSubject(v string) error
+	SetSubjectContext(ctx context.Context, v string) error
+	GetSubject() (string, error)
+	GetSubjectContext(ctx context.Context) (string, error)
+
+	SetTimestamp(v string) error
+	SetTimestampContext(ctx context.Context, v string) error
+	GetTimestamp() (string, error)
+	GetTimestampContext(ctx context.Context) (string, error)
+
+	SetSender(v string) error
+	SetSenderContext(ctx context.Context, v string) error
+	GetSender() (string, error)
+	GetSenderContext(ctx context.Context) (string, error)
+
+	SetSenderAddress(v string) error
+	SetSenderAddressContext(ctx context.Context, v string) error
+	GetSenderAddress() (string, error)
+	GetSenderAddressContext(ctx context.Context) (string, error)
+
+	SetReplyTo(v string) error
+	SetReplyToContext(ctx context.Context, v string) error
+	GetReplyTo() (string, error)
+	GetReplyToContext(ctx context.Context) (string, error)
+
+	SetRecipient(v string) error
+	SetRecipientContext(ctx context.Context, v string) error
+	GetRecipient() (string, error)
+	GetRecipientContext(ctx context.Context) (string, error)
+
+	SetRecipientAddress(v string) error
+	SetRecipientAddressContext(ctx context.Context, v string) error
+	GetRecipientAddress() (string, error)
+	GetRecipientAddressContext(ctx context.Context) (string, error)
+
+	SetType(v string) error
+	SetTypeContext(ctx context.Context, v string) error
+	GetType() (string, error)
+	GetTypeContext(ctx context.Context) (string, error)
+
+	SetStatus(v string) error
+	SetStatusContext(ctx context.Context, v string) error
+	GetStatus() (string, error)
+	GetStatusContext(ctx context.Context) (string, error)
+
+	SetPriority(v bool) error
+	SetPriorityContext(ctx context.Context, v bool) error
+	GetPriority() (bool, error)
+	GetPriorityContext(ctx context.Context) (bool, error)
+
+	SetRead(v bool) error
+	SetReadContext(ctx context.Context, v bool) error
+	GetRead() (bool, error)
+	GetReadContext(ctx context.Context) (bool, error)
+
+	SetDeleted(v bool) error
+	SetDeletedContext(ctx context.Context, v bool) error
+	GetDeleted() (bool, error)
+	GetDeletedContext(ctx context.Context) (bool, error)
+
+	SetSent(v bool) error
+	SetSentContext(ctx context.Context, v bool) error
+	GetSent() (bool, error)
+	GetSentContext(ctx context.Context) (bool, error)
+
+	SetProtected(v bool) error
+	SetProtectedContext(ctx context.Context, v bool) error
+	GetProtected() (bool, error)
+	GetProtectedContext(ctx context.Context) (bool, error)
+
+	Get(targetfile string, attachment bool) (dbus.ObjectPath, map[string]interface{}, error)
+	GetContext(ctx context.Context, targetfile string, attachment bool) (dbus.ObjectPath, map[string]interface{}, error)
+}
+
+var _ Message1API = (*Message1)(nil)
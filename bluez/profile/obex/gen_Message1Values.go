@@ -0,0 +1,60 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package obex
+
+
+// Message1Type is a value documented for Message1.Type
+type Message1Type string
+
+const (
+	Message1TypeEmail Message1Type = "email"
+	Message1TypeSmsGsm Message1Type = "sms-gsm"
+	Message1TypeSmsCdma Message1Type = "sms-cdma"
+	Message1TypeMms Message1Type = "mms"
+)
+
+// Message1TypeValues list the documented values of Message1Type
+var Message1TypeValues = []Message1Type{
+	Message1TypeEmail,
+	Message1TypeSmsGsm,
+	Message1TypeSmsCdma,
+	Message1TypeMms,
+}
+
+// Valid report if v is one of the documented values
+func (v Message1Type) Valid() bool {
+	for _, value := range Message1TypeValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+
+// Message1Status is a value documented for Message1.Status
+type Message1Status string
+
+const (
+	Message1StatusComplete Message1Status = "complete"
+	Message1StatusFractioned Message1Status = "fractioned"
+	Message1StatusNotification Message1Status = "notification"
+)
+
+// Message1StatusValues list the documented values of Message1Status
+var Message1StatusValues = []Message1Status{
+	Message1StatusComplete,
+	Message1StatusFractioned,
+	Message1StatusNotification,
+}
+
+// Valid report if v is one of the documented values
+func (v Message1Status) Valid() bool {
+	for _, value := range Message1StatusValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
@@ -5,6 +5,7 @@ package obex
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var MessageAccess1Interface = "org.bluez.obex.MessageAccess1"
 // Args:
 // - objectPath: [Session object path]
 func NewMessageAccess1(objectPath dbus.ObjectPath) (*MessageAccess1, error) {
+	return NewMessageAccess1WithConn(nil, objectPath)
+}
+
+// NewMessageAccess1WithConn create a new instance of MessageAccess1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [Session object path]
+func NewMessageAccess1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*MessageAccess1, error) {
 	a := new(MessageAccess1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewMessageAccess1(objectPath dbus.ObjectPath) (*MessageAccess1, error) {
 			Iface: MessageAccess1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -47,8 +57,7 @@ MessageAccess1 Message Access hierarchy
 */
 type MessageAccess1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*MessageAccess1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -95,35 +104,24 @@ func (a *MessageAccess1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *MessageAccess1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *MessageAccess1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -153,20 +151,31 @@ func (a *MessageAccess1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *MessageAccess1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *MessageAccess1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *MessageAccess1) GetProperties() (*MessageAccess1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *MessageAccess1) GetPropertiesContext(ctx context.Context) (*MessageAccess1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -176,29 +185,40 @@ func (a *MessageAccess1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *MessageAccess1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *MessageAccess1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *MessageAccess1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *MessageAccess1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *MessageAccess1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *MessageAccess1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -208,6 +228,7 @@ func (a *MessageAccess1) WatchProperties() (chan *bluez.PropertyChanged, error)
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *MessageAccess1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -223,8 +244,13 @@ SetFolder 			Set working directory for current session, *name* may
 
 */
 func (a *MessageAccess1) SetFolder(name string) error {
+	return a.SetFolderContext(context.Background(), name)
+}
+
+// SetFolderContext call SetFolder, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MessageAccess1) SetFolderContext(ctx context.Context, name string) error {
 	
-	return a.client.Call("SetFolder", 0, name).Store()
+	return a.client.CallWithContext(ctx, "SetFolder", 0, name).Store()
 	
 }
 
@@ -239,9 +265,14 @@ ListFolders 			Returns a dictionary containing information about
 
 */
 func (a *MessageAccess1) ListFolders(filter map[string]interface{}) ([]map[string]interface{}, error) {
+	return a.ListFoldersContext(context.Background(), filter)
+}
+
+// ListFoldersContext call ListFolders, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MessageAccess1) ListFoldersContext(ctx context.Context, filter map[string]interface{}) ([]map[string]interface{}, error) {
 	
 	 val0 := []map[string]interface{}{}
-	err := a.client.Call("ListFolders", 0, filter).Store(&val0)
+	err := a.client.CallWithContext(ctx, "ListFolders", 0, filter).Store(&val0)
 	return val0, err	
 }
 
@@ -252,9 +283,14 @@ ListFilterFields 			Return all available fields that can be used in Fields
 
 */
 func (a *MessageAccess1) ListFilterFields() ([]string, error) {
+	return a.ListFilterFieldsContext(context.Background())
+}
+
+// ListFilterFieldsContext call ListFilterFields, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MessageAccess1) ListFilterFieldsContext(ctx context.Context) ([]string, error) {
 	
 	 val0 := []string{}
-	err := a.client.Call("ListFilterFields", 0, ).Store(&val0)
+	err := a.client.CallWithContext(ctx, "ListFilterFields", 0, ).Store(&val0)
 	return val0, err	
 }
 
@@ -314,9 +350,14 @@ ListMessages 			Returns an array containing the messages found in the
 
 */
 func (a *MessageAccess1) ListMessages(folder string, filter map[string]interface{}) ([]Message, error) {
+	return a.ListMessagesContext(context.Background(), folder, filter)
+}
+
+// ListMessagesContext call ListMessages, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MessageAccess1) ListMessagesContext(ctx context.Context, folder string, filter map[string]interface{}) ([]Message, error) {
 	
 	 val0 := []Message{}
-	err := a.client.Call("ListMessages", 0, folder, filter).Store(&val0)
+	err := a.client.CallWithContext(ctx, "ListMessages", 0, folder, filter).Store(&val0)
 	return val0, err	
 }
 
@@ -324,8 +365,13 @@ func (a *MessageAccess1) ListMessages(folder string, filter map[string]interface
 UpdateInbox 
 */
 func (a *MessageAccess1) UpdateInbox() error {
+	return a.UpdateInboxContext(context.Background())
+}
+
+// UpdateInboxContext call UpdateInbox, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *MessageAccess1) UpdateInboxContext(ctx context.Context) error {
 	
-	return a.client.Call("UpdateInbox", 0, ).Store()
+	return a.client.CallWithContext(ctx, "UpdateInbox", 0, ).Store()
 	
 }
 
@@ -0,0 +1,44 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package obex
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// MessageAccess1API is the interface of MessageAccess1, implemented by
+// FakeMessageAccess1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for MessageAccess1.
+type MessageAccess1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*MessageAccess1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*MessageAccess1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	SetFolder(name string) error
+	SetFolderContext(ctx context.Context, name string) error
+	ListFolders(filter map[string]interface{}) ([]map[string]interface{}, error)
+	ListFoldersContext(ctx context.Context, filter map[string]interface{}) ([]map[string]interface{}, error)
+	ListFilterFields() ([]string, error)
+	ListFilterFieldsContext(ctx context.Context) ([]string, error)
+	ListMessages(folder string, filter map[string]interface{}) ([]Message, error)
+	ListMessagesContext(ctx context.Context, folder string, filter map[string]interface{}) ([]Message, error)
+	UpdateInbox() error
+	UpdateInboxContext(ctx context.Context) error
+}
+
+var _ MessageAccess1API = (*MessageAccess1)(nil)
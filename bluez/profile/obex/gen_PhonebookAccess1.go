@@ -5,6 +5,7 @@ package obex
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var PhonebookAccess1Interface = "org.bluez.obex.PhonebookAccess1"
 // Args:
 // - objectPath: [Session object path]
 func NewPhonebookAccess1(objectPath dbus.ObjectPath) (*PhonebookAccess1, error) {
+	return NewPhonebookAccess1WithConn(nil, objectPath)
+}
+
+// NewPhonebookAccess1WithConn create a new instance of PhonebookAccess1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [Session object path]
+func NewPhonebookAccess1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*PhonebookAccess1, error) {
 	a := new(PhonebookAccess1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewPhonebookAccess1(objectPath dbus.ObjectPath) (*PhonebookAccess1, error)
 			Iface: PhonebookAccess1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -47,8 +57,7 @@ PhonebookAccess1 Phonebook Access hierarchy
 */
 type PhonebookAccess1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*PhonebookAccess1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -58,6 +67,11 @@ type PhonebookAccess1 struct {
 type PhonebookAccess1Properties struct {
 	lock sync.RWMutex `dbus:"ignore"`
 
+	/*
+	Folder Current folder.
+	*/
+	Folder string
+
 	/*
 	DatabaseIdentifier 128 bits persistent database identifier.
 
@@ -90,11 +104,6 @@ type PhonebookAccess1Properties struct {
 	*/
 	FixedImageSize bool
 
-	/*
-	Folder Current folder.
-	*/
-	Folder string
-
 }
 
 //Lock access to properties
@@ -110,16 +119,55 @@ func (p *PhonebookAccess1Properties) Unlock() {
 
 
 
+// SetFolder set Folder value
+func (a *PhonebookAccess1) SetFolder(v string) error {
+	return a.SetFolderContext(context.Background(), v)
+}
+
+// SetFolderContext set Folder value, failing with a bluez.ContextError if ctx is done
+func (a *PhonebookAccess1) SetFolderContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "Folder", v)
+}
+
+
+
+// GetFolder get Folder value
+func (a *PhonebookAccess1) GetFolder() (string, error) {
+	return a.GetFolderContext(context.Background())
+}
+
+// GetFolderContext get Folder value, failing with a bluez.ContextError if ctx is done
+func (a *PhonebookAccess1) GetFolderContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "Folder")
+	if err != nil {
+		return "", err
+	}
+	return v.Value().(string), nil
+}
+
+
+
+
 // SetDatabaseIdentifier set DatabaseIdentifier value
 func (a *PhonebookAccess1) SetDatabaseIdentifier(v string) error {
-	return a.SetProperty("DatabaseIdentifier", v)
+	return a.SetDatabaseIdentifierContext(context.Background(), v)
+}
+
+// SetDatabaseIdentifierContext set DatabaseIdentifier value, failing with a bluez.ContextError if ctx is done
+func (a *PhonebookAccess1) SetDatabaseIdentifierContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "DatabaseIdentifier", v)
 }
 
 
 
 // GetDatabaseIdentifier get DatabaseIdentifier value
 func (a *PhonebookAccess1) GetDatabaseIdentifier() (string, error) {
-	v, err := a.GetProperty("DatabaseIdentifier")
+	return a.GetDatabaseIdentifierContext(context.Background())
+}
+
+// GetDatabaseIdentifierContext get DatabaseIdentifier value, failing with a bluez.ContextError if ctx is done
+func (a *PhonebookAccess1) GetDatabaseIdentifierContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "DatabaseIdentifier")
 	if err != nil {
 		return "", err
 	}
@@ -131,14 +179,24 @@ func (a *PhonebookAccess1) GetDatabaseIdentifier() (string, error) {
 
 // SetPrimaryCounter set PrimaryCounter value
 func (a *PhonebookAccess1) SetPrimaryCounter(v string) error {
-	return a.SetProperty("PrimaryCounter", v)
+	return a.SetPrimaryCounterContext(context.Background(), v)
+}
+
+// SetPrimaryCounterContext set PrimaryCounter value, failing with a bluez.ContextError if ctx is done
+func (a *PhonebookAccess1) SetPrimaryCounterContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "PrimaryCounter", v)
 }
 
 
 
 // GetPrimaryCounter get PrimaryCounter value
 func (a *PhonebookAccess1) GetPrimaryCounter() (string, error) {
-	v, err := a.GetProperty("PrimaryCounter")
+	return a.GetPrimaryCounterContext(context.Background())
+}
+
+// GetPrimaryCounterContext get PrimaryCounter value, failing with a bluez.ContextError if ctx is done
+func (a *PhonebookAccess1) GetPrimaryCounterContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "PrimaryCounter")
 	if err != nil {
 		return "", err
 	}
@@ -150,14 +208,24 @@ func (a *PhonebookAccess1) GetPrimaryCounter() (string, error) {
 
 // SetSecondaryCounter set SecondaryCounter value
 func (a *PhonebookAccess1) SetSecondaryCounter(v string) error {
-	return a.SetProperty("SecondaryCounter", v)
+	return a.SetSecondaryCounterContext(context.Background(), v)
+}
+
+// SetSecondaryCounterContext set SecondaryCounter value, failing with a bluez.ContextError if ctx is done
+func (a *PhonebookAccess1) SetSecondaryCounterContext(ctx context.Context, v string) error {
+	return a.SetPropertyContext(ctx, "SecondaryCounter", v)
 }
 
 
 
 // GetSecondaryCounter get SecondaryCounter value
 func (a *PhonebookAccess1) GetSecondaryCounter() (string, error) {
-	v, err := a.GetProperty("SecondaryCounter")
+	return a.GetSecondaryCounterContext(context.Background())
+}
+
+// GetSecondaryCounterContext get SecondaryCounter value, failing with a bluez.ContextError if ctx is done
+func (a *PhonebookAccess1) GetSecondaryCounterContext(ctx context.Context) (string, error) {
+	v, err := a.GetPropertyContext(ctx, "SecondaryCounter")
 	if err != nil {
 		return "", err
 	}
@@ -169,37 +237,28 @@ func (a *PhonebookAccess1) GetSecondaryCounter() (string, error) {
 
 // SetFixedImageSize set FixedImageSize value
 func (a *PhonebookAccess1) SetFixedImageSize(v bool) error {
-	return a.SetProperty("FixedImageSize", v)
+	return a.SetFixedImageSizeContext(context.Background(), v)
 }
 
-
-
-// GetFixedImageSize get FixedImageSize value
-func (a *PhonebookAccess1) GetFixedImageSize() (bool, error) {
-	v, err := a.GetProperty("FixedImageSize")
-	if err != nil {
-		return false, err
-	}
-	return v.Value().(bool), nil
+// SetFixedImageSizeContext set FixedImageSize value, failing with a bluez.ContextError if ctx is done
+func (a *PhonebookAccess1) SetFixedImageSizeContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "FixedImageSize", v)
 }
 
 
 
-
-// SetFolder set Folder value
-func (a *PhonebookAccess1) SetFolder(v string) error {
-	return a.SetProperty("Folder", v)
+// GetFixedImageSize get FixedImageSize value
+func (a *PhonebookAccess1) GetFixedImageSize() (bool, error) {
+	return a.GetFixedImageSizeContext(context.Background())
 }
 
-
-
-// GetFolder get Folder value
-func (a *PhonebookAccess1) GetFolder() (string, error) {
-	v, err := a.GetProperty("Folder")
+// GetFixedImageSizeContext get FixedImageSize value, failing with a bluez.ContextError if ctx is done
+func (a *PhonebookAccess1) GetFixedImageSizeContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "FixedImageSize")
 	if err != nil {
-		return "", err
+		return false, err
 	}
-	return v.Value().(string), nil
+	return v.Value().(bool), nil
 }
 
 
@@ -227,35 +286,24 @@ func (a *PhonebookAccess1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *PhonebookAccess1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *PhonebookAccess1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -285,20 +333,31 @@ func (a *PhonebookAccess1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *PhonebookAccess1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *PhonebookAccess1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *PhonebookAccess1) GetProperties() (*PhonebookAccess1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *PhonebookAccess1) GetPropertiesContext(ctx context.Context) (*PhonebookAccess1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -308,29 +367,40 @@ func (a *PhonebookAccess1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *PhonebookAccess1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *PhonebookAccess1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *PhonebookAccess1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *PhonebookAccess1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *PhonebookAccess1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *PhonebookAccess1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -340,6 +410,7 @@ func (a *PhonebookAccess1) WatchProperties() (chan *bluez.PropertyChanged, error
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *PhonebookAccess1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -369,8 +440,13 @@ Select 			Select the phonebook object for other operations. Should
 
 */
 func (a *PhonebookAccess1) Select(location string, phonebook string) error {
+	return a.SelectContext(context.Background(), location, phonebook)
+}
+
+// SelectContext call Select, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *PhonebookAccess1) SelectContext(ctx context.Context, location string, phonebook string) error {
 	
-	return a.client.Call("Select", 0, location, phonebook).Store()
+	return a.client.CallWithContext(ctx, "Select", 0, location, phonebook).Store()
 	
 }
 
@@ -392,10 +468,15 @@ PullAll 			Return the entire phonebook object from the PSE server
 
 */
 func (a *PhonebookAccess1) PullAll(targetfile string, filters map[string]interface{}) (dbus.ObjectPath, map[string]interface{}, error) {
+	return a.PullAllContext(context.Background(), targetfile, filters)
+}
+
+// PullAllContext call PullAll, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *PhonebookAccess1) PullAllContext(ctx context.Context, targetfile string, filters map[string]interface{}) (dbus.ObjectPath, map[string]interface{}, error) {
 	
 	var val0 dbus.ObjectPath
   var val1 map[string]interface{}
-	err := a.client.Call("PullAll", 0, targetfile, filters).Store(&val0, &val1)
+	err := a.client.CallWithContext(ctx, "PullAll", 0, targetfile, filters).Store(&val0, &val1)
 	return val0, val1, err	
 }
 
@@ -410,9 +491,14 @@ List 			Return an array of vcard-listing data where every entry
 
 */
 func (a *PhonebookAccess1) List(filters map[string]interface{}) ([]VCardItem, error) {
+	return a.ListContext(context.Background(), filters)
+}
+
+// ListContext call List, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *PhonebookAccess1) ListContext(ctx context.Context, filters map[string]interface{}) ([]VCardItem, error) {
 	
 	 val0 := []VCardItem{}
-	err := a.client.Call("List", 0, filters).Store(&val0)
+	err := a.client.CallWithContext(ctx, "List", 0, filters).Store(&val0)
 	return val0, err	
 }
 
@@ -433,10 +519,15 @@ Pull 			Given a vcard handle, retrieve the vcard in the current
 
 */
 func (a *PhonebookAccess1) Pull(vcard string, targetfile string, filters map[string]interface{}) (dbus.ObjectPath, map[string]interface{}, error) {
+	return a.PullContext(context.Background(), vcard, targetfile, filters)
+}
+
+// PullContext call Pull, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *PhonebookAccess1) PullContext(ctx context.Context, vcard string, targetfile string, filters map[string]interface{}) (dbus.ObjectPath, map[string]interface{}, error) {
 	
 	var val0 dbus.ObjectPath
   var val1 map[string]interface{}
-	err := a.client.Call("Pull", 0, vcard, targetfile, filters).Store(&val0, &val1)
+	err := a.client.CallWithContext(ctx, "Pull", 0, vcard, targetfile, filters).Store(&val0, &val1)
 	return val0, val1, err	
 }
 
@@ -456,9 +547,14 @@ Search 			Search for entries matching the given condition and
 
 */
 func (a *PhonebookAccess1) Search(field string, value string, filters map[string]interface{}) ([]VCardItem, error) {
+	return a.SearchContext(context.Background(), field, value, filters)
+}
+
+// SearchContext call Search, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *PhonebookAccess1) SearchContext(ctx context.Context, field string, value string, filters map[string]interface{}) ([]VCardItem, error) {
 	
 	 val0 := []VCardItem{}
-	err := a.client.Call("Search", 0, field, value, filters).Store(&val0)
+	err := a.client.CallWithContext(ctx, "Search", 0, field, value, filters).Store(&val0)
 	return val0, err	
 }
 
@@ -471,9 +567,14 @@ GetSize 			Return the number of entries in the selected phonebook
 
 */
 func (a *PhonebookAccess1) GetSize() (uint16, error) {
+	return a.GetSizeContext(context.Background())
+}
+
+// GetSizeContext call GetSize, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *PhonebookAccess1) GetSizeContext(ctx context.Context) (uint16, error) {
 	
 	var val0 uint16
-	err := a.client.Call("GetSize", 0, ).Store(&val0)
+	err := a.client.CallWithContext(ctx, "GetSize", 0, ).Store(&val0)
 	return val0, err	
 }
 
@@ -485,8 +586,13 @@ UpdateVersion 			Attempt to update PrimaryCounter and SecondaryCounter.
 
 */
 func (a *PhonebookAccess1) UpdateVersion() error {
+	return a.UpdateVersionContext(context.Background())
+}
+
+// UpdateVersionContext call UpdateVersion, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *PhonebookAccess1) UpdateVersionContext(ctx context.Context) error {
 	
-	return a.client.Call("UpdateVersion", 0, ).Store()
+	return a.client.CallWithContext(ctx, "UpdateVersion", 0, ).Store()
 	
 }
 
@@ -497,9 +603,14 @@ ListFilterFields 			Return All Available fields that can be used in Fields
 
 */
 func (a *PhonebookAccess1) ListFilterFields() ([]string, error) {
+	return a.ListFilterFieldsContext(context.Background())
+}
+
+// ListFilterFieldsContext call ListFilterFields, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *PhonebookAccess1) ListFilterFieldsContext(ctx context.Context) ([]string, error) {
 	
 	 val0 := []string{}
-	err := a.client.Call("ListFilterFields", 0, ).Store(&val0)
+	err := a.client.CallWithContext(ctx, "ListFilterFields", 0, ).Store(&val0)
 	return val0, err	
 }
 
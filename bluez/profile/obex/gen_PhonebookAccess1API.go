@@ -0,0 +1,75 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package obex
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// PhonebookAccess1API is the interface of PhonebookAccess1, implemented by
+// FakePhonebookAccess1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for PhonebookAccess1.
+type PhonebookAccess1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*PhonebookAccess1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*PhonebookAccess1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	SetFolder(v string) error
+	SetFolderContext(ctx context.Context, v string) error
+	GetFolder() (string, error)
+	GetFolderContext(ctx context.Context) (string, error)
+
+	SetDatabaseIdentifier(v string) error
+	SetDatabaseIdentifierContext(ctx context.Context, v string) error
+	GetDatabaseIdentifier() (string, error)
+	GetDatabaseIdentifierContext(ctx context.Context) (string, error)
+
+	SetPrimaryCounter(v string) error
+	SetPrimaryCounterContext(ctx context.Context, v string) error
+	GetPrimaryCounter() (string, error)
+	GetPrimaryCounterContext(ctx context.Context) (string, error)
+
+	SetSecondaryCounter(v string) error
+	SetSecondaryCounterContext(ctx context.Context, v string) error
+	GetSecondaryCounter() (string, error)
+	GetSecondaryCounterContext(ctx context.Context) (string, error)
+
+	SetFixedImageSize(v bool) error
+	SetFixedImageSizeContext(ctx context.Context, v bool) error
+	GetFixedImageSize() (bool, error)
+	GetFixedImageSizeContext(ctx context.Context) (bool, error)
+
+	Select(location string, phonebook string) error
+	SelectContext(ctx context.Context, location string, phonebook string) error
+	PullAll(targetfile string, filters map[string]interface{}) (dbus.ObjectPath, map[string]interface{}, error)
+	PullAllContext(ctx context.Context, targetfile string, filters map[string]interface{}) (dbus.ObjectPath, map[string]interface{}, error)
+	List(filters map[string]interface{}) ([]VCardItem, error)
+	ListContext(ctx context.Context, filters map[string]interface{}) ([]VCardItem, error)
+	Pull(vcard string, targetfile string, filters map[string]interface{}) (dbus.ObjectPath, map[string]interface{}, error)
+	PullContext(ctx context.Context, vcard string, targetfile string, filters map[string]interface{}) (dbus.ObjectPath, map[string]interface{}, error)
+	Search(field string, value string, filters map[string]interface{}) ([]VCardItem, error)
+	SearchContext(ctx context.Context, field string, value string, filters map[string]interface{}) ([]VCardItem, error)
+	GetSize() (uint16, error)
+	GetSizeContext(ctx context.Context) (uint16, error)
+	UpdateVersion() error
+	UpdateVersionContext(ctx context.Context) error
+	ListFilterFields() ([]string, error)
+	ListFilterFieldsContext(ctx context.Context) ([]string, error)
+}
+
+var _ PhonebookAccess1API = (*PhonebookAccess1)(nil)
@@ -5,6 +5,7 @@ package obex
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var Synchronization1Interface = "org.bluez.obex.Synchronization1"
 // Args:
 // - objectPath: [Session object path]
 func NewSynchronization1(objectPath dbus.ObjectPath) (*Synchronization1, error) {
+	return NewSynchronization1WithConn(nil, objectPath)
+}
+
+// NewSynchronization1WithConn create a new instance of Synchronization1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [Session object path]
+func NewSynchronization1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*Synchronization1, error) {
 	a := new(Synchronization1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewSynchronization1(objectPath dbus.ObjectPath) (*Synchronization1, error)
 			Iface: Synchronization1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -47,8 +57,7 @@ Synchronization1 Synchronization hierarchy
 */
 type Synchronization1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*Synchronization1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -95,35 +104,24 @@ func (a *Synchronization1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *Synchronization1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *Synchronization1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -153,20 +151,31 @@ func (a *Synchronization1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Synchronization1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Synchronization1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *Synchronization1) GetProperties() (*Synchronization1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *Synchronization1) GetPropertiesContext(ctx context.Context) (*Synchronization1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -176,29 +185,40 @@ func (a *Synchronization1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *Synchronization1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *Synchronization1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *Synchronization1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *Synchronization1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *Synchronization1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *Synchronization1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -208,6 +228,7 @@ func (a *Synchronization1) WatchProperties() (chan *bluez.PropertyChanged, error
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *Synchronization1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -229,8 +250,13 @@ SetLocation 			Set the phonebook object store location for other
 
 */
 func (a *Synchronization1) SetLocation(location string) error {
+	return a.SetLocationContext(context.Background(), location)
+}
+
+// SetLocationContext call SetLocation, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Synchronization1) SetLocationContext(ctx context.Context, location string) error {
 	
-	return a.client.Call("SetLocation", 0, location).Store()
+	return a.client.CallWithContext(ctx, "SetLocation", 0, location).Store()
 	
 }
 
@@ -249,10 +275,15 @@ GetPhonebook 			Retrieve an entire Phonebook Object store from remote
 
 */
 func (a *Synchronization1) GetPhonebook(targetfile string) (dbus.ObjectPath, map[string]interface{}, error) {
+	return a.GetPhonebookContext(context.Background(), targetfile)
+}
+
+// GetPhonebookContext call GetPhonebook, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Synchronization1) GetPhonebookContext(ctx context.Context, targetfile string) (dbus.ObjectPath, map[string]interface{}, error) {
 	
 	var val0 dbus.ObjectPath
   var val1 map[string]interface{}
-	err := a.client.Call("GetPhonebook", 0, targetfile).Store(&val0, &val1)
+	err := a.client.CallWithContext(ctx, "GetPhonebook", 0, targetfile).Store(&val0, &val1)
 	return val0, val1, err	
 }
 
@@ -268,10 +299,15 @@ PutPhonebook 			Send an entire Phonebook Object store to remote device.
 
 */
 func (a *Synchronization1) PutPhonebook(sourcefile string) (dbus.ObjectPath, map[string]interface{}, error) {
+	return a.PutPhonebookContext(context.Background(), sourcefile)
+}
+
+// PutPhonebookContext call PutPhonebook, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Synchronization1) PutPhonebookContext(ctx context.Context, sourcefile string) (dbus.ObjectPath, map[string]interface{}, error) {
 	
 	var val0 dbus.ObjectPath
   var val1 map[string]interface{}
-	err := a.client.Call("PutPhonebook", 0, sourcefile).Store(&val0, &val1)
+	err := a.client.CallWithContext(ctx, "PutPhonebook", 0, sourcefile).Store(&val0, &val1)
 	return val0, val1, err	
 }
 
@@ -0,0 +1,40 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package obex
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// Synchronization1API is the interface of Synchronization1, implemented by
+// FakeSynchronization1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for Synchronization1.
+type Synchronization1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*Synchronization1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*Synchronization1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	SetLocation(location string) error
+	SetLocationContext(ctx context.Context, location string) error
+	GetPhonebook(targetfile string) (dbus.ObjectPath, map[string]interface{}, error)
+	GetPhonebookContext(ctx context.Context, targetfile string) (dbus.ObjectPath, map[string]interface{}, error)
+	PutPhonebook(sourcefile string) (dbus.ObjectPath, map[string]interface{}, error)
+	PutPhonebookContext(ctx context.Context, sourcefile string) (dbus.ObjectPath, map[string]interface{}, error)
+}
+
+var _ Synchronization1API = (*Synchronization1)(nil)
@@ -0,0 +1,35 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package obex
+
+
+// Transfer1Status is a value documented for Transfer1.Status
+type Transfer1Status string
+
+const (
+	Transfer1StatusQueued Transfer1Status = "queued"
+	Transfer1StatusActive Transfer1Status = "active"
+	Transfer1StatusSuspended Transfer1Status = "suspended"
+	Transfer1StatusComplete Transfer1Status = "complete"
+	Transfer1StatusError Transfer1Status = "error"
+)
+
+// Transfer1StatusValues list the documented values of Transfer1Status
+var Transfer1StatusValues = []Transfer1Status{
+	Transfer1StatusQueued,
+	Transfer1StatusActive,
+	Transfer1StatusSuspended,
+	Transfer1StatusComplete,
+	Transfer1StatusError,
+}
+
+// Valid report if v is one of the documented values
+func (v Transfer1Status) Valid() bool {
+	for _, value := range Transfer1StatusValues {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
@@ -0,0 +1,40 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package obex_agent
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// Agent1API is the interface of Agent1, implemented by
+// FakeAgent1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for Agent1.
+type Agent1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*Agent1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*Agent1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	Release() error
+	ReleaseContext(ctx context.Context) error
+	AuthorizePush(transfer dbus.ObjectPath) (string, error)
+	AuthorizePushContext(ctx context.Context, transfer dbus.ObjectPath) (string, error)
+	Cancel() error
+	CancelContext(ctx context.Context) error
+}
+
+var _ Agent1API = (*Agent1)(nil)
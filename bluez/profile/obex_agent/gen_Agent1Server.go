@@ -0,0 +1,135 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package obex_agent
+
+
+
+import (
+   "github.com/godbus/dbus/v5"
+   "github.com/godbus/dbus/v5/introspect"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/bluez/profile"
+)
+
+// Agent1Handler is implemented by the application to serve
+// org.bluez.obex.Agent1, see Agent1 for the documentation of the
+// methods. Embed UnimplementedAgent1Handler to implement only some of them.
+type Agent1Handler interface {
+	Release() *dbus.Error
+	AuthorizePush(transfer dbus.ObjectPath) (string, *dbus.Error)
+	Cancel() *dbus.Error
+}
+
+// UnimplementedAgent1Handler reply org.bluez.Error.NotImplemented to
+// every method of Agent1Handler
+type UnimplementedAgent1Handler struct{}
+
+// Release reply org.bluez.Error.NotImplemented
+func (UnimplementedAgent1Handler) Release() *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+// AuthorizePush reply org.bluez.Error.NotImplemented
+func (UnimplementedAgent1Handler) AuthorizePush(transfer dbus.ObjectPath) (string, *dbus.Error) {
+	
+	var val0 string
+	return val0, &profile.ErrNotImplemented	
+}
+
+// Cancel reply org.bluez.Error.NotImplemented
+func (UnimplementedAgent1Handler) Cancel() *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+var _ Agent1Handler = UnimplementedAgent1Handler{}
+
+// Agent1Server export a Agent1Handler on a connection,
+// forwarding the calls of bluez to it
+type Agent1Server struct {
+	conn       *dbus.Conn
+	path       dbus.ObjectPath
+	handler    Agent1Handler
+}
+
+// ExportAgent1 export handler as org.bluez.obex.Agent1 at path, with
+// its introspection data. The Introspectable interface of path only describes
+// org.bluez.obex.Agent1, use Introspection to describe objects exporting more interfaces.
+func ExportAgent1(conn *dbus.Conn, path dbus.ObjectPath, handler Agent1Handler) (*Agent1Server, error) {
+
+	s := &Agent1Server{
+		conn:    conn,
+		path:    path,
+		handler: handler,
+	}
+
+	err := conn.Export(s, path, Agent1Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	node := &introspect.Node{
+		Interfaces: []introspect.Interface{
+			introspect.IntrospectData,
+			s.Introspection(),
+		},
+	}
+	err = conn.Export(introspect.NewIntrospectable(node), path, bluez.Introspectable)
+	if err != nil {
+		s.Unexport()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Path return the object path of the server
+func (s *Agent1Server) Path() dbus.ObjectPath {
+	return s.path
+}
+
+// Interface return the exported interface
+func (s *Agent1Server) Interface() string {
+	return Agent1Interface
+}
+
+// Handler return the handler serving the calls
+func (s *Agent1Server) Handler() Agent1Handler {
+	return s.handler
+}
+
+// Introspection return the introspection data of org.bluez.obex.Agent1
+func (s *Agent1Server) Introspection() introspect.Interface {
+	return introspect.Interface{
+		Name:       Agent1Interface,
+		Methods:    introspect.Methods(s),
+	}
+}
+
+// Unexport remove the object from the connection
+func (s *Agent1Server) Unexport() error {
+	err := s.conn.Export(nil, s.path, Agent1Interface)
+	if err != nil {
+		return err
+	}
+	return s.conn.Export(nil, s.path, bluez.Introspectable)
+}
+
+// Release forward the call to the handler
+func (s *Agent1Server) Release() *dbus.Error {
+	return s.handler.Release()
+}
+
+// AuthorizePush forward the call to the handler
+func (s *Agent1Server) AuthorizePush(transfer dbus.ObjectPath) (string, *dbus.Error) {
+	return s.handler.AuthorizePush(transfer)
+}
+
+// Cancel forward the call to the handler
+func (s *Agent1Server) Cancel() *dbus.Error {
+	return s.handler.Cancel()
+}
+
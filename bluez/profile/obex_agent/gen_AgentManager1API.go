@@ -0,0 +1,38 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package obex_agent
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// AgentManager1API is the interface of AgentManager1, implemented by
+// FakeAgentManager1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for AgentManager1.
+type AgentManager1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*AgentManager1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*AgentManager1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	RegisterAgent(agent dbus.ObjectPath) error
+	RegisterAgentContext(ctx context.Context, agent dbus.ObjectPath) error
+	UnregisterAgent(agent dbus.ObjectPath) error
+	UnregisterAgentContext(ctx context.Context, agent dbus.ObjectPath) error
+}
+
+var _ AgentManager1API = (*AgentManager1)(nil)
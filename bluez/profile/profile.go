@@ -8,3 +8,10 @@ type BluezApi interface {
 	Interface() string
 	Close()
 }
+
+// ErrNotImplemented is the reply of the generated Unimplemented<Interface>Handler
+// to the methods not implemented by the application
+var ErrNotImplemented = dbus.Error{
+	Name: "org.bluez.Error.NotImplemented",
+	Body: []interface{}{"NotImplemented"},
+}
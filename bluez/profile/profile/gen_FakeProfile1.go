@@ -0,0 +1,114 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package profile
+
+
+
+import (
+   "context"
+   "sync"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeProfile1 is an in-memory Profile1API to test the code depending on
+// Profile1 without D-Bus. Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeProfile1 struct {
+	ObjectPath dbus.ObjectPath
+
+	// ReleaseFunc handle the calls to Release
+	ReleaseFunc func(ctx context.Context) error
+	// NewConnectionFunc handle the calls to NewConnection
+	NewConnectionFunc func(ctx context.Context, device dbus.ObjectPath, fd int32, fd_properties map[string]interface{}) error
+	// RequestDisconnectionFunc handle the calls to RequestDisconnection
+	RequestDisconnectionFunc func(ctx context.Context, device dbus.ObjectPath) error
+}
+
+var _ Profile1API = (*FakeProfile1)(nil)
+
+
+// NewFakeProfile1 create a FakeProfile1
+func NewFakeProfile1(objectPath dbus.ObjectPath) *FakeProfile1 {
+	return &FakeProfile1{
+		ObjectPath: objectPath,
+	}
+}
+
+
+// Path return FakeProfile1 object path
+func (a *FakeProfile1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return Profile1 interface
+func (a *FakeProfile1) Interface() string {
+	return Profile1Interface
+}
+
+// Close stop the watchers
+func (a *FakeProfile1) Close() {
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeProfile1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+
+
+
+
+// Release call ReleaseFunc
+func (a *FakeProfile1) Release() error {
+	return a.ReleaseContext(context.Background())
+}
+
+// ReleaseContext call ReleaseFunc
+func (a *FakeProfile1) ReleaseContext(ctx context.Context) error {
+	if a.ReleaseFunc != nil {
+		return a.ReleaseFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
+// NewConnection call NewConnectionFunc
+func (a *FakeProfile1) NewConnection(device dbus.ObjectPath, fd int32, fd_properties map[string]interface{}) error {
+	return a.NewConnectionContext(context.Background(), device, fd, fd_properties)
+}
+
+// NewConnectionContext call NewConnectionFunc
+func (a *FakeProfile1) NewConnectionContext(ctx context.Context, device dbus.ObjectPath, fd int32, fd_properties map[string]interface{}) error {
+	if a.NewConnectionFunc != nil {
+		return a.NewConnectionFunc(ctx, device, fd, fd_properties)
+	}
+	
+	return nil
+	
+}
+
+// RequestDisconnection call RequestDisconnectionFunc
+func (a *FakeProfile1) RequestDisconnection(device dbus.ObjectPath) error {
+	return a.RequestDisconnectionContext(context.Background(), device)
+}
+
+// RequestDisconnectionContext call RequestDisconnectionFunc
+func (a *FakeProfile1) RequestDisconnectionContext(ctx context.Context, device dbus.ObjectPath) error {
+	if a.RequestDisconnectionFunc != nil {
+		return a.RequestDisconnectionFunc(ctx, device)
+	}
+	
+	return nil
+	
+}
+
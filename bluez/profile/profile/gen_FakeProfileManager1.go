@@ -0,0 +1,97 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package profile
+
+
+
+import (
+   "context"
+   "sync"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeProfileManager1 is an in-memory ProfileManager1API to test the code depending on
+// ProfileManager1 without D-Bus. Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeProfileManager1 struct {
+	ObjectPath dbus.ObjectPath
+
+	// RegisterProfileFunc handle the calls to RegisterProfile
+	RegisterProfileFunc func(ctx context.Context, profile dbus.ObjectPath, uuid string, options map[string]interface{}) error
+	// UnregisterProfileFunc handle the calls to UnregisterProfile
+	UnregisterProfileFunc func(ctx context.Context, profile dbus.ObjectPath) error
+}
+
+var _ ProfileManager1API = (*FakeProfileManager1)(nil)
+
+
+// NewFakeProfileManager1 create a FakeProfileManager1
+func NewFakeProfileManager1(objectPath dbus.ObjectPath) *FakeProfileManager1 {
+	return &FakeProfileManager1{
+		ObjectPath: objectPath,
+	}
+}
+
+
+// Path return FakeProfileManager1 object path
+func (a *FakeProfileManager1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return ProfileManager1 interface
+func (a *FakeProfileManager1) Interface() string {
+	return ProfileManager1Interface
+}
+
+// Close stop the watchers
+func (a *FakeProfileManager1) Close() {
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeProfileManager1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+
+
+
+
+// RegisterProfile call RegisterProfileFunc
+func (a *FakeProfileManager1) RegisterProfile(profile dbus.ObjectPath, uuid string, options map[string]interface{}) error {
+	return a.RegisterProfileContext(context.Background(), profile, uuid, options)
+}
+
+// RegisterProfileContext call RegisterProfileFunc
+func (a *FakeProfileManager1) RegisterProfileContext(ctx context.Context, profile dbus.ObjectPath, uuid string, options map[string]interface{}) error {
+	if a.RegisterProfileFunc != nil {
+		return a.RegisterProfileFunc(ctx, profile, uuid, options)
+	}
+	
+	return nil
+	
+}
+
+// UnregisterProfile call UnregisterProfileFunc
+func (a *FakeProfileManager1) UnregisterProfile(profile dbus.ObjectPath) error {
+	return a.UnregisterProfileContext(context.Background(), profile)
+}
+
+// UnregisterProfileContext call UnregisterProfileFunc
+func (a *FakeProfileManager1) UnregisterProfileContext(ctx context.Context, profile dbus.ObjectPath) error {
+	if a.UnregisterProfileFunc != nil {
+		return a.UnregisterProfileFunc(ctx, profile)
+	}
+	
+	return nil
+	
+}
+
@@ -5,6 +5,7 @@ package profile
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/godbus/dbus/v5"
@@ -19,6 +20,15 @@ var Profile1Interface = "org.bluez.Profile1"
 // - servicePath: unique name
 // - objectPath: freely definable
 func NewProfile1(servicePath string, objectPath dbus.ObjectPath) (*Profile1, error) {
+	return NewProfile1WithConn(nil, servicePath, objectPath)
+}
+
+// NewProfile1WithConn create a new instance of Profile1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - servicePath: unique name
+// - objectPath: freely definable
+func NewProfile1WithConn(conn *bluez.Conn, servicePath string, objectPath dbus.ObjectPath) (*Profile1, error) {
 	a := new(Profile1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -26,6 +36,7 @@ func NewProfile1(servicePath string, objectPath dbus.ObjectPath) (*Profile1, err
 			Iface: Profile1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -39,8 +50,7 @@ Profile1 Profile hierarchy
 */
 type Profile1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*Profile1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -85,35 +95,24 @@ func (a *Profile1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *Profile1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *Profile1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -128,8 +127,13 @@ Release 			This method gets called when the service daemon
 
 */
 func (a *Profile1) Release() error {
+	return a.ReleaseContext(context.Background())
+}
+
+// ReleaseContext call Release, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Profile1) ReleaseContext(ctx context.Context) error {
 	
-	return a.client.Call("Release", 0, ).Store()
+	return a.client.CallWithContext(ctx, "Release", 0, ).Store()
 	
 }
 
@@ -144,8 +148,13 @@ NewConnection 			This method gets called when a new service level
 
 */
 func (a *Profile1) NewConnection(device dbus.ObjectPath, fd int32, fd_properties map[string]interface{}) error {
+	return a.NewConnectionContext(context.Background(), device, fd, fd_properties)
+}
+
+// NewConnectionContext call NewConnection, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Profile1) NewConnectionContext(ctx context.Context, device dbus.ObjectPath, fd int32, fd_properties map[string]interface{}) error {
 	
-	return a.client.Call("NewConnection", 0, device, fd, fd_properties).Store()
+	return a.client.CallWithContext(ctx, "NewConnection", 0, device, fd, fd_properties).Store()
 	
 }
 
@@ -164,8 +173,13 @@ RequestDisconnection 			This method gets called when a profile gets
 
 */
 func (a *Profile1) RequestDisconnection(device dbus.ObjectPath) error {
+	return a.RequestDisconnectionContext(context.Background(), device)
+}
+
+// RequestDisconnectionContext call RequestDisconnection, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *Profile1) RequestDisconnectionContext(ctx context.Context, device dbus.ObjectPath) error {
 	
-	return a.client.Call("RequestDisconnection", 0, device).Store()
+	return a.client.CallWithContext(ctx, "RequestDisconnection", 0, device).Store()
 	
 }
 
@@ -0,0 +1,29 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package profile
+
+
+import (
+   "context"
+   "github.com/godbus/dbus/v5"
+)
+
+// Profile1API is the interface of Profile1, implemented by
+// FakeProfile1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for Profile1.
+type Profile1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+
+	Release() error
+	ReleaseContext(ctx context.Context) error
+	NewConnection(device dbus.ObjectPath, fd int32, fd_properties map[string]interface{}) error
+	NewConnectionContext(ctx context.Context, device dbus.ObjectPath, fd int32, fd_properties map[string]interface{}) error
+	RequestDisconnection(device dbus.ObjectPath) error
+	RequestDisconnectionContext(ctx context.Context, device dbus.ObjectPath) error
+}
+
+var _ Profile1API = (*Profile1)(nil)
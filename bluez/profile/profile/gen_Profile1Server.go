@@ -0,0 +1,135 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package profile
+
+
+
+import (
+   "github.com/godbus/dbus/v5"
+   "github.com/godbus/dbus/v5/introspect"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/bluez/profile"
+)
+
+// Profile1Handler is implemented by the application to serve
+// org.bluez.Profile1, see Profile1 for the documentation of the
+// methods. Embed UnimplementedProfile1Handler to implement only some of them.
+type Profile1Handler interface {
+	Release() *dbus.Error
+	NewConnection(device dbus.ObjectPath, fd dbus.UnixFD, fd_properties map[string]interface{}) *dbus.Error
+	RequestDisconnection(device dbus.ObjectPath) *dbus.Error
+}
+
+// UnimplementedProfile1Handler reply org.bluez.Error.NotImplemented to
+// every method of Profile1Handler
+type UnimplementedProfile1Handler struct{}
+
+// Release reply org.bluez.Error.NotImplemented
+func (UnimplementedProfile1Handler) Release() *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+// NewConnection reply org.bluez.Error.NotImplemented
+func (UnimplementedProfile1Handler) NewConnection(device dbus.ObjectPath, fd dbus.UnixFD, fd_properties map[string]interface{}) *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+// RequestDisconnection reply org.bluez.Error.NotImplemented
+func (UnimplementedProfile1Handler) RequestDisconnection(device dbus.ObjectPath) *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+var _ Profile1Handler = UnimplementedProfile1Handler{}
+
+// Profile1Server export a Profile1Handler on a connection,
+// forwarding the calls of bluez to it
+type Profile1Server struct {
+	conn       *dbus.Conn
+	path       dbus.ObjectPath
+	handler    Profile1Handler
+}
+
+// ExportProfile1 export handler as org.bluez.Profile1 at path, with
+// its introspection data. The Introspectable interface of path only describes
+// org.bluez.Profile1, use Introspection to describe objects exporting more interfaces.
+func ExportProfile1(conn *dbus.Conn, path dbus.ObjectPath, handler Profile1Handler) (*Profile1Server, error) {
+
+	s := &Profile1Server{
+		conn:    conn,
+		path:    path,
+		handler: handler,
+	}
+
+	err := conn.Export(s, path, Profile1Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	node := &introspect.Node{
+		Interfaces: []introspect.Interface{
+			introspect.IntrospectData,
+			s.Introspection(),
+		},
+	}
+	err = conn.Export(introspect.NewIntrospectable(node), path, bluez.Introspectable)
+	if err != nil {
+		s.Unexport()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Path return the object path of the server
+func (s *Profile1Server) Path() dbus.ObjectPath {
+	return s.path
+}
+
+// Interface return the exported interface
+func (s *Profile1Server) Interface() string {
+	return Profile1Interface
+}
+
+// Handler return the handler serving the calls
+func (s *Profile1Server) Handler() Profile1Handler {
+	return s.handler
+}
+
+// Introspection return the introspection data of org.bluez.Profile1
+func (s *Profile1Server) Introspection() introspect.Interface {
+	return introspect.Interface{
+		Name:       Profile1Interface,
+		Methods:    introspect.Methods(s),
+	}
+}
+
+// Unexport remove the object from the connection
+func (s *Profile1Server) Unexport() error {
+	err := s.conn.Export(nil, s.path, Profile1Interface)
+	if err != nil {
+		return err
+	}
+	return s.conn.Export(nil, s.path, bluez.Introspectable)
+}
+
+// Release forward the call to the handler
+func (s *Profile1Server) Release() *dbus.Error {
+	return s.handler.Release()
+}
+
+// NewConnection forward the call to the handler
+func (s *Profile1Server) NewConnection(device dbus.ObjectPath, fd dbus.UnixFD, fd_properties map[string]interface{}) *dbus.Error {
+	return s.handler.NewConnection(device, fd, fd_properties)
+}
+
+// RequestDisconnection forward the call to the handler
+func (s *Profile1Server) RequestDisconnection(device dbus.ObjectPath) *dbus.Error {
+	return s.handler.RequestDisconnection(device)
+}
+
@@ -5,6 +5,7 @@ package profile
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/godbus/dbus/v5"
@@ -18,6 +19,14 @@ var ProfileManager1Interface = "org.bluez.ProfileManager1"
 // Args:
 
 func NewProfileManager1() (*ProfileManager1, error) {
+	return NewProfileManager1WithConn(nil)
+}
+
+// NewProfileManager1WithConn create a new instance of ProfileManager1 on a connection, nil for the shared system bus connection
+//
+// Args:
+
+func NewProfileManager1WithConn(conn *bluez.Conn) (*ProfileManager1, error) {
 	a := new(ProfileManager1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -25,6 +34,7 @@ func NewProfileManager1() (*ProfileManager1, error) {
 			Iface: ProfileManager1Interface,
 			Path:  dbus.ObjectPath("/org/bluez"),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -38,8 +48,7 @@ ProfileManager1 Profile Manager hierarchy
 */
 type ProfileManager1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*ProfileManager1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -84,35 +93,24 @@ func (a *ProfileManager1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *ProfileManager1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *ProfileManager1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -172,8 +170,13 @@ RegisterProfile 			This registers a profile implementation.
 
 */
 func (a *ProfileManager1) RegisterProfile(profile dbus.ObjectPath, uuid string, options map[string]interface{}) error {
+	return a.RegisterProfileContext(context.Background(), profile, uuid, options)
+}
+
+// RegisterProfileContext call RegisterProfile, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *ProfileManager1) RegisterProfileContext(ctx context.Context, profile dbus.ObjectPath, uuid string, options map[string]interface{}) error {
 	
-	return a.client.Call("RegisterProfile", 0, profile, uuid, options).Store()
+	return a.client.CallWithContext(ctx, "RegisterProfile", 0, profile, uuid, options).Store()
 	
 }
 
@@ -185,8 +188,13 @@ UnregisterProfile 			This unregisters the profile that has been previously
 
 */
 func (a *ProfileManager1) UnregisterProfile(profile dbus.ObjectPath) error {
+	return a.UnregisterProfileContext(context.Background(), profile)
+}
+
+// UnregisterProfileContext call UnregisterProfile, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *ProfileManager1) UnregisterProfileContext(ctx context.Context, profile dbus.ObjectPath) error {
 	
-	return a.client.Call("UnregisterProfile", 0, profile).Store()
+	return a.client.CallWithContext(ctx, "UnregisterProfile", 0, profile).Store()
 	
 }
 
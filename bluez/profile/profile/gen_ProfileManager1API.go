@@ -0,0 +1,27 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package profile
+
+
+import (
+   "context"
+   "github.com/godbus/dbus/v5"
+)
+
+// ProfileManager1API is the interface of ProfileManager1, implemented by
+// FakeProfileManager1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for ProfileManager1.
+type ProfileManager1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+
+	RegisterProfile(profile dbus.ObjectPath, uuid string, options map[string]interface{}) error
+	RegisterProfileContext(ctx context.Context, profile dbus.ObjectPath, uuid string, options map[string]interface{}) error
+	UnregisterProfile(profile dbus.ObjectPath) error
+	UnregisterProfileContext(ctx context.Context, profile dbus.ObjectPath) error
+}
+
+var _ ProfileManager1API = (*ProfileManager1)(nil)
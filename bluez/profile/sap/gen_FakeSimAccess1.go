@@ -0,0 +1,172 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package sap
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeSimAccess1 is an in-memory SimAccess1API to test the code depending on
+// SimAccess1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeSimAccess1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// DisconnectFunc handle the calls to Disconnect
+	DisconnectFunc func(ctx context.Context) error
+}
+
+var _ SimAccess1API = (*FakeSimAccess1)(nil)
+
+
+// NewFakeSimAccess1 create a FakeSimAccess1 with the initial properties, nil for none
+func NewFakeSimAccess1(objectPath dbus.ObjectPath, properties *SimAccess1Properties) *FakeSimAccess1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeSimAccess1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(SimAccess1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeSimAccess1 object path
+func (a *FakeSimAccess1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return SimAccess1 interface
+func (a *FakeSimAccess1) Interface() string {
+	return SimAccess1Interface
+}
+
+// Close stop the watchers
+func (a *FakeSimAccess1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeSimAccess1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeSimAccess1) GetProperties() (*SimAccess1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeSimAccess1) GetPropertiesContext(ctx context.Context) (*SimAccess1Properties, error) {
+	return new(SimAccess1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeSimAccess1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeSimAccess1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeSimAccess1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeSimAccess1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeSimAccess1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeSimAccess1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeSimAccess1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+// SetConnected set Connected value
+func (a *FakeSimAccess1) SetConnected(v bool) error {
+	return a.SetConnectedContext(context.Background(), v)
+}
+
+// SetConnectedContext set Connected value
+func (a *FakeSimAccess1) SetConnectedContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Connected", v)
+}
+
+
+
+// GetConnected get Connected value
+func (a *FakeSimAccess1) GetConnected() (bool, error) {
+	return a.GetConnectedContext(context.Background())
+}
+
+// GetConnectedContext get Connected value
+func (a *FakeSimAccess1) GetConnectedContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Connected")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Connected: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
+// Disconnect call DisconnectFunc
+func (a *FakeSimAccess1) Disconnect() error {
+	return a.DisconnectContext(context.Background())
+}
+
+// DisconnectContext call DisconnectFunc
+func (a *FakeSimAccess1) DisconnectContext(ctx context.Context) error {
+	if a.DisconnectFunc != nil {
+		return a.DisconnectFunc(ctx)
+	}
+	
+	return nil
+	
+}
+
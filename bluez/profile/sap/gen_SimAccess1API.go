@@ -0,0 +1,41 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package sap
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// SimAccess1API is the interface of SimAccess1, implemented by
+// FakeSimAccess1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for SimAccess1.
+type SimAccess1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*SimAccess1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*SimAccess1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	SetConnected(v bool) error
+	SetConnectedContext(ctx context.Context, v bool) error
+	GetConnected() (bool, error)
+	GetConnectedContext(ctx context.Context) (bool, error)
+
+	Disconnect() error
+	DisconnectContext(ctx context.Context) error
+}
+
+var _ SimAccess1API = (*SimAccess1)(nil)
@@ -0,0 +1,251 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package thermometer
+
+
+
+import (
+   "context"
+   "fmt"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeThermometer1 is an in-memory Thermometer1API to test the code depending on
+// Thermometer1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeThermometer1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+}
+
+var _ Thermometer1API = (*FakeThermometer1)(nil)
+
+
+// NewFakeThermometer1 create a FakeThermometer1 with the initial properties, nil for none
+func NewFakeThermometer1(objectPath dbus.ObjectPath, properties *Thermometer1Properties) *FakeThermometer1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeThermometer1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(Thermometer1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeThermometer1 object path
+func (a *FakeThermometer1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return Thermometer1 interface
+func (a *FakeThermometer1) Interface() string {
+	return Thermometer1Interface
+}
+
+// Close stop the watchers
+func (a *FakeThermometer1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeThermometer1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeThermometer1) GetProperties() (*Thermometer1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeThermometer1) GetPropertiesContext(ctx context.Context) (*Thermometer1Properties, error) {
+	return new(Thermometer1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeThermometer1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeThermometer1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeThermometer1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeThermometer1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeThermometer1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeThermometer1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeThermometer1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+// SetIntermediate set Intermediate value
+func (a *FakeThermometer1) SetIntermediate(v bool) error {
+	return a.SetIntermediateContext(context.Background(), v)
+}
+
+// SetIntermediateContext set Intermediate value
+func (a *FakeThermometer1) SetIntermediateContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Intermediate", v)
+}
+
+
+
+// GetIntermediate get Intermediate value
+func (a *FakeThermometer1) GetIntermediate() (bool, error) {
+	return a.GetIntermediateContext(context.Background())
+}
+
+// GetIntermediateContext get Intermediate value
+func (a *FakeThermometer1) GetIntermediateContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Intermediate")
+	if err != nil {
+		return false, err
+	}
+	value, ok := v.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("Intermediate: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetInterval set Interval value
+func (a *FakeThermometer1) SetInterval(v uint16) error {
+	return a.SetIntervalContext(context.Background(), v)
+}
+
+// SetIntervalContext set Interval value
+func (a *FakeThermometer1) SetIntervalContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "Interval", v)
+}
+
+
+
+// GetInterval get Interval value
+func (a *FakeThermometer1) GetInterval() (uint16, error) {
+	return a.GetIntervalContext(context.Background())
+}
+
+// GetIntervalContext get Interval value
+func (a *FakeThermometer1) GetIntervalContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Interval")
+	if err != nil {
+		return uint16(0), err
+	}
+	value, ok := v.Value().(uint16)
+	if !ok {
+		return uint16(0), fmt.Errorf("Interval: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetMaximum set Maximum value
+func (a *FakeThermometer1) SetMaximum(v uint16) error {
+	return a.SetMaximumContext(context.Background(), v)
+}
+
+// SetMaximumContext set Maximum value
+func (a *FakeThermometer1) SetMaximumContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "Maximum", v)
+}
+
+
+
+// GetMaximum get Maximum value
+func (a *FakeThermometer1) GetMaximum() (uint16, error) {
+	return a.GetMaximumContext(context.Background())
+}
+
+// GetMaximumContext get Maximum value
+func (a *FakeThermometer1) GetMaximumContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Maximum")
+	if err != nil {
+		return uint16(0), err
+	}
+	value, ok := v.Value().(uint16)
+	if !ok {
+		return uint16(0), fmt.Errorf("Maximum: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+// SetMinimum set Minimum value
+func (a *FakeThermometer1) SetMinimum(v uint16) error {
+	return a.SetMinimumContext(context.Background(), v)
+}
+
+// SetMinimumContext set Minimum value
+func (a *FakeThermometer1) SetMinimumContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "Minimum", v)
+}
+
+
+
+// GetMinimum get Minimum value
+func (a *FakeThermometer1) GetMinimum() (uint16, error) {
+	return a.GetMinimumContext(context.Background())
+}
+
+// GetMinimumContext get Minimum value
+func (a *FakeThermometer1) GetMinimumContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Minimum")
+	if err != nil {
+		return uint16(0), err
+	}
+	value, ok := v.Value().(uint16)
+	if !ok {
+		return uint16(0), fmt.Errorf("Minimum: unexpected type %T", v.Value())
+	}
+	return value, nil
+}
+
+
+
+
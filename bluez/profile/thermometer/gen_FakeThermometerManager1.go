@@ -0,0 +1,190 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package thermometer
+
+
+
+import (
+   "context"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeThermometerManager1 is an in-memory ThermometerManager1API to test the code depending on
+// ThermometerManager1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeThermometerManager1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// RegisterWatcherFunc handle the calls to RegisterWatcher
+	RegisterWatcherFunc func(ctx context.Context, agent dbus.ObjectPath) error
+	// UnregisterWatcherFunc handle the calls to UnregisterWatcher
+	UnregisterWatcherFunc func(ctx context.Context, agent dbus.ObjectPath) error
+	// EnableIntermediateMeasurementFunc handle the calls to EnableIntermediateMeasurement
+	EnableIntermediateMeasurementFunc func(ctx context.Context, agent dbus.ObjectPath) error
+	// DisableIntermediateMeasurementFunc handle the calls to DisableIntermediateMeasurement
+	DisableIntermediateMeasurementFunc func(ctx context.Context, agent dbus.ObjectPath) error
+}
+
+var _ ThermometerManager1API = (*FakeThermometerManager1)(nil)
+
+
+// NewFakeThermometerManager1 create a FakeThermometerManager1 with the initial properties, nil for none
+func NewFakeThermometerManager1(objectPath dbus.ObjectPath, properties *ThermometerManager1Properties) *FakeThermometerManager1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeThermometerManager1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(ThermometerManager1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeThermometerManager1 object path
+func (a *FakeThermometerManager1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return ThermometerManager1 interface
+func (a *FakeThermometerManager1) Interface() string {
+	return ThermometerManager1Interface
+}
+
+// Close stop the watchers
+func (a *FakeThermometerManager1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeThermometerManager1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeThermometerManager1) GetProperties() (*ThermometerManager1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeThermometerManager1) GetPropertiesContext(ctx context.Context) (*ThermometerManager1Properties, error) {
+	return new(ThermometerManager1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeThermometerManager1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeThermometerManager1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeThermometerManager1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeThermometerManager1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeThermometerManager1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeThermometerManager1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeThermometerManager1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+
+// RegisterWatcher call RegisterWatcherFunc
+func (a *FakeThermometerManager1) RegisterWatcher(agent dbus.ObjectPath) error {
+	return a.RegisterWatcherContext(context.Background(), agent)
+}
+
+// RegisterWatcherContext call RegisterWatcherFunc
+func (a *FakeThermometerManager1) RegisterWatcherContext(ctx context.Context, agent dbus.ObjectPath) error {
+	if a.RegisterWatcherFunc != nil {
+		return a.RegisterWatcherFunc(ctx, agent)
+	}
+	
+	return nil
+	
+}
+
+// UnregisterWatcher call UnregisterWatcherFunc
+func (a *FakeThermometerManager1) UnregisterWatcher(agent dbus.ObjectPath) error {
+	return a.UnregisterWatcherContext(context.Background(), agent)
+}
+
+// UnregisterWatcherContext call UnregisterWatcherFunc
+func (a *FakeThermometerManager1) UnregisterWatcherContext(ctx context.Context, agent dbus.ObjectPath) error {
+	if a.UnregisterWatcherFunc != nil {
+		return a.UnregisterWatcherFunc(ctx, agent)
+	}
+	
+	return nil
+	
+}
+
+// EnableIntermediateMeasurement call EnableIntermediateMeasurementFunc
+func (a *FakeThermometerManager1) EnableIntermediateMeasurement(agent dbus.ObjectPath) error {
+	return a.EnableIntermediateMeasurementContext(context.Background(), agent)
+}
+
+// EnableIntermediateMeasurementContext call EnableIntermediateMeasurementFunc
+func (a *FakeThermometerManager1) EnableIntermediateMeasurementContext(ctx context.Context, agent dbus.ObjectPath) error {
+	if a.EnableIntermediateMeasurementFunc != nil {
+		return a.EnableIntermediateMeasurementFunc(ctx, agent)
+	}
+	
+	return nil
+	
+}
+
+// DisableIntermediateMeasurement call DisableIntermediateMeasurementFunc
+func (a *FakeThermometerManager1) DisableIntermediateMeasurement(agent dbus.ObjectPath) error {
+	return a.DisableIntermediateMeasurementContext(context.Background(), agent)
+}
+
+// DisableIntermediateMeasurementContext call DisableIntermediateMeasurementFunc
+func (a *FakeThermometerManager1) DisableIntermediateMeasurementContext(ctx context.Context, agent dbus.ObjectPath) error {
+	if a.DisableIntermediateMeasurementFunc != nil {
+		return a.DisableIntermediateMeasurementFunc(ctx, agent)
+	}
+	
+	return nil
+	
+}
+
@@ -0,0 +1,139 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package thermometer
+
+
+
+import (
+   "context"
+   "sync"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/props"
+   "github.com/godbus/dbus/v5"
+)
+
+// FakeThermometerWatcher1 is an in-memory ThermometerWatcher1API to test the code depending on
+// ThermometerWatcher1 without D-Bus. Properties are kept in memory and their
+// changes sent to the watchers, read-only ones can be changed with SetProperty.
+// Methods run the matching Func field if set, otherwise they succeed with
+// zero values.
+type FakeThermometerWatcher1 struct {
+	ObjectPath dbus.ObjectPath
+
+	props *bluez.FakeProperties
+
+	// MeasurementReceivedFunc handle the calls to MeasurementReceived
+	MeasurementReceivedFunc func(ctx context.Context, measurement map[string]interface{}) error
+}
+
+var _ ThermometerWatcher1API = (*FakeThermometerWatcher1)(nil)
+
+
+// NewFakeThermometerWatcher1 create a FakeThermometerWatcher1 with the initial properties, nil for none
+func NewFakeThermometerWatcher1(objectPath dbus.ObjectPath, properties *ThermometerWatcher1Properties) *FakeThermometerWatcher1 {
+	values := map[string]interface{}{}
+	if properties != nil {
+		values = props.ToMap(properties)
+	}
+	return &FakeThermometerWatcher1{
+		ObjectPath: objectPath,
+		props:      bluez.NewFakeProperties(ThermometerWatcher1Interface, objectPath, values),
+	}
+}
+
+
+// Path return FakeThermometerWatcher1 object path
+func (a *FakeThermometerWatcher1) Path() dbus.ObjectPath {
+	return a.ObjectPath
+}
+
+// Interface return ThermometerWatcher1 interface
+func (a *FakeThermometerWatcher1) Interface() string {
+	return ThermometerWatcher1Interface
+}
+
+// Close stop the watchers
+func (a *FakeThermometerWatcher1) Close() {
+	
+	a.props.Close()
+	
+}
+
+// GetObjectManagerSignal return a channel receiving no signal, closed by cancel
+func (a *FakeThermometerWatcher1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
+	ch := make(chan *dbus.Signal)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			close(ch)
+		})
+	}
+	return ch, cancel, nil
+}
+
+
+// GetProperties return the properties
+func (a *FakeThermometerWatcher1) GetProperties() (*ThermometerWatcher1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext return the properties
+func (a *FakeThermometerWatcher1) GetPropertiesContext(ctx context.Context) (*ThermometerWatcher1Properties, error) {
+	return new(ThermometerWatcher1Properties).FromDBusMap(a.props.Values())
+}
+
+// SetProperty set a property, read-only ones included
+func (a *FakeThermometerWatcher1) SetProperty(name string, value interface{}) error {
+	return a.SetPropertyContext(context.Background(), name, value)
+}
+
+// SetPropertyContext set a property, read-only ones included
+func (a *FakeThermometerWatcher1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.props.Set(name, value)
+}
+
+// GetProperty get a property
+func (a *FakeThermometerWatcher1) GetProperty(name string) (dbus.Variant, error) {
+	return a.GetPropertyContext(context.Background(), name)
+}
+
+// GetPropertyContext get a property
+func (a *FakeThermometerWatcher1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.props.Get(name)
+}
+
+// GetPropertiesSignal return a channel receiving the property changes as
+// PropertiesChanged signals. The channel is closed by Close
+func (a *FakeThermometerWatcher1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
+	return a.props.Signal()
+}
+
+// WatchProperties updates on property changes
+func (a *FakeThermometerWatcher1) WatchProperties() (chan *bluez.PropertyChanged, error) {
+	return a.props.Watch()
+}
+
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
+func (a *FakeThermometerWatcher1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
+	return a.props.Unwatch(ch)
+}
+
+
+
+
+
+// MeasurementReceived call MeasurementReceivedFunc
+func (a *FakeThermometerWatcher1) MeasurementReceived(measurement map[string]interface{}) error {
+	return a.MeasurementReceivedContext(context.Background(), measurement)
+}
+
+// MeasurementReceivedContext call MeasurementReceivedFunc
+func (a *FakeThermometerWatcher1) MeasurementReceivedContext(ctx context.Context, measurement map[string]interface{}) error {
+	if a.MeasurementReceivedFunc != nil {
+		return a.MeasurementReceivedFunc(ctx, measurement)
+	}
+	
+	return nil
+	
+}
+
@@ -5,6 +5,7 @@ package thermometer
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var Thermometer1Interface = "org.bluez.Thermometer1"
 // Args:
 // - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX
 func NewThermometer1(objectPath dbus.ObjectPath) (*Thermometer1, error) {
+	return NewThermometer1WithConn(nil, objectPath)
+}
+
+// NewThermometer1WithConn create a new instance of Thermometer1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX
+func NewThermometer1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*Thermometer1, error) {
 	a := new(Thermometer1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewThermometer1(objectPath dbus.ObjectPath) (*Thermometer1, error) {
 			Iface: Thermometer1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -47,8 +57,7 @@ Thermometer1 Health Thermometer Profile hierarchy
 */
 type Thermometer1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*Thermometer1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -103,14 +112,24 @@ func (p *Thermometer1Properties) Unlock() {
 
 // SetIntermediate set Intermediate value
 func (a *Thermometer1) SetIntermediate(v bool) error {
-	return a.SetProperty("Intermediate", v)
+	return a.SetIntermediateContext(context.Background(), v)
+}
+
+// SetIntermediateContext set Intermediate value, failing with a bluez.ContextError if ctx is done
+func (a *Thermometer1) SetIntermediateContext(ctx context.Context, v bool) error {
+	return a.SetPropertyContext(ctx, "Intermediate", v)
 }
 
 
 
 // GetIntermediate get Intermediate value
 func (a *Thermometer1) GetIntermediate() (bool, error) {
-	v, err := a.GetProperty("Intermediate")
+	return a.GetIntermediateContext(context.Background())
+}
+
+// GetIntermediateContext get Intermediate value, failing with a bluez.ContextError if ctx is done
+func (a *Thermometer1) GetIntermediateContext(ctx context.Context) (bool, error) {
+	v, err := a.GetPropertyContext(ctx, "Intermediate")
 	if err != nil {
 		return false, err
 	}
@@ -122,14 +141,24 @@ func (a *Thermometer1) GetIntermediate() (bool, error) {
 
 // SetInterval set Interval value
 func (a *Thermometer1) SetInterval(v uint16) error {
-	return a.SetProperty("Interval", v)
+	return a.SetIntervalContext(context.Background(), v)
+}
+
+// SetIntervalContext set Interval value, failing with a bluez.ContextError if ctx is done
+func (a *Thermometer1) SetIntervalContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "Interval", v)
 }
 
 
 
 // GetInterval get Interval value
 func (a *Thermometer1) GetInterval() (uint16, error) {
-	v, err := a.GetProperty("Interval")
+	return a.GetIntervalContext(context.Background())
+}
+
+// GetIntervalContext get Interval value, failing with a bluez.ContextError if ctx is done
+func (a *Thermometer1) GetIntervalContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Interval")
 	if err != nil {
 		return uint16(0), err
 	}
@@ -141,14 +170,24 @@ func (a *Thermometer1) GetInterval() (uint16, error) {
 
 // SetMaximum set Maximum value
 func (a *Thermometer1) SetMaximum(v uint16) error {
-	return a.SetProperty("Maximum", v)
+	return a.SetMaximumContext(context.Background(), v)
+}
+
+// SetMaximumContext set Maximum value, failing with a bluez.ContextError if ctx is done
+func (a *Thermometer1) SetMaximumContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "Maximum", v)
 }
 
 
 
 // GetMaximum get Maximum value
 func (a *Thermometer1) GetMaximum() (uint16, error) {
-	v, err := a.GetProperty("Maximum")
+	return a.GetMaximumContext(context.Background())
+}
+
+// GetMaximumContext get Maximum value, failing with a bluez.ContextError if ctx is done
+func (a *Thermometer1) GetMaximumContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Maximum")
 	if err != nil {
 		return uint16(0), err
 	}
@@ -160,14 +199,24 @@ func (a *Thermometer1) GetMaximum() (uint16, error) {
 
 // SetMinimum set Minimum value
 func (a *Thermometer1) SetMinimum(v uint16) error {
-	return a.SetProperty("Minimum", v)
+	return a.SetMinimumContext(context.Background(), v)
+}
+
+// SetMinimumContext set Minimum value, failing with a bluez.ContextError if ctx is done
+func (a *Thermometer1) SetMinimumContext(ctx context.Context, v uint16) error {
+	return a.SetPropertyContext(ctx, "Minimum", v)
 }
 
 
 
 // GetMinimum get Minimum value
 func (a *Thermometer1) GetMinimum() (uint16, error) {
-	v, err := a.GetProperty("Minimum")
+	return a.GetMinimumContext(context.Background())
+}
+
+// GetMinimumContext get Minimum value, failing with a bluez.ContextError if ctx is done
+func (a *Thermometer1) GetMinimumContext(ctx context.Context) (uint16, error) {
+	v, err := a.GetPropertyContext(ctx, "Minimum")
 	if err != nil {
 		return uint16(0), err
 	}
@@ -199,35 +248,24 @@ func (a *Thermometer1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *Thermometer1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *Thermometer1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
-
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -257,20 +295,31 @@ func (a *Thermometer1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Thermometer1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *Thermometer1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *Thermometer1) GetProperties() (*Thermometer1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *Thermometer1) GetPropertiesContext(ctx context.Context) (*Thermometer1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -280,29 +329,40 @@ func (a *Thermometer1) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *Thermometer1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *Thermometer1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *Thermometer1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *Thermometer1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *Thermometer1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *Thermometer1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -312,6 +372,7 @@ func (a *Thermometer1) WatchProperties() (chan *bluez.PropertyChanged, error) {
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *Thermometer1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -0,0 +1,54 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package thermometer
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// Thermometer1API is the interface of Thermometer1, implemented by
+// FakeThermometer1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for Thermometer1.
+type Thermometer1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*Thermometer1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*Thermometer1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	SetIntermediate(v bool) error
+	SetIntermediateContext(ctx context.Context, v bool) error
+	GetIntermediate() (bool, error)
+	GetIntermediateContext(ctx context.Context) (bool, error)
+
+	SetInterval(v uint16) error
+	SetIntervalContext(ctx context.Context, v uint16) error
+	GetInterval() (uint16, error)
+	GetIntervalContext(ctx context.Context) (uint16, error)
+
+	SetMaximum(v uint16) error
+	SetMaximumContext(ctx context.Context, v uint16) error
+	GetMaximum() (uint16, error)
+	GetMaximumContext(ctx context.Context) (uint16, error)
+
+	SetMinimum(v uint16) error
+	SetMinimumContext(ctx context.Context, v uint16) error
+	GetMinimum() (uint16, error)
+	GetMinimumContext(ctx context.Context) (uint16, error)
+
+}
+
+var _ Thermometer1API = (*Thermometer1)(nil)
@@ -5,6 +5,7 @@ package thermometer
 
 
 import (
+   "context"
    "sync"
    "github.com/muka/go-bluetooth/bluez"
    "github.com/muka/go-bluetooth/util"
@@ -20,6 +21,14 @@ var ThermometerManager1Interface = "org.bluez.ThermometerManager1"
 // Args:
 // - objectPath: [variable prefix]/{hci0,hci1,...}
 func NewThermometerManager1(objectPath dbus.ObjectPath) (*ThermometerManager1, error) {
+	return NewThermometerManager1WithConn(nil, objectPath)
+}
+
+// NewThermometerManager1WithConn create a new instance of ThermometerManager1 on a connection, nil for the shared system bus connection
+//
+// Args:
+// - objectPath: [variable prefix]/{hci0,hci1,...}
+func NewThermometerManager1WithConn(conn *bluez.Conn, objectPath dbus.ObjectPath) (*ThermometerManager1, error) {
 	a := new(ThermometerManager1)
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -27,6 +36,7 @@ func NewThermometerManager1(objectPath dbus.ObjectPath) (*ThermometerManager1, e
 			Iface: ThermometerManager1Interface,
 			Path:  dbus.ObjectPath(objectPath),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	
@@ -47,8 +57,7 @@ ThermometerManager1 Health Thermometer Manager hierarchy
 */
 type ThermometerManager1 struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*ThermometerManager1Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -95,35 +104,24 @@ func (a *ThermometerManager1) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *ThermometerManager1) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
-
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *ThermometerManager1) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 
@@ -153,20 +151,31 @@ func (a *ThermometerManager1) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *ThermometerManager1) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *ThermometerManager1) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *ThermometerManager1) GetProperties() (*ThermometerManager1Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *ThermometerManager1) GetPropertiesContext(ctx context.Context) (*ThermometerManager1Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -176,29 +185,40 @@ func (a *ThermometerManager1) SetProperty(name string, value interface{}) error
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *ThermometerManager1) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *ThermometerManager1) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *ThermometerManager1) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *ThermometerManager1) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *ThermometerManager1) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *ThermometerManager1) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -208,6 +228,7 @@ func (a *ThermometerManager1) WatchProperties() (chan *bluez.PropertyChanged, er
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *ThermometerManager1) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -223,8 +244,13 @@ RegisterWatcher 			Registers a watcher to monitor scanned measurements.
 
 */
 func (a *ThermometerManager1) RegisterWatcher(agent dbus.ObjectPath) error {
+	return a.RegisterWatcherContext(context.Background(), agent)
+}
+
+// RegisterWatcherContext call RegisterWatcher, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *ThermometerManager1) RegisterWatcherContext(ctx context.Context, agent dbus.ObjectPath) error {
 	
-	return a.client.Call("RegisterWatcher", 0, agent).Store()
+	return a.client.CallWithContext(ctx, "RegisterWatcher", 0, agent).Store()
 	
 }
 
@@ -233,8 +259,13 @@ UnregisterWatcher 			Unregisters a watcher.
 
 */
 func (a *ThermometerManager1) UnregisterWatcher(agent dbus.ObjectPath) error {
+	return a.UnregisterWatcherContext(context.Background(), agent)
+}
+
+// UnregisterWatcherContext call UnregisterWatcher, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *ThermometerManager1) UnregisterWatcherContext(ctx context.Context, agent dbus.ObjectPath) error {
 	
-	return a.client.Call("UnregisterWatcher", 0, agent).Store()
+	return a.client.CallWithContext(ctx, "UnregisterWatcher", 0, agent).Store()
 	
 }
 
@@ -246,8 +277,13 @@ EnableIntermediateMeasurement 			Enables intermediate measurement notifications
 
 */
 func (a *ThermometerManager1) EnableIntermediateMeasurement(agent dbus.ObjectPath) error {
+	return a.EnableIntermediateMeasurementContext(context.Background(), agent)
+}
+
+// EnableIntermediateMeasurementContext call EnableIntermediateMeasurement, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *ThermometerManager1) EnableIntermediateMeasurementContext(ctx context.Context, agent dbus.ObjectPath) error {
 	
-	return a.client.Call("EnableIntermediateMeasurement", 0, agent).Store()
+	return a.client.CallWithContext(ctx, "EnableIntermediateMeasurement", 0, agent).Store()
 	
 }
 
@@ -261,8 +297,13 @@ DisableIntermediateMeasurement 			Disables intermediate measurement notification
 
 */
 func (a *ThermometerManager1) DisableIntermediateMeasurement(agent dbus.ObjectPath) error {
+	return a.DisableIntermediateMeasurementContext(context.Background(), agent)
+}
+
+// DisableIntermediateMeasurementContext call DisableIntermediateMeasurement, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *ThermometerManager1) DisableIntermediateMeasurementContext(ctx context.Context, agent dbus.ObjectPath) error {
 	
-	return a.client.Call("DisableIntermediateMeasurement", 0, agent).Store()
+	return a.client.CallWithContext(ctx, "DisableIntermediateMeasurement", 0, agent).Store()
 	
 }
 
@@ -0,0 +1,42 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package thermometer
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// ThermometerManager1API is the interface of ThermometerManager1, implemented by
+// FakeThermometerManager1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for ThermometerManager1.
+type ThermometerManager1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*ThermometerManager1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*ThermometerManager1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	RegisterWatcher(agent dbus.ObjectPath) error
+	RegisterWatcherContext(ctx context.Context, agent dbus.ObjectPath) error
+	UnregisterWatcher(agent dbus.ObjectPath) error
+	UnregisterWatcherContext(ctx context.Context, agent dbus.ObjectPath) error
+	EnableIntermediateMeasurement(agent dbus.ObjectPath) error
+	EnableIntermediateMeasurementContext(ctx context.Context, agent dbus.ObjectPath) error
+	DisableIntermediateMeasurement(agent dbus.ObjectPath) error
+	DisableIntermediateMeasurementContext(ctx context.Context, agent dbus.ObjectPath) error
+}
+
+var _ ThermometerManager1API = (*ThermometerManager1)(nil)
@@ -0,0 +1,36 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package thermometer
+
+
+import (
+   "context"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/godbus/dbus/v5"
+)
+
+// ThermometerWatcher1API is the interface of ThermometerWatcher1, implemented by
+// FakeThermometerWatcher1 to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for ThermometerWatcher1.
+type ThermometerWatcher1API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+
+	GetProperties() (*ThermometerWatcher1Properties, error)
+	GetPropertiesContext(ctx context.Context) (*ThermometerWatcher1Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+
+
+	MeasurementReceived(measurement map[string]interface{}) error
+	MeasurementReceivedContext(ctx context.Context, measurement map[string]interface{}) error
+}
+
+var _ ThermometerWatcher1API = (*ThermometerWatcher1)(nil)
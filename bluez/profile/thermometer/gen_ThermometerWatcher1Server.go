@@ -0,0 +1,109 @@
+// Code generated by go-bluetooth generator DO NOT EDIT.
+
+package thermometer
+
+
+
+import (
+   "github.com/godbus/dbus/v5"
+   "github.com/godbus/dbus/v5/introspect"
+   "github.com/muka/go-bluetooth/bluez"
+   "github.com/muka/go-bluetooth/bluez/profile"
+)
+
+// ThermometerWatcher1Handler is implemented by the application to serve
+// org.bluez.ThermometerWatcher1, see ThermometerWatcher1 for the documentation of the
+// methods. Embed UnimplementedThermometerWatcher1Handler to implement only some of them.
+type ThermometerWatcher1Handler interface {
+	MeasurementReceived(measurement map[string]interface{}) *dbus.Error
+}
+
+// UnimplementedThermometerWatcher1Handler reply org.bluez.Error.NotImplemented to
+// every method of ThermometerWatcher1Handler
+type UnimplementedThermometerWatcher1Handler struct{}
+
+// MeasurementReceived reply org.bluez.Error.NotImplemented
+func (UnimplementedThermometerWatcher1Handler) MeasurementReceived(measurement map[string]interface{}) *dbus.Error {
+	
+	return &profile.ErrNotImplemented
+	
+}
+
+var _ ThermometerWatcher1Handler = UnimplementedThermometerWatcher1Handler{}
+
+// ThermometerWatcher1Server export a ThermometerWatcher1Handler on a connection,
+// forwarding the calls of bluez to it
+type ThermometerWatcher1Server struct {
+	conn       *dbus.Conn
+	path       dbus.ObjectPath
+	handler    ThermometerWatcher1Handler
+}
+
+// ExportThermometerWatcher1 export handler as org.bluez.ThermometerWatcher1 at path, with
+// its introspection data. The Introspectable interface of path only describes
+// org.bluez.ThermometerWatcher1, use Introspection to describe objects exporting more interfaces.
+func ExportThermometerWatcher1(conn *dbus.Conn, path dbus.ObjectPath, handler ThermometerWatcher1Handler) (*ThermometerWatcher1Server, error) {
+
+	s := &ThermometerWatcher1Server{
+		conn:    conn,
+		path:    path,
+		handler: handler,
+	}
+
+	err := conn.Export(s, path, ThermometerWatcher1Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	node := &introspect.Node{
+		Interfaces: []introspect.Interface{
+			introspect.IntrospectData,
+			s.Introspection(),
+		},
+	}
+	err = conn.Export(introspect.NewIntrospectable(node), path, bluez.Introspectable)
+	if err != nil {
+		s.Unexport()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Path return the object path of the server
+func (s *ThermometerWatcher1Server) Path() dbus.ObjectPath {
+	return s.path
+}
+
+// Interface return the exported interface
+func (s *ThermometerWatcher1Server) Interface() string {
+	return ThermometerWatcher1Interface
+}
+
+// Handler return the handler serving the calls
+func (s *ThermometerWatcher1Server) Handler() ThermometerWatcher1Handler {
+	return s.handler
+}
+
+// Introspection return the introspection data of org.bluez.ThermometerWatcher1
+func (s *ThermometerWatcher1Server) Introspection() introspect.Interface {
+	return introspect.Interface{
+		Name:       ThermometerWatcher1Interface,
+		Methods:    introspect.Methods(s),
+	}
+}
+
+// Unexport remove the object from the connection
+func (s *ThermometerWatcher1Server) Unexport() error {
+	err := s.conn.Export(nil, s.path, ThermometerWatcher1Interface)
+	if err != nil {
+		return err
+	}
+	return s.conn.Export(nil, s.path, bluez.Introspectable)
+}
+
+// MeasurementReceived forward the call to the handler
+func (s *ThermometerWatcher1Server) MeasurementReceived(measurement map[string]interface{}) *dbus.Error {
+	return s.handler.MeasurementReceived(measurement)
+}
+
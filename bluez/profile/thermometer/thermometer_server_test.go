@@ -0,0 +1,72 @@
+package thermometer_test
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/godbus/dbus/v5/introspect"
+	"github.com/muka/go-bluetooth/bluez"
+	"github.com/muka/go-bluetooth/bluez/mock"
+	"github.com/muka/go-bluetooth/bluez/profile/thermometer"
+	"github.com/stretchr/testify/assert"
+)
+
+type testWatcher struct {
+	thermometer.UnimplementedThermometerWatcher1Handler
+	measurements chan map[string]interface{}
+}
+
+func (w *testWatcher) MeasurementReceived(measurement map[string]interface{}) *dbus.Error {
+	w.measurements <- measurement
+	return nil
+}
+
+func TestExportThermometerWatcher1(t *testing.T) {
+	b := mock.StartTest(t)
+	defer b.Close()
+
+	path := dbus.ObjectPath("/go_bluetooth/test/watcher")
+	watcher := &testWatcher{measurements: make(chan map[string]interface{}, 1)}
+	s, err := thermometer.ExportThermometerWatcher1(b.ClientConn(), path, watcher)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, path, s.Path())
+	assert.Equal(t, thermometer.ThermometerWatcher1Interface, s.Interface())
+
+	err = b.CallApp(path, thermometer.ThermometerWatcher1Interface+".MeasurementReceived", map[string]dbus.Variant{
+		"Exponent": dbus.MakeVariant(int16(-1)),
+		"Unit":     dbus.MakeVariant("celsius"),
+	}).Err
+	assert.NoError(t, err)
+	measurement := <-watcher.measurements
+	assert.Equal(t, "celsius", measurement["Unit"])
+
+	// only the methods of the interface are described
+	var data string
+	err = b.CallApp(path, bluez.Introspectable+".Introspect").Store(&data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	node := introspect.Node{}
+	err = xml.Unmarshal([]byte(data), &node)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := []string{}
+	for _, iface := range node.Interfaces {
+		names = append(names, iface.Name)
+		if iface.Name == thermometer.ThermometerWatcher1Interface {
+			assert.Len(t, iface.Methods, 1)
+			assert.Equal(t, "MeasurementReceived", iface.Methods[0].Name)
+		}
+	}
+	assert.Contains(t, names, thermometer.ThermometerWatcher1Interface)
+	assert.NotContains(t, names, bluez.PropertiesInterface)
+
+	err = s.Unexport()
+	assert.NoError(t, err)
+	err = b.CallApp(path, thermometer.ThermometerWatcher1Interface+".MeasurementReceived", map[string]dbus.Variant{}).Err
+	assert.Error(t, err)
+}
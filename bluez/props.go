@@ -2,104 +2,143 @@ package bluez
 
 import (
 	"reflect"
+	"sync"
 
 	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/logger"
 	"github.com/muka/go-bluetooth/util"
-	log "github.com/sirupsen/logrus"
 )
 
 type WatchableClient interface {
 	Client() *Client
 	Path() dbus.ObjectPath
 	ToProps() Properties
-	GetWatchPropertiesChannel() chan *dbus.Signal
-	SetWatchPropertiesChannel(chan *dbus.Signal)
 }
 
-// WatchProperties updates on property changes
+// propertiesWatch is a running WatchProperties
+type propertiesWatch struct {
+	sub  *Subscription
+	stop chan struct{}
+	done chan struct{}
+}
+
+var (
+	watchesLock sync.Mutex
+	watches     = make(map[chan *PropertyChanged]*propertiesWatch)
+)
+
+// WatchProperties updates on property changes. The channel is closed by
+// UnwatchProperties
 func WatchProperties(wprop WatchableClient) (chan *PropertyChanged, error) {
 
-	channel, err := wprop.Client().Register(wprop.Path(), PropertiesInterface)
+	d, err := wprop.Client().Dispatcher()
 	if err != nil {
 		return nil, err
 	}
 
-	wprop.SetWatchPropertiesChannel(channel)
-	ch := make(chan *PropertyChanged)
+	sub, err := d.Subscribe(SignalMatch{
+		Path:      wprop.Path(),
+		Interface: PropertiesInterface,
+		Member:    "PropertiesChanged",
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	go (func() {
-		for {
+	ch := make(chan *PropertyChanged)
+	w := &propertiesWatch{
+		sub:  sub,
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
 
-			if channel == nil {
-				break
-			}
+	watchesLock.Lock()
+	watches[ch] = w
+	watchesLock.Unlock()
 
-			sig := <-channel
+	go func() {
+		defer close(w.done)
+		defer close(ch)
 
-			if sig == nil {
-				return
-			}
+		for sig := range sub.Signals() {
 
-			if sig.Name != PropertiesChanged {
+			if len(sig.Body) < 2 {
 				continue
 			}
-			if sig.Path != wprop.Path() {
+			iface, ok1 := sig.Body[0].(string)
+			changes, ok2 := sig.Body[1].(map[string]dbus.Variant)
+			if !ok1 || !ok2 {
 				continue
 			}
 
-			iface := sig.Body[0].(string)
-			changes := sig.Body[1].(map[string]dbus.Variant)
-
 			for field, val := range changes {
 
-				// updates [*]Properties struct when a property change
-				s := reflect.ValueOf(wprop.ToProps()).Elem()
-				// exported field
-				f := s.FieldByName(field)
-				if f.IsValid() {
-					// A Value can be changed only if it is
-					// addressable and was not obtained by
-					// the use of unexported struct fields.
-					if f.CanSet() {
-						x := reflect.ValueOf(val.Value())
-						wprop.ToProps().Lock()
-						// map[*]variant -> map[*]interface{}
-						ok, err := util.AssignMapVariantToInterface(f, x)
-						if err != nil {
-							log.Errorf("Failed to set %s: %s", f.String(), err)
-							continue
-						}
-						// direct assignment
-						if !ok {
-							f.Set(x)
-						}
-						wprop.ToProps().Unlock()
-					}
-				}
+				updateProperty(wprop.Client().Logger(), wprop.ToProps(), field, val)
 
 				propChanged := &PropertyChanged{
 					Interface: iface,
 					Name:      field,
 					Value:     val.Value(),
 				}
-				ch <- propChanged
-			}
 
+				select {
+				case ch <- propChanged:
+				case <-w.stop:
+					return
+				}
+			}
 		}
-	})()
+	}()
 
 	return ch, nil
 }
 
+// updateProperty set a field of a [*]Properties struct when a property change
+func updateProperty(log *logger.Entry, props Properties, field string, val dbus.Variant) {
+
+	s := reflect.ValueOf(props).Elem()
+	// exported field
+	f := s.FieldByName(field)
+	// A Value can be changed only if it is
+	// addressable and was not obtained by
+	// the use of unexported struct fields.
+	if !f.IsValid() || !f.CanSet() {
+		return
+	}
+
+	x := reflect.ValueOf(val.Value())
+
+	props.Lock()
+	defer props.Unlock()
+
+	// map[*]variant -> map[*]interface{}
+	ok, err := util.AssignMapVariantToInterface(f, x)
+	if err != nil {
+		log.Errorf("Failed to set %s: %s", f.String(), err)
+		return
+	}
+	// direct assignment
+	if !ok {
+		f.Set(x)
+	}
+}
+
+// UnwatchProperties stop the updates started by WatchProperties and close ch.
+// Pending changes are dropped, it does not require ch to be drained.
 func UnwatchProperties(wprop WatchableClient, ch chan *PropertyChanged) error {
-	if wprop.GetWatchPropertiesChannel() != nil {
-		wprop.GetWatchPropertiesChannel() <- nil
-		err := wprop.Client().Unregister(wprop.Path(), PropertiesInterface, wprop.GetWatchPropertiesChannel())
-		if err != nil {
-			return err
-		}
+
+	watchesLock.Lock()
+	w, ok := watches[ch]
+	delete(watches, ch)
+	watchesLock.Unlock()
+
+	if !ok {
+		return nil
 	}
-	ch <- nil
-	close(ch)
+
+	close(w.stop)
+	w.sub.Unsubscribe()
+	<-w.done
+
 	return nil
 }
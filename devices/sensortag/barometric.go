@@ -11,7 +11,7 @@ import (
 //getting config,data,period characteristics for BAROMETRIC sensor
 func newBarometricSensor(tag *SensorTag) (*BarometricSensor, error) {
 
-	dev := tag.Device1
+	dev := tag.device
 
 	BarometerConfigUUID, err := getUUID("BarometerConfig")
 	if err != nil {
@@ -26,41 +26,47 @@ func newBarometricSensor(tag *SensorTag) (*BarometricSensor, error) {
 		return nil, err
 	}
 
-	i, err := retryCall(DefaultRetry, DefaultRetryWait, func() (interface{}, error) {
+	var sensor *BarometricSensor
+	err = tag.loadChars("BarometricSensor", func() error {
 
-		cfg, err := dev.GetCharByUUID(BarometerConfigUUID)
+		cfg, err := dev.GetChar(BarometerConfigUUID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		data, err := dev.GetCharByUUID(BarometerDataUUID)
+		data, err := dev.GetChar(BarometerDataUUID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if data == nil {
-			return nil, errors.New("Cannot find BarometerData characteristic " + BarometerDataUUID)
+			return errors.New("Cannot find BarometerData characteristic " + BarometerDataUUID)
 		}
 
-		period, err := dev.GetCharByUUID(BarometerPeriodUUID)
+		period, err := dev.GetChar(BarometerPeriodUUID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if period == nil {
-			return nil, errors.New("Cannot find BarometerPeriod characteristic " + BarometerPeriodUUID)
+			return errors.New("Cannot find BarometerPeriod characteristic " + BarometerPeriodUUID)
 		}
 
-		return &BarometricSensor{tag, cfg, data, period}, err
+		sensor = &BarometricSensor{tag, cfg, data, period}
+		return nil
 	})
 
-	return i.(*BarometricSensor), err
+	if err != nil {
+		return nil, err
+	}
+
+	return sensor, nil
 }
 
 //BarometricSensor structure
 type BarometricSensor struct {
 	tag    *SensorTag
-	cfg    *gatt.GattCharacteristic1
-	data   *gatt.GattCharacteristic1
-	period *gatt.GattCharacteristic1
+	cfg    gatt.GattCharacteristic1API
+	data   gatt.GattCharacteristic1API
+	period gatt.GattCharacteristic1API
 }
 
 //GetName return the sensor name
@@ -78,7 +84,7 @@ func (s *BarometricSensor) Enable() error {
 		return nil
 	}
 	options := getOptions()
-	err = s.cfg.WriteValue([]byte{1}, options)
+	err = s.tag.writeValue(s.cfg, []byte{1}, options)
 	if err != nil {
 		return err
 	}
@@ -95,7 +101,7 @@ func (s *BarometricSensor) Disable() error {
 		return nil
 	}
 	options := getOptions()
-	err = s.cfg.WriteValue([]byte{0}, options)
+	err = s.tag.writeValue(s.cfg, []byte{0}, options)
 	if err != nil {
 		return err
 	}
@@ -106,7 +112,7 @@ func (s *BarometricSensor) Disable() error {
 func (s *BarometricSensor) IsEnabled() (bool, error) {
 	options := getOptions()
 
-	val, err := s.cfg.ReadValue(options)
+	val, err := s.tag.readValue(s.cfg, options)
 	if err != nil {
 		return false, err
 	}
@@ -139,7 +145,7 @@ func (s *BarometricSensor) Read() (float64, error) {
 	}
 
 	options := getOptions()
-	b, err := s.data.ReadValue(options)
+	b, err := s.tag.readValue(s.data, options)
 
 	if err != nil {
 		return 0, err
@@ -11,7 +11,7 @@ import (
 //getting config,data,period characteristics for Humidity sensor
 func newHumiditySensor(tag *SensorTag) (*HumiditySensor, error) {
 
-	dev := tag.Device1
+	dev := tag.device
 	HumidityConfigUUID, err := getUUID("HumidityConfig")
 	if err != nil {
 		return nil, err
@@ -25,41 +25,48 @@ func newHumiditySensor(tag *SensorTag) (*HumiditySensor, error) {
 		return nil, err
 	}
 
-	i, err := retryCall(DefaultRetry, DefaultRetryWait, func() (interface{}, error) {
-		cfg, err := dev.GetCharByUUID(HumidityConfigUUID)
+	var sensor *HumiditySensor
+	err = tag.loadChars("HumiditySensor", func() error {
+
+		cfg, err := dev.GetChar(HumidityConfigUUID)
 
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		data, err := dev.GetCharByUUID(HumidityDataUUID)
+		data, err := dev.GetChar(HumidityDataUUID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if data == nil {
-			return nil, errors.New("Cannot find HumidityData characteristic " + HumidityDataUUID)
+			return errors.New("Cannot find HumidityData characteristic " + HumidityDataUUID)
 		}
 
-		period, err := dev.GetCharByUUID(HumidityPeriodUUID)
+		period, err := dev.GetChar(HumidityPeriodUUID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if period == nil {
-			return nil, errors.New("Cannot find HumidityPeriod characteristic " + HumidityPeriodUUID)
+			return errors.New("Cannot find HumidityPeriod characteristic " + HumidityPeriodUUID)
 		}
 
-		return &HumiditySensor{tag, cfg, data, period}, err
+		sensor = &HumiditySensor{tag, cfg, data, period}
+		return nil
 	})
 
-	return i.(*HumiditySensor), err
+	if err != nil {
+		return nil, err
+	}
+
+	return sensor, nil
 }
 
 //HumiditySensor struct
 type HumiditySensor struct {
 	tag    *SensorTag
-	cfg    *gatt.GattCharacteristic1
-	data   *gatt.GattCharacteristic1
-	period *gatt.GattCharacteristic1
+	cfg    gatt.GattCharacteristic1API
+	data   gatt.GattCharacteristic1API
+	period gatt.GattCharacteristic1API
 }
 
 //GetName return the sensor name
@@ -77,7 +84,7 @@ func (s *HumiditySensor) Enable() error {
 		return nil
 	}
 	options := getOptions()
-	err = s.cfg.WriteValue([]byte{1}, options)
+	err = s.tag.writeValue(s.cfg, []byte{1}, options)
 	if err != nil {
 		return err
 	}
@@ -94,7 +101,7 @@ func (s *HumiditySensor) Disable() error {
 		return nil
 	}
 	options := make(map[string]interface{})
-	err = s.cfg.WriteValue([]byte{0}, options)
+	err = s.tag.writeValue(s.cfg, []byte{0}, options)
 	if err != nil {
 		return err
 	}
@@ -105,7 +112,7 @@ func (s *HumiditySensor) Disable() error {
 func (s *HumiditySensor) IsEnabled() (bool, error) {
 	options := make(map[string]interface{})
 
-	val, err := s.cfg.ReadValue(options)
+	val, err := s.tag.readValue(s.cfg, options)
 	if err != nil {
 		return false, err
 	}
@@ -136,7 +143,7 @@ func (s *HumiditySensor) Read() (float64, error) {
 	}
 
 	options := make(map[string]interface{})
-	b, err := s.data.ReadValue(options)
+	b, err := s.tag.readValue(s.data, options)
 
 	if err != nil {
 		return 0, err
@@ -186,7 +193,7 @@ func (s *HumiditySensor) StartNotify() error {
 				HumidityUnit:      "%RH",
 				HumidityTempValue: tempValue,
 				HumidityTempUnit:  "C",
-				SensorID:          s.tag.Properties.Address,
+				SensorID:          s.tag.address,
 			}
 
 			s.tag.Data() <- &dataEvent
@@ -14,7 +14,7 @@ import (
 
 func newLuxometerSensor(tag *SensorTag) (*LuxometerSensor, error) {
 
-	dev := tag.Device1
+	dev := tag.device
 
 	LuxometerConfigUUID, err := getUUID("LUXOMETER_CONFIG_UUID")
 	if err != nil {
@@ -29,41 +29,47 @@ func newLuxometerSensor(tag *SensorTag) (*LuxometerSensor, error) {
 		return nil, err
 	}
 
-	i, err := retryCall(DefaultRetry, DefaultRetryWait, func() (interface{}, error) {
+	var sensor *LuxometerSensor
+	err = tag.loadChars("LuxometerSensor", func() error {
 
-		cfg, err := dev.GetCharByUUID(LuxometerConfigUUID)
+		cfg, err := dev.GetChar(LuxometerConfigUUID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		data, err := dev.GetCharByUUID(LuxometerDataUUID)
+		data, err := dev.GetChar(LuxometerDataUUID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if data == nil {
-			return nil, errors.New("Cannot find LuxometerDataUUID  characteristic " + LuxometerDataUUID)
+			return errors.New("Cannot find LuxometerDataUUID  characteristic " + LuxometerDataUUID)
 		}
 
-		period, err := dev.GetCharByUUID(LuxometerPeriodUUID)
+		period, err := dev.GetChar(LuxometerPeriodUUID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if period == nil {
-			return nil, errors.New("Cannot find LuxometerPeriodUUID  characteristic " + LuxometerPeriodUUID)
+			return errors.New("Cannot find LuxometerPeriodUUID  characteristic " + LuxometerPeriodUUID)
 		}
 
-		return &LuxometerSensor{tag, cfg, data, period}, err
+		sensor = &LuxometerSensor{tag, cfg, data, period}
+		return nil
 	})
 
-	return i.(*LuxometerSensor), err
+	if err != nil {
+		return nil, err
+	}
+
+	return sensor, nil
 }
 
 //LuxometerSensor sensor structure
 type LuxometerSensor struct {
 	tag    *SensorTag
-	cfg    *gatt.GattCharacteristic1
-	data   *gatt.GattCharacteristic1
-	period *gatt.GattCharacteristic1
+	cfg    gatt.GattCharacteristic1API
+	data   gatt.GattCharacteristic1API
+	period gatt.GattCharacteristic1API
 }
 
 //GetName return the sensor name
@@ -81,7 +87,7 @@ func (s *LuxometerSensor) Enable() error {
 		return nil
 	}
 	options := getOptions()
-	err = s.cfg.WriteValue([]byte{1}, options)
+	err = s.tag.writeValue(s.cfg, []byte{1}, options)
 	if err != nil {
 		return err
 	}
@@ -98,7 +104,7 @@ func (s *LuxometerSensor) Disable() error {
 		return nil
 	}
 	options := make(map[string]interface{})
-	err = s.cfg.WriteValue([]byte{0}, options)
+	err = s.tag.writeValue(s.cfg, []byte{0}, options)
 	if err != nil {
 		return err
 	}
@@ -109,7 +115,7 @@ func (s *LuxometerSensor) Disable() error {
 func (s *LuxometerSensor) IsEnabled() (bool, error) {
 
 	options := getOptions()
-	val, err := s.cfg.ReadValue(options)
+	val, err := s.tag.readValue(s.cfg, options)
 	if err != nil {
 		return false, err
 	}
@@ -141,7 +147,7 @@ func (s *LuxometerSensor) Read() (float64, error) {
 	}
 
 	options := getOptions()
-	b, err := s.data.ReadValue(options)
+	b, err := s.tag.readValue(s.data, options)
 
 	if err != nil {
 		return 0, err
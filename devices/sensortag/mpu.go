@@ -12,7 +12,7 @@ import (
 //getting config,data,period characteristics for Humidity sensor
 func newMpuSensor(tag *SensorTag) (*MpuSensor, error) {
 
-	dev := tag.Device1
+	dev := tag.device
 
 	//accelerometer,magnetometer,gyroscope
 	MpuConfigUUID, err := getUUID("MPU9250_CONFIG_UUID")
@@ -28,41 +28,47 @@ func newMpuSensor(tag *SensorTag) (*MpuSensor, error) {
 		return nil, err
 	}
 
-	i, err := retryCall(DefaultRetry, DefaultRetryWait, func() (interface{}, error) {
+	var sensor *MpuSensor
+	err = tag.loadChars("MpuSensor", func() error {
 
-		cfg, err := dev.GetCharByUUID(MpuConfigUUID)
+		cfg, err := dev.GetChar(MpuConfigUUID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		data, err := dev.GetCharByUUID(MpuDataUUID)
+		data, err := dev.GetChar(MpuDataUUID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if data == nil {
-			return nil, errors.New("Cannot find MpuData characteristic " + MpuDataUUID)
+			return errors.New("Cannot find MpuData characteristic " + MpuDataUUID)
 		}
 
-		period, err := dev.GetCharByUUID(MpuPeriodUUID)
+		period, err := dev.GetChar(MpuPeriodUUID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if period == nil {
-			return nil, errors.New("Cannot find MpuPeriod characteristic " + MpuPeriodUUID)
+			return errors.New("Cannot find MpuPeriod characteristic " + MpuPeriodUUID)
 		}
 
-		return &MpuSensor{tag, cfg, data, period}, err
+		sensor = &MpuSensor{tag, cfg, data, period}
+		return nil
 	})
 
-	return i.(*MpuSensor), err
+	if err != nil {
+		return nil, err
+	}
+
+	return sensor, nil
 }
 
 //MpuSensor structure
 type MpuSensor struct {
 	tag    *SensorTag
-	cfg    *gatt.GattCharacteristic1
-	data   *gatt.GattCharacteristic1
-	period *gatt.GattCharacteristic1
+	cfg    gatt.GattCharacteristic1API
+	data   gatt.GattCharacteristic1API
+	period gatt.GattCharacteristic1API
 }
 
 //GetName return's the sensor name
@@ -80,7 +86,7 @@ func (s *MpuSensor) Enable() error {
 		return nil
 	}
 	options := make(map[string]interface{})
-	err = s.cfg.WriteValue([]byte{0x0007f, 0x0007f}, options)
+	err = s.tag.writeValue(s.cfg, []byte{0x0007f, 0x0007f}, options)
 	if err != nil {
 		return err
 	}
@@ -97,7 +103,7 @@ func (s *MpuSensor) Disable() error {
 		return nil
 	}
 	options := make(map[string]interface{})
-	err = s.cfg.WriteValue([]byte{0}, options)
+	err = s.tag.writeValue(s.cfg, []byte{0}, options)
 	if err != nil {
 		return err
 	}
@@ -108,7 +114,7 @@ func (s *MpuSensor) Disable() error {
 func (s *MpuSensor) IsEnabled() (bool, error) {
 	options := make(map[string]interface{})
 
-	val, err := s.cfg.ReadValue(options)
+	val, err := s.tag.readValue(s.cfg, options)
 	if err != nil {
 		return false, err
 	}
@@ -140,7 +146,7 @@ func (s *MpuSensor) Read() (float64, error) {
 	}
 
 	options := make(map[string]interface{})
-	b, err := s.data.ReadValue(options)
+	b, err := s.tag.readValue(s.data, options)
 
 	if err != nil {
 		return 0, err
@@ -1,20 +1,26 @@
 package sensortag
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/godbus/dbus/v5"
 	"github.com/muka/go-bluetooth/bluez/profile/device"
 	"github.com/muka/go-bluetooth/bluez/profile/gatt"
-	log "github.com/sirupsen/logrus"
+	"github.com/muka/go-bluetooth/logger"
 )
 
 // DefaultRetry times
+//
+// Deprecated: the sensors are loaded in the device queue, retried as set by
+// device.DefaultOperationRetries
 const DefaultRetry = 3
 
 // DefaultRetryWait in millis
+//
+// Deprecated: the sensors are loaded in the device queue, waiting as set by
+// device.DefaultRetryBackoff
 const DefaultRetryWait = 500
 
 var dataChannel chan dbus.Signal
@@ -138,31 +144,115 @@ func getDeviceInfoUUID(name string) string {
 	return "0000" + sensorTagUUIDs[name] + "-0000-1000-8000-00805F9B34FB"
 }
 
-//retryCall n. times, sleep millis, callback
-func retryCall(times int, sleep int64, fn func() (interface{}, error)) (intf interface{}, err error) {
-	for i := 0; i < times; i++ {
-		intf, err = fn()
-		if err == nil {
-			return intf, nil
-		}
-		time.Sleep(time.Millisecond * time.Duration(sleep))
+// Device is the device of a SensorTag. NewSensorTag implement it for a
+// *device.Device1, fakes can implement it for NewSensorTagAPI with
+// device.FakeDevice1 and gatt.FakeGattCharacteristic1.
+type Device interface {
+	device.Device1API
+	// GetChar return the characteristic with uuid, failing with
+	// device.ErrCharacteristicNotFound if it is not available
+	GetChar(uuid string) (gatt.GattCharacteristic1API, error)
+	// Do run op in the operation queue of the device
+	Do(ctx context.Context, op device.Operation) error
+}
+
+// queuedDevice is the Device of a *device.Device1, using its operation queue
+type queuedDevice struct {
+	*device.Device1
+}
+
+func (d queuedDevice) GetChar(uuid string) (gatt.GattCharacteristic1API, error) {
+	char, err := d.GetCharByUUID(uuid)
+	if err != nil {
+		return nil, err
 	}
-	return nil, err
+	return char, nil
+}
+
+func (d queuedDevice) Do(ctx context.Context, op device.Operation) error {
+	return d.Queue().Do(ctx, op)
+}
+
+// loadChars run fn in the device queue, retrying with the queue backoff until
+// the characteristics are available as bluez exposes them only once the
+// services are resolved
+func (s *SensorTag) loadChars(name string, fn func() error) error {
+	return s.device.Do(context.Background(), device.Operation{
+		Name:     name,
+		Priority: device.PriorityLow,
+		Retryable: func(err error) bool {
+			return errors.Is(err, device.ErrCharacteristicNotFound)
+		},
+		Run: func(ctx context.Context) error {
+			return fn()
+		},
+	})
+}
+
+// readValue read the value of char in the device queue
+func (s *SensorTag) readValue(char gatt.GattCharacteristic1API, options map[string]interface{}) ([]byte, error) {
+	var value []byte
+	err := s.device.Do(context.Background(), device.Operation{
+		Name:     "ReadValue",
+		Priority: device.PriorityNormal,
+		Run: func(ctx context.Context) (err error) {
+			value, err = char.ReadValueContext(ctx, options)
+			return err
+		},
+	})
+	return value, err
+}
+
+// writeValue write the value of char in the device queue
+func (s *SensorTag) writeValue(char gatt.GattCharacteristic1API, value []byte, options map[string]interface{}) error {
+	return s.device.Do(context.Background(), device.Operation{
+		Name:     "WriteValue",
+		Priority: device.PriorityNormal,
+		Run: func(ctx context.Context) error {
+			return char.WriteValueContext(ctx, value, options)
+		},
+	})
 }
 
 //NewSensorTag creates a new sensortag instance
 func NewSensorTag(d *device.Device1) (*SensorTag, error) {
+	s, err := NewSensorTagAPI(queuedDevice{d})
+	if err != nil {
+		return nil, err
+	}
+	s.Device1 = d
+	return s, nil
+}
 
-	if !d.Properties.Connected {
-		log.Debug("Connecting")
-		err := d.Connect()
+// NewSensorTagAPI creates a new sensortag instance on d, SensorTag.Device1 is
+// nil as d may not be a *device.Device1
+func NewSensorTagAPI(d Device) (*SensorTag, error) {
+
+	connected, err := d.GetConnected()
+	if err != nil {
+		return nil, err
+	}
+	if !connected {
+		logger.Debugf("Connecting")
+		err := d.Do(context.Background(), device.Operation{
+			Name:     "Connect",
+			Priority: device.PriorityHigh,
+			Run:      d.ConnectContext,
+		})
 		if err != nil {
 			return nil, err
 		}
-		log.Debug("Connected")
+		logger.Debugf("Connected")
+	}
+
+	address, err := d.GetAddress()
+	if err != nil {
+		return nil, err
 	}
 
 	s := new(SensorTag)
+	s.device = d
+	s.address = address
 
 	s.dataChannel = make(chan *SensorTagDataEvent)
 
@@ -189,8 +279,6 @@ func NewSensorTag(d *device.Device1) (*SensorTag, error) {
 	// 	return nil, err
 	// }
 
-	s.Device1 = d
-
 	temp, err := newTemperatureSensor(s)
 	if err != nil {
 		return nil, err
@@ -233,6 +321,8 @@ func NewSensorTag(d *device.Device1) (*SensorTag, error) {
 //SensorTag a SensorTag object representation
 type SensorTag struct {
 	*device.Device1
+	device      Device
+	address     string
 	dataChannel chan *SensorTagDataEvent
 	Temperature TemperatureSensor
 	Humidity    HumiditySensor
@@ -246,6 +336,11 @@ func (s *SensorTag) Data() chan *SensorTagDataEvent {
 	return s.dataChannel
 }
 
+// Device return the device of the sensortag
+func (s *SensorTag) Device() Device {
+	return s.device
+}
+
 //Sensor generic sensor interface
 type Sensor interface {
 	GetName() string
@@ -256,7 +351,7 @@ type Sensor interface {
 
 func newDeviceInfo(tag *SensorTag) (SensorTagDeviceInfo, error) {
 
-	dev := tag.Device1
+	dev := tag.device
 
 	DeviceFirmwareUUID := getDeviceInfoUUID("FIRMWARE_REVISION_UUID")
 	DeviceHardwareUUID := getDeviceInfoUUID("HARDWARE_REVISION_UUID")
@@ -265,7 +360,7 @@ func newDeviceInfo(tag *SensorTag) (SensorTagDeviceInfo, error) {
 
 	loadChars := func() (SensorTagDeviceInfo, error) {
 
-		firmwareInfo, err := dev.GetCharByUUID(DeviceFirmwareUUID)
+		firmwareInfo, err := dev.GetChar(DeviceFirmwareUUID)
 		if err != nil {
 			return SensorTagDeviceInfo{}, err
 		}
@@ -273,7 +368,7 @@ func newDeviceInfo(tag *SensorTag) (SensorTagDeviceInfo, error) {
 			return SensorTagDeviceInfo{}, errors.New("Cannot find DeviceFirmwareUUID characteristic " + DeviceFirmwareUUID)
 		}
 
-		hardwareInfo, err := dev.GetCharByUUID(DeviceHardwareUUID)
+		hardwareInfo, err := dev.GetChar(DeviceHardwareUUID)
 		if err != nil {
 			return SensorTagDeviceInfo{}, err
 		}
@@ -281,7 +376,7 @@ func newDeviceInfo(tag *SensorTag) (SensorTagDeviceInfo, error) {
 			return SensorTagDeviceInfo{}, errors.New("Cannot find DeviceHardwareUUID characteristic " + DeviceHardwareUUID)
 		}
 
-		manufacturerInfo, err := dev.GetCharByUUID(DeviceManufacturerUUID)
+		manufacturerInfo, err := dev.GetChar(DeviceManufacturerUUID)
 		if err != nil {
 			return SensorTagDeviceInfo{}, err
 		}
@@ -289,7 +384,7 @@ func newDeviceInfo(tag *SensorTag) (SensorTagDeviceInfo, error) {
 			return SensorTagDeviceInfo{}, errors.New("Cannot find DeviceManufacturerUUID characteristic " + DeviceManufacturerUUID)
 		}
 
-		modelInfo, err := dev.GetCharByUUID(DeviceModelUUID)
+		modelInfo, err := dev.GetChar(DeviceModelUUID)
 		if err != nil {
 			return SensorTagDeviceInfo{}, err
 		}
@@ -306,32 +401,32 @@ func newDeviceInfo(tag *SensorTag) (SensorTagDeviceInfo, error) {
 //SensorTagDeviceInfo sensorTag structure
 type SensorTagDeviceInfo struct {
 	tag              *SensorTag
-	firmwareInfo     *gatt.GattCharacteristic1
-	hardwareInfo     *gatt.GattCharacteristic1
-	manufacturerInfo *gatt.GattCharacteristic1
-	modelInfo        *gatt.GattCharacteristic1
+	firmwareInfo     gatt.GattCharacteristic1API
+	hardwareInfo     gatt.GattCharacteristic1API
+	manufacturerInfo gatt.GattCharacteristic1API
+	modelInfo        gatt.GattCharacteristic1API
 }
 
 //Read device info from sensorTag
 func (s *SensorTagDeviceInfo) Read() (*SensorTagDataEvent, error) {
 
 	options1 := getOptions()
-	fw, err := s.firmwareInfo.ReadValue(options1)
+	fw, err := s.tag.readValue(s.firmwareInfo, options1)
 	if err != nil {
 		return nil, err
 	}
 	options2 := getOptions()
-	hw, err := s.hardwareInfo.ReadValue(options2)
+	hw, err := s.tag.readValue(s.hardwareInfo, options2)
 	if err != nil {
 		return nil, err
 	}
 	options3 := getOptions()
-	manufacturer, err := s.manufacturerInfo.ReadValue(options3)
+	manufacturer, err := s.tag.readValue(s.manufacturerInfo, options3)
 	if err != nil {
 		return nil, err
 	}
 	options4 := getOptions()
-	model, err := s.modelInfo.ReadValue(options4)
+	model, err := s.tag.readValue(s.modelInfo, options4)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,117 @@
+package sensortag
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/muka/go-bluetooth/bluez/profile/device"
+	"github.com/muka/go-bluetooth/bluez/profile/gatt"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDevicePath = dbus.ObjectPath("/org/bluez/hci0/dev_00_11_22_33_44_55")
+
+// fakeDevice is a Device of fake characteristics, running the operations in order
+type fakeDevice struct {
+	*device.FakeDevice1
+	lock  sync.Mutex
+	chars map[string]*gatt.FakeGattCharacteristic1
+	ops   []string
+}
+
+func newFakeDevice(connected bool) *fakeDevice {
+	return &fakeDevice{
+		FakeDevice1: device.NewFakeDevice1(testDevicePath, &device.Device1Properties{
+			Address:   "00:11:22:33:44:55",
+			Connected: connected,
+		}),
+		chars: make(map[string]*gatt.FakeGattCharacteristic1),
+	}
+}
+
+// char return the characteristic with uuid, created if missing
+func (d *fakeDevice) char(uuid string) *gatt.FakeGattCharacteristic1 {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	uuid = strings.ToUpper(uuid)
+	char, ok := d.chars[uuid]
+	if !ok {
+		path := testDevicePath + dbus.ObjectPath("/char"+strings.ToLower(uuid[4:8]))
+		char = gatt.NewFakeGattCharacteristic1(path, &gatt.GattCharacteristic1Properties{
+			UUID: uuid,
+		})
+		d.chars[uuid] = char
+	}
+	return char
+}
+
+func (d *fakeDevice) GetChar(uuid string) (gatt.GattCharacteristic1API, error) {
+	return d.char(uuid), nil
+}
+
+func (d *fakeDevice) Do(ctx context.Context, op device.Operation) error {
+	d.lock.Lock()
+	d.ops = append(d.ops, op.Name)
+	d.lock.Unlock()
+	return op.Run(ctx)
+}
+
+func TestNewSensorTagAPI(t *testing.T) {
+
+	dev := newFakeDevice(false)
+	dev.ConnectFunc = func(ctx context.Context) error {
+		return dev.SetProperty("Connected", true)
+	}
+
+	tag, err := NewSensorTagAPI(dev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, tag.Device1)
+	assert.Equal(t, dev, tag.Device())
+	assert.Equal(t, "Connect", dev.ops[0])
+
+	connected, err := dev.GetConnected()
+	assert.NoError(t, err)
+	assert.True(t, connected)
+}
+
+func TestSensorTagTemperatureRead(t *testing.T) {
+
+	dev := newFakeDevice(true)
+
+	tag, err := NewSensorTagAPI(dev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(t, dev.ops, "Connect")
+
+	cfgUUID, err := getUUID("TemperatureConfig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataUUID, err := getUUID("TemperatureData")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var written []byte
+	dev.char(cfgUUID).WriteValueFunc = func(ctx context.Context, value []byte, options map[string]interface{}) error {
+		written = value
+		return nil
+	}
+	// ambient temperature of 25C in the last two bytes
+	dev.char(dataUUID).ReadValueFunc = func(ctx context.Context, options map[string]interface{}) ([]byte, error) {
+		return []byte{0, 0, 0x80, 0x0c}, nil
+	}
+
+	dev.ops = nil
+	value, err := tag.Temperature.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, 25.0, value)
+	assert.Equal(t, []byte{1}, written)
+	assert.Equal(t, []string{"ReadValue", "WriteValue", "ReadValue"}, dev.ops)
+}
@@ -11,7 +11,7 @@ import (
 //.....getting config,data,period characteristics for TEMPERATURE sensor............
 func newTemperatureSensor(tag *SensorTag) (*TemperatureSensor, error) {
 
-	dev := tag.Device1
+	dev := tag.device
 
 	TemperatureConfigUUID, err := getUUID("TemperatureConfig")
 	if err != nil {
@@ -26,45 +26,47 @@ func newTemperatureSensor(tag *SensorTag) (*TemperatureSensor, error) {
 		return nil, err
 	}
 
-	i, err := retryCall(DefaultRetry, DefaultRetryWait, func() (interface{}, error) {
+	var sensor *TemperatureSensor
+	err = tag.loadChars("TemperatureSensor", func() error {
 
-		cfg, err := dev.GetCharByUUID(TemperatureConfigUUID)
+		cfg, err := dev.GetChar(TemperatureConfigUUID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		data, err := dev.GetCharByUUID(TemperatureDataUUID)
+		data, err := dev.GetChar(TemperatureDataUUID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if data == nil {
-			return nil, fmt.Errorf("Cannot find TemperatureData characteristic %s", TemperatureDataUUID)
+			return fmt.Errorf("Cannot find TemperatureData characteristic %s", TemperatureDataUUID)
 		}
 
-		period, err := dev.GetCharByUUID(TemperaturePeriodUUID)
+		period, err := dev.GetChar(TemperaturePeriodUUID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if period == nil {
-			return nil, fmt.Errorf("Cannot find TemperaturePeriod characteristic %s", TemperaturePeriodUUID)
+			return fmt.Errorf("Cannot find TemperaturePeriod characteristic %s", TemperaturePeriodUUID)
 		}
 
-		return &TemperatureSensor{tag, cfg, data, period}, err
+		sensor = &TemperatureSensor{tag, cfg, data, period}
+		return nil
 	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	return i.(*TemperatureSensor), nil
+	return sensor, nil
 }
 
 //TemperatureSensor the temperature sensor structure
 type TemperatureSensor struct {
 	tag    *SensorTag
-	cfg    *gatt.GattCharacteristic1
-	data   *gatt.GattCharacteristic1
-	period *gatt.GattCharacteristic1
+	cfg    gatt.GattCharacteristic1API
+	data   gatt.GattCharacteristic1API
+	period gatt.GattCharacteristic1API
 }
 
 // GetName return the sensor name
@@ -82,7 +84,7 @@ func (s *TemperatureSensor) Enable() error {
 		return nil
 	}
 	options := getOptions()
-	err = s.cfg.WriteValue([]byte{1}, options)
+	err = s.tag.writeValue(s.cfg, []byte{1}, options)
 	return err
 }
 
@@ -96,7 +98,7 @@ func (s *TemperatureSensor) Disable() error {
 		return nil
 	}
 	options := getOptions()
-	err = s.cfg.WriteValue([]byte{0}, options)
+	err = s.tag.writeValue(s.cfg, []byte{0}, options)
 	if err != nil {
 		return err
 	}
@@ -107,7 +109,7 @@ func (s *TemperatureSensor) Disable() error {
 func (s *TemperatureSensor) IsEnabled() (bool, error) {
 
 	options := make(map[string]interface{})
-	val, err := s.cfg.ReadValue(options)
+	val, err := s.tag.readValue(s.cfg, options)
 	if err != nil {
 		return false, err
 	}
@@ -140,7 +142,7 @@ func (s *TemperatureSensor) Read() (float64, error) {
 	}
 
 	options := getOptions()
-	b, err := s.data.ReadValue(options)
+	b, err := s.tag.readValue(s.data, options)
 
 	if err != nil {
 		return 0, err
@@ -189,7 +191,7 @@ func (s *TemperatureSensor) StartNotify() error {
 				AmbientTempUnit:  "C",
 				ObjectTempValue:  dieValue,
 				ObjectTempUnit:   "C",
-				SensorID:         s.tag.Properties.Address,
+				SensorID:         s.tag.address,
 			}
 
 			s.tag.Data() <- &dataEvent
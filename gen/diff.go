@@ -0,0 +1,455 @@
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/muka/go-bluetooth/gen/types"
+)
+
+// ChangeType describe how an item changed between two BlueZ versions
+type ChangeType string
+
+const (
+	Added   ChangeType = "added"
+	Removed ChangeType = "removed"
+	Changed ChangeType = "changed"
+)
+
+// ApiDiff list the changes of the D-Bus API between two BlueZ versions
+type ApiDiff struct {
+	From       string
+	To         string
+	Interfaces []InterfaceDiff
+}
+
+// InterfaceDiff is an interface added, removed or with changed members
+type InterfaceDiff struct {
+	Interface  string
+	Change     ChangeType
+	Methods    []MemberDiff `json:",omitempty"`
+	Signals    []MemberDiff `json:",omitempty"`
+	Properties []MemberDiff `json:",omitempty"`
+}
+
+// MemberDiff is a method, signal or property added, removed or changed
+type MemberDiff struct {
+	Name   string
+	Change ChangeType
+	// From is the signature in the older version, empty if added
+	From string `json:",omitempty"`
+	// To is the signature in the newer version, empty if removed
+	To string `json:",omitempty"`
+	// Details describe a change, eg. argument added: dict options
+	Details []string `json:",omitempty"`
+}
+
+// Diff compare the API of two BlueZ versions
+func Diff(from *BluezAPI, to *BluezAPI) ApiDiff {
+
+	diff := ApiDiff{
+		From:       from.Version,
+		To:         to.Version,
+		Interfaces: []InterfaceDiff{},
+	}
+
+	fromApis := indexApis(from)
+	toApis := indexApis(to)
+
+	for _, iface := range sortedKeys(fromApis, toApis) {
+
+		a1, inFrom := fromApis[iface]
+		a2, inTo := toApis[iface]
+
+		if !inFrom {
+			diff.Interfaces = append(diff.Interfaces, InterfaceDiff{Interface: iface, Change: Added})
+			continue
+		}
+		if !inTo {
+			diff.Interfaces = append(diff.Interfaces, InterfaceDiff{Interface: iface, Change: Removed})
+			continue
+		}
+
+		ifaceDiff := InterfaceDiff{
+			Interface:  iface,
+			Change:     Changed,
+			Methods:    diffMethods(a1.Methods, a2.Methods),
+			Signals:    diffMethods(a1.Signals, a2.Signals),
+			Properties: diffProperties(a1.Properties, a2.Properties),
+		}
+		if len(ifaceDiff.Methods)+len(ifaceDiff.Signals)+len(ifaceDiff.Properties) > 0 {
+			diff.Interfaces = append(diff.Interfaces, ifaceDiff)
+		}
+	}
+
+	return diff
+}
+
+// JSON return the diff serialized as JSON
+func (d *ApiDiff) JSON() ([]byte, error) {
+	return json.MarshalIndent(d, "", "  ")
+}
+
+// Markdown return the diff as a Markdown report
+func (d *ApiDiff) Markdown() string {
+
+	b := new(strings.Builder)
+	fmt.Fprintf(b, "# BlueZ D-Bus API changes from %s to %s\n", d.From, d.To)
+
+	if len(d.Interfaces) == 0 {
+		b.WriteString("\nNo changes.\n")
+		return b.String()
+	}
+
+	for _, change := range []ChangeType{Added, Removed} {
+		list := []string{}
+		for _, iface := range d.Interfaces {
+			if iface.Change == change {
+				list = append(list, fmt.Sprintf("- `%s`\n", iface.Interface))
+			}
+		}
+		if len(list) > 0 {
+			fmt.Fprintf(b, "\n## %s interfaces\n\n%s", strings.Title(string(change)), strings.Join(list, ""))
+		}
+	}
+
+	header := false
+	for _, iface := range d.Interfaces {
+		if iface.Change != Changed {
+			continue
+		}
+		if !header {
+			b.WriteString("\n## Changed interfaces\n")
+			header = true
+		}
+		fmt.Fprintf(b, "\n### %s\n", iface.Interface)
+		writeMembersMarkdown(b, "Methods", iface.Methods)
+		writeMembersMarkdown(b, "Signals", iface.Signals)
+		writeMembersMarkdown(b, "Properties", iface.Properties)
+	}
+
+	return b.String()
+}
+
+func writeMembersMarkdown(b *strings.Builder, title string, members []MemberDiff) {
+	if len(members) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n%s:\n\n", title)
+	for _, m := range members {
+		switch m.Change {
+		case Added:
+			fmt.Fprintf(b, "- added `%s`\n", m.To)
+		case Removed:
+			fmt.Fprintf(b, "- removed `%s`\n", m.From)
+		case Changed:
+			fmt.Fprintf(b, "- changed `%s`: %s\n", m.Name, strings.Join(m.Details, ", "))
+		}
+	}
+}
+
+// AnnotateSince set the version introducing each interface, method, signal
+// and property of api, looking for them in history. The items of the oldest
+// version of history are not annotated as their origin is unknown, nor the
+// members introduced with their interface.
+func AnnotateSince(api *BluezAPI, history ...*BluezAPI) {
+
+	history = append([]*BluezAPI{}, history...)
+	sort.SliceStable(history, func(i, j int) bool {
+		return CompareVersions(history[i].Version, history[j].Version) < 0
+	})
+	if len(history) == 0 {
+		return
+	}
+
+	indexes := make([]map[string]*types.Api, len(history))
+	for i, h := range history {
+		indexes[i] = indexApis(h)
+	}
+
+	// since return the first version with the item, empty if it is in the oldest
+	since := func(iface string, has func(*types.Api) bool) string {
+		for i, index := range indexes {
+			if a, ok := index[iface]; ok && has(a) {
+				if i == 0 {
+					return ""
+				}
+				return history[i].Version
+			}
+		}
+		return ""
+	}
+
+	for _, group := range api.Api {
+		if group == nil {
+			continue
+		}
+		for _, a := range group.Api {
+			if a == nil {
+				continue
+			}
+			a.Since = since(a.Interface, func(*types.Api) bool { return true })
+
+			for _, m := range a.Methods {
+				name := methodName(m)
+				m.Since = memberSince(a.Since, since(a.Interface, func(a1 *types.Api) bool {
+					return findMethod(a1.Methods, name) != nil
+				}))
+			}
+			for _, s := range a.Signals {
+				name := methodName(s)
+				s.Since = memberSince(a.Since, since(a.Interface, func(a1 *types.Api) bool {
+					return findMethod(a1.Signals, name) != nil
+				}))
+			}
+			for _, p := range a.Properties {
+				name := propertyName(p)
+				p.Since = memberSince(a.Since, since(a.Interface, func(a1 *types.Api) bool {
+					return findProperty(a1.Properties, name) != nil
+				}))
+			}
+		}
+	}
+}
+
+// memberSince omit the version of a member introduced with its interface
+func memberSince(ifaceSince string, since string) string {
+	if since == ifaceSince {
+		return ""
+	}
+	return since
+}
+
+// CompareVersions compare two BlueZ versions, eg. 5.50 and 5.9, returning -1,
+// 0 or 1 as a is older, the same or newer than b
+func CompareVersions(a string, b string) int {
+	p1 := strings.Split(a, ".")
+	p2 := strings.Split(b, ".")
+	for i := 0; i < len(p1) || i < len(p2); i++ {
+		n1, n2 := 0, 0
+		if i < len(p1) {
+			n1, _ = strconv.Atoi(p1[i])
+		}
+		if i < len(p2) {
+			n2, _ = strconv.Atoi(p2[i])
+		}
+		if n1 != n2 {
+			if n1 < n2 {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func diffMethods(from []*types.Method, to []*types.Method) []MemberDiff {
+
+	var diffs []MemberDiff
+
+	for _, m1 := range from {
+		name := methodName(m1)
+		m2 := findMethod(to, name)
+		if m2 == nil {
+			diffs = append(diffs, MemberDiff{Name: name, Change: Removed, From: methodSignature(m1)})
+			continue
+		}
+
+		details := []string{}
+		if m1.ReturnType != m2.ReturnType {
+			details = append(details, fmt.Sprintf("return type changed from `%s` to `%s`", m1.ReturnType, m2.ReturnType))
+		}
+		for _, a1 := range m1.Args {
+			a2 := findArg(m2.Args, a1.Name)
+			if a2 == nil {
+				details = append(details, fmt.Sprintf("argument removed: `%s`", a1.String()))
+			} else if a1.Type != a2.Type {
+				details = append(details, fmt.Sprintf("argument `%s` type changed from `%s` to `%s`", a1.Name, a1.Type, a2.Type))
+			}
+		}
+		for _, a2 := range m2.Args {
+			if findArg(m1.Args, a2.Name) == nil {
+				details = append(details, fmt.Sprintf("argument added: `%s`", a2.String()))
+			}
+		}
+
+		if len(details) > 0 {
+			diffs = append(diffs, MemberDiff{
+				Name:    name,
+				Change:  Changed,
+				From:    methodSignature(m1),
+				To:      methodSignature(m2),
+				Details: details,
+			})
+		}
+	}
+
+	for _, m2 := range to {
+		name := methodName(m2)
+		if findMethod(from, name) == nil {
+			diffs = append(diffs, MemberDiff{Name: name, Change: Added, To: methodSignature(m2)})
+		}
+	}
+
+	return diffs
+}
+
+func diffProperties(from []*types.Property, to []*types.Property) []MemberDiff {
+
+	var diffs []MemberDiff
+
+	for _, p1 := range from {
+		name := propertyName(p1)
+		p2 := findProperty(to, name)
+		if p2 == nil {
+			diffs = append(diffs, MemberDiff{Name: name, Change: Removed, From: propertySignature(p1)})
+			continue
+		}
+
+		details := []string{}
+		if p1.Type != p2.Type {
+			details = append(details, fmt.Sprintf("type changed from `%s` to `%s`", p1.Type, p2.Type))
+		}
+		if access1, access2 := propertyAccess(p1), propertyAccess(p2); access1 != access2 {
+			details = append(details, fmt.Sprintf("access changed from %s to %s", access1, access2))
+		}
+		if e1, e2 := hasFlag(p1, types.FlagExperimental), hasFlag(p2, types.FlagExperimental); e1 != e2 {
+			if e2 {
+				details = append(details, "now experimental")
+			} else {
+				details = append(details, "no longer experimental")
+			}
+		}
+		if o1, o2 := hasFlag(p1, types.FlagOptional), hasFlag(p2, types.FlagOptional); o1 != o2 {
+			if o2 {
+				details = append(details, "now optional")
+			} else {
+				details = append(details, "no longer optional")
+			}
+		}
+
+		if len(details) > 0 {
+			diffs = append(diffs, MemberDiff{
+				Name:    name,
+				Change:  Changed,
+				From:    propertySignature(p1),
+				To:      propertySignature(p2),
+				Details: details,
+			})
+		}
+	}
+
+	for _, p2 := range to {
+		name := propertyName(p2)
+		if findProperty(from, name) == nil {
+			diffs = append(diffs, MemberDiff{Name: name, Change: Added, To: propertySignature(p2)})
+		}
+	}
+
+	return diffs
+}
+
+// indexApis map the interfaces of a version by name
+func indexApis(api *BluezAPI) map[string]*types.Api {
+	index := make(map[string]*types.Api)
+	for _, group := range api.Api {
+		if group == nil {
+			continue
+		}
+		for _, a := range group.Api {
+			if a != nil {
+				index[strings.Trim(a.Interface, " \t")] = a
+			}
+		}
+	}
+	return index
+}
+
+func sortedKeys(maps ...map[string]*types.Api) []string {
+	keys := []string{}
+	seen := make(map[string]bool)
+	for _, m := range maps {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// methodName return the name of a method as generated, eg. without (optional)
+func methodName(m *types.Method) string {
+	return strings.Trim(strings.Replace(m.Name, " (optional)", "", -1), " \t")
+}
+
+// propertyName return the name of a property as generated
+func propertyName(p *types.Property) string {
+	return strings.Trim(p.Name, ": \t")
+}
+
+func methodSignature(m *types.Method) string {
+	method := *m
+	method.Name = methodName(m)
+	return method.String()
+}
+
+func propertySignature(p *types.Property) string {
+	property := *p
+	property.Name = propertyName(p)
+	return strings.Trim(property.String(), " ")
+}
+
+func findMethod(methods []*types.Method, name string) *types.Method {
+	for _, m := range methods {
+		if methodName(m) == name {
+			return m
+		}
+	}
+	return nil
+}
+
+func findProperty(properties []*types.Property, name string) *types.Property {
+	for _, p := range properties {
+		if propertyName(p) == name {
+			return p
+		}
+	}
+	return nil
+}
+
+func findArg(args []types.Arg, name string) *types.Arg {
+	for i := range args {
+		if args[i].Name == name {
+			return &args[i]
+		}
+	}
+	return nil
+}
+
+func hasFlag(p *types.Property, flag types.Flag) bool {
+	for _, f := range p.Flags {
+		if f == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// propertyAccess describe the access flags of a property
+func propertyAccess(p *types.Property) string {
+	switch {
+	case hasFlag(p, types.FlagReadWrite):
+		return "readwrite"
+	case hasFlag(p, types.FlagWriteOnly):
+		return "writeonly"
+	case hasFlag(p, types.FlagReadOnly):
+		return "readonly"
+	}
+	return "unspecified"
+}
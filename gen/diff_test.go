@@ -0,0 +1,161 @@
+package gen
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/muka/go-bluetooth/gen/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func loadDiffFixtures(t *testing.T) (*BluezAPI, *BluezAPI) {
+	from, err := LoadJSON("../bluez-5.50.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	to, err := LoadJSON("../bluez-5.54.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return from, to
+}
+
+func findInterfaceDiff(diff ApiDiff, iface string) *InterfaceDiff {
+	for i := range diff.Interfaces {
+		if diff.Interfaces[i].Interface == iface {
+			return &diff.Interfaces[i]
+		}
+	}
+	return nil
+}
+
+func TestDiff(t *testing.T) {
+
+	from, to := loadDiffFixtures(t)
+	diff := Diff(from, to)
+
+	assert.Equal(t, "5.50", diff.From)
+	assert.Equal(t, "5.54", diff.To)
+
+	node := findInterfaceDiff(diff, "org.bluez.mesh.Node1")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, Added, node.Change)
+	}
+
+	media := findInterfaceDiff(diff, "org.bluez.Media1")
+	if assert.NotNil(t, media) {
+		assert.Equal(t, Changed, media.Change)
+		assert.Contains(t, media.Methods, MemberDiff{
+			Name:   "RegisterApplication",
+			Change: Added,
+			To:     "void RegisterApplication(object root, dict options)",
+		})
+	}
+
+	char := findInterfaceDiff(diff, "org.bluez.GattCharacteristic1")
+	if assert.NotNil(t, char) && assert.Len(t, char.Properties, 1) {
+		assert.Equal(t, "Handle", char.Properties[0].Name)
+		assert.Equal(t, Added, char.Properties[0].Change)
+	}
+
+	// unchanged interfaces are not listed
+	assert.Nil(t, findInterfaceDiff(diff, "org.bluez.Device1"))
+
+	md := diff.Markdown()
+	assert.Contains(t, md, "- `org.bluez.mesh.Node1`")
+	assert.Contains(t, md, "### org.bluez.Media1")
+
+	data, err := diff.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff1 := ApiDiff{}
+	err = json.Unmarshal(data, &diff1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, diff, diff1)
+}
+
+func TestDiffChanged(t *testing.T) {
+
+	newApi := func(method *types.Method, property *types.Property) *BluezAPI {
+		return &BluezAPI{
+			Api: []*types.ApiGroup{{
+				Api: []*types.Api{{
+					Interface:  "org.bluez.Test1",
+					Methods:    []*types.Method{method},
+					Properties: []*types.Property{property},
+				}},
+			}},
+		}
+	}
+
+	from := newApi(
+		&types.Method{Name: "Call", ReturnType: "void", Args: []types.Arg{{Type: "string", Name: "name"}}},
+		&types.Property{Name: "Level", Type: "byte", Flags: []types.Flag{types.FlagReadOnly}},
+	)
+	to := newApi(
+		&types.Method{Name: "Call (optional)", ReturnType: "void", Args: []types.Arg{{Type: "object", Name: "name"}, {Type: "dict", Name: "options"}}},
+		&types.Property{Name: "Level", Type: "byte", Flags: []types.Flag{types.FlagReadWrite, types.FlagExperimental}},
+	)
+
+	diff := Diff(from, to)
+	if !assert.Len(t, diff.Interfaces, 1) {
+		return
+	}
+	iface := diff.Interfaces[0]
+
+	if assert.Len(t, iface.Methods, 1) {
+		assert.Equal(t, Changed, iface.Methods[0].Change)
+		assert.Equal(t, []string{
+			"argument `name` type changed from `string` to `object`",
+			"argument added: `dict options`",
+		}, iface.Methods[0].Details)
+	}
+	if assert.Len(t, iface.Properties, 1) {
+		assert.Equal(t, []string{
+			"access changed from readonly to readwrite",
+			"now experimental",
+		}, iface.Properties[0].Details)
+	}
+
+	assert.True(t, strings.Contains(diff.Markdown(), "- changed `Level`: access changed from readonly to readwrite, now experimental"))
+}
+
+func TestAnnotateSince(t *testing.T) {
+
+	from, to := loadDiffFixtures(t)
+	api, err := LoadJSON("../bluez-5.54.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// history order does not matter
+	AnnotateSince(api, to, from)
+
+	apis := indexApis(api)
+
+	assert.Equal(t, "5.54", apis["org.bluez.mesh.Node1"].Since)
+	// members of a new interface are not annotated
+	for _, m := range apis["org.bluez.mesh.Node1"].Methods {
+		assert.Empty(t, m.Since)
+	}
+
+	media := apis["org.bluez.Media1"]
+	assert.Empty(t, media.Since)
+	assert.Equal(t, "5.54", findMethod(media.Methods, "RegisterApplication").Since)
+	assert.Empty(t, findMethod(media.Methods, "RegisterEndpoint").Since)
+
+	char := apis["org.bluez.GattCharacteristic1"]
+	assert.Equal(t, "5.54", findProperty(char.Properties, "Handle").Since)
+	assert.Empty(t, findProperty(char.Properties, "UUID").Since)
+}
+
+func TestCompareVersions(t *testing.T) {
+	assert.Equal(t, -1, CompareVersions("5.9", "5.50"))
+	assert.Equal(t, 0, CompareVersions("5.54", "5.54"))
+	assert.Equal(t, 1, CompareVersions("5.54", "5.53"))
+	assert.Equal(t, 1, CompareVersions("5.54.1", "5.54"))
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/muka/go-bluetooth/gen"
+	"github.com/muka/go-bluetooth/gen/types"
 	"github.com/muka/go-bluetooth/gen/util"
 	log "github.com/sirupsen/logrus"
 )
@@ -44,12 +45,6 @@ func Generate(bluezApi gen.BluezAPI, outDir string, debug bool, forceOverwrite b
 		}
 	}
 
-	// filename = filepath.Join(outDir, "interfaces.go")
-	// err = InterfacesTemplate(filename, apiGroups)
-	// if err != nil {
-	// 	return err
-	// }
-
 	for _, apiGroup := range apiGroups {
 
 		if apiGroup == nil {
@@ -87,25 +82,47 @@ func Generate(bluezApi gen.BluezAPI, outDir string, debug bool, forceOverwrite b
 			apiBaseName := pts[len(pts)-1]
 
 			apiFilename := path.Join(dirpath, fmt.Sprintf("%s.go", apiBaseName))
-			apiGenFilename := path.Join(dirpath, fmt.Sprintf("gen_%s.go", apiBaseName))
-
-			if util.Exists(apiFilename) {
-				// log.Debugf("Skipped generation, API file exists: %s", apiFilename)
-				continue
-			}
-
-			if !forceOverwrite && util.Exists(apiGenFilename) {
-				// log.Debugf("Skipped, file exists: %s", apiGenFilename)
-				continue
+			customApi := util.Exists(apiFilename)
+
+			templates := []struct {
+				filename string
+				write    func(string, *types.Api, *types.ApiGroup) error
+				// standalone files are generated along a custom API file
+				standalone bool
+			}{
+				{fmt.Sprintf("gen_%s.go", apiBaseName), ApiTemplate, false},
+				// interface and in-memory fake, to test the code using the API without dbus
+				{fmt.Sprintf("gen_%sAPI.go", apiBaseName), InterfacesTemplate, false},
+				{fmt.Sprintf("gen_Fake%s.go", apiBaseName), FakeTemplate, false},
+				// typed options of the dict arguments, if documented
+				{fmt.Sprintf("gen_%sOptions.go", apiBaseName), OptionsTemplate, false},
+				// typed constants of the documented values, if any
+				{fmt.Sprintf("gen_%sValues.go", apiBaseName), ValuesTemplate, true},
+				// handler and exporter of the interfaces implemented by the applications
+				{fmt.Sprintf("gen_%sServer.go", apiBaseName), ServerTemplate, false},
 			}
 
-			err1 := ApiTemplate(apiGenFilename, api, apiGroup)
-			if err1 != nil {
-				log.Errorf("Api generation failed %s: %s", api.Title, err1)
-				return err1
-			}
-			if debug {
-				log.Tracef("Wrote %s", apiGenFilename)
+			for _, tpl := range templates {
+
+				if customApi && !tpl.standalone {
+					// log.Debugf("Skipped generation, API file exists: %s", apiFilename)
+					continue
+				}
+
+				filename := path.Join(dirpath, tpl.filename)
+				if !forceOverwrite && util.Exists(filename) {
+					// log.Debugf("Skipped, file exists: %s", filename)
+					continue
+				}
+
+				err1 := tpl.write(filename, api, apiGroup)
+				if err1 != nil {
+					log.Errorf("Api generation failed %s: %s", api.Title, err1)
+					return err1
+				}
+				if debug {
+					log.Tracef("Wrote %s", filename)
+				}
 			}
 		}
 	}
@@ -2,6 +2,9 @@ package generator
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
 	"testing"
 
 	"github.com/muka/go-bluetooth/gen"
@@ -38,3 +41,43 @@ func TestGenerate(t *testing.T) {
 	assert.DirExists(t, fmt.Sprintf("%s/profile/adapter", outdir))
 
 }
+
+func TestGenerateInterfaces(t *testing.T) {
+
+	TplPath = "../../gen/generator/tpl/%s.go.tpl"
+
+	bluezApi, err := gen.LoadJSON("../../bluez-5.53.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outdir, err := ioutil.TempDir("", "go-bluetooth-gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(outdir)
+
+	err = Generate(*bluezApi, outdir, false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := path.Join(outdir, "profile", "device")
+
+	iface, err := ioutil.ReadFile(path.Join(dir, "gen_Device1API.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, string(iface), "type Device1API interface {")
+	assert.Contains(t, string(iface), "ConnectContext(ctx context.Context) error")
+	assert.Contains(t, string(iface), "GetRSSI() (int16, error)")
+	assert.Contains(t, string(iface), "var _ Device1API = (*Device1)(nil)")
+
+	fake, err := ioutil.ReadFile(path.Join(dir, "gen_FakeDevice1.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, string(fake), "type FakeDevice1 struct {")
+	assert.Contains(t, string(fake), "ConnectFunc func(ctx context.Context) error")
+	assert.Contains(t, string(fake), "var _ Device1API = (*FakeDevice1)(nil)")
+}
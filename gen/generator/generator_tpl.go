@@ -78,47 +78,3 @@ func ErrorsTemplate(filename string, apis []*types.ApiGroup) error {
 	// log.Debugf("Created %s", filename)
 	return nil
 }
-
-func InterfacesTemplate(filename string, apis []types.ApiGroup) error {
-
-	fw, err := os.Create(filename)
-	if err != nil {
-		return fmt.Errorf("create file: %s", err)
-	}
-
-	interfaces := []types.InterfaceDoc{}
-	for _, apiGroup := range apis {
-		for _, api := range apiGroup.Api {
-
-			pts := strings.Split(api.Interface, ".")
-			ifaceName := pts[len(pts)-1]
-			// org.bluez.obex.AgentManager1
-			if len(pts) > 3 {
-				ifaceName = ""
-				for _, pt := range pts[2:] {
-					ifaceName += strings.ToUpper(string(pt[0])) + pt[1:]
-				}
-			}
-
-			iface := types.InterfaceDoc{
-				Title:     api.Title,
-				Name:      ifaceName,
-				Interface: api.Interface,
-			}
-			interfaces = append(interfaces, iface)
-		}
-	}
-
-	ifaces := types.InterfacesDoc{
-		Interfaces: interfaces,
-	}
-
-	tmpl := loadtpl("interfaces")
-	err = tmpl.Execute(fw, ifaces)
-	if err != nil {
-		return fmt.Errorf("tpl write: %s", err)
-	}
-
-	// log.Debugf("Created %s", filename)
-	return nil
-}
@@ -3,6 +3,7 @@ package generator
 import (
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/muka/go-bluetooth/gen/override"
@@ -11,15 +12,120 @@ import (
 )
 
 func ApiTemplate(filename string, api *types.Api, apiGroup *types.ApiGroup) error {
+	apidocs, imports := newApiDoc(api, apiGroup)
+	apidocs.Imports = formatImports(imports)
+	return executeApiTpl(filename, "api", apidocs)
+}
+
+// InterfacesTemplate write the <Interface>API interface implemented by the
+// generated struct and its fake
+func InterfacesTemplate(filename string, api *types.Api, apiGroup *types.ApiGroup) error {
+
+	apidocs, _ := newApiDoc(api, apiGroup)
+
+	imports := []string{}
+	if usesContext(apidocs) {
+		imports = append(imports, "context")
+	}
+	if apidocs.ExposeProperties {
+		imports = append(imports, "github.com/muka/go-bluetooth/bluez")
+	}
+	imports = append(imports, "github.com/godbus/dbus/v5")
+	apidocs.Imports = formatImports(imports)
+
+	return executeApiTpl(filename, "interfaces", apidocs)
+}
+
+// FakeTemplate write the in-memory Fake<Interface> implementing the
+// <Interface>API interface
+func FakeTemplate(filename string, api *types.Api, apiGroup *types.ApiGroup) error {
+
+	apidocs, _ := newApiDoc(api, apiGroup)
+
+	imports := []string{}
+	if usesContext(apidocs) {
+		imports = append(imports, "context")
+	}
+	for _, prop := range apidocs.Properties {
+		if !prop.WriteOnly {
+			imports = append(imports, "fmt")
+			break
+		}
+	}
+	imports = append(imports, "sync")
+	if apidocs.ExposeProperties {
+		imports = append(imports,
+			"github.com/muka/go-bluetooth/bluez",
+			"github.com/muka/go-bluetooth/props",
+		)
+	} else if hasEnumSetter(apidocs) {
+		imports = append(imports, "github.com/muka/go-bluetooth/bluez")
+	}
+	imports = append(imports, "github.com/godbus/dbus/v5")
+	apidocs.Imports = formatImports(imports)
+
+	return executeApiTpl(filename, "fake", apidocs)
+}
+
+// hasEnumSetter report if a setter checks the documented values, returning a
+// bluez.InvalidValueError
+func hasEnumSetter(apidocs types.ApiDoc) bool {
+	for _, prop := range apidocs.Properties {
+		if prop.Enum != "" && !prop.ReadOnly {
+			return true
+		}
+	}
+	return false
+}
+
+// usesContext report if the interface has methods with a context argument
+func usesContext(apidocs types.ApiDoc) bool {
+	return apidocs.ExposeProperties || len(apidocs.Properties) > 0 || len(apidocs.Methods) > 0
+}
+
+func executeApiTpl(filename string, name string, apidocs types.ApiDoc) error {
 
 	fw, err := os.Create(filename)
 	if err != nil {
 		return fmt.Errorf("create file: %s", err)
 	}
+	defer fw.Close()
+
+	tmpl := loadtpl(name)
+	err = tmpl.Execute(fw, apidocs)
+	if err != nil {
+		return fmt.Errorf("%s tpl: %s", name, err)
+	}
+
+	// log.Debugf("Created %s", filename)
+	return nil
+}
+
+// formatImports return the import block of a list of packages, optionally
+// prefixed by an alias, eg. "log github.com/sirupsen/logrus"
+func formatImports(imports []string) string {
+	if len(imports) == 0 {
+		return ""
+	}
+	for i := range imports {
+		pts := strings.Split(imports[i], " ")
+		if len(pts) == 1 {
+			pts = append(pts, pts[0])
+			pts[0] = ""
+		}
+		imports[i] = fmt.Sprintf(`%s "%s"`, pts[0], pts[1])
+	}
+	return fmt.Sprintf("import (\n  %s\n)", strings.Join(imports, "\n  "))
+}
+
+// newApiDoc prepare the template data of an API and return the packages
+// imported by its implementation. The parsed API is not modified.
+func newApiDoc(api *types.Api, apiGroup *types.ApiGroup) (types.ApiDoc, []string) {
 
 	apiName := getApiPackage(apiGroup)
 
 	imports := []string{
+		"context",
 		"sync",
 		"github.com/muka/go-bluetooth/bluez",
 	}
@@ -48,11 +154,14 @@ func ApiTemplate(filename string, api *types.Api, apiGroup *types.ApiGroup) erro
 	iface := pts[len(pts)-1]
 
 	propsList := map[string]*types.PropertyDoc{}
+	// keep properties in the documentation order to produce stable sources
+	propsOrder := []string{}
 
 	for _, p := range api.Properties {
 
+		property := *p
 		prop := types.PropertyDoc{
-			Property: p,
+			Property: &property,
 		}
 
 		prop.Name = strings.Trim(p.Name, ": \t")
@@ -63,12 +172,23 @@ func ApiTemplate(filename string, api *types.Api, apiGroup *types.ApiGroup) erro
 		// log.Debugf("RAWTYPE %s %s = %s", api.Interface, prop.Name, prop.Property.Type)
 
 		prop.RawTypeInitializer = getRawTypeInitializer(prop.Property.Type)
+		if _, ok := propsList[prop.Name]; !ok {
+			propsOrder = append(propsOrder, prop.Name)
+		}
 		propsList[prop.Name] = &prop
 	}
 
 	propertiesOverride, found := override.GetPropertiesOverride(api.Interface)
 	if found {
-		for propName, propType := range propertiesOverride {
+
+		overrideNames := []string{}
+		for propName := range propertiesOverride {
+			overrideNames = append(overrideNames, propName)
+		}
+		sort.Strings(overrideNames)
+
+		for _, propName := range overrideNames {
+			propType := propertiesOverride[propName]
 
 			var prop *types.PropertyDoc
 			if _, ok := propsList[propName]; ok {
@@ -87,6 +207,7 @@ func ApiTemplate(filename string, api *types.Api, apiGroup *types.ApiGroup) erro
 					RawTypeInitializer: getRawTypeInitializer(propType),
 				}
 				propsList[propName] = prop
+				propsOrder = append(propsOrder, propName)
 			}
 
 			if !importDbus {
@@ -97,7 +218,8 @@ func ApiTemplate(filename string, api *types.Api, apiGroup *types.ApiGroup) erro
 	}
 
 	props := []types.PropertyDoc{}
-	for _, prop := range propsList {
+	for _, propName := range propsOrder {
+		prop := propsList[propName]
 
 		// add propery flags
 		for _, flag := range prop.Flags {
@@ -115,9 +237,15 @@ func ApiTemplate(filename string, api *types.Api, apiGroup *types.ApiGroup) erro
 		props = append(props, *prop)
 	}
 
+	enums := newEnumsDoc(iface, api, props)
+
 	methods := []types.MethodDoc{}
+	options := []types.MethodOptionsDoc{}
 	for _, m := range api.Methods {
 
+		method := *m
+		m := &method
+
 		args := []string{}
 		params := []string{}
 		for _, a := range m.Args {
@@ -193,6 +321,11 @@ func ApiTemplate(filename string, api *types.Api, apiGroup *types.ApiGroup) erro
 			continue
 		}
 
+		mm.Options = newMethodOptionsDoc(iface, m)
+		if mm.Options != nil {
+			options = append(options, *mm.Options)
+		}
+
 		methods = append(methods, mm)
 	}
 
@@ -215,21 +348,7 @@ func ApiTemplate(filename string, api *types.Api, apiGroup *types.ApiGroup) erro
 		}
 	}
 
-	importsTpl := ""
-	if len(imports) > 0 {
-		for i := range imports {
-			pts := strings.Split(imports[i], " ")
-			if len(pts) == 1 {
-				pts = append(pts, pts[0])
-				pts[0] = ""
-			}
-			imports[i] = fmt.Sprintf(`%s "%s"`, pts[0], pts[1])
-		}
-		importsTpl = fmt.Sprintf("import (\n  %s\n)", strings.Join(imports, "\n  "))
-	}
-
 	apidocs := types.ApiDoc{
-		Imports:          importsTpl,
 		Package:          apiName,
 		Api:              api,
 		InterfaceName:    iface,
@@ -237,14 +356,9 @@ func ApiTemplate(filename string, api *types.Api, apiGroup *types.ApiGroup) erro
 		Methods:          methods,
 		Constructors:     ctrs,
 		ExposeProperties: exposeProps,
+		Options:          options,
+		Enums:            enums,
 	}
 
-	tmpl := loadtpl("api")
-	err = tmpl.Execute(fw, apidocs)
-	if err != nil {
-		return fmt.Errorf("api tpl: %s", err)
-	}
-
-	// log.Debugf("Created %s", filename)
-	return nil
+	return apidocs, imports
 }
@@ -26,8 +26,10 @@ func createConstructors(api *types.Api) []types.Constructor {
 	for i, c := range constructors {
 
 		args := []string{}
+		params := []string{}
 		if c.Service == "" {
 			args = append(args, "servicePath string")
+			params = append(params, "servicePath")
 			c.Service = "servicePath"
 		} else {
 			c.Service = fmt.Sprintf(`"%s"`, c.Service)
@@ -35,12 +37,14 @@ func createConstructors(api *types.Api) []types.Constructor {
 
 		if c.ObjectPath == "" {
 			args = append(args, "objectPath dbus.ObjectPath")
+			params = append(params, "objectPath")
 			c.ObjectPath = "objectPath"
 		} else {
 			c.ObjectPath = fmt.Sprintf(`"%s"`, c.ObjectPath)
 		}
 
 		c.Args = strings.Join(args, ", ")
+		c.Params = strings.Join(params, ", ")
 
 		docs := []string{}
 		for _, doc := range c.Docs {
@@ -70,6 +74,7 @@ func createConstructors(api *types.Api) []types.Constructor {
 
 					c := types.Constructor{
 						Args:       "adapterID string",
+						Params:     "adapterID",
 						ArgsDocs:   "// adapterID: ID of an adapter eg. hci0",
 						Docs:       c1.Docs,
 						ObjectPath: `fmt.Sprintf("/org/bluez/%s", adapterID)`,
@@ -0,0 +1,106 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/muka/go-bluetooth/gen/override"
+	"github.com/muka/go-bluetooth/gen/parser"
+	"github.com/muka/go-bluetooth/gen/types"
+)
+
+// OptionsTemplate write the typed options of the methods with a documented
+// dict argument, the file is not created if there are none
+func OptionsTemplate(filename string, api *types.Api, apiGroup *types.ApiGroup) error {
+
+	apidocs, _ := newApiDoc(api, apiGroup)
+	if len(apidocs.Options) == 0 {
+		return nil
+	}
+
+	apidocs.Imports = formatImports([]string{
+		"fmt",
+		"github.com/godbus/dbus/v5",
+	})
+
+	return executeApiTpl(filename, "options", apidocs)
+}
+
+// newMethodOptionsDoc parse the options of the dict argument of a method, it
+// return nil if they are not documented
+func newMethodOptionsDoc(iface string, method *types.Method) *types.MethodOptionsDoc {
+
+	arg := ""
+	for _, a := range method.Args {
+		if a.Type == "dict" {
+			arg = renameReserved(a.Name)
+		}
+	}
+	if arg == "" {
+		return nil
+	}
+
+	options := parser.ParseMethodOptions(method.Docs)
+	if len(options) == 0 {
+		return nil
+	}
+
+	doc := &types.MethodOptionsDoc{
+		Name:   fmt.Sprintf("%s%sOptions", iface, method.Name),
+		Method: method.Name,
+		Arg:    arg,
+	}
+	for i := range options {
+		option := &options[i]
+
+		optionType, ok := override.GetOptionType(option.Key)
+		if !ok {
+			optionType = castType(option.Type)
+		}
+		if optionType == "" {
+			optionType = "interface{}"
+		}
+
+		doc.Options = append(doc.Options, types.MethodOptionDoc{
+			MethodOption: option,
+			Name:         optionName(option.Key),
+			Type:         optionType,
+			IsSet:        optionIsSet(optionName(option.Key), optionType, option.Default),
+		})
+	}
+
+	return doc
+}
+
+// optionName return the field name of an option key, eg. prepare-authorize
+// is PrepareAuthorize
+func optionName(key string) string {
+	if strings.ToLower(key) == "mtu" {
+		return "MTU"
+	}
+	parts := strings.Split(key, "-")
+	for i, part := range parts {
+		parts[i] = strings.ToUpper(part[:1]) + part[1:]
+	}
+	return strings.Join(parts, "")
+}
+
+// optionIsSet return the condition to send an option, empty if it is always
+// sent as a zero value would not be the default, eg. DuplicateData
+func optionIsSet(name string, optionType string, defaultValue string) string {
+	field := "o." + name
+	switch {
+	case optionType == "bool":
+		if defaultValue == "true" {
+			return ""
+		}
+		return field
+	case optionType == "string" || optionType == "dbus.ObjectPath":
+		return field + ` != ""`
+	case strings.HasPrefix(optionType, "[]") || strings.HasPrefix(optionType, "map"):
+		return "len(" + field + ") > 0"
+	case strings.HasPrefix(optionType, "int") || strings.HasPrefix(optionType, "uint") || optionType == "byte":
+		return field + " != 0"
+	}
+	return field + " != nil"
+}
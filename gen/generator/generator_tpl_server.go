@@ -0,0 +1,75 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/muka/go-bluetooth/gen/override"
+	"github.com/muka/go-bluetooth/gen/types"
+)
+
+// ServerTemplate write the handler interface, its NotImplemented defaults and
+// the exporter of an interface implemented by the applications, the file is
+// not created for the interfaces implemented by bluez
+func ServerTemplate(filename string, api *types.Api, apiGroup *types.ApiGroup) error {
+
+	if !override.IsServerInterface(api.Interface) {
+		return nil
+	}
+
+	apidocs, _ := newApiDoc(api, apiGroup)
+	apidocs.Methods = newServerMethodsDoc(api.Interface, apidocs.Methods)
+	// the Properties interface is not exported if there are none to serve
+	apidocs.ExposeProperties = apidocs.ExposeProperties && len(apidocs.Properties) > 0
+
+	imports := []string{
+		"github.com/godbus/dbus/v5",
+		"github.com/godbus/dbus/v5/introspect",
+	}
+	if apidocs.ExposeProperties {
+		imports = append(imports,
+			"github.com/godbus/dbus/v5/prop",
+			"github.com/muka/go-bluetooth/props",
+		)
+	}
+	imports = append(imports,
+		"github.com/muka/go-bluetooth/bluez",
+		"github.com/muka/go-bluetooth/bluez/profile",
+	)
+	apidocs.Imports = formatImports(imports)
+
+	return executeApiTpl(filename, "server", apidocs)
+}
+
+// newServerMethodsDoc adapt the methods to the signature expected by
+// dbus.Conn.Export, returning a *dbus.Error, with the arguments as sent by bluez
+func newServerMethodsDoc(iface string, methods []types.MethodDoc) []types.MethodDoc {
+
+	res := []types.MethodDoc{}
+	for _, m := range methods {
+
+		method := *m.Method
+		m.Method = &method
+
+		args := []string{}
+		for _, a := range m.Method.Args {
+			argName := renameReserved(a.Name)
+			argType, ok := override.GetServerArgType(iface, m.Method.Name, a.Name)
+			if !ok {
+				argType = castType(a.Type)
+			}
+			args = append(args, fmt.Sprintf("%s %s", argName, argType))
+		}
+		m.ArgsList = strings.Join(args, ", ")
+
+		if m.SingleReturn {
+			m.Method.ReturnType = "*dbus.Error"
+		} else {
+			m.Method.ReturnType = strings.TrimSuffix(m.Method.ReturnType, ", error)") + ", *dbus.Error)"
+		}
+
+		res = append(res, m)
+	}
+
+	return res
+}
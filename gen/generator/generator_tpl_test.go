@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/muka/go-bluetooth/gen/types"
 	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCastType(t *testing.T) {
@@ -26,3 +28,35 @@ func TestCastType(t *testing.T) {
 	}
 
 }
+
+func TestServerMethodsDoc(t *testing.T) {
+
+	api := &types.Api{
+		Interface: "org.bluez.Profile1",
+		Methods: []*types.Method{
+			{
+				Name:       "NewConnection",
+				ReturnType: "void",
+				Args: []types.Arg{
+					{Type: "object", Name: "device"},
+					{Type: "int32", Name: "fd"},
+					{Type: "dict", Name: "fd_properties"},
+				},
+			},
+			{
+				Name:       "RequestPinCode",
+				ReturnType: "string",
+				Args:       []types.Arg{{Type: "object", Name: "device"}},
+			},
+		},
+	}
+
+	apidocs, _ := newApiDoc(api, &types.ApiGroup{FileName: "profile-api.txt"})
+	methods := newServerMethodsDoc(api.Interface, apidocs.Methods)
+
+	assert.Equal(t, "device dbus.ObjectPath, fd dbus.UnixFD, fd_properties map[string]interface{}", methods[0].ArgsList)
+	assert.Equal(t, "*dbus.Error", methods[0].Method.ReturnType)
+	assert.Equal(t, "(string, *dbus.Error)", methods[1].Method.ReturnType)
+	// the client methods are not changed
+	assert.Equal(t, "error", apidocs.Methods[0].Method.ReturnType)
+}
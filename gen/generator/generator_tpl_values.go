@@ -0,0 +1,111 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/muka/go-bluetooth/gen/parser"
+	"github.com/muka/go-bluetooth/gen/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// ValuesTemplate write the typed constants of the documented values of the
+// properties and method arguments, the file is not created if there are none
+func ValuesTemplate(filename string, api *types.Api, apiGroup *types.ApiGroup) error {
+
+	apidocs, _ := newApiDoc(api, apiGroup)
+	if len(apidocs.Enums) == 0 {
+		return nil
+	}
+
+	return executeApiTpl(filename, "values", apidocs)
+}
+
+// newEnumsDoc return the enums of the string properties and arguments with a
+// closed set of values, setting the Enum of the properties. The values parsed
+// from the docs are used for the APIs serialized before they were extracted.
+func newEnumsDoc(iface string, api *types.Api, props []types.PropertyDoc) []types.EnumDoc {
+
+	enums := []types.EnumDoc{}
+
+	for i := range props {
+		prop := &props[i]
+		if prop.RawType != "string" && prop.RawType != "[]string" {
+			continue
+		}
+		values := prop.Values
+		if len(values) == 0 {
+			values = parser.ParsePossibleValues(prop.Docs)
+		}
+		enum := newEnumDoc(iface+prop.Name, fmt.Sprintf("%s.%s", iface, prop.Name), values)
+		if enum == nil {
+			continue
+		}
+		prop.Enum = enum.Name
+		enums = append(enums, *enum)
+	}
+
+	for _, m := range api.Methods {
+		name := strings.Replace(m.Name, " (optional)", "", -1)
+		for _, arg := range m.Args {
+			if arg.Type != "string" {
+				continue
+			}
+			values := arg.Values
+			if len(values) == 0 {
+				values = parser.ParseArgValues(m.Docs, arg.Name)
+			}
+			enum := newEnumDoc(
+				iface+name+enumValueName(arg.Name),
+				fmt.Sprintf("the %s argument of %s.%s", arg.Name, iface, name),
+				values,
+			)
+			if enum != nil {
+				enums = append(enums, *enum)
+			}
+		}
+	}
+
+	return enums
+}
+
+// newEnumDoc return the enum of a list of values, nil if empty
+func newEnumDoc(name string, of string, values []string) *types.EnumDoc {
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	enum := &types.EnumDoc{
+		Name: name,
+		Of:   of,
+	}
+	names := map[string]bool{}
+	for _, value := range values {
+		valueName := name + enumValueName(value)
+		if names[valueName] {
+			log.Warnf("%s: skipped value %s, %s is already defined", name, value, valueName)
+			continue
+		}
+		names[valueName] = true
+		enum.Values = append(enum.Values, types.EnumValueDoc{
+			Name:  valueName,
+			Value: value,
+		})
+	}
+
+	return enum
+}
+
+// enumValueName return the identifier of a value, eg. write-without-response
+// is WriteWithoutResponse and Audio Book is AudioBook
+func enumValueName(value string) string {
+	parts := strings.FieldsFunc(value, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, part := range parts {
+		parts[i] = strings.ToUpper(part[:1]) + part[1:]
+	}
+	return strings.Join(parts, "")
+}
@@ -61,6 +61,9 @@ func prepareDocs(src string, skipFirstComment bool, leftpad int) string {
 }
 
 func getApiPackage(apiGroup *types.ApiGroup) string {
+	if apiGroup.Package != "" {
+		return apiGroup.Package
+	}
 	apiName := strings.Replace(apiGroup.FileName, "-api.txt", "", -1)
 	apiName = strings.Replace(apiName, "-", "_", -1)
 	return apiName
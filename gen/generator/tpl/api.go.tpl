@@ -12,6 +12,12 @@ var {{.InterfaceName}}Interface = "{{.Api.Interface}}"
 // New{{$InterfaceName}}{{.Role}} create a new instance of {{$InterfaceName}}
 {{.ArgsDocs}}
 func New{{$InterfaceName}}{{.Role}}({{.Args}}) (*{{$InterfaceName}}, error) {
+	return New{{$InterfaceName}}{{.Role}}WithConn(nil{{if .Params}}, {{.Params}}{{end}})
+}
+
+// New{{$InterfaceName}}{{.Role}}WithConn create a new instance of {{$InterfaceName}} on a connection, nil for the shared system bus connection
+{{.ArgsDocs}}
+func New{{$InterfaceName}}{{.Role}}WithConn(conn *bluez.Conn{{if .Args}}, {{.Args}}{{end}}) (*{{$InterfaceName}}, error) {
 	a := new({{$InterfaceName}})
 	a.client = bluez.NewClient(
 		&bluez.Config{
@@ -19,6 +25,7 @@ func New{{$InterfaceName}}{{.Role}}({{.Args}}) (*{{$InterfaceName}}, error) {
 			Iface: {{$InterfaceName}}Interface,
 			Path:  dbus.ObjectPath({{.ObjectPath}}),
 			Bus:   bluez.SystemBus,
+			Conn:  conn,
 		},
 	)
 	{{if $ExposeProperties }}
@@ -35,12 +42,13 @@ func New{{$InterfaceName}}{{.Role}}({{.Args}}) (*{{$InterfaceName}}, error) {
 
 /*
 {{.InterfaceName}} {{.Api.Title}}
-{{.Api.Description}}
+{{.Api.Description}}{{if .Api.Since}}
+
+Since BlueZ {{.Api.Since}}{{end}}
 */
 type {{.InterfaceName}} struct {
 	client     				*bluez.Client
-	propertiesSignal 	chan *dbus.Signal
-	objectManagerSignal chan *dbus.Signal
+	propertiesSignal 	*bluez.Subscription
 	objectManager       *bluez.ObjectManager
 	Properties 				*{{.InterfaceName}}Properties
 	watchPropertiesChannel chan *dbus.Signal
@@ -51,7 +59,9 @@ type {{.InterfaceName}}Properties struct {
 	lock sync.RWMutex `dbus:"ignore"`
 {{ range .Properties }}
 	/*
-	{{.Property.Name}} {{.Property.Docs}}
+	{{.Property.Name}} {{.Property.Docs}}{{if .Property.Since}}
+
+	Since BlueZ {{.Property.Since}}{{end}}
 	*/
 	{{.Property.Name}} {{.Property.Type}}
 {{end}}
@@ -72,14 +82,37 @@ func (p *{{.InterfaceName}}Properties) Unlock() {
 {{ if not .ReadOnly }}
 // Set{{.Property.Name}} set {{.Property.Name}} value
 func (a *{{$InterfaceName}}) Set{{.Property.Name}}(v {{.RawType}}) error {
-	return a.SetProperty("{{.Property.Name}}", v)
+	return a.Set{{.Property.Name}}Context(context.Background(), v)
+}
+
+// Set{{.Property.Name}}Context set {{.Property.Name}} value, failing with a bluez.ContextError if ctx is done
+func (a *{{$InterfaceName}}) Set{{.Property.Name}}Context(ctx context.Context, v {{.RawType}}) error {
+{{- if .Enum}}
+{{- if eq .RawType "[]string"}}
+	for _, value := range v {
+		if !{{.Enum}}(value).Valid() {
+			return &bluez.InvalidValueError{Interface: {{$InterfaceName}}Interface, Member: "{{.Property.Name}}", Path: a.Path(), Value: value}
+		}
+	}
+{{- else}}
+	if !{{.Enum}}(v).Valid() {
+		return &bluez.InvalidValueError{Interface: {{$InterfaceName}}Interface, Member: "{{.Property.Name}}", Path: a.Path(), Value: v}
+	}
+{{- end}}
+{{- end}}
+	return a.SetPropertyContext(ctx, "{{.Property.Name}}", v)
 }
 {{end}}
 
 {{if not .WriteOnly }}
 // Get{{.Property.Name}} get {{.Property.Name}} value
 func (a *{{$InterfaceName}}) Get{{.Property.Name}}() ({{.RawType}}, error) {
-	v, err := a.GetProperty("{{.Property.Name}}")
+	return a.Get{{.Property.Name}}Context(context.Background())
+}
+
+// Get{{.Property.Name}}Context get {{.Property.Name}} value, failing with a bluez.ContextError if ctx is done
+func (a *{{$InterfaceName}}) Get{{.Property.Name}}Context(ctx context.Context) ({{.RawType}}, error) {
+	v, err := a.GetPropertyContext(ctx, "{{.Property.Name}}")
 	if err != nil {
 		return {{.RawTypeInitializer}}, err
 	}
@@ -111,35 +144,24 @@ func (a *{{.InterfaceName}}) Interface() string {
 	return a.client.Config.Iface
 }
 
-// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager
-func (a *{{.InterfaceName}}) GetObjectManagerSignal() (chan *dbus.Signal, func(), error) {
+// GetObjectManagerSignal return a channel for receiving updates from the ObjectManager.
+// Call cancel to stop receiving updates, the channel is closed afterwards.
+func (a *{{.InterfaceName}}) GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error) {
 
-	if a.objectManagerSignal == nil {
-		if a.objectManager == nil {
-			om, err := bluez.GetObjectManager()
-			if err != nil {
-				return nil, nil, err
-			}
-			a.objectManager = om
-		}
-
-		s, err := a.objectManager.Register()
+	if a.objectManager == nil {
+		om, err := bluez.GetObjectManagerWithConn(a.client.Conn())
 		if err != nil {
 			return nil, nil, err
 		}
-		a.objectManagerSignal = s
+		a.objectManager = om
 	}
 
-	cancel := func() {
-		if a.objectManagerSignal == nil {
-			return
-		}
-		a.objectManagerSignal <- nil
-		a.objectManager.Unregister(a.objectManagerSignal)
-		a.objectManagerSignal = nil
+	sub, err := a.objectManager.Subscribe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return a.objectManagerSignal, cancel, nil
+	return sub.Signals(), sub.Unsubscribe, nil
 }
 
 {{if .ExposeProperties }}
@@ -169,20 +191,31 @@ func (a *{{.InterfaceName}}) ToProps() bluez.Properties {
 	return a.Properties
 }
 
-// GetWatchPropertiesChannel return the dbus channel to receive properties interface
+// GetWatchPropertiesChannel return the channel set by SetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *{{.InterfaceName}}) GetWatchPropertiesChannel() chan *dbus.Signal {
 	return a.watchPropertiesChannel
 }
 
-// SetWatchPropertiesChannel set the dbus channel to receive properties interface
+// SetWatchPropertiesChannel set the channel returned by GetWatchPropertiesChannel
+//
+// Deprecated: WatchProperties subscribe through the dispatcher of the
+// connection and does not use this channel anymore
 func (a *{{.InterfaceName}}) SetWatchPropertiesChannel(c chan *dbus.Signal) {
 	a.watchPropertiesChannel = c
 }
 
 // GetProperties load all available properties
 func (a *{{.InterfaceName}}) GetProperties() (*{{.InterfaceName}}Properties, error) {
+	return a.GetPropertiesContext(context.Background())
+}
+
+// GetPropertiesContext load all available properties, failing with a bluez.ContextError if ctx is done
+func (a *{{.InterfaceName}}) GetPropertiesContext(ctx context.Context) (*{{.InterfaceName}}Properties, error) {
 	a.Properties.Lock()
-	err := a.client.GetProperties(a.Properties)
+	err := a.client.GetPropertiesContext(ctx, a.Properties)
 	a.Properties.Unlock()
 	return a.Properties, err
 }
@@ -192,29 +225,40 @@ func (a *{{.InterfaceName}}) SetProperty(name string, value interface{}) error {
 	return a.client.SetProperty(name, value)
 }
 
+// SetPropertyContext set a property, failing with a bluez.ContextError if ctx is done
+func (a *{{.InterfaceName}}) SetPropertyContext(ctx context.Context, name string, value interface{}) error {
+	return a.client.SetPropertyContext(ctx, name, value)
+}
+
 // GetProperty get a property
 func (a *{{.InterfaceName}}) GetProperty(name string) (dbus.Variant, error) {
 	return a.client.GetProperty(name)
 }
 
-// GetPropertiesSignal return a channel for receiving udpdates on property changes
-func (a *{{.InterfaceName}}) GetPropertiesSignal() (chan *dbus.Signal, error) {
+// GetPropertyContext get a property, failing with a bluez.ContextError if ctx is done
+func (a *{{.InterfaceName}}) GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error) {
+	return a.client.GetPropertyContext(ctx, name)
+}
+
+// GetPropertiesSignal return a channel for receiving udpdates on property changes.
+// The channel is closed by Close
+func (a *{{.InterfaceName}}) GetPropertiesSignal() (<-chan *dbus.Signal, error) {
 
 	if a.propertiesSignal == nil {
-		s, err := a.client.Register(a.client.Config.Path, bluez.PropertiesInterface)
+		s, err := a.client.Subscribe(a.client.Config.Path, bluez.PropertiesInterface)
 		if err != nil {
 			return nil, err
 		}
 		a.propertiesSignal = s
 	}
 
-	return a.propertiesSignal, nil
+	return a.propertiesSignal.Signals(), nil
 }
 
 // Unregister for changes signalling
 func (a *{{.InterfaceName}}) unregisterPropertiesSignal() {
 	if a.propertiesSignal != nil {
-		a.propertiesSignal <- nil
+		a.propertiesSignal.Unsubscribe()
 		a.propertiesSignal = nil
 	}
 }
@@ -224,6 +268,7 @@ func (a *{{.InterfaceName}}) WatchProperties() (chan *bluez.PropertyChanged, err
 	return bluez.WatchProperties(a)
 }
 
+// UnwatchProperties stop the updates and close the channel returned by WatchProperties
 func (a *{{.InterfaceName}}) UnwatchProperties(ch chan *bluez.PropertyChanged) error {
 	return bluez.UnwatchProperties(a, ch)
 }
@@ -232,14 +277,21 @@ func (a *{{.InterfaceName}}) UnwatchProperties(ch chan *bluez.PropertyChanged) e
 
 {{range .Methods}}
 /*
-{{.Name}} {{.Docs}}
+{{.Name}} {{.Docs}}{{if .Method.Since}}
+
+Since BlueZ {{.Method.Since}}{{end}}
 */
 func (a *{{$InterfaceName}}) {{.Name}}({{.ArgsList}}) {{.Method.ReturnType}} {
+	return a.{{.Name}}Context(context.Background(){{if .ParamsList}}, {{.ParamsList}}{{end}})
+}
+
+// {{.Name}}Context call {{.Name}}, failing with a bluez.ContextError if ctx is done before bluez replies
+func (a *{{$InterfaceName}}) {{.Name}}Context(ctx context.Context{{if .ArgsList}}, {{.ArgsList}}{{end}}) {{.Method.ReturnType}} {
 	{{if .SingleReturn}}
-	return a.client.Call("{{.Name}}", 0, {{.ParamsList}}).Store()
+	return a.client.CallWithContext(ctx, "{{.Name}}", 0, {{.ParamsList}}).Store()
 	{{else}}
 	{{.ReturnVarsDefinition}}
-	err := a.client.Call("{{.Name}}", 0, {{.ParamsList}}).Store({{.ReturnVarsRefs}})
+	err := a.client.CallWithContext(ctx, "{{.Name}}", 0, {{.ParamsList}}).Store({{.ReturnVarsRefs}})
 	return {{.ReturnVarsList}}, err	{{end}}
 }
 {{end}}
@@ -1,11 +1,43 @@
 // Code generated by go-bluetooth generator DO NOT EDIT.
 
-package profile
-
-const (
-	OrgBluezInterface = "org.bluez"
-{{ range .Interfaces }}
-	//{{.Name}}Interface {{.Title}}
-	{{.Name}}Interface = "{{.Interface}}"
-{{ end }}
-)
+package {{.Package}}
+{{$InterfaceName := .InterfaceName}}
+
+{{.Imports}}
+
+// {{.InterfaceName}}API is the interface of {{.InterfaceName}}, implemented by
+// Fake{{.InterfaceName}} to test the code depending on it without D-Bus. It
+// lists the generated methods only, not the helpers written for {{.InterfaceName}}.
+type {{.InterfaceName}}API interface {
+	Path() dbus.ObjectPath
+	Interface() string
+	Close()
+	GetObjectManagerSignal() (<-chan *dbus.Signal, func(), error)
+{{if .ExposeProperties }}
+	GetProperties() (*{{.InterfaceName}}Properties, error)
+	GetPropertiesContext(ctx context.Context) (*{{.InterfaceName}}Properties, error)
+	SetProperty(name string, value interface{}) error
+	SetPropertyContext(ctx context.Context, name string, value interface{}) error
+	GetProperty(name string) (dbus.Variant, error)
+	GetPropertyContext(ctx context.Context, name string) (dbus.Variant, error)
+	GetPropertiesSignal() (<-chan *dbus.Signal, error)
+	WatchProperties() (chan *bluez.PropertyChanged, error)
+	UnwatchProperties(ch chan *bluez.PropertyChanged) error
+{{end}}
+{{- range .Properties }}
+{{ if not .ReadOnly }}
+	Set{{.Property.Name}}(v {{.RawType}}) error
+	Set{{.Property.Name}}Context(ctx context.Context, v {{.RawType}}) error
+{{- end}}
+{{- if not .WriteOnly }}
+	Get{{.Property.Name}}() ({{.RawType}}, error)
+	Get{{.Property.Name}}Context(ctx context.Context) ({{.RawType}}, error)
+{{- end}}
+{{- end}}
+{{range .Methods}}
+	{{.Name}}({{.ArgsList}}) {{.Method.ReturnType}}
+	{{.Name}}Context(ctx context.Context{{if .ArgsList}}, {{.ArgsList}}{{end}}) {{.Method.ReturnType}}
+{{- end}}
+}
+
+var _ {{.InterfaceName}}API = (*{{.InterfaceName}})(nil)
@@ -0,0 +1,77 @@
+package override
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ApiGroups map the interfaces documented in the rst docs, one per file, to
+// the package they are generated in, as the api groups of the txt docs did.
+// Interfaces are listed without the org.bluez prefix and the version.
+var ApiGroups = map[string]string{
+	"Adapter":                     "adapter",
+	"AdminPolicySet":              "admin_policy",
+	"AdminPolicyStatus":           "admin_policy",
+	"AdvertisementMonitor":        "advertisement_monitor",
+	"AdvertisementMonitorManager": "advertisement_monitor",
+	"Agent":                       "agent",
+	"AgentManager":                "agent",
+	"Battery":                     "battery",
+	"BatteryProvider":             "battery",
+	"BatteryProviderManager":      "battery",
+	"Device":                      "device",
+	"GattCharacteristic":          "gatt",
+	"GattDescriptor":              "gatt",
+	"GattManager":                 "gatt",
+	"GattProfile":                 "gatt",
+	"GattService":                 "gatt",
+	"HealthChannel":               "health",
+	"HealthDevice":                "health",
+	"HealthManager":               "health",
+	"Input":                       "input",
+	"LEAdvertisement":             "advertising",
+	"LEAdvertisingManager":        "advertising",
+	"Media":                       "media",
+	"MediaAssistant":              "media",
+	"MediaControl":                "media",
+	"MediaEndpoint":               "media",
+	"MediaFolder":                 "media",
+	"MediaItem":                   "media",
+	"MediaPlayer":                 "media",
+	"MediaTransport":              "media",
+	"Network":                     "network",
+	"NetworkServer":               "network",
+	"Profile":                     "profile",
+	"ProfileManager":              "profile",
+	"SimAccess":                   "sap",
+	"Thermometer":                 "thermometer",
+	"ThermometerManager":          "thermometer",
+	"ThermometerWatcher":          "thermometer",
+	"obex.Agent":                  "obex_agent",
+	"obex.AgentManager":           "obex_agent",
+}
+
+var (
+	ifaceVersionRegex = regexp.MustCompile(`[0-9]+$`)
+	camelCaseRegex    = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+)
+
+// GetApiGroup return the package of an interface, eg. gatt for
+// org.bluez.GattCharacteristic1. Interfaces not listed in ApiGroups are
+// grouped by namespace, eg. org.bluez.mesh.Node1 in mesh, or else named after
+// the interface, eg. org.bluez.Foo1 in foo
+func GetApiGroup(iface string) string {
+
+	name := strings.TrimPrefix(iface, "org.bluez.")
+	name = ifaceVersionRegex.ReplaceAllString(name, "")
+
+	if group, ok := ApiGroups[name]; ok {
+		return group
+	}
+
+	if pts := strings.Split(name, "."); len(pts) > 1 {
+		return strings.ToLower(pts[0])
+	}
+
+	return strings.ToLower(camelCaseRegex.ReplaceAllString(name, "${1}_${2}"))
+}
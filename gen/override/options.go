@@ -0,0 +1,18 @@
+package override
+
+// OptionTypes map the keys of the method options to their type, as the docs
+// often describe them without one, eg. "offset": Start offset
+var OptionTypes = map[string]string{
+	"offset":            "uint16",
+	"mtu":               "uint16",
+	"device":            "dbus.ObjectPath",
+	"link":              "string",
+	"type":              "string",
+	"prepare-authorize": "bool",
+}
+
+// GetOptionType return the type of a method option
+func GetOptionType(key string) (string, bool) {
+	t, ok := OptionTypes[key]
+	return t, ok
+}
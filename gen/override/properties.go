@@ -11,12 +11,15 @@ var PropertyTypes = map[string]map[string]string{
 	"org.bluez.Device1": map[string]string{
 		"ServiceData":      "map[string]interface{}",
 		"ManufacturerData": "map[uint16]interface{}",
+		// dbus type: (yv) dict of byte variant (array of bytes)
+		"AdvertisingData": "map[byte]interface{}",
 	},
 	"org.bluez.GattCharacteristic1": map[string]string{
 		"Value":          "[]byte `dbus:\"emit\"`",
 		"Descriptors":    "[]dbus.ObjectPath",
-		"WriteAcquired":  "bool `dbus:\"ignore\"`",
-		"NotifyAcquired": "bool `dbus:\"ignore\"`",
+		// exposed by a server only if AcquireWrite / AcquireNotify are supported
+		"WriteAcquired":  "bool `dbus:\"omitEmpty\"`",
+		"NotifyAcquired": "bool `dbus:\"omitEmpty\"`",
 	},
 	"org.bluez.GattDescriptor1": map[string]string{
 		"Value":          "[]byte `dbus:\"emit\"`",
@@ -0,0 +1,35 @@
+package override
+
+// ServerInterfaces list the interfaces implemented by the applications and
+// called by bluez, a server skeleton is generated for them
+var ServerInterfaces = map[string]bool{
+	"org.bluez.Agent1":               true,
+	"org.bluez.Profile1":             true,
+	"org.bluez.MediaEndpoint1":       true,
+	"org.bluez.MediaPlayer1":         true,
+	"org.bluez.mesh.Application1":    true,
+	"org.bluez.mesh.Element1":        true,
+	"org.bluez.mesh.ProvisionAgent1": true,
+	"org.bluez.mesh.Provisioner1":    true,
+	"org.bluez.LEAdvertisement1":     true,
+	"org.bluez.ThermometerWatcher1":  true,
+	"org.bluez.obex.Agent1":          true,
+}
+
+// serverArgTypes map the arguments documented with a type other than the one
+// sent by bluez, by interface.method.argument
+var serverArgTypes = map[string]string{
+	// documented as int32, bluez send the file descriptor
+	"org.bluez.Profile1.NewConnection.fd": "dbus.UnixFD",
+}
+
+// IsServerInterface report if an interface is implemented by the applications
+func IsServerInterface(iface string) bool {
+	return ServerInterfaces[iface]
+}
+
+// GetServerArgType return the type of a method argument received by a server
+func GetServerArgType(iface, method, arg string) (string, bool) {
+	argType, ok := serverArgTypes[iface+"."+method+"."+arg]
+	return argType, ok
+}
@@ -4,13 +4,15 @@ import (
 	"strings"
 
 	"github.com/muka/go-bluetooth/gen/filters"
+	"github.com/muka/go-bluetooth/gen/override"
 	"github.com/muka/go-bluetooth/gen/parser"
 	"github.com/muka/go-bluetooth/gen/types"
 	"github.com/muka/go-bluetooth/gen/util"
 	log "github.com/sirupsen/logrus"
 )
 
-// Parse bluez DBus API docs
+// Parse bluez DBus API docs, both the txt docs (eg. device-api.txt) and the
+// rst docs of the newer releases (eg. org.bluez.Device.rst)
 func Parse(docsDir string, filtersList []filters.Filter, debug bool) (BluezAPI, error) {
 	files, err := util.ListFiles(docsDir)
 	if err != nil {
@@ -19,24 +21,7 @@ func Parse(docsDir string, filtersList []filters.Filter, debug bool) (BluezAPI,
 	apis := make([]*types.ApiGroup, 0)
 	for _, file := range files {
 
-		keep := true
-		if len(filtersList) > 0 {
-			keep = false
-			for _, filter1 := range filtersList {
-				if filter1.Context != filters.FilterFile {
-					continue
-				}
-				if strings.Contains(file, filter1.Value) {
-					keep = true
-					if debug {
-						log.Debugf("[filter %s] Keep %s", filter1.Value, file)
-					}
-					break
-				}
-			}
-		}
-
-		if !keep {
+		if !keepFile(file, filtersList, debug) {
 			continue
 		}
 
@@ -49,6 +34,12 @@ func Parse(docsDir string, filtersList []filters.Filter, debug bool) (BluezAPI,
 		apis = append(apis, apiGroup)
 	}
 
+	rstFiles, err := util.ListRstFiles(docsDir)
+	if err != nil {
+		return BluezAPI{}, err
+	}
+	apis = append(apis, parseRst(rstFiles, filtersList, debug)...)
+
 	version, err := util.GetGitVersion(docsDir)
 	if err != nil {
 		log.Errorf("Failed to parse version: %s", err)
@@ -59,3 +50,66 @@ func Parse(docsDir string, filtersList []filters.Filter, debug bool) (BluezAPI,
 		Api:     apis,
 	}, nil
 }
+
+// parseRst parse the rst docs, one interface per file, and group the
+// interfaces by package, eg. GattService1 and GattCharacteristic1 in gatt
+func parseRst(files []string, filtersList []filters.Filter, debug bool) []*types.ApiGroup {
+
+	groups := make([]*types.ApiGroup, 0)
+	byPackage := make(map[string]*types.ApiGroup)
+
+	for _, file := range files {
+
+		if !keepFile(file, filtersList, debug) {
+			continue
+		}
+
+		rstParser := parser.NewRstParser(debug, filtersList)
+		apiGroup, err := rstParser.Parse(file)
+		if err != nil {
+			log.Errorf("Failed to load %s, skipped: %s", file, err)
+			continue
+		}
+
+		for _, api := range apiGroup.Api {
+			pkg := override.GetApiGroup(api.Interface)
+			group, ok := byPackage[pkg]
+			if !ok {
+				group = &types.ApiGroup{
+					FileName:    apiGroup.FileName,
+					Name:        apiGroup.Name,
+					Description: apiGroup.Description,
+					Package:     pkg,
+					Api:         make([]*types.Api, 0),
+				}
+				byPackage[pkg] = group
+				groups = append(groups, group)
+			}
+			group.Api = append(group.Api, api)
+		}
+	}
+
+	return groups
+}
+
+// keepFile report if a doc file is selected by the file filters
+func keepFile(file string, filtersList []filters.Filter, debug bool) bool {
+
+	if len(filtersList) == 0 {
+		return true
+	}
+
+	for _, filter1 := range filtersList {
+		if filter1.Context != filters.FilterFile {
+			continue
+		}
+		if strings.Contains(file, filter1.Value) {
+			if debug {
+				log.Debugf("[filter %s] Keep %s", filter1.Value, file)
+			}
+			return true
+		}
+	}
+
+	return false
+}
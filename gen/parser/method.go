@@ -89,8 +89,13 @@ func (g *MethodParser) Parse(raw []byte) (*types.Method, error) {
 				args = append(args, arg)
 			}
 		}
-		method.Args = args
 		method.Docs = string(matches2[5])
+		for i := range args {
+			if args[i].Type == "string" {
+				args[i].Values = ParseArgValues(method.Docs, args[i].Name)
+			}
+		}
+		method.Args = args
 	}
 
 	//
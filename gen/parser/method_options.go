@@ -0,0 +1,220 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/muka/go-bluetooth/gen/types"
+)
+
+var (
+	// eg. "offset": uint16 offset
+	possibleOptionRegex = regexp.MustCompile(`^"([\w-]+)":\s*(.*)$`)
+	// eg. :uint16 mtu (server only): in the rst docs
+	rstOptionRegex = regexp.MustCompile(`^:(\S+) ([\w-]+)(?: \(([^)]*)\))?:$`)
+	// eg. string Transport (Default "auto")
+	filterParameterRegex = regexp.MustCompile(`^(\S+) (\w+)(?: \(Default:? ?"?([^")]*)"?\))?$`)
+	// types a "Possible options" description may start with
+	optionTypes = map[string]string{
+		"boolean": "boolean",
+		"bool":    "boolean",
+		"byte":    "byte",
+		"int16":   "int16",
+		"uint16":  "uint16",
+		"uint32":  "uint32",
+		"string":  "string",
+		"object":  "object",
+	}
+)
+
+// ParseMethodOptions return the keys of the dict argument of a method, as
+// listed in its docs by a "Possible options:" section or, eg. for
+// SetDiscoveryFilter, by "Parameters that may be set in the filter dictionary".
+// Both the txt and the rst docs formats are supported.
+func ParseMethodOptions(docs string) []types.MethodOption {
+	lines := strings.Split(docs, "\n")
+	for i, line := range lines {
+		if strings.Contains(line, "Possible options:") {
+			if isRstFieldList(lines[i+1:]) {
+				return parseRstOptions(lines[i+1:])
+			}
+			return parsePossibleOptions(lines[i:])
+		}
+		if strings.Contains(line, "Parameters that may be set") {
+			for j := i; j < len(lines); j++ {
+				if strings.HasSuffix(strings.Trim(lines[j], " \t\r"), "following:") {
+					if isRstFieldList(lines[j+1:]) {
+						return parseRstOptions(lines[j+1:])
+					}
+					return parseFilterParameters(lines[j+1:])
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// isRstFieldList report if the first non blank line is a rst field
+func isRstFieldList(lines []string) bool {
+	for _, line := range lines {
+		line = strings.Trim(line, " \t\r")
+		if line != "" {
+			return strings.HasPrefix(line, ":")
+		}
+	}
+	return false
+}
+
+// parseRstOptions parse a field list of the rst docs, eg.
+// `:string Transport (Default "auto"):` followed by a more indented description
+func parseRstOptions(lines []string) []types.MethodOption {
+
+	options := []types.MethodOption{}
+	indent := -1
+
+	for _, line := range lines {
+
+		trimmed := strings.Trim(line, " \t\r")
+		if trimmed == "" {
+			continue
+		}
+		lineIndent := indentWidth(line)
+		if indent == -1 {
+			indent = lineIndent
+		}
+
+		if lineIndent > indent {
+			if len(options) > 0 {
+				option := &options[len(options)-1]
+				option.Docs = append(option.Docs, trimmed)
+			}
+			continue
+		}
+
+		matches := rstOptionRegex.FindStringSubmatch(trimmed)
+		if lineIndent < indent || len(matches) == 0 {
+			break
+		}
+
+		option := types.MethodOption{
+			Key:  matches[2],
+			Type: matches[1],
+		}
+		if strings.HasPrefix(matches[3], "Default") {
+			option.Default = strings.Trim(strings.TrimPrefix(matches[3], "Default"), ` :"`)
+		}
+		options = append(options, option)
+	}
+
+	return options
+}
+
+// parsePossibleOptions parse `"key": description` lines, the first one
+// following "Possible options:"
+func parsePossibleOptions(lines []string) []types.MethodOption {
+
+	options := []types.MethodOption{}
+	// indentation of a "Possible values:" list, its entries look like options
+	valuesIndent := -1
+
+	for i, line := range lines {
+
+		indent := indentWidth(line)
+		line = strings.Trim(line, " \t\r")
+		if valuesIndent != -1 && indent >= valuesIndent && line != "" {
+			option := &options[len(options)-1]
+			option.Docs = append(option.Docs, line)
+			continue
+		}
+		valuesIndent = -1
+
+		if i == 0 {
+			line = strings.Trim(strings.SplitN(line, "Possible options:", 2)[1], " \t")
+		}
+		if line == "" || strings.HasPrefix(strings.ToLower(line), "possible errors") {
+			break
+		}
+
+		matches := possibleOptionRegex.FindStringSubmatch(line)
+		if len(matches) == 0 {
+			if len(options) > 0 {
+				option := &options[len(options)-1]
+				option.Docs = append(option.Docs, line)
+				if strings.HasPrefix(line, "Possible values:") {
+					valuesIndent = indent
+				}
+			}
+			continue
+		}
+
+		option := types.MethodOption{
+			Key:  matches[1],
+			Docs: []string{matches[2]},
+		}
+		// the description may start with the type, eg. "boolean Is prepare"
+		rawType := strings.ToLower(strings.Split(matches[2], " ")[0])
+		if t, ok := optionTypes[rawType]; ok {
+			option.Type = t
+		}
+		options = append(options, option)
+	}
+
+	return options
+}
+
+// parseFilterParameters parse `<type> <Name>` lines followed by a more
+// indented description
+func parseFilterParameters(lines []string) []types.MethodOption {
+
+	options := []types.MethodOption{}
+	indent := -1
+
+	for _, line := range lines {
+
+		trimmed := strings.Trim(line, " \t\r")
+		if trimmed == "" {
+			continue
+		}
+		lineIndent := len(line) - len(strings.TrimLeft(line, "\t"))
+		if indent == -1 {
+			indent = lineIndent
+		}
+
+		if lineIndent > indent {
+			if len(options) > 0 {
+				option := &options[len(options)-1]
+				option.Docs = append(option.Docs, trimmed)
+			}
+			continue
+		}
+
+		matches := filterParameterRegex.FindStringSubmatch(trimmed)
+		if lineIndent < indent || len(matches) == 0 {
+			break
+		}
+
+		options = append(options, types.MethodOption{
+			Key:     matches[2],
+			Type:    matches[1],
+			Default: matches[3],
+		})
+	}
+
+	return options
+}
+
+// indentWidth return the width of the indentation of line, with 8 columns tabs
+func indentWidth(line string) int {
+	width := 0
+	for _, c := range line {
+		switch c {
+		case '\t':
+			width += 8 - width%8
+		case ' ':
+			width++
+		default:
+			return width
+		}
+	}
+	return width
+}
@@ -0,0 +1,71 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePossibleOptions(t *testing.T) {
+
+	docs := "\t\t\tIssues a request to write the value of the\n" +
+		"\t\t\tcharacteristic.\n" +
+		"\t\t\tPossible options: \"offset\": Start offset\n" +
+		"\t\t\t\t\t  \"type\": string\n" +
+		"\t\t\t\t\t\tPossible values:\n" +
+		"\t\t\t\t\t\t\"command\": Write without\n" +
+		"\t\t\t\t\t\tresponse\n" +
+		"\t\t\t\t\t\t\"request\": Write with response\n" +
+		"\t\t\t\t\t  \"prepare-authorize\": boolean Is prepare\n" +
+		"\t\t\t\t\t\t\t       authorization\n" +
+		"\t\t\tPossible Errors: org.bluez.Error.Failed\n"
+
+	options := ParseMethodOptions(docs)
+	if !assert.Len(t, options, 3) {
+		return
+	}
+
+	assert.Equal(t, "offset", options[0].Key)
+	assert.Equal(t, "", options[0].Type)
+
+	assert.Equal(t, "type", options[1].Key)
+	assert.Equal(t, "string", options[1].Type)
+	assert.Contains(t, options[1].Docs, `"request": Write with response`)
+
+	assert.Equal(t, "prepare-authorize", options[2].Key)
+	assert.Equal(t, "boolean", options[2].Type)
+	assert.Equal(t, []string{"boolean Is prepare", "authorization"}, options[2].Docs)
+}
+
+func TestParseFilterParameters(t *testing.T) {
+
+	docs := "\t\t\tParameters that may be set in the filter dictionary\n" +
+		"\t\t\tinclude the following:\n" +
+		"\t\t\tarray{string} UUIDs\n" +
+		"\t\t\t\tFilter by service UUIDs.\n" +
+		"\t\t\tstring Transport (Default \"auto\")\n" +
+		"\t\t\t\tTransport parameter.\n" +
+		"\t\t\tbool DuplicateData (Default: true)\n" +
+		"\t\t\t\tDisables duplicate detection.\n" +
+		"\t\t\tWhen discovery filter is set, Device objects will be\n" +
+		"\t\t\tcreated as new devices are discovered.\n"
+
+	options := ParseMethodOptions(docs)
+	if !assert.Len(t, options, 3) {
+		return
+	}
+
+	assert.Equal(t, "UUIDs", options[0].Key)
+	assert.Equal(t, "array{string}", options[0].Type)
+	assert.Equal(t, []string{"Filter by service UUIDs."}, options[0].Docs)
+
+	assert.Equal(t, "Transport", options[1].Key)
+	assert.Equal(t, "auto", options[1].Default)
+
+	assert.Equal(t, "DuplicateData", options[2].Key)
+	assert.Equal(t, "true", options[2].Default)
+}
+
+func TestParseMethodOptionsNone(t *testing.T) {
+	assert.Empty(t, ParseMethodOptions("\t\t\tConnect the device.\n"))
+}
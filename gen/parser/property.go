@@ -115,6 +115,7 @@ func (g *PropertyParser) Parse(raw []byte) (*types.Property, error) {
 	property.Name = name
 	property.Flags = flags
 	property.Docs = docs
+	property.Values = ParsePossibleValues(docs)
 
 	if g.debug {
 		log.Debugf("\t - %s", property)
@@ -0,0 +1,475 @@
+package parser
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/muka/go-bluetooth/gen/filters"
+	"github.com/muka/go-bluetooth/gen/types"
+	"github.com/muka/go-bluetooth/gen/util"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	// eg. :Object path:	/org/bluez
+	rstFieldRegex = regexp.MustCompile(`^:([\w ]+):\s*(.*)$`)
+	// eg. void ConnectProfile(string uuid) [experimental]
+	rstMethodRegex = regexp.MustCompile(`^(.*?)\s*(\w+)\(([^)]*)\)\s*(.*)$`)
+	rstErrorRegex  = regexp.MustCompile(`org\.bluez(?:\.obex)?\.Error\.\w+`)
+)
+
+// RstParser parse the reStructuredText docs of the newer BlueZ releases, eg.
+// doc/org.bluez.Device.rst, which document one interface per file
+type RstParser struct {
+	model  *types.ApiGroup
+	debug  bool
+	filter []filters.Filter
+}
+
+// NewRstParser parser for the rst docs
+func NewRstParser(debug bool, filtersList []filters.Filter) RstParser {
+	return RstParser{
+		debug:  debug,
+		filter: filtersList,
+		model: &types.ApiGroup{
+			Api: make([]*types.Api, 0),
+		},
+	}
+}
+
+// rstHeading is a title, its underline character tells the level
+type rstHeading struct {
+	line  int
+	title string
+	char  byte
+	// overline the title has also an overline, as the document title
+	overline bool
+}
+
+// rstEntry is a method, signal or property with its docs
+type rstEntry struct {
+	section   string
+	signature string
+	docs      []string
+}
+
+// Parse load a rst documentation file and parse the content
+func (g *RstParser) Parse(srcFile string) (*types.ApiGroup, error) {
+
+	apiGroup := g.model
+
+	if g.debug {
+		log.Debugf("------------------- Parsing %s -------------------", srcFile)
+	}
+
+	apiGroup.FileName = filepath.Base(srcFile)
+	apiGroup.Api = make([]*types.Api, 0)
+
+	raw, err := util.ReadFile(srcFile)
+	if err != nil {
+		return apiGroup, err
+	}
+
+	lines := strings.Split(strings.Replace(string(raw), "\r\n", "\n", -1), "\n")
+	headings := parseRstHeadings(lines)
+	if len(headings) == 0 {
+		return apiGroup, fmt.Errorf("%s: no title found", srcFile)
+	}
+
+	title := ""
+	description := []string{}
+	var api *types.Api
+	var entry *rstEntry
+	section := ""
+	subsection := ""
+
+	flush := func() error {
+		if entry == nil || api == nil {
+			return nil
+		}
+		err := g.addEntry(api, entry)
+		entry = nil
+		return err
+	}
+
+	for i, h := range headings {
+
+		// body of the heading, up to the next one
+		end := len(lines)
+		if i+1 < len(headings) {
+			end = headings[i+1].line
+			if headings[i+1].overline {
+				end--
+			}
+		}
+		if end < h.line+2 {
+			end = h.line + 2
+		}
+		body := lines[h.line+2 : end]
+
+		switch {
+		case h.overline && h.char == '=':
+			title = h.title
+			continue
+		case h.overline:
+			// subtitle, eg. BlueZ D-Bus Device API documentation
+			apiGroup.Name = h.title
+			continue
+		case h.char == '=':
+			err = flush()
+			if err != nil {
+				return apiGroup, err
+			}
+			section = h.title
+			subsection = ""
+			switch section {
+			case "Description":
+				description = append(description, trimRstBlock(body)...)
+			case "Interface":
+				fields := parseRstFields(body)
+				if api != nil && fields["Interface"] == api.Interface {
+					// the interface is documented for another role
+					mergeRstField(&api.Service, fields["Service"])
+					mergeRstField(&api.ObjectPath, fields["Object path"])
+					continue
+				}
+				api = &types.Api{
+					Title:       title,
+					Description: strings.Join(description, "\n"),
+					Service:     fields["Service"],
+					Interface:   fields["Interface"],
+					ObjectPath:  fields["Object path"],
+					Methods:     []*types.Method{},
+					Signals:     []*types.Method{},
+					Properties:  []*types.Property{},
+				}
+				apiGroup.Api = append(apiGroup.Api, api)
+			}
+		case h.char == '-':
+			err = flush()
+			if err != nil {
+				return apiGroup, err
+			}
+			subsection = h.title
+		case h.char == '`' && section == "Interface":
+			err = flush()
+			if err != nil {
+				return apiGroup, err
+			}
+			entry = &rstEntry{
+				section:   subsection,
+				signature: h.title,
+				docs:      body,
+			}
+		}
+	}
+
+	err = flush()
+	if err != nil {
+		return apiGroup, err
+	}
+
+	if apiGroup.Name == "" {
+		apiGroup.Name = title
+	}
+	apiGroup.Description = strings.Join(description, "\n")
+
+	if len(apiGroup.Api) == 0 {
+		return apiGroup, fmt.Errorf("%s: no interface defined?", srcFile)
+	}
+
+	apis := []*types.Api{}
+	for _, api := range apiGroup.Api {
+		if g.skipApi(api) {
+			log.Debugf("Skip filtered API %s", api.Interface)
+			continue
+		}
+		if g.debug {
+			log.Debugf("= %s", api.Interface)
+		}
+		apis = append(apis, api)
+	}
+	apiGroup.Api = apis
+
+	return apiGroup, nil
+}
+
+// skipApi report if the api is excluded by the filters
+func (g *RstParser) skipApi(api *types.Api) bool {
+	skip := false
+	for _, filter := range g.filter {
+		if filter.Context != filters.FilterApi {
+			continue
+		}
+		skip = !strings.Contains(strings.ToLower(api.Title), strings.ToLower(filter.Value))
+	}
+	return skip
+}
+
+// skipMethod report if the method is excluded by the filters
+func (g *RstParser) skipMethod(method *types.Method) bool {
+	skip := false
+	for _, filter := range g.filter {
+		if filter.Context != filters.FilterMethod {
+			continue
+		}
+		skip = !strings.Contains(strings.ToLower(method.Name), strings.ToLower(filter.Value))
+	}
+	return skip
+}
+
+// addEntry parse an entry of the Methods, Signals or Properties sections
+func (g *RstParser) addEntry(api *types.Api, entry *rstEntry) error {
+
+	switch entry.section {
+	case "Methods", "Signals":
+		method, err := parseRstMethod(entry)
+		if err != nil {
+			return fmt.Errorf("%s: %s", api.Interface, err)
+		}
+		if entry.section == "Signals" {
+			api.Signals = append(api.Signals, method)
+			break
+		}
+		if g.skipMethod(method) {
+			break
+		}
+		api.Methods = append(api.Methods, method)
+		if g.debug {
+			log.Debugf("\t - %s", method)
+		}
+	case "Properties":
+		property, err := parseRstProperty(entry)
+		if err != nil {
+			return fmt.Errorf("%s: %s", api.Interface, err)
+		}
+		api.Properties = append(api.Properties, property)
+		if g.debug {
+			log.Debugf("\t - %s", property)
+		}
+	}
+
+	return nil
+}
+
+// parseRstMethod parse a method signature, eg.
+// object, dict NewConnection(string uuid) [noreply]
+func parseRstMethod(entry *rstEntry) (*types.Method, error) {
+
+	matches := rstMethodRegex.FindStringSubmatch(entry.signature)
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("cannot parse method `%s`", entry.signature)
+	}
+
+	method := &types.Method{
+		ReturnType: strings.Trim(matches[1], " \t"),
+		Name:       matches[2],
+		Args:       []types.Arg{},
+		Docs:       strings.Join(trimRstBlock(entry.docs), "\n"),
+	}
+
+	for _, arg := range splitRstList(matches[3]) {
+		if arg == "" || arg == "void" {
+			continue
+		}
+		pos := strings.LastIndex(arg, " ")
+		if pos == -1 {
+			// eg. fd
+			method.Args = append(method.Args, types.Arg{Type: "<unknown>", Name: arg})
+			continue
+		}
+		method.Args = append(method.Args, types.Arg{
+			Type: strings.Trim(arg[:pos], " "),
+			Name: arg[pos+1:],
+		})
+	}
+
+	for i := range method.Args {
+		if method.Args[i].Type == "string" {
+			method.Args[i].Values = ParseArgValues(method.Docs, method.Args[i].Name)
+		}
+	}
+
+	for _, e := range rstErrorRegex.FindAllString(method.Docs, -1) {
+		exists := false
+		for _, e1 := range method.Errors {
+			exists = exists || e1 == e
+		}
+		if !exists {
+			method.Errors = append(method.Errors, e)
+		}
+	}
+
+	return method, nil
+}
+
+// parseRstProperty parse a property signature, eg.
+// array{string} UUIDs [readonly, optional]
+func parseRstProperty(entry *rstEntry) (*types.Property, error) {
+
+	signature := strings.Trim(entry.signature, " \t")
+
+	// the type may contain spaces, eg. array{byte, dict}
+	depth := 0
+	pos := -1
+	for i, c := range signature {
+		switch c {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case ' ':
+			if depth == 0 && pos == -1 {
+				pos = i
+			}
+		}
+	}
+	if pos == -1 {
+		return nil, fmt.Errorf("cannot parse property `%s`", entry.signature)
+	}
+
+	property := &types.Property{
+		Type:  signature[:pos],
+		Flags: []types.Flag{},
+		Docs:  strings.Trim(strings.Join(trimRstBlock(entry.docs), "\n"), " \t\n"),
+	}
+	property.Values = ParsePossibleValues(property.Docs)
+
+	rest := strings.Trim(signature[pos+1:], " ")
+	fields := strings.SplitN(rest, " ", 2)
+	property.Name = fields[0]
+	if len(fields) == 1 {
+		return property, nil
+	}
+
+	for _, flag := range strings.FieldsFunc(strings.ToLower(fields[1]), func(r rune) bool {
+		return r == '[' || r == ']' || r == '(' || r == ')' || r == ','
+	}) {
+		switch strings.Trim(flag, " ") {
+		case "readonly", "read-only":
+			property.Flags = append(property.Flags, types.FlagReadOnly)
+		case "writeonly", "write-only":
+			property.Flags = append(property.Flags, types.FlagWriteOnly)
+		case "readwrite", "read-write", "read/write":
+			property.Flags = append(property.Flags, types.FlagReadWrite)
+		case "experimental":
+			property.Flags = append(property.Flags, types.FlagExperimental)
+		case "optional":
+			property.Flags = append(property.Flags, types.FlagOptional)
+		case "server only", "server-only":
+			property.Flags = append(property.Flags, types.FlagServerOnly)
+		case "":
+		default:
+			log.Warnf("Unknown flag %s", flag)
+		}
+	}
+
+	return property, nil
+}
+
+// parseRstHeadings find the titles, underlined and optionally overlined by
+// the same punctuation character
+func parseRstHeadings(lines []string) []rstHeading {
+	headings := []rstHeading{}
+	for i := 0; i+1 < len(lines); i++ {
+		title := strings.TrimRight(lines[i], " \t")
+		if title == "" || title[0] == ' ' || title[0] == '\t' || isRstAdornment(title) {
+			continue
+		}
+		underline := strings.TrimRight(lines[i+1], " \t")
+		if !isRstAdornment(underline) || len(underline) < len(title) {
+			continue
+		}
+		headings = append(headings, rstHeading{
+			line:     i,
+			title:    title,
+			char:     underline[0],
+			overline: i > 0 && strings.TrimRight(lines[i-1], " \t") == underline,
+		})
+		i++
+	}
+	return headings
+}
+
+// isRstAdornment report if line is made of a single punctuation character
+func isRstAdornment(line string) bool {
+	if len(line) < 3 || !strings.ContainsRune("=-`~*^#", rune(line[0])) {
+		return false
+	}
+	return strings.Count(line, line[:1]) == len(line)
+}
+
+// parseRstFields parse a field list, eg. `:Service:	org.bluez`. Indented
+// lines continue the previous field, repeated fields are merged.
+func parseRstFields(lines []string) map[string]string {
+	fields := map[string]string{}
+	last := ""
+	for _, line := range lines {
+		trimmed := strings.Trim(line, " \t")
+		if trimmed == "" {
+			continue
+		}
+		matches := rstFieldRegex.FindStringSubmatch(trimmed)
+		if len(matches) > 0 {
+			last = matches[1]
+			value := fields[last]
+			mergeRstField(&value, matches[2])
+			fields[last] = value
+			continue
+		}
+		if last != "" && (line[0] == ' ' || line[0] == '\t') {
+			fields[last] += "\n\t\t" + trimmed
+		}
+	}
+	return fields
+}
+
+// mergeRstField append a value documented for another role, as the txt docs
+// list them, eg. "unique name (Server role)\n\t\torg.bluez (Client role)"
+func mergeRstField(field *string, value string) {
+	value = strings.Trim(value, " \t")
+	if value == "" || *field == value {
+		return
+	}
+	if *field == "" {
+		*field = value
+		return
+	}
+	*field += "\n\t\t" + value
+}
+
+// trimRstBlock drop the blank lines around a block
+func trimRstBlock(lines []string) []string {
+	start, end := 0, len(lines)
+	for start < end && strings.Trim(lines[start], " \t") == "" {
+		start++
+	}
+	for end > start && strings.Trim(lines[end-1], " \t") == "" {
+		end--
+	}
+	return lines[start:end]
+}
+
+// splitRstList split a list on the commas out of braces, eg. the arguments
+// `array{byte, dict} data, dict options`
+func splitRstList(list string) []string {
+	items := []string{}
+	depth := 0
+	last := 0
+	for i, c := range list {
+		switch c {
+		case '{', '(':
+			depth++
+		case '}', ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				items = append(items, strings.Trim(list[last:i], " \t"))
+				last = i + 1
+			}
+		}
+	}
+	return append(items, strings.Trim(list[last:], " \t"))
+}
@@ -0,0 +1,191 @@
+package parser
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/muka/go-bluetooth/gen/filters"
+	"github.com/muka/go-bluetooth/gen/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// the entries are underlined with backquotes, written as quotes here
+var rstGattCharacteristic = strings.Replace(`=============================
+org.bluez.GattCharacteristic
+=============================
+
+------------------------------------------------
+BlueZ D-Bus GattCharacteristic API documentation
+------------------------------------------------
+
+:Version: BlueZ
+:Date: October 2023
+:Manual section: 5
+:Manual group: Linux System Administration
+
+Description
+===========
+
+GATT local/server and remote/client characteristic attribute representation
+share the same high-level D-Bus API.
+
+Interface
+=========
+
+:Service:	org.bluez (Client role)
+:Service:	unique name (Server role)
+:Interface:	org.bluez.GattCharacteristic1
+:Object path:	[variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX/serviceXX/charYYYY
+
+Methods
+-------
+
+array{byte} ReadValue(dict options)
+'''''''''''''''''''''''''''''''''''
+
+	Issues a request to read the value of the characteristic and returns the
+	value if the operation was successful.
+
+	Possible options:
+
+	:uint16 offset:
+
+		Read start offset in bytes.
+
+	:uint16 mtu (server only):
+
+		Exchanged MTU.
+
+	:object device (server only):
+
+		Device object.
+
+	Possible Errors:
+
+	:org.bluez.Error.Failed:
+	:org.bluez.Error.InProgress:
+
+fd, uint16 AcquireWrite(dict options) [optional]
+''''''''''''''''''''''''''''''''''''''''''''''''
+
+	Acquire file descriptor and MTU for writing.
+
+	Possible Errors:
+
+	:org.bluez.Error.Failed:
+
+Properties
+----------
+
+string UUID [read-only]
+'''''''''''''''''''''''
+
+	128-bit characteristic UUID.
+
+array{string} Flags [read-only]
+'''''''''''''''''''''''''''''''
+
+	Defines how the characteristic value can be used.
+
+	Possible values:
+
+	:"broadcast":
+	:"read":
+
+uint16 MTU [read-only, optional]
+''''''''''''''''''''''''''''''''
+
+	Characteristic MTU.
+`, "'", "`", -1)
+
+func writeRstDoc(t *testing.T, name string, content string) (string, func()) {
+	dir, err := ioutil.TempDir("", "go-bluetooth-rst")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := path.Join(dir, name)
+	err = ioutil.WriteFile(file, []byte(content), 0644)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return file, func() {
+		os.RemoveAll(dir)
+	}
+}
+
+func TestRstParser(t *testing.T) {
+
+	file, cleanup := writeRstDoc(t, "org.bluez.GattCharacteristic.rst", rstGattCharacteristic)
+	defer cleanup()
+
+	rstParser := NewRstParser(false, []filters.Filter{})
+	apiGroup, err := rstParser.Parse(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "BlueZ D-Bus GattCharacteristic API documentation", apiGroup.Name)
+	if !assert.Len(t, apiGroup.Api, 1) {
+		return
+	}
+
+	api := apiGroup.Api[0]
+	assert.Equal(t, "org.bluez.GattCharacteristic", api.Title)
+	assert.Contains(t, api.Description, "share the same high-level D-Bus API")
+	assert.Equal(t, "org.bluez.GattCharacteristic1", api.Interface)
+	assert.Equal(t, "org.bluez (Client role)\n\t\tunique name (Server role)", api.Service)
+	assert.Equal(t, "[variable prefix]/{hci0,hci1,...}/dev_XX_XX_XX_XX_XX_XX/serviceXX/charYYYY", api.ObjectPath)
+
+	if !assert.Len(t, api.Methods, 2) {
+		return
+	}
+	read := api.Methods[0]
+	assert.Equal(t, "ReadValue", read.Name)
+	assert.Equal(t, "array{byte}", read.ReturnType)
+	assert.Equal(t, []types.Arg{{Type: "dict", Name: "options"}}, read.Args)
+	assert.Equal(t, []string{"org.bluez.Error.Failed", "org.bluez.Error.InProgress"}, read.Errors)
+
+	acquire := api.Methods[1]
+	assert.Equal(t, "AcquireWrite", acquire.Name)
+	assert.Equal(t, "fd, uint16", acquire.ReturnType)
+
+	if !assert.Len(t, api.Properties, 3) {
+		return
+	}
+	assert.Equal(t, "UUID", api.Properties[0].Name)
+	assert.Equal(t, "string", api.Properties[0].Type)
+	assert.Equal(t, "128-bit characteristic UUID.", api.Properties[0].Docs)
+	assert.Equal(t, []types.Flag{types.FlagReadOnly}, api.Properties[0].Flags)
+	assert.Equal(t, "array{string}", api.Properties[1].Type)
+	assert.Equal(t, []types.Flag{types.FlagReadOnly, types.FlagOptional}, api.Properties[2].Flags)
+
+	options := ParseMethodOptions(read.Docs)
+	if !assert.Len(t, options, 3) {
+		return
+	}
+	assert.Equal(t, "offset", options[0].Key)
+	assert.Equal(t, "uint16", options[0].Type)
+	assert.Equal(t, []string{"Read start offset in bytes."}, options[0].Docs)
+	assert.Equal(t, "device", options[2].Key)
+	assert.Equal(t, "object", options[2].Type)
+}
+
+func TestRstParserFilter(t *testing.T) {
+
+	file, cleanup := writeRstDoc(t, "org.bluez.GattCharacteristic.rst", rstGattCharacteristic)
+	defer cleanup()
+
+	rstParser := NewRstParser(false, []filters.Filter{
+		filters.NewFilter("read", filters.FilterMethod),
+	})
+	apiGroup, err := rstParser.Parse(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, apiGroup.Api[0].Methods, 1)
+}
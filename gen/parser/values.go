@@ -0,0 +1,117 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// eg. Possible values:, Possible status:, Allowed values are:, can have the values
+	possibleValuesRegex = regexp.MustCompile(`(?i)(possible\s+(values?|status)|allowed\s+values|can\s+have\s+the\s+values)(\s+are)?\s*:?`)
+	// eg. "public" - Public address
+	quotedValueRegex = regexp.MustCompile(`^"([^"]*)"`)
+)
+
+// ParsePossibleValues return the closed set of values listed in the docs of a
+// property, eg. after "Possible values:", nil if there is none. The values may
+// follow on the same line, be listed one per line with a description, as in
+// `"idle": not streaming`, or as a rst field list, as in `:"public":`.
+func ParsePossibleValues(docs string) []string {
+
+	loc := possibleValuesRegex.FindStringIndex(docs)
+	if loc == nil {
+		return nil
+	}
+
+	lines := strings.Split(docs[loc[1]:], "\n")
+	values := []string{}
+	// indentation of the values, more indented lines describe them
+	valuesIndent := -1
+
+	first := strings.Trim(lines[0], " \t\r")
+	if first != "" {
+		values = quotedValues(first)
+		if len(values) == 0 {
+			// eg. Possible values: as found on GAP Service.
+			return nil
+		}
+		lineStart := strings.LastIndex(docs[:loc[0]], "\n") + 1
+		valuesIndent = indentWidth(docs[lineStart:])
+	}
+
+	for _, line := range lines[1:] {
+
+		trimmed := strings.Trim(line, " \t\r")
+		if trimmed == "" {
+			continue
+		}
+		indent := indentWidth(line)
+
+		found := quotedValues(trimmed)
+		if len(found) > 0 && indent >= valuesIndent {
+			if valuesIndent == -1 {
+				valuesIndent = indent
+			}
+			values = append(values, found...)
+			continue
+		}
+		if valuesIndent != -1 && indent > valuesIndent {
+			continue
+		}
+		break
+	}
+
+	res := []string{}
+	seen := map[string]bool{}
+	for _, value := range values {
+		// eg. "/Filesystem/...", the set is not closed
+		if value == "" || strings.Contains(value, "...") {
+			return nil
+		}
+		if !seen[value] {
+			seen[value] = true
+			res = append(res, value)
+		}
+	}
+	if len(res) == 0 {
+		return nil
+	}
+	return res
+}
+
+// ParseArgValues return the values of a method argument, listed in the method
+// docs after a sentence like `The capability parameter can have the values`
+func ParseArgValues(docs string, arg string) []string {
+
+	re := regexp.MustCompile(`(?i)\bthe\s+` + regexp.QuoteMeta(arg) + `\s+parameter\b`)
+	loc := re.FindStringIndex(docs)
+	if loc == nil {
+		return nil
+	}
+	docs = docs[loc[0]:]
+
+	// the values must be listed by the sentence introducing the argument
+	marker := possibleValuesRegex.FindStringIndex(docs)
+	if marker == nil || strings.Count(docs[:marker[0]], "\n") > 1 {
+		return nil
+	}
+
+	return ParsePossibleValues(docs)
+}
+
+// quotedValues return the quoted values at the start of a line, eg.
+// `"off", "alltracks" or "group"`
+func quotedValues(line string) []string {
+	values := []string{}
+	for {
+		line = strings.TrimLeft(line, " \t,:")
+		line = strings.TrimPrefix(line, "or ")
+		line = strings.TrimPrefix(line, "and ")
+		matches := quotedValueRegex.FindStringSubmatch(line)
+		if len(matches) == 0 {
+			return values
+		}
+		values = append(values, matches[1])
+		line = line[len(matches[0]):]
+	}
+}
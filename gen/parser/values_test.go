@@ -0,0 +1,61 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePossibleValues(t *testing.T) {
+
+	// inline, wrapped on the next lines
+	docs := "Possible status: \"playing\", \"stopped\", \"paused\",\n" +
+		"\t\t\t\t\t\"forward-seek\", \"reverse-seek\"\n" +
+		"\t\t\t\t\tor \"error\"\n"
+	assert.Equal(t, []string{"playing", "stopped", "paused", "forward-seek", "reverse-seek", "error"}, ParsePossibleValues(docs))
+
+	// one per line with a description
+	docs = "Indicates the state of the transport. Possible\n" +
+		"\t\t\tvalues are:\n" +
+		"\t\t\t\t\"idle\": not streaming\n" +
+		"\t\t\t\t\"pending\": streaming but not acquired\n" +
+		"\t\t\t\t\"active\": streaming and acquired\n"
+	assert.Equal(t, []string{"idle", "pending", "active"}, ParsePossibleValues(docs))
+
+	// described on the following lines
+	docs = "define the following four possible values:\n\n" +
+		"\t\t\t\"none\"\t\tDevice and host are not required to\n" +
+		"\t\t\t\t\tautomatically restore the connection.\n\n" +
+		"\t\t\t\"host\"\t\tBluetooth HID host restores connection.\n\n" +
+		"\t\tuint16 VendorId [readonly]\n"
+	assert.Equal(t, []string{"none", "host"}, ParsePossibleValues(docs))
+
+	// rst field list
+	docs = "List of supported roles.\n\n" +
+		"Possible values:\n\n" +
+		":\"central\":\n\n" +
+		"\tSupports the central role.\n\n" +
+		":\"peripheral\":\n\n" +
+		"\tSupports the peripheral role.\n"
+	assert.Equal(t, []string{"central", "peripheral"}, ParsePossibleValues(docs))
+
+	// not a closed set
+	assert.Nil(t, ParsePossibleValues("Possible values: as found on GAP Service."))
+	assert.Nil(t, ParsePossibleValues("Possible values:\n\t\t\t\t\"/Filesystem/...\": Filesystem scope\n"))
+	assert.Nil(t, ParsePossibleValues("Player name"))
+}
+
+func TestParseArgValues(t *testing.T) {
+
+	docs := "\t\t\tAn application can only register one agent.\n" +
+		"\t\t\tThe capability parameter can have the values\n" +
+		"\t\t\t\"DisplayOnly\", \"DisplayYesNo\", \"KeyboardOnly\",\n" +
+		"\t\t\t\"NoInputNoOutput\" and \"KeyboardDisplay\" which\n" +
+		"\t\t\treflects the input and output capabilities of the\n" +
+		"\t\t\tagent.\n" +
+		"\t\t\tIf an empty string is used it will fallback to\n" +
+		"\t\t\t\"KeyboardDisplay\".\n"
+
+	assert.Equal(t, []string{"DisplayOnly", "DisplayYesNo", "KeyboardOnly", "NoInputNoOutput", "KeyboardDisplay"}, ParseArgValues(docs, "capability"))
+	assert.Nil(t, ParseArgValues(docs, "agent"))
+}
@@ -1,7 +1,11 @@
 package gen
 
 import (
+	"fmt"
+	"io/ioutil"
+	"os"
 	"path"
+	"strings"
 	"testing"
 
 	"github.com/muka/go-bluetooth/gen/filters"
@@ -45,3 +49,44 @@ func TestSerialization(t *testing.T) {
 
 	assert.Equal(t, api.Version, api1.Version)
 }
+
+func TestParseRst(t *testing.T) {
+
+	docsDir, err := ioutil.TempDir("", "go-bluetooth-rst")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(docsDir)
+
+	docs := map[string]string{
+		"org.bluez.Device.rst":         "org.bluez.Device1",
+		"org.bluez.GattService.rst":    "org.bluez.GattService1",
+		"org.bluez.GattDescriptor.rst": "org.bluez.GattDescriptor1",
+		// not a D-Bus API doc
+		"l2cap.rst": "",
+	}
+	for name, iface := range docs {
+		content := fmt.Sprintf("%s\n%s\n\nInterface\n=========\n\n:Service:\torg.bluez\n:Interface:\t%s\n:Object path:\t/org/bluez\n",
+			name, strings.Repeat("=", len(name)), iface)
+		err = ioutil.WriteFile(path.Join(docsDir, name), []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	api, err := Parse(docsDir, []filters.Filter{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	packages := map[string][]string{}
+	for _, group := range api.Api {
+		for _, a := range group.Api {
+			packages[group.Package] = append(packages[group.Package], a.Interface)
+		}
+	}
+	assert.Equal(t, map[string][]string{
+		"device": {"org.bluez.Device1"},
+		"gatt":   {"org.bluez.GattDescriptor1", "org.bluez.GattService1"},
+	}, packages)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/muka/go-bluetooth/gen"
@@ -21,6 +22,8 @@ const (
 	flagGenerateModeFull     = "full"
 	flagGenerateModeParse    = "parse"
 	flagGenerateModeGenerate = "generate"
+	flagDiff                 = "diff"
+	flagJSON                 = "json"
 )
 
 const docsDir = "src/bluez/doc"
@@ -45,6 +48,19 @@ func getOutputDir() string {
 func main() {
 
 	parseLogLevel()
+
+	// eg. diff 5.50 5.54 [json]
+	if len(os.Args) > 1 && os.Args[1] == flagDiff {
+		if len(os.Args) < 4 {
+			log.Fatal("Usage: diff <from version> <to version> [json]")
+		}
+		err := Diff(os.Args[2], os.Args[3], hasFlag(flagJSON))
+		if err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	bluezVersion := getBluezVersion()
 	debug := hasFlag(flagDebug)
 
@@ -154,6 +170,13 @@ func Generate(filename string, debug bool, overwrite bool) error {
 		return err
 	}
 
+	history, err := loadHistory(api.Version)
+	if err != nil {
+		log.Fatalf("Generation failed: %s", err)
+		return err
+	}
+	gen.AnnotateSince(&api, history...)
+
 	err = generator.Generate(api, getOutputDir(), debug, overwrite)
 	if err != nil {
 		log.Fatalf("Generation failed: %s", err)
@@ -162,3 +185,54 @@ func Generate(filename string, debug bool, overwrite bool) error {
 
 	return nil
 }
+
+// loadHistory load the serialized APIs up to version, to annotate when the
+// generated interfaces and members have been introduced
+func loadHistory(version string) ([]*gen.BluezAPI, error) {
+
+	files, err := filepath.Glob(fmt.Sprintf("%s/bluez-*.json", getBaseDir()))
+	if err != nil {
+		return nil, err
+	}
+
+	history := []*gen.BluezAPI{}
+	for _, file := range files {
+		api, err := gen.LoadJSON(file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", file, err)
+		}
+		if gen.CompareVersions(api.Version, version) <= 0 {
+			history = append(history, api)
+		}
+	}
+
+	return history, nil
+}
+
+// Diff print the changes of the API between two serialized versions, as
+// Markdown or JSON
+func Diff(fromVersion string, toVersion string, asJSON bool) error {
+
+	from, err := gen.LoadJSON(fmt.Sprintf("%s/bluez-%s.json", getBaseDir(), fromVersion))
+	if err != nil {
+		return err
+	}
+	to, err := gen.LoadJSON(fmt.Sprintf("%s/bluez-%s.json", getBaseDir(), toVersion))
+	if err != nil {
+		return err
+	}
+
+	diff := gen.Diff(from, to)
+
+	if !asJSON {
+		fmt.Print(diff.Markdown())
+		return nil
+	}
+
+	data, err := diff.JSON()
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
+	return nil
+}
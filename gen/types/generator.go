@@ -17,16 +17,25 @@ type MethodDoc struct {
 	ReturnVarsDefinition string
 	ReturnVarsRefs       string
 	ReturnVarsList       string
+	// Options is the typed struct of the dict argument, if its keys are documented
+	Options *MethodOptionsDoc
 }
 
-type InterfaceDoc struct {
-	Title     string
-	Name      string
-	Interface string
+// MethodOptionsDoc is the struct of the options of a method
+type MethodOptionsDoc struct {
+	Name   string
+	Method string
+	// Arg is the name of the dict argument
+	Arg     string
+	Options []MethodOptionDoc
 }
 
-type InterfacesDoc struct {
-	Interfaces []InterfaceDoc
+type MethodOptionDoc struct {
+	*MethodOption
+	Name string
+	Type string
+	// IsSet is the condition to add the option to the map
+	IsSet string
 }
 
 type PropertyDoc struct {
@@ -36,6 +45,22 @@ type PropertyDoc struct {
 	ReadOnly           bool
 	WriteOnly          bool
 	ReadWrite          bool
+	// Enum is the type of the documented values, if any, checked by the setter
+	Enum string
+}
+
+// EnumDoc is a string type listing the documented values of a property or of
+// a method argument
+type EnumDoc struct {
+	Name string
+	// Of is the documented property or argument, eg. Device1.AddressType
+	Of     string
+	Values []EnumValueDoc
+}
+
+type EnumValueDoc struct {
+	Name  string
+	Value string
 }
 
 type ApiGroupDoc struct {
@@ -52,6 +77,11 @@ type ApiDoc struct {
 	Imports          string
 	Constructors     []Constructor
 	ExposeProperties bool
+	// Options is the list of the typed options of the methods
+	Options []MethodOptionsDoc
+	// Enums is the list of the documented values of the properties and the
+	// method arguments
+	Enums []EnumDoc
 }
 
 type Constructor struct {
@@ -59,6 +89,8 @@ type Constructor struct {
 	Role       string
 	ObjectPath string
 	Args       string
+	// Params is the list of the argument names
+	Params     string
 	ArgsDocs   string
 	Docs       []string
 }
@@ -10,7 +10,10 @@ type ApiGroup struct {
 	Name        string
 	Description string
 	Api         []*Api
-	debug       bool
+	// Package is set for the groups of the rst docs, else it is named after
+	// FileName, eg. gatt for gatt-api.txt
+	Package string `json:",omitempty"`
+	debug   bool
 }
 
 type Api struct {
@@ -23,6 +26,8 @@ type Api struct {
 	// those are currently avail only in health-api
 	Signals    []*Method
 	Properties []*Property
+	// Since is the BlueZ version introducing the interface, set by gen.AnnotateSince
+	Since string `json:",omitempty"`
 }
 
 type Flag int
@@ -39,6 +44,8 @@ const (
 type Arg struct {
 	Type string
 	Name string
+	// Values is the closed set of values documented for a string argument
+	Values []string `json:",omitempty"`
 }
 
 func (a *Arg) String() string {
@@ -51,6 +58,8 @@ type Method struct {
 	Args       []Arg
 	Errors     []string
 	Docs       string
+	// Since is the BlueZ version introducing the method, set by gen.AnnotateSince
+	Since string `json:",omitempty"`
 }
 
 func (m *Method) String() string {
@@ -66,6 +75,11 @@ type Property struct {
 	Type  string
 	Docs  string
 	Flags []Flag
+	// Since is the BlueZ version introducing the property, set by gen.AnnotateSince
+	Since string `json:",omitempty"`
+	// Values is the closed set of values documented for the property, eg.
+	// "public" and "random" for Device1.AddressType
+	Values []string `json:",omitempty"`
 }
 
 func (p *Property) String() string {
@@ -104,3 +118,13 @@ func (p *Property) String() string {
 
 	return fmt.Sprintf("%s %s %s", p.Type, p.Name, flagsStr)
 }
+
+// MethodOption is a key of the dict argument of a method, as listed by the
+// "Possible options" section of its documentation
+type MethodOption struct {
+	Key string
+	// Type is the raw type, empty if not documented
+	Type    string
+	Default string
+	Docs    []string
+}
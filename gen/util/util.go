@@ -55,6 +55,38 @@ func ListFiles(dir string) ([]string, error) {
 	return list, nil
 }
 
+// ListRstFiles return the list of the rst D-Bus API docs, eg. org.bluez.Device.rst
+func ListRstFiles(dir string) ([]string, error) {
+
+	list := make([]string, 0)
+
+	if !Exists(dir) {
+		return list, fmt.Errorf("Doc dir not found %s", dir)
+	}
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+
+		if info.IsDir() {
+			return nil
+		}
+
+		name := filepath.Base(path)
+		if !strings.HasPrefix(name, "org.bluez.") || !strings.HasSuffix(name, ".rst") {
+			return nil
+		}
+
+		list = append(list, path)
+		return nil
+	})
+
+	if err != nil {
+		log.Errorf("Failed to list files: %s", err)
+		return list, nil
+	}
+
+	return list, nil
+}
+
 // ReadFile read a file content
 func ReadFile(srcFile string) ([]byte, error) {
 	file, err := os.Open(srcFile)
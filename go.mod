@@ -17,5 +17,5 @@ require (
 	golang.org/x/sys v0.0.0-20200728102440-3e129f6d46b1
 	gopkg.in/check.v1 v1.0.0-20200227125254-8fa46927fb4f // indirect
 	gopkg.in/yaml.v2 v2.2.8 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c
 )
-
@@ -3,7 +3,7 @@ package cmd
 import (
 	"os/exec"
 
-	log "github.com/sirupsen/logrus"
+	"github.com/muka/go-bluetooth/logger"
 )
 
 // Exec Execute a command and collect the output
@@ -12,7 +12,7 @@ func Exec(args ...string) (string, error) {
 	baseCmd := args[0]
 	cmdArgs := args[1:]
 
-	log.Tracef("Exec: %s %s", baseCmd, cmdArgs)
+	logger.Tracef("Exec: %s %s", baseCmd, cmdArgs)
 
 	cmd := exec.Command(baseCmd, cmdArgs...)
 	res, err := cmd.CombinedOutput()
@@ -7,7 +7,7 @@ import (
 	"github.com/muka/go-bluetooth/hw/linux/btmgmt"
 	"github.com/muka/go-bluetooth/hw/linux/hci"
 	"github.com/muka/go-bluetooth/hw/linux/hciconfig"
-	log "github.com/sirupsen/logrus"
+	"github.com/muka/go-bluetooth/logger"
 )
 
 type BackendType string
@@ -131,7 +131,7 @@ func Down(adapterID string) error {
 func Reset(adapterID string) error {
 	err := Down(adapterID)
 	if err != nil {
-		log.Warnf("Down failed: %s", err)
+		logger.Warnf("Down failed: %s", err)
 	}
 	return Up(adapterID)
 }
@@ -0,0 +1,105 @@
+package logger
+
+import (
+	"sort"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Logrus adapt a logrus logger or entry, fields are passed as logrus fields
+func Logrus(l logrus.FieldLogger) Logger {
+	return &logrusLogger{l}
+}
+
+type logrusLogger struct {
+	l logrus.FieldLogger
+}
+
+func toLogrusLevel(level Level) logrus.Level {
+	switch level {
+	case ErrorLevel:
+		return logrus.ErrorLevel
+	case WarnLevel:
+		return logrus.WarnLevel
+	case InfoLevel:
+		return logrus.InfoLevel
+	case DebugLevel:
+		return logrus.DebugLevel
+	}
+	return logrus.TraceLevel
+}
+
+func (l *logrusLogger) Enabled(level Level) bool {
+	switch ll := l.l.(type) {
+	case *logrus.Logger:
+		return ll.IsLevelEnabled(toLogrusLevel(level))
+	case *logrus.Entry:
+		return ll.Logger.IsLevelEnabled(toLogrusLevel(level))
+	}
+	return true
+}
+
+func (l *logrusLogger) Log(level Level, msg string, fields Fields) {
+	l.l.WithFields(logrus.Fields(fields)).Log(toLogrusLevel(level), msg)
+}
+
+// SlogLogger is the subset of *slog.Logger (log/slog, Go 1.21) used by Slog
+type SlogLogger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// Slog adapt a log/slog logger, or any logger with the same methods. Fields
+// are passed as key-value pairs sorted by key, TraceLevel is logged as debug.
+func Slog(l SlogLogger) Logger {
+	return &slogLogger{l}
+}
+
+type slogLogger struct {
+	l SlogLogger
+}
+
+// Enabled is always true, levels are filtered by the slog handler
+func (l *slogLogger) Enabled(level Level) bool {
+	return true
+}
+
+func (l *slogLogger) Log(level Level, msg string, fields Fields) {
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	args := make([]interface{}, 0, len(fields)*2)
+	for _, k := range keys {
+		args = append(args, k, fields[k])
+	}
+
+	switch level {
+	case ErrorLevel:
+		l.l.Error(msg, args...)
+	case WarnLevel:
+		l.l.Warn(msg, args...)
+	case InfoLevel:
+		l.l.Info(msg, args...)
+	default:
+		l.l.Debug(msg, args...)
+	}
+}
+
+// Nop return a logger discarding all the messages
+func Nop() Logger {
+	return nopLogger{}
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Enabled(level Level) bool {
+	return false
+}
+
+func (nopLogger) Log(level Level, msg string, fields Fields) {}
@@ -0,0 +1,231 @@
+// Package logger is the logging interface of the library. Logs are sent to a
+// Logger, set globally with SetDefault or per client, connection and app, so
+// that applications can route, silence or structure them. Adapters are
+// provided for logrus (the default), log/slog and a no-op logger.
+package logger
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/sirupsen/logrus"
+)
+
+// Level of a log message, from the least to the most verbose
+type Level uint8
+
+const (
+	// ErrorLevel failures the library cannot recover from
+	ErrorLevel Level = iota
+	// WarnLevel failures the library recovered from
+	WarnLevel
+	// InfoLevel relevant changes of state
+	InfoLevel
+	// DebugLevel details of the operations
+	DebugLevel
+	// TraceLevel every call and signal
+	TraceLevel
+)
+
+func (l Level) String() string {
+	switch l {
+	case ErrorLevel:
+		return "error"
+	case WarnLevel:
+		return "warn"
+	case InfoLevel:
+		return "info"
+	case DebugLevel:
+		return "debug"
+	case TraceLevel:
+		return "trace"
+	}
+	return fmt.Sprintf("level(%d)", uint8(l))
+}
+
+// Fields are the structured context of a log message
+type Fields map[string]interface{}
+
+// Field names used by the library
+const (
+	// FieldPath the DBus object path
+	FieldPath = "path"
+	// FieldInterface the DBus interface
+	FieldInterface = "interface"
+	// FieldMethod the DBus method or signal
+	FieldMethod = "method"
+)
+
+// Logger receives the log messages of the library
+type Logger interface {
+	// Enabled report if messages of level are logged, to skip building them
+	Enabled(level Level) bool
+	// Log a message with its fields
+	Log(level Level, msg string, fields Fields)
+}
+
+var (
+	defaultLock   sync.RWMutex
+	defaultLogger Logger = Logrus(logrus.StandardLogger())
+)
+
+// SetDefault set the logger used when none is set on a client, connection or
+// app. A nil logger discards all the messages.
+func SetDefault(l Logger) {
+	if l == nil {
+		l = Nop()
+	}
+	defaultLock.Lock()
+	defaultLogger = l
+	defaultLock.Unlock()
+}
+
+// Default return the default logger, the logrus standard logger unless
+// changed by SetDefault
+func Default() Logger {
+	defaultLock.RLock()
+	defer defaultLock.RUnlock()
+	return defaultLogger
+}
+
+// Entry is a logger with fields, it is immutable and safe to share
+type Entry struct {
+	logger Logger
+	fields Fields
+}
+
+// New return an entry logging to l, nil for the default logger at the time
+// messages are logged
+func New(l Logger) *Entry {
+	return &Entry{logger: l}
+}
+
+// Logger return the logger of the entry
+func (e *Entry) Logger() Logger {
+	if e.logger == nil {
+		return Default()
+	}
+	return e.logger
+}
+
+// Fields return a copy of the fields of the entry
+func (e *Entry) Fields() Fields {
+	fields := make(Fields, len(e.fields))
+	for k, v := range e.fields {
+		fields[k] = v
+	}
+	return fields
+}
+
+// WithFields return a new entry with fields added
+func (e *Entry) WithFields(fields Fields) *Entry {
+	f := e.Fields()
+	for k, v := range fields {
+		f[k] = v
+	}
+	return &Entry{logger: e.logger, fields: f}
+}
+
+// WithField return a new entry with a field added
+func (e *Entry) WithField(key string, value interface{}) *Entry {
+	return e.WithFields(Fields{key: value})
+}
+
+// WithObject return a new entry with the path and interface fields, an empty
+// interface is omitted
+func (e *Entry) WithObject(path dbus.ObjectPath, iface string) *Entry {
+	fields := Fields{FieldPath: path}
+	if iface != "" {
+		fields[FieldInterface] = iface
+	}
+	return e.WithFields(fields)
+}
+
+// WithMethod return a new entry with the method field
+func (e *Entry) WithMethod(method string) *Entry {
+	return e.WithField(FieldMethod, method)
+}
+
+func (e *Entry) logf(level Level, format string, args ...interface{}) {
+	l := e.Logger()
+	if !l.Enabled(level) {
+		return
+	}
+	msg := format
+	if len(args) > 0 {
+		msg = fmt.Sprintf(format, args...)
+	}
+	l.Log(level, msg, e.Fields())
+}
+
+// Errorf log at ErrorLevel
+func (e *Entry) Errorf(format string, args ...interface{}) {
+	e.logf(ErrorLevel, format, args...)
+}
+
+// Warnf log at WarnLevel
+func (e *Entry) Warnf(format string, args ...interface{}) {
+	e.logf(WarnLevel, format, args...)
+}
+
+// Infof log at InfoLevel
+func (e *Entry) Infof(format string, args ...interface{}) {
+	e.logf(InfoLevel, format, args...)
+}
+
+// Debugf log at DebugLevel
+func (e *Entry) Debugf(format string, args ...interface{}) {
+	e.logf(DebugLevel, format, args...)
+}
+
+// Tracef log at TraceLevel
+func (e *Entry) Tracef(format string, args ...interface{}) {
+	e.logf(TraceLevel, format, args...)
+}
+
+// WithFields return an entry of the default logger with fields
+func WithFields(fields Fields) *Entry {
+	return New(nil).WithFields(fields)
+}
+
+// WithField return an entry of the default logger with a field
+func WithField(key string, value interface{}) *Entry {
+	return New(nil).WithField(key, value)
+}
+
+// WithObject return an entry of the default logger with the path and
+// interface fields
+func WithObject(path dbus.ObjectPath, iface string) *Entry {
+	return New(nil).WithObject(path, iface)
+}
+
+// WithMethod return an entry of the default logger with the method field
+func WithMethod(method string) *Entry {
+	return New(nil).WithMethod(method)
+}
+
+// Errorf log at ErrorLevel to the default logger
+func Errorf(format string, args ...interface{}) {
+	New(nil).Errorf(format, args...)
+}
+
+// Warnf log at WarnLevel to the default logger
+func Warnf(format string, args ...interface{}) {
+	New(nil).Warnf(format, args...)
+}
+
+// Infof log at InfoLevel to the default logger
+func Infof(format string, args ...interface{}) {
+	New(nil).Infof(format, args...)
+}
+
+// Debugf log at DebugLevel to the default logger
+func Debugf(format string, args ...interface{}) {
+	New(nil).Debugf(format, args...)
+}
+
+// Tracef log at TraceLevel to the default logger
+func Tracef(format string, args ...interface{}) {
+	New(nil).Tracef(format, args...)
+}
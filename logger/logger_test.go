@@ -0,0 +1,126 @@
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+type record struct {
+	level  Level
+	msg    string
+	fields Fields
+}
+
+type recorder struct {
+	level   Level
+	records []record
+}
+
+func (r *recorder) Enabled(level Level) bool {
+	return level <= r.level
+}
+
+func (r *recorder) Log(level Level, msg string, fields Fields) {
+	r.records = append(r.records, record{level, msg, fields})
+}
+
+type fakeSlog struct {
+	calls [][]interface{}
+}
+
+func (f *fakeSlog) log(level, msg string, args ...interface{}) {
+	f.calls = append(f.calls, append([]interface{}{level, msg}, args...))
+}
+
+func (f *fakeSlog) Debug(msg string, args ...interface{}) { f.log("debug", msg, args...) }
+func (f *fakeSlog) Info(msg string, args ...interface{})  { f.log("info", msg, args...) }
+func (f *fakeSlog) Warn(msg string, args ...interface{})  { f.log("warn", msg, args...) }
+func (f *fakeSlog) Error(msg string, args ...interface{}) { f.log("error", msg, args...) }
+
+func TestEntry(t *testing.T) {
+
+	r := &recorder{level: DebugLevel}
+	base := New(r).WithObject("/org/bluez/hci0", "org.bluez.Adapter1")
+	e := base.WithMethod("StartDiscovery")
+
+	e.Debugf("Call %d", 1)
+	e.Tracef("skipped")
+	base.Warnf("warn %s", "x")
+
+	assert.Equal(t, []record{
+		{DebugLevel, "Call 1", Fields{
+			FieldPath:      dbus.ObjectPath("/org/bluez/hci0"),
+			FieldInterface: "org.bluez.Adapter1",
+			FieldMethod:    "StartDiscovery",
+		}},
+		{WarnLevel, "warn x", Fields{
+			FieldPath:      dbus.ObjectPath("/org/bluez/hci0"),
+			FieldInterface: "org.bluez.Adapter1",
+		}},
+	}, r.records)
+
+	// entries are not changed by derived ones
+	assert.Len(t, base.Fields(), 2)
+	assert.Equal(t, Fields{FieldPath: dbus.ObjectPath("/a")}, New(r).WithObject("/a", "").Fields())
+}
+
+func TestDefault(t *testing.T) {
+	defer SetDefault(Default())
+
+	r := &recorder{level: TraceLevel}
+	e := WithField("k", "v")
+
+	// the default logger is resolved when logging
+	SetDefault(r)
+	e.Infof("info")
+	Errorf("error")
+	assert.Equal(t, []record{
+		{InfoLevel, "info", Fields{"k": "v"}},
+		{ErrorLevel, "error", Fields{}},
+	}, r.records)
+
+	SetDefault(nil)
+	assert.False(t, Default().Enabled(ErrorLevel))
+	Errorf("dropped")
+	assert.Len(t, r.records, 2)
+}
+
+func TestSlog(t *testing.T) {
+	f := &fakeSlog{}
+	l := New(Slog(f)).WithFields(Fields{"b": 2, "a": 1})
+
+	l.Tracef("trace")
+	l.Warnf("warn")
+
+	assert.Equal(t, [][]interface{}{
+		{"debug", "trace", "a", 1, "b", 2},
+		{"warn", "warn", "a", 1, "b", 2},
+	}, f.calls)
+}
+
+func TestLogrus(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	ll := logrus.New()
+	ll.SetOutput(buf)
+	ll.SetLevel(logrus.InfoLevel)
+	ll.SetFormatter(&logrus.TextFormatter{DisableTimestamp: true})
+
+	l := Logrus(ll)
+	assert.True(t, l.Enabled(WarnLevel))
+	assert.False(t, l.Enabled(DebugLevel))
+	assert.False(t, Logrus(logrus.NewEntry(ll)).Enabled(TraceLevel))
+
+	New(l).WithMethod("Connect").Debugf("skipped")
+	New(l).WithMethod("Connect").Warnf("failed")
+	assert.Equal(t, "level=warning msg=failed method=Connect\n", buf.String())
+}
+
+func TestNop(t *testing.T) {
+	l := Nop()
+	assert.False(t, l.Enabled(ErrorLevel))
+	l.Log(ErrorLevel, "dropped", nil)
+}
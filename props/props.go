@@ -8,7 +8,7 @@ import (
 	"github.com/godbus/dbus/v5"
 	"github.com/godbus/dbus/v5/prop"
 	"github.com/muka/go-bluetooth/bluez"
-	log "github.com/sirupsen/logrus"
+	"github.com/muka/go-bluetooth/logger"
 )
 
 type PropInfo struct {
@@ -61,17 +61,17 @@ func ParseProperties(propertyVal bluez.Properties) map[string]*PropInfo {
 
 					checkField, ok := t.FieldOk(tagValue)
 					if !ok {
-						log.Warnf("%s: field not found,  is it avaialable?", tagValue)
+						logger.Warnf("%s: field not found,  is it avaialable?", tagValue)
 						continue
 					}
 					if !checkField.IsExported() {
-						log.Warnf("%s: field must be exported. (add a tag `ignore` to avoid exposing it as property)", tagValue)
+						logger.Warnf("%s: field must be exported. (add a tag `ignore` to avoid exposing it as property)", tagValue)
 						continue
 					}
 
 					varKind := checkField.Kind()
 					if varKind != reflect.Bool {
-						log.Warnf("%s: ignore tag expect a bool property to check, %s given", tagValue, varKind)
+						logger.Warnf("%s: ignore tag expect a bool property to check, %s given", tagValue, varKind)
 						continue
 					}
 
@@ -5,7 +5,7 @@ import (
 	"reflect"
 
 	"github.com/godbus/dbus/v5"
-	log "github.com/sirupsen/logrus"
+	"github.com/muka/go-bluetooth/logger"
 )
 
 func AssignMapVariantToInterface(mapVal reflect.Value, mapVariant reflect.Value) (bool, error) {
@@ -38,13 +38,13 @@ func AssignMapVariantToInterface(mapVal reflect.Value, mapVariant reflect.Value)
 
 	// receiving value is interface{}
 	if mapValValue.Kind() != reflect.Interface {
-		log.Debugf("val is not interface")
+		logger.Debugf("val is not interface")
 		return false, nil
 	}
 
 	// source value is dbus.Variant
 	if mapVariantValue.Kind() != reflect.TypeOf(dbus.Variant{}).Kind() {
-		log.Debugf("mapVariant value is not variant")
+		logger.Debugf("mapVariant value is not variant")
 		return false, nil
 	}
 
@@ -115,7 +115,7 @@ func mapStructField(obj interface{}, name string, value dbus.Variant) error {
 	}
 
 	if val.Type().Kind() == reflect.Array {
-		log.Warn("@TODO type array to interface{} is not implemented")
+		logger.Warnf("@TODO type array to interface{} is not implemented")
 	}
 
 	return fmt.Errorf("Mismatching types for field=%s object=%s props=%s", name, structFieldType, val.Type())